@@ -31,6 +31,8 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_DelegateGovernance_FullMethodName           = "/cosmos.gov.v1.Msg/DelegateGovernance"
+	Msg_UndelegateGovernance_FullMethodName         = "/cosmos.gov.v1.Msg/UndelegateGovernance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -62,6 +64,10 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// DelegateGovernance defines a method to assign the governance power of an account to a governor.
+	DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to revoke the governance delegation of an account.
+	UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error)
 }
 
 type msgClient struct {
@@ -172,6 +178,26 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateGovernance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUndelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateGovernance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -201,6 +227,10 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// DelegateGovernance defines a method to assign the governance power of an account to a governor.
+	DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to revoke the governance delegation of an account.
+	UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -241,6 +271,12 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernance not implemented")
+}
+func (UnimplementedMsgServer) UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -442,6 +478,42 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateGovernance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernance(ctx, req.(*MsgDelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateGovernance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernance(ctx, req.(*MsgUndelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -489,6 +561,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "DelegateGovernance",
+			Handler:    _Msg_DelegateGovernance_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernance",
+			Handler:    _Msg_UndelegateGovernance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
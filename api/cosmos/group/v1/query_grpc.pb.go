@@ -35,6 +35,8 @@ const (
 	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
 	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
 	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_VoterWeightsByProposal_FullMethodName = "/cosmos.group.v1.Query/VoterWeightsByProposal"
+	Query_QueuedProposals_FullMethodName        = "/cosmos.group.v1.Query/QueuedProposals"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +77,13 @@ type QueryClient interface {
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// VoterWeightsByProposal queries the voter weights snapshotted at the
+	// submission of a proposal of a token-weighted group. Snapshots are pruned
+	// together with the votes once the proposal is final.
+	VoterWeightsByProposal(ctx context.Context, in *QueryVoterWeightsByProposalRequest, opts ...grpc.CallOption) (*QueryVoterWeightsByProposalResponse, error)
+	// QueuedProposals queries the accepted proposals queued behind the timelock
+	// of their decision policy and not executed yet, ordered by timelock end.
+	QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error)
 }
 
 type queryClient struct {
@@ -225,6 +234,26 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VoterWeightsByProposal(ctx context.Context, in *QueryVoterWeightsByProposalRequest, opts ...grpc.CallOption) (*QueryVoterWeightsByProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVoterWeightsByProposalResponse)
+	err := c.cc.Invoke(ctx, Query_VoterWeightsByProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryQueuedProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -263,6 +292,13 @@ type QueryServer interface {
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// VoterWeightsByProposal queries the voter weights snapshotted at the
+	// submission of a proposal of a token-weighted group. Snapshots are pruned
+	// together with the votes once the proposal is final.
+	VoterWeightsByProposal(context.Context, *QueryVoterWeightsByProposalRequest) (*QueryVoterWeightsByProposalResponse, error)
+	// QueuedProposals queries the accepted proposals queued behind the timelock
+	// of their decision policy and not executed yet, ordered by timelock end.
+	QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -315,6 +351,12 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) VoterWeightsByProposal(context.Context, *QueryVoterWeightsByProposalRequest) (*QueryVoterWeightsByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoterWeightsByProposal not implemented")
+}
+func (UnimplementedQueryServer) QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedProposals not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -588,6 +630,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoterWeightsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoterWeightsByProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoterWeightsByProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoterWeightsByProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoterWeightsByProposal(ctx, req.(*QueryVoterWeightsByProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedProposals(ctx, req.(*QueryQueuedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -651,6 +729,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "VoterWeightsByProposal",
+			Handler:    _Query_VoterWeightsByProposal_Handler,
+		},
+		{
+			MethodName: "QueuedProposals",
+			Handler:    _Query_QueuedProposals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
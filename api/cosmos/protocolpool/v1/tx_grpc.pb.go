@@ -26,6 +26,9 @@ const (
 	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
 	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
 	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_CreateGrant_FullMethodName            = "/cosmos.protocolpool.v1.Msg/CreateGrant"
+	Msg_ApproveMilestone_FullMethodName       = "/cosmos.protocolpool.v1.Msg/ApproveMilestone"
+	Msg_CancelGrant_FullMethodName            = "/cosmos.protocolpool.v1.Msg/CancelGrant"
 )
 
 // MsgClient is the client API for Msg service.
@@ -52,6 +55,15 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreateGrant defines a method to create a milestone-based grant, escrowing its funds
+	// from the community pool.
+	CreateGrant(ctx context.Context, in *MsgCreateGrant, opts ...grpc.CallOption) (*MsgCreateGrantResponse, error)
+	// ApproveMilestone defines a method for a reviewer to approve a grant milestone,
+	// paying its amount to the recipient.
+	ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error)
+	// CancelGrant defines a method to cancel a grant, returning the funds of the
+	// milestones not approved yet to the community pool.
+	CancelGrant(ctx context.Context, in *MsgCancelGrant, opts ...grpc.CallOption) (*MsgCancelGrantResponse, error)
 }
 
 type msgClient struct {
@@ -132,6 +144,36 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreateGrant(ctx context.Context, in *MsgCreateGrant, opts ...grpc.CallOption) (*MsgCreateGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateGrantResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApproveMilestoneResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelGrant(ctx context.Context, in *MsgCancelGrant, opts ...grpc.CallOption) (*MsgCancelGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelGrantResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -156,6 +198,15 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreateGrant defines a method to create a milestone-based grant, escrowing its funds
+	// from the community pool.
+	CreateGrant(context.Context, *MsgCreateGrant) (*MsgCreateGrantResponse, error)
+	// ApproveMilestone defines a method for a reviewer to approve a grant milestone,
+	// paying its amount to the recipient.
+	ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error)
+	// CancelGrant defines a method to cancel a grant, returning the funds of the
+	// milestones not approved yet to the community pool.
+	CancelGrant(context.Context, *MsgCancelGrant) (*MsgCancelGrantResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -187,6 +238,15 @@ func (UnimplementedMsgServer) WithdrawContinuousFund(context.Context, *MsgWithdr
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) CreateGrant(context.Context, *MsgCreateGrant) (*MsgCreateGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGrant not implemented")
+}
+func (UnimplementedMsgServer) ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMilestone not implemented")
+}
+func (UnimplementedMsgServer) CancelGrant(context.Context, *MsgCancelGrant) (*MsgCancelGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGrant not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -334,6 +394,60 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGrant(ctx, req.(*MsgCreateGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMilestone(ctx, req.(*MsgApproveMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelGrant(ctx, req.(*MsgCancelGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +483,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreateGrant",
+			Handler:    _Msg_CreateGrant_Handler,
+		},
+		{
+			MethodName: "ApproveMilestone",
+			Handler:    _Msg_ApproveMilestone_Handler,
+		},
+		{
+			MethodName: "CancelGrant",
+			Handler:    _Msg_CancelGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
@@ -46,6 +46,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
@@ -1070,6 +1071,57 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateWithStateOverrides(t *testing.T) {
+	balanceKey := []byte("balance")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			if string(ctx.KVStore(capKey1).Get(balanceKey)) != "rich" {
+				return ctx, sdkerrors.ErrInsufficientFunds
+			}
+			return ctx, nil
+		})
+	}
+
+	for _, enabled := range []bool{false, true} {
+		suite := NewBaseAppSuite(t, anteOpt, baseapp.SetSimulationStateOverrides(enabled))
+		_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, suite.ac, 0, 0))
+		require.NoError(t, err)
+
+		overrides := []*txtypes.StateOverride{{StoreKey: capKey1.Name(), Key: balanceKey, Value: []byte("rich")}}
+		_, _, err = suite.baseApp.SimulateWithStateOverrides(txBytes, nil)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+		_, result, err := suite.baseApp.SimulateWithStateOverrides(txBytes, overrides)
+		if !enabled {
+			require.ErrorContains(t, err, "simulation state overrides are disabled on this node")
+			continue
+		}
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		// the overrides are discarded after the simulation
+		_, _, err = suite.baseApp.Simulate(txBytes)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+		require.Nil(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1).Get(balanceKey))
+
+		// deleting the overridden key
+		overrides = append(overrides, &txtypes.StateOverride{StoreKey: capKey1.Name(), Key: balanceKey})
+		_, _, err = suite.baseApp.SimulateWithStateOverrides(txBytes, overrides)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+		_, _, err = suite.baseApp.SimulateWithStateOverrides(txBytes, []*txtypes.StateOverride{{StoreKey: "unknown", Key: balanceKey}})
+		require.ErrorContains(t, err, "unknown KV store \"unknown\"")
+		_, _, err = suite.baseApp.SimulateWithStateOverrides(txBytes, []*txtypes.StateOverride{{StoreKey: capKey1.Name()}})
+		require.ErrorContains(t, err, "empty key in store \"key1\"")
+	}
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
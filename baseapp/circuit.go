@@ -1,8 +1,21 @@
 package baseapp
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// MsgRateLimiter is an optional interface a CircuitBreaker can implement to throttle
+// the execution of messages. It is enforced by the MsgServiceRouter, so that it also
+// applies to nested messages.
+type MsgRateLimiter interface {
+	// ConsumeMsgRateLimit records an execution of the msg by its signers and returns
+	// an error if it exceeds the rate limit in place for the msg.
+	ConsumeMsgRateLimit(ctx context.Context, msg sdk.Msg) error
+}
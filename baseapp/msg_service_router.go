@@ -128,6 +128,11 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 		if !allowed {
 			return fmt.Errorf("circuit breaker disallows execution of message %s", messageName)
 		}
+		if rateLimiter, ok := msr.circuitBreaker.(MsgRateLimiter); ok {
+			if err := rateLimiter.ConsumeMsgRateLimit(ctx, req); err != nil {
+				return err
+			}
+		}
 		return hybridHandler(ctx, req, resp)
 	}
 	msr.hybridHandlers[string(inputName)] = circuitBreakerHybridHandler
@@ -213,6 +218,12 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			if !isAllowed {
 				return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
 			}
+
+			if rateLimiter, ok := msr.circuitBreaker.(MsgRateLimiter); ok {
+				if err := rateLimiter.ConsumeMsgRateLimit(ctx, msg); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		// Call the method handler from the service description with the handler object.
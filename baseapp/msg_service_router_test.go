@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -16,6 +17,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -203,3 +205,61 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code, "res=%+v", res)
 }
+
+type mockRateLimiter struct {
+	limit    int
+	consumed int
+}
+
+func (m *mockRateLimiter) IsAllowed(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (m *mockRateLimiter) ConsumeMsgRateLimit(context.Context, sdk.Msg) error {
+	if m.consumed == m.limit {
+		return errors.New("rate limited")
+	}
+	m.consumed++
+	return nil
+}
+
+func TestMsgServiceRouterRateLimit(t *testing.T) {
+	// Setup baseapp and router.
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(coretesting.NewMemDB(), nil)
+	testdata.RegisterInterfaces(registry)
+
+	rateLimiter := &mockRateLimiter{limit: 2}
+	app.SetCircuitBreaker(rateLimiter)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+	require.NoError(t, app.Init())
+	ctx := app.NewContext(true)
+
+	msg := &testdata.MsgCreateDog{
+		Dog:   &testdata.Dog{Name: "Spot"},
+		Owner: "me",
+	}
+
+	// both top level and nested messages consume the rate limit
+	_, err = app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+	hybridHandler := app.MsgServiceRouter().HybridHandlerByMsgName("testpb.MsgCreateDog")
+	require.NoError(t, hybridHandler(ctx, msg, new(testdata.MsgCreateDogResponse)))
+	require.Equal(t, 2, rateLimiter.consumed)
+
+	_, err = app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.ErrorContains(t, err, "rate limited")
+	require.ErrorContains(t, hybridHandler(ctx, msg, new(testdata.MsgCreateDogResponse)), "rate limited")
+}
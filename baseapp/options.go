@@ -49,6 +49,12 @@ func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.queryGasLimit = queryGasLimit }
 }
 
+// SetSimulationStateOverrides returns an option that enables or disables state
+// overrides in simulations.
+func SetSimulationStateOverrides(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.simulationStateOverrides = enabled }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
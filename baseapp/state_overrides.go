@@ -0,0 +1,63 @@
+package baseapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// SimulateWithStateOverrides executes a tx in simulate mode, like Simulate, after
+// applying the given overrides to the branch of the state the tx runs against.
+// The overrides are discarded with the branch once the simulation ends. State
+// overrides must be enabled with SetSimulationStateOverrides.
+func (app *BaseApp) SimulateWithStateOverrides(txBytes []byte, overrides []*txtypes.StateOverride) (sdk.GasInfo, *sdk.Result, error) {
+	if len(overrides) == 0 {
+		return app.Simulate(txBytes)
+	}
+
+	if !app.simulationStateOverrides {
+		return sdk.GasInfo{}, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "simulation state overrides are disabled on this node")
+	}
+
+	ctx := app.getContextForTx(execModeSimulate, txBytes)
+	if err := app.applyStateOverrides(ctx, overrides); err != nil {
+		return sdk.GasInfo{}, nil, err
+	}
+
+	gasInfo, result, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+	return gasInfo, result, err
+}
+
+// applyStateOverrides sets or deletes the overridden keys in the KV stores of
+// the given context.
+func (app *BaseApp) applyStateOverrides(ctx sdk.Context, overrides []*txtypes.StateOverride) error {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "state overrides are not supported by the multistore %T", app.cms)
+	}
+
+	keys := rms.StoreKeysByName()
+	for _, override := range overrides {
+		key, ok := keys[override.StoreKey].(*storetypes.KVStoreKey)
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown KV store %q", override.StoreKey)
+		}
+
+		if len(override.Key) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "empty key in store %q", override.StoreKey)
+		}
+
+		store := ctx.MultiStore().GetKVStore(key)
+		if len(override.Value) == 0 {
+			store.Delete(override.Key)
+			continue
+		}
+		store.Set(override.Key, override.Value)
+	}
+
+	return nil
+}
@@ -134,6 +134,15 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
+		// the remote keyring backend signs with the keys of the signer at the given address
+		if remoteAddr, _ := flagSet.GetString(flags.FlagKeyringRemote); remoteAddr != "" {
+			opts := append(slices.Clone(clientCtx.KeyringOptions), keyring.RemoteSigner(remoteAddr))
+			if insecure, _ := flagSet.GetBool(flags.FlagKeyringInsecure); insecure {
+				opts = append(opts, keyring.RemoteSignerInsecure())
+			}
+			clientCtx = clientCtx.WithKeyringOptions(opts...)
+		}
+
 		if keyringBackend != "" {
 			kr, err := NewKeyringFromBackend(clientCtx, keyringBackend)
 			if err != nil {
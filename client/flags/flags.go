@@ -67,6 +67,8 @@ const (
 	FlagSkipConfirmation = "yes"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
+	FlagKeyringRemote    = "keyring-remote-addr"
+	FlagKeyringInsecure  = "keyring-remote-insecure"
 	FlagPage             = "page"
 	FlagLimit            = "limit"
 	FlagSignMode         = "sign-mode"
@@ -153,7 +155,9 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 // AddKeyringFlags sets common keyring flags
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	flags.String(FlagKeyringRemote, "", "The gRPC address of the signer used by the remote keyring backend")
+	flags.Bool(FlagKeyringInsecure, false, "Connect to the remote keyring signer without TLS")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
@@ -41,6 +41,9 @@ const (
 	flagPubKeyBase64 = "pubkey-base64"
 	flagIndiscreet   = "indiscreet"
 	flagMnemonicSrc  = "source"
+	flagShares       = "shares"
+	flagThreshold    = "threshold"
+	flagRecoverShare = "recover-shares"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -74,6 +77,15 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Use the --shares and --threshold flags to split the mnemonic of a new key into share phrases with
+Shamir's secret sharing, instead of printing the mnemonic itself. Any threshold of the shares
+recovers the key with --recover-shares, while fewer shares reveal nothing about it. The shares
+use the BIP39 English wordlist but are not compatible with SLIP39 wallets.
+Example:
+
+    keys add treasury --shares 5 --threshold 3
+    keys add treasury --recover-shares
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -96,6 +108,9 @@ Example:
 	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
 	f.Bool(flagIndiscreet, false, "Print seed phrase directly on current terminal (only valid when --no-backup is false)")
 	f.String(flagMnemonicSrc, "", "Import mnemonic from a file (only usable when recover or interactive is passed)")
+	f.Int(flagShares, 0, "Split the mnemonic into the given number of share phrases instead of printing it")
+	f.Int(flagThreshold, 0, "Number of shares needed to recover the mnemonic. For use in conjunction with --shares")
+	f.Bool(flagRecoverShare, false, "Provide mnemonic share phrases to recover existing key instead of creating (--source reads one share per line)")
 
 	// support old flags name for backwards compatibility
 	f.SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -291,8 +306,34 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	var mnemonic, bip39Passphrase string
 
 	recoverFlag, _ := cmd.Flags().GetBool(flagRecover)
+	recoverShares, _ := cmd.Flags().GetBool(flagRecoverShare)
 	mnemonicSrc, _ := cmd.Flags().GetString(flagMnemonicSrc)
-	if recoverFlag {
+	noBackup, _ := cmd.Flags().GetBool(flagNoBackup)
+
+	numShares, _ := cmd.Flags().GetInt(flagShares)
+	sharesThreshold, _ := cmd.Flags().GetInt(flagThreshold)
+	if numShares != 0 {
+		if recoverFlag || recoverShares {
+			return fmt.Errorf("--%s cannot be used when recovering a key", flagShares)
+		}
+		if noBackup {
+			return fmt.Errorf("--%s cannot be used with --%s", flagShares, flagNoBackup)
+		}
+		if sharesThreshold < 2 || sharesThreshold > numShares || numShares > hd.MaxMnemonicShares {
+			return fmt.Errorf("threshold must be between 2 and the number of shares, and at most %d shares can be created", hd.MaxMnemonicShares)
+		}
+	}
+
+	if recoverShares {
+		if recoverFlag {
+			return fmt.Errorf("flags %s and %s cannot be used simultaneously", flagRecover, flagRecoverShare)
+		}
+
+		mnemonic, err = readMnemonicShares(mnemonicSrc, inBuf)
+		if err != nil {
+			return err
+		}
+	} else if recoverFlag {
 		if mnemonicSrc != "" {
 			mnemonic, err = readMnemonicFromFile(mnemonicSrc)
 			if err != nil {
@@ -365,12 +406,20 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	if err != nil {
 		return err
 	}
-	noBackup, _ := cmd.Flags().GetBool(flagNoBackup)
 	showMnemonic := !noBackup
 	showMnemonicIndiscreetly, _ := cmd.Flags().GetBool(flagIndiscreet)
 
+	if numShares != 0 {
+		shares, err := hd.SplitMnemonic(mnemonic, sharesThreshold, numShares)
+		if err != nil {
+			return err
+		}
+
+		return printCreateShares(ctx, cmd, k, showMnemonicIndiscreetly, shares, sharesThreshold, outputFormat)
+	}
+
 	// Recover key from seed passphrase
-	if recoverFlag {
+	if recoverFlag || recoverShares {
 		// Hide mnemonic from output
 		showMnemonic = false
 		showMnemonicIndiscreetly = false
@@ -429,6 +478,87 @@ func printCreate(ctx client.Context, cmd *cobra.Command, k *keyring.Record, show
 	return nil
 }
 
+func printCreateShares(ctx client.Context, cmd *cobra.Command, k *keyring.Record, showIndiscreetly bool, shares []string, threshold int, outputFormat string) error {
+	ko, err := MkAccKeyOutput(k, ctx.AddressCodec)
+	if err != nil {
+		return err
+	}
+
+	switch outputFormat {
+	case flags.OutputFormatText:
+		cmd.PrintErrln()
+		if err := printKeyringRecord(cmd.OutOrStdout(), ko, outputFormat); err != nil {
+			return err
+		}
+
+		for i, share := range shares {
+			prompt := fmt.Sprintf("**Important** write this mnemonic share %d of %d in a safe place, apart from the other shares.\n"+
+				"Any %d of the shares recover your account if you ever forget your password.", i+1, len(shares), threshold)
+			if showIndiscreetly {
+				if _, err = fmt.Fprintf(cmd.ErrOrStderr(), "\n%s\n\n%s\n", prompt, share); err != nil {
+					return fmt.Errorf("failed to print mnemonic share: %w", err)
+				}
+			} else if err = printDiscreetly(ctx, cmd.ErrOrStderr(), prompt, share); err != nil {
+				return fmt.Errorf("failed to print mnemonic share: %w", err)
+			}
+		}
+	case flags.OutputFormatJSON:
+		jsonString, err := json.Marshal(struct {
+			KeyOutput
+			MnemonicShares []string `json:"mnemonic_shares"`
+		}{ko, shares})
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(string(jsonString))
+
+	default:
+		return fmt.Errorf("invalid output format %s", outputFormat)
+	}
+
+	return nil
+}
+
+// readMnemonicShares reads mnemonic share phrases, one per line, from the given file or prompts for them,
+// and recovers the mnemonic.
+func readMnemonicShares(src string, inBuf *bufio.Reader) (string, error) {
+	var shares []string
+	if src != "" {
+		bz, err := readMnemonicFromFile(src)
+		if err != nil {
+			return "", err
+		}
+
+		for _, line := range strings.Split(bz, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				shares = append(shares, line)
+			}
+		}
+	} else {
+		first, err := input.GetString("Enter your first mnemonic share", inBuf)
+		if err != nil {
+			return "", err
+		}
+
+		share, err := hd.ParseMnemonicShare(first)
+		if err != nil {
+			return "", fmt.Errorf("invalid mnemonic share: %w", err)
+		}
+
+		shares = append(shares, first)
+		for i := 2; i <= share.Threshold; i++ {
+			next, err := input.GetString(fmt.Sprintf("Enter mnemonic share %d of %d", i, share.Threshold), inBuf)
+			if err != nil {
+				return "", err
+			}
+			shares = append(shares, next)
+		}
+	}
+
+	return hd.CombineMnemonicShares(shares)
+}
+
 func readMnemonicFromFile(filePath string) (string, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -3,8 +3,12 @@ package keys
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/go-bip39"
@@ -397,3 +401,82 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func Test_runAddCmdShares(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	type sharesOutput struct {
+		KeyOutput
+		MnemonicShares []string `json:"mnemonic_shares"`
+	}
+
+	runAdd := func(t *testing.T, input string, args ...string) (sharesOutput, error) {
+		t.Helper()
+
+		cmd := AddKeyCommand()
+		cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+		mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+		mockIn.Reset(input)
+
+		kbHome := t.TempDir()
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+		require.NoError(t, err)
+
+		clientCtx := client.Context{}.
+			WithCodec(cdc).
+			WithKeyringDir(kbHome).
+			WithKeyring(kb).
+			WithInput(mockIn).
+			WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+			WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+			WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetArgs(append([]string{"treasury", fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON)}, args...))
+		if err := cmd.ExecuteContext(ctx); err != nil {
+			return sharesOutput{}, err
+		}
+
+		var out sharesOutput
+		require.NoError(t, json.Unmarshal(b.Bytes(), &out))
+		return out, nil
+	}
+
+	created, err := runAdd(t, "", fmt.Sprintf("--%s=5", flagShares), fmt.Sprintf("--%s=3", flagThreshold))
+	require.NoError(t, err)
+	require.Empty(t, created.Mnemonic)
+	require.Len(t, created.MnemonicShares, 5)
+	shares := created.MnemonicShares
+
+	// recover from prompted shares, the threshold is read from the first one
+	recovered, err := runAdd(t, fmt.Sprintf("%s\n%s\n%s\n", shares[4], shares[0], shares[2]), fmt.Sprintf("--%s", flagRecoverShare))
+	require.NoError(t, err)
+	require.Equal(t, created.Address, recovered.Address)
+	require.Empty(t, recovered.Mnemonic)
+	require.Empty(t, recovered.MnemonicShares)
+
+	// recover from a file holding one share per line
+	src := filepath.Join(t.TempDir(), "shares.txt")
+	require.NoError(t, os.WriteFile(src, []byte(strings.Join(shares[1:4], "\n")+"\n"), 0o600))
+	recovered, err = runAdd(t, "", fmt.Sprintf("--%s", flagRecoverShare), fmt.Sprintf("--%s=%s", flagMnemonicSrc, src))
+	require.NoError(t, err)
+	require.Equal(t, created.Address, recovered.Address)
+
+	require.NoError(t, os.WriteFile(src, []byte(strings.Join(shares[1:3], "\n")), 0o600))
+	_, err = runAdd(t, "", fmt.Sprintf("--%s", flagRecoverShare), fmt.Sprintf("--%s=%s", flagMnemonicSrc, src))
+	require.ErrorContains(t, err, "3 shares are needed to recover the mnemonic, got 2")
+
+	_, err = runAdd(t, "", fmt.Sprintf("--%s=3", flagShares), fmt.Sprintf("--%s=4", flagThreshold))
+	require.ErrorContains(t, err, "threshold must be between 2 and the number of shares")
+
+	_, err = runAdd(t, "", fmt.Sprintf("--%s=3", flagShares), fmt.Sprintf("--%s=1", flagThreshold))
+	require.ErrorContains(t, err, "threshold must be between 2 and the number of shares")
+
+	_, err = runAdd(t, "", fmt.Sprintf("--%s=3", flagShares), fmt.Sprintf("--%s=2", flagThreshold), fmt.Sprintf("--%s", flagNoBackup))
+	require.ErrorContains(t, err, "--shares cannot be used with --no-backup")
+
+	_, err = runAdd(t, "", fmt.Sprintf("--%s", flagRecoverShare), fmt.Sprintf("--%s", flagRecover))
+	require.ErrorContains(t, err, "cannot be used simultaneously")
+}
@@ -0,0 +1,86 @@
+package keys
+
+import (
+	"errors"
+	"net"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagListenAddr = "listen-addr"
+	flagTLSCert    = "tls-cert"
+	flagTLSKey     = "tls-key"
+)
+
+// RemoteSignerCommand serves the keys of the keyring to the remote keyring backend.
+func RemoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer",
+		Short: "Serve the keys of the keyring to the remote keyring backend",
+		Long: `Serve the keys of the keyring with the RemoteSigner gRPC service, so that a client using
+the remote keyring backend (--keyring-backend remote --keyring-remote-addr <addr>) can list the keys
+and sign with them without the private keys leaving this host.
+
+Clients require TLS by default: serve it with --tls-cert and --tls-key, or connect the clients with
+--keyring-remote-insecure when the signer serves plain gRPC on a trusted network.
+
+This is a reference signer: it signs every request it receives and does not authenticate clients.
+Only listen on a trusted network, or put it behind a proxy authenticating clients.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Keyring == nil {
+				return errors.New("no keyring configured")
+			}
+			if clientCtx.Keyring.Backend() == keyring.BackendRemote {
+				return errors.New("the remote signer cannot serve the keys of a remote keyring")
+			}
+
+			var srvOpts []grpc.ServerOption
+			certFile, _ := cmd.Flags().GetString(flagTLSCert)
+			keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+			if (certFile == "") != (keyFile == "") {
+				return errors.New("both --tls-cert and --tls-key must be set to serve TLS")
+			}
+			if certFile != "" {
+				creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+				if err != nil {
+					return err
+				}
+				srvOpts = append(srvOpts, grpc.Creds(creds))
+			}
+
+			addr, _ := cmd.Flags().GetString(flagListenAddr)
+			lis, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+
+			srv := grpc.NewServer(srvOpts...)
+			keyring.RegisterRemoteSignerServer(srv, keyring.NewRemoteSigner(clientCtx.Keyring))
+			go func() {
+				<-cmd.Context().Done()
+				srv.GracefulStop()
+			}()
+
+			cmd.PrintErrf("remote signer listening on %s\n", lis.Addr())
+			return srv.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flagListenAddr, "localhost:9190", "The address the remote signer listens on")
+	cmd.Flags().String(flagTLSCert, "", "The TLS certificate file served to the clients")
+	cmd.Flags().String(flagTLSKey, "", "The TLS private key file of the certificate")
+
+	return cmd
+}
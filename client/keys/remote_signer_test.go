@@ -0,0 +1,63 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func Test_runRemoteSignerCmd(t *testing.T) {
+	cmd := RemoteSignerCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), mockIn, cdc)
+	require.NoError(t, err)
+	_, err = kb.NewAccount("something", testdata.TestMnemonic, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	// reserve a free port for the signer
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	clientCtx := client.Context{}.WithKeyring(kb)
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	defer cancel()
+
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flagListenAddr, addr)})
+	done := make(chan error)
+	go func() { done <- cmd.ExecuteContext(ctx) }()
+
+	remote, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendRemote, "", nil, cdc, keyring.RemoteSigner(addr), keyring.RemoteSignerInsecure())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		records, err := remote.List()
+		return err == nil && len(records) == 1 && records[0].Name == "something"
+	}, 5*time.Second, 50*time.Millisecond)
+
+	msg := []byte("message to sign")
+	sig, pk, err := remote.Sign("something", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pk.VerifySignature(msg, sig))
+
+	cancel()
+	require.NoError(t, <-done)
+}
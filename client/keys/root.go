@@ -26,6 +26,8 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Delegates listing keys and signing to a remote signer over gRPC, set with
+                --keyring-remote-addr. Private keys are held by the signer and never read locally.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -33,6 +35,10 @@ information:
     pass        https://www.passwordstore.org/
 
 The pass backend requires GnuPG: https://gnupg.org/
+
+The remote-signer command serves the keys of another backend to the remote backend, for testing it
+locally or as a reference for signing services implementing the cosmos.crypto.keyring.v1.RemoteSigner
+gRPC service.
 `,
 	}
 
@@ -49,6 +55,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		RemoteSignerCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
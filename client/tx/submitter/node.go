@@ -0,0 +1,89 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// ErrTxNotFound is returned by Node.GetTx when the transaction is not committed.
+var ErrTxNotFound = errors.New("tx not found")
+
+// Node is the connection to the chain used by the Submitter to track the
+// account sequence, broadcast transactions and confirm them.
+type Node interface {
+	// AccountNumberSequence returns the account number and the committed
+	// sequence of the given address.
+	AccountNumberSequence(ctx context.Context, addr sdk.AccAddress) (accNum, seq uint64, err error)
+	// Simulate simulates the given transaction and returns the gas it used.
+	Simulate(ctx context.Context, txBytes []byte) (gasUsed uint64, err error)
+	// BroadcastTx broadcasts the given transaction and returns once it went
+	// through CheckTx.
+	BroadcastTx(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error)
+	// GetTx returns the result of the committed transaction with the given
+	// hash, or ErrTxNotFound if it is not committed.
+	GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error)
+	// LatestBlockTime returns the time of the latest committed block.
+	LatestBlockTime(ctx context.Context) (time.Time, error)
+}
+
+// NewNode returns a Node using the given client context. The context must have
+// its account retriever and its node or gRPC client set.
+func NewNode(clientCtx client.Context) Node {
+	return clientNode{clientCtx: clientCtx}
+}
+
+type clientNode struct {
+	clientCtx client.Context
+}
+
+func (n clientNode) AccountNumberSequence(_ context.Context, addr sdk.AccAddress) (accNum, seq uint64, err error) {
+	return n.clientCtx.AccountRetriever.GetAccountNumberSequence(n.clientCtx, addr)
+}
+
+func (n clientNode) Simulate(ctx context.Context, txBytes []byte) (uint64, error) {
+	res, err := tx.NewServiceClient(n.clientCtx).Simulate(ctx, &tx.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GasInfo.GasUsed, nil
+}
+
+func (n clientNode) BroadcastTx(_ context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	return n.clientCtx.WithBroadcastMode(flags.BroadcastSync).BroadcastTx(txBytes)
+}
+
+func (n clientNode) GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	res, err := tx.NewServiceClient(n.clientCtx).GetTx(ctx, &tx.GetTxRequest{Hash: hash})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrTxNotFound
+		}
+
+		return nil, err
+	}
+
+	return res.TxResponse, nil
+}
+
+func (n clientNode) LatestBlockTime(ctx context.Context) (time.Time, error) {
+	res, err := cmtservice.NewServiceClient(n.clientCtx).GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if res.SdkBlock == nil {
+		return time.Time{}, errors.New("latest block not found")
+	}
+
+	return res.SdkBlock.Header.Time, nil
+}
@@ -0,0 +1,686 @@
+// Package submitter implements a transaction submitter broadcasting many
+// transactions per block from a single key.
+//
+// Unlike tx.BroadcastTx, which queries the account sequence before every
+// transaction, the Submitter tracks the sequence of the account locally and
+// pipelines transactions without waiting for them to be committed. Submitted
+// messages are batched into transactions, which are re-signed and broadcast
+// again when their sequence does not match the one expected by the node, or
+// when they are evicted from the mempool. Confirmations are reported through
+// the Submission returned by Submit and through events.
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Config defines the batching, pipelining and retry behavior of a Submitter.
+type Config struct {
+	// MaxMsgsPerTx is the maximum number of messages batched into a single
+	// transaction. Messages submitted together are always part of the same
+	// transaction, even if they exceed it.
+	MaxMsgsPerTx int
+	// MaxInFlight is the maximum number of broadcast transactions waiting to
+	// be committed.
+	MaxInFlight int
+	// BatchInterval is the duration submitted messages wait for other
+	// messages to be batched with before being broadcast.
+	BatchInterval time.Duration
+	// PollInterval is the interval at which broadcast transactions are checked
+	// for confirmation.
+	PollInterval time.Duration
+	// ConfirmTimeout is the duration after which a broadcast transaction which
+	// is not committed is considered evicted from the mempool, and is re-signed
+	// and broadcast again. Unordered transactions are only broadcast again once
+	// a block committed after their timeout timestamp.
+	ConfirmTimeout time.Duration
+	// MaxRetries is the number of times a transaction is re-signed and
+	// broadcast again before failing.
+	MaxRetries int
+	// UnorderedTimeout is the duration after which unordered transactions
+	// time out. It is only used when the factory is unordered.
+	UnorderedTimeout time.Duration
+	// EventHandler is called with the events of the submitted transactions.
+	// It is called from the goroutine running the Submitter and must not
+	// block.
+	EventHandler func(Event)
+}
+
+// DefaultConfig returns the default Submitter config.
+func DefaultConfig() Config {
+	return Config{
+		MaxMsgsPerTx:     10,
+		MaxInFlight:      64,
+		BatchInterval:    100 * time.Millisecond,
+		PollInterval:     time.Second,
+		ConfirmTimeout:   30 * time.Second,
+		MaxRetries:       5,
+		UnorderedTimeout: 5 * time.Minute,
+	}
+}
+
+func (c Config) validate() error {
+	if c.MaxMsgsPerTx <= 0 {
+		return errors.New("max msgs per tx must be positive")
+	}
+	if c.MaxInFlight <= 0 {
+		return errors.New("max in flight must be positive")
+	}
+	if c.PollInterval <= 0 {
+		return errors.New("poll interval must be positive")
+	}
+	if c.ConfirmTimeout <= 0 {
+		return errors.New("confirm timeout must be positive")
+	}
+	if c.BatchInterval < 0 || c.MaxRetries < 0 || c.UnorderedTimeout < 0 {
+		return errors.New("batch interval, max retries and unordered timeout must not be negative")
+	}
+
+	return nil
+}
+
+// EventType defines the type of an Event.
+type EventType int
+
+const (
+	// EventBroadcast is emitted when a transaction is accepted in the mempool.
+	EventBroadcast EventType = iota
+	// EventRetry is emitted when a transaction is re-signed to be broadcast
+	// again.
+	EventRetry
+	// EventConfirmed is emitted when a transaction is committed successfully.
+	EventConfirmed
+	// EventFailed is emitted when a transaction fails, either in CheckTx, when
+	// committed, or after exhausting its retries.
+	EventFailed
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventBroadcast:
+		return "broadcast"
+	case EventRetry:
+		return "retry"
+	case EventConfirmed:
+		return "confirmed"
+	case EventFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
+// Event reports a change in the state of a submitted transaction.
+type Event struct {
+	Type EventType
+	// TxHash is the hash of the transaction, empty if it was not signed.
+	TxHash string
+	// Sequence is the sequence the transaction was signed with.
+	Sequence uint64
+	// Msgs are the messages of the transaction.
+	Msgs []sdk.Msg
+	// Response is the response of the node, set once broadcast or committed.
+	Response *sdk.TxResponse
+	// Err is the error which caused a retry or a failure.
+	Err error
+}
+
+// Submission tracks messages submitted together until their transaction is
+// committed or fails.
+type Submission struct {
+	msgs []sdk.Msg
+	done chan struct{}
+	res  *sdk.TxResponse
+	err  error
+}
+
+// Done returns a channel closed once the transaction is committed or failed.
+func (s *Submission) Done() <-chan struct{} { return s.done }
+
+// Result returns the response of the committed transaction, or the error it
+// failed with. It must only be called once Done is closed.
+func (s *Submission) Result() (*sdk.TxResponse, error) { return s.res, s.err }
+
+// Wait blocks until the transaction is committed or failed, and returns its
+// result.
+func (s *Submission) Wait(ctx context.Context) (*sdk.TxResponse, error) {
+	select {
+	case <-s.done:
+		return s.res, s.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *Submission) finish(res *sdk.TxResponse, err error) {
+	s.res, s.err = res, err
+	close(s.done)
+}
+
+// pendingTx is a batch of submissions signed into a single transaction.
+type pendingTx struct {
+	subs []*Submission
+	msgs []sdk.Msg
+
+	hash        string
+	sequence    uint64
+	timeout     time.Time
+	broadcastAt time.Time
+	retries     int
+}
+
+// Submitter signs and broadcasts submitted messages from a single key. It must
+// be started with Run.
+type Submitter struct {
+	clientCtx client.Context
+	txf       clienttx.Factory
+	node      Node
+	cfg       Config
+	fromName  string
+	fromAddr  sdk.AccAddress
+
+	mu      sync.Mutex
+	queue   []*Submission
+	running bool
+	stopped bool
+	wake    chan struct{}
+
+	// the fields below are only accessed from the goroutine running Run.
+	accountNumber uint64
+	sequence      uint64
+	retryQueue    []*pendingTx
+	inFlight      []*pendingTx
+}
+
+// New creates a Submitter signing with the key and the options of the given
+// factory, and broadcasting through the given node. The client context is
+// used for signing and encoding the transactions.
+func New(clientCtx client.Context, txf clienttx.Factory, node Node, cfg Config) (*Submitter, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if txf.Keybase() == nil {
+		return nil, errors.New("keybase must be set to sign transactions")
+	}
+
+	fromName := txf.FromName()
+	if fromName == "" {
+		fromName = clientCtx.FromName
+	}
+
+	k, err := txf.Keybase().Key(fromName)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAddr, err := k.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Submitter{
+		clientCtx: clientCtx,
+		txf:       txf.WithTxConfig(clientCtx.TxConfig),
+		node:      node,
+		cfg:       cfg,
+		fromName:  fromName,
+		fromAddr:  fromAddr,
+		wake:      make(chan struct{}, 1),
+	}, nil
+}
+
+// Submit queues the given messages to be broadcast in the same transaction,
+// and returns the Submission tracking them.
+func (s *Submitter) Submit(msgs ...sdk.Msg) (*Submission, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no messages to submit")
+	}
+
+	for _, msg := range msgs {
+		m, ok := msg.(sdk.HasValidateBasic)
+		if !ok {
+			continue
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return nil, errors.New("submitter is stopped")
+	}
+
+	sub := &Submission{msgs: msgs, done: make(chan struct{})}
+	s.queue = append(s.queue, sub)
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+
+	return sub, nil
+}
+
+// Run broadcasts the submitted messages and tracks their transactions until
+// the given context is done. The submissions which are not committed by then
+// fail.
+func (s *Submitter) Run(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running || s.stopped {
+		s.mu.Unlock()
+		return errors.New("submitter is already running or stopped")
+	}
+	s.running = true
+	s.mu.Unlock()
+
+	defer s.stop(ctx)
+
+	accNum, seq, err := s.node.AccountNumberSequence(ctx, s.fromAddr)
+	if err != nil {
+		return fmt.Errorf("failed to query account %s: %w", s.fromAddr, err)
+	}
+	s.accountNumber, s.sequence = accNum, seq
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-s.wake:
+			if s.cfg.BatchInterval > 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(s.cfg.BatchInterval):
+				}
+			}
+			s.flush(ctx)
+
+		case <-ticker.C:
+			s.poll(ctx)
+			s.flush(ctx)
+		}
+	}
+}
+
+// stop fails all the submissions which are not committed.
+func (s *Submitter) stop(ctx context.Context) {
+	s.mu.Lock()
+	s.stopped = true
+	queue := s.queue
+	s.queue = nil
+	s.mu.Unlock()
+
+	err := errors.New("submitter stopped")
+	if ctx.Err() != nil {
+		err = fmt.Errorf("submitter stopped: %w", ctx.Err())
+	}
+
+	for _, sub := range queue {
+		sub.finish(nil, err)
+	}
+	for _, ptx := range append(s.retryQueue, s.inFlight...) {
+		for _, sub := range ptx.subs {
+			sub.finish(nil, err)
+		}
+	}
+	s.retryQueue, s.inFlight = nil, nil
+}
+
+// flush broadcasts the transactions to retry, then batches the queued
+// submissions into new transactions, as long as the in flight limit allows.
+func (s *Submitter) flush(ctx context.Context) {
+	for len(s.inFlight) < s.cfg.MaxInFlight && ctx.Err() == nil {
+		if len(s.retryQueue) > 0 {
+			ptx := s.retryQueue[0]
+			s.retryQueue = s.retryQueue[1:]
+			s.broadcast(ctx, ptx)
+			continue
+		}
+
+		ptx := s.nextBatch()
+		if ptx == nil {
+			return
+		}
+		s.broadcast(ctx, ptx)
+	}
+}
+
+// nextBatch removes the next submissions from the queue, up to the maximum
+// number of messages per transaction.
+func (s *Submitter) nextBatch() *pendingTx {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.queue) == 0 {
+		return nil
+	}
+
+	ptx := &pendingTx{}
+	for len(s.queue) > 0 {
+		sub := s.queue[0]
+		if len(ptx.msgs) > 0 && len(ptx.msgs)+len(sub.msgs) > s.cfg.MaxMsgsPerTx {
+			break
+		}
+
+		ptx.subs = append(ptx.subs, sub)
+		ptx.msgs = append(ptx.msgs, sub.msgs...)
+		s.queue = s.queue[1:]
+	}
+
+	return ptx
+}
+
+// broadcast signs the given transaction with the next sequence and broadcasts
+// it.
+func (s *Submitter) broadcast(ctx context.Context, ptx *pendingTx) {
+	txBytes, err := s.sign(ctx, ptx)
+	if err != nil {
+		s.fail(ptx, nil, err)
+		return
+	}
+
+	res, err := s.node.BroadcastTx(ctx, txBytes)
+	switch {
+	case err != nil:
+		s.retry(ptx, err)
+
+	case res.Code == 0 || isABCIError(res, sdkerrors.ErrTxInMempoolCache):
+		ptx.broadcastAt = time.Now()
+		s.inFlight = append(s.inFlight, ptx)
+		if !s.txf.Unordered() {
+			s.sequence++
+		}
+		s.emit(EventBroadcast, ptx, res, nil)
+
+	case isABCIError(res, sdkerrors.ErrWrongSequence):
+		expected, ok := parseExpectedSequence(res.RawLog)
+		if !ok {
+			_, expected, err = s.node.AccountNumberSequence(ctx, s.fromAddr)
+			if err != nil {
+				s.retry(ptx, err)
+				return
+			}
+		}
+		s.resync(expected)
+		s.retry(ptx, abciError(res))
+
+	case isABCIError(res, sdkerrors.ErrMempoolIsFull):
+		s.retry(ptx, abciError(res))
+
+	default:
+		s.fail(ptx, res, abciError(res))
+	}
+}
+
+// sign builds and signs the given transaction with the next sequence, and
+// returns its bytes.
+func (s *Submitter) sign(ctx context.Context, ptx *pendingTx) ([]byte, error) {
+	txf := s.txf.
+		WithAccountNumber(s.accountNumber).
+		WithSequence(s.sequence)
+	if txf.Unordered() {
+		ptx.timeout = time.Now().Add(s.cfg.UnorderedTimeout)
+		txf = txf.WithTimeoutTimestamp(ptx.timeout)
+	}
+
+	if txf.SimulateAndExecute() {
+		simBytes, err := txf.BuildSimTx(ptx.msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		gasUsed, err := s.node.Simulate(ctx, simBytes)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(uint64(txf.GasAdjustment() * float64(gasUsed)))
+	}
+
+	builder, err := txf.BuildUnsignedTx(ptx.msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := clienttx.Sign(s.clientCtx.WithCmdContext(ctx), txf, s.fromName, builder, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	ptx.sequence = txf.Sequence()
+	ptx.hash = fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+
+	return txBytes, nil
+}
+
+// poll checks whether the transactions in flight are committed, and broadcasts
+// again the ones evicted from the mempool.
+func (s *Submitter) poll(ctx context.Context) {
+	now := time.Now()
+	evicted := false
+
+	// the local clock may run ahead of the chain, so unordered transactions
+	// are only considered timed out once a block committed after their
+	// timeout. The block time is queried before the transactions, so that a
+	// transaction not found then can no longer be committed.
+	var blockTime time.Time
+	if s.txf.Unordered() && s.pastTimeout(now) {
+		t, err := s.node.LatestBlockTime(ctx)
+		if err == nil {
+			blockTime = t
+		}
+	}
+
+	inFlight := s.inFlight[:0]
+	for _, ptx := range s.inFlight {
+		res, err := s.node.GetTx(ctx, ptx.hash)
+		switch {
+		case err == nil && res.Code == 0:
+			s.confirm(ptx, res)
+
+		case err == nil:
+			s.fail(ptx, res, abciError(res))
+
+		case errors.Is(err, ErrTxNotFound) && s.isEvicted(ptx, now, blockTime):
+			evicted = true
+			inFlight = append(inFlight, ptx)
+
+		default:
+			inFlight = append(inFlight, ptx)
+		}
+	}
+	s.inFlight = inFlight
+
+	if !evicted {
+		return
+	}
+
+	if s.txf.Unordered() {
+		// timed out unordered transactions can no longer be committed, so they
+		// are broadcast again with a new timeout.
+		inFlight = s.inFlight[:0]
+		for _, ptx := range s.inFlight {
+			if s.isEvicted(ptx, now, blockTime) {
+				s.retry(ptx, errors.New("unordered transaction timed out"))
+				continue
+			}
+			inFlight = append(inFlight, ptx)
+		}
+		s.inFlight = inFlight
+		return
+	}
+
+	_, committed, err := s.node.AccountNumberSequence(ctx, s.fromAddr)
+	if err != nil {
+		return
+	}
+
+	// the transactions signed with a sequence which was used by another
+	// transaction can no longer be committed, while the other ones are
+	// broadcast again from the committed sequence.
+	resync := false
+	inFlight = s.inFlight[:0]
+	for _, ptx := range s.inFlight {
+		switch {
+		case !s.isEvicted(ptx, now, blockTime):
+		case ptx.sequence < committed:
+			s.fail(ptx, nil, fmt.Errorf("sequence %d was used by another transaction", ptx.sequence))
+			continue
+		default:
+			resync = true
+		}
+		inFlight = append(inFlight, ptx)
+	}
+	s.inFlight = inFlight
+
+	if resync {
+		s.resync(committed)
+	}
+}
+
+// pastTimeout returns whether the local clock is past the timeout of any of
+// the transactions in flight.
+func (s *Submitter) pastTimeout(now time.Time) bool {
+	for _, ptx := range s.inFlight {
+		if !ptx.timeout.IsZero() && now.After(ptx.timeout) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isEvicted returns whether the given transaction waited for its confirmation
+// longer than the confirm timeout, or, if unordered, whether the latest block
+// committed after its own timeout.
+func (s *Submitter) isEvicted(ptx *pendingTx, now, blockTime time.Time) bool {
+	if !ptx.timeout.IsZero() {
+		return blockTime.After(ptx.timeout)
+	}
+
+	return now.Sub(ptx.broadcastAt) > s.cfg.ConfirmTimeout
+}
+
+// resync sets the sequence of the account to the one expected by the node.
+// When ordered, the transactions in flight signed with the same or a later
+// sequence can no longer be committed, and are queued to be re-signed and
+// broadcast again, in the order of their sequence.
+func (s *Submitter) resync(expected uint64) {
+	s.sequence = expected
+	if s.txf.Unordered() {
+		return
+	}
+
+	var stale []*pendingTx
+	inFlight := s.inFlight[:0]
+	for _, ptx := range s.inFlight {
+		if ptx.sequence >= expected {
+			stale = append(stale, ptx)
+			continue
+		}
+		inFlight = append(inFlight, ptx)
+	}
+	s.inFlight = inFlight
+
+	retryQueue := s.retryQueue
+	s.retryQueue = nil
+	for _, ptx := range stale {
+		s.retry(ptx, fmt.Errorf("account sequence reset to %d", expected))
+	}
+	s.retryQueue = append(s.retryQueue, retryQueue...)
+}
+
+// retry queues the given transaction to be re-signed and broadcast again,
+// unless it exhausted its retries.
+func (s *Submitter) retry(ptx *pendingTx, err error) {
+	if ptx.retries >= s.cfg.MaxRetries {
+		s.fail(ptx, nil, fmt.Errorf("max retries exceeded: %w", err))
+		return
+	}
+
+	ptx.retries++
+	s.retryQueue = append(s.retryQueue, ptx)
+	s.emit(EventRetry, ptx, nil, err)
+}
+
+func (s *Submitter) confirm(ptx *pendingTx, res *sdk.TxResponse) {
+	for _, sub := range ptx.subs {
+		sub.finish(res, nil)
+	}
+	s.emit(EventConfirmed, ptx, res, nil)
+}
+
+func (s *Submitter) fail(ptx *pendingTx, res *sdk.TxResponse, err error) {
+	for _, sub := range ptx.subs {
+		sub.finish(res, err)
+	}
+	s.emit(EventFailed, ptx, res, err)
+}
+
+func (s *Submitter) emit(typ EventType, ptx *pendingTx, res *sdk.TxResponse, err error) {
+	if s.cfg.EventHandler == nil {
+		return
+	}
+
+	s.cfg.EventHandler(Event{
+		Type:     typ,
+		TxHash:   ptx.hash,
+		Sequence: ptx.sequence,
+		Msgs:     ptx.msgs,
+		Response: res,
+		Err:      err,
+	})
+}
+
+// isABCIError returns whether the given response failed with the given error.
+func isABCIError(res *sdk.TxResponse, err *errorsmod.Error) bool {
+	return res.Codespace == err.Codespace() && res.Code == err.ABCICode()
+}
+
+// abciError returns the error the given response failed with.
+func abciError(res *sdk.TxResponse) error {
+	return errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+}
+
+var expectedSequenceRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// parseExpectedSequence parses the sequence expected by the node from the log
+// of a sequence mismatch.
+func parseExpectedSequence(log string) (uint64, bool) {
+	matches := expectedSequenceRegexp.FindStringSubmatch(log)
+	if matches == nil {
+		return 0, false
+	}
+
+	seq, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return seq, true
+}
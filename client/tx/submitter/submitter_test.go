@@ -0,0 +1,462 @@
+package submitter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const keyName = "submitter"
+
+// mockNode is an in memory chain, whose mempool txs are committed on
+// every GetTx call unless paused.
+type mockNode struct {
+	txConfig client.TxConfig
+
+	mu         sync.Mutex
+	committed  uint64
+	checkSeq   uint64
+	mempool    []mockTx
+	txs        map[string]*sdk.TxResponse
+	paused     bool
+	broadcasts []mockTx
+	checkTxErr func(tx mockTx) *sdk.TxResponse
+	blockTime  time.Time
+}
+
+type mockTx struct {
+	hash      string
+	sequence  uint64
+	unordered bool
+	timeout   time.Time
+	msgs      int
+}
+
+func newMockNode(txConfig client.TxConfig) *mockNode {
+	return &mockNode{txConfig: txConfig, txs: map[string]*sdk.TxResponse{}}
+}
+
+func (m *mockNode) AccountNumberSequence(context.Context, sdk.AccAddress) (uint64, uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return 1, m.committed, nil
+}
+
+func (m *mockNode) Simulate(context.Context, []byte) (uint64, error) {
+	return 100_000, nil
+}
+
+func (m *mockNode) BroadcastTx(_ context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	decoded, err := m.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigTx := decoded.(signing.Tx)
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := mockTx{
+		hash:      fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()),
+		sequence:  sigs[0].Sequence,
+		unordered: sigTx.GetUnordered(),
+		timeout:   sigTx.GetTimeoutTimeStamp(),
+		msgs:      len(decoded.GetMsgs()),
+	}
+	m.broadcasts = append(m.broadcasts, tx)
+
+	if m.checkTxErr != nil {
+		if res := m.checkTxErr(tx); res != nil {
+			return res, nil
+		}
+	}
+
+	if tx.sequence != m.checkSeq {
+		return &sdk.TxResponse{
+			TxHash:    tx.hash,
+			Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			RawLog:    fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", m.checkSeq, tx.sequence),
+		}, nil
+	}
+
+	m.mempool = append(m.mempool, tx)
+	if !tx.unordered {
+		m.checkSeq++
+	}
+
+	return &sdk.TxResponse{TxHash: tx.hash}, nil
+}
+
+func (m *mockNode) GetTx(_ context.Context, hash string) (*sdk.TxResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.paused {
+		for _, tx := range m.mempool {
+			m.txs[tx.hash] = &sdk.TxResponse{TxHash: tx.hash, Height: 1}
+			if !tx.unordered {
+				m.committed++
+			}
+		}
+		m.mempool = nil
+	}
+
+	res, ok := m.txs[hash]
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+
+	return res, nil
+}
+
+func (m *mockNode) LatestBlockTime(context.Context) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.blockTime, nil
+}
+
+func (m *mockNode) setBlockTime(blockTime time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blockTime = blockTime
+}
+
+// evict drops the txs of the mempool, as a node restart would.
+func (m *mockNode) evict() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mempool = nil
+	m.checkSeq = m.committed
+}
+
+func (m *mockNode) setPaused(paused bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.paused = paused
+}
+
+func (m *mockNode) getBroadcasts() []mockTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]mockTx(nil), m.broadcasts...)
+}
+
+func testConfig() Config {
+	cfg := DefaultConfig()
+	cfg.BatchInterval = 0
+	cfg.PollInterval = 10 * time.Millisecond
+	cfg.ConfirmTimeout = 100 * time.Millisecond
+	return cfg
+}
+
+func setupSubmitter(t *testing.T, cfg Config, unordered bool) (*Submitter, *mockNode, string) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(testutil.CodecOptions{})
+	countertypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	kb, err := keyring.New(t.Name(), keyring.BackendMemory, t.TempDir(), nil, encCfg.Codec)
+	require.NoError(t, err)
+	k, _, err := kb.NewMnemonic(keyName, keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	ac := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	addrStr, err := ac.BytesToString(addr)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithAddressCodec(ac).
+		WithChainID("test-chain")
+	txf := clienttx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithKeybase(kb).
+		WithFromName(keyName).
+		WithChainID("test-chain").
+		WithGas(200_000).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+		WithUnordered(unordered)
+
+	node := newMockNode(encCfg.TxConfig)
+	s, err := New(clientCtx, txf, node, cfg)
+	require.NoError(t, err)
+
+	return s, node, addrStr
+}
+
+func runSubmitter(t *testing.T, s *Submitter) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = s.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func submit(t *testing.T, s *Submitter, signer string, n int) []*Submission {
+	t.Helper()
+
+	subs := make([]*Submission, n)
+	for i := range subs {
+		sub, err := s.Submit(&countertypes.MsgIncreaseCounter{Signer: signer, Count: int64(i + 1)})
+		require.NoError(t, err)
+		subs[i] = sub
+	}
+
+	return subs
+}
+
+func waitAll(t *testing.T, subs []*Submission) []error {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	errs := make([]error, len(subs))
+	for i, sub := range subs {
+		_, errs[i] = sub.Wait(ctx)
+		require.NotErrorIs(t, errs[i], context.DeadlineExceeded)
+	}
+
+	return errs
+}
+
+func TestSubmitterBatchesAndPipelines(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxMsgsPerTx = 2
+
+	var (
+		mu     sync.Mutex
+		events []EventType
+	)
+	cfg.EventHandler = func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, e.Type)
+	}
+
+	s, node, signer := setupSubmitter(t, cfg, false)
+	node.setPaused(true)
+
+	subs := submit(t, s, signer, 5)
+	multi, err := s.Submit(
+		&countertypes.MsgIncreaseCounter{Signer: signer, Count: 1},
+		&countertypes.MsgIncreaseCounter{Signer: signer, Count: 2},
+		&countertypes.MsgIncreaseCounter{Signer: signer, Count: 3},
+	)
+	require.NoError(t, err)
+	subs = append(subs, multi)
+	runSubmitter(t, s)
+
+	// all the txs are broadcast before any of them is committed
+	require.Eventually(t, func() bool { return len(node.getBroadcasts()) == 4 }, time.Second, 5*time.Millisecond)
+	node.setPaused(false)
+
+	for _, err := range waitAll(t, subs) {
+		require.NoError(t, err)
+	}
+
+	broadcasts := node.getBroadcasts()
+	require.Len(t, broadcasts, 4)
+	for i, tx := range broadcasts {
+		require.Equal(t, uint64(i), tx.sequence)
+	}
+	require.Equal(t, []int{2, 2, 1, 3}, []int{broadcasts[0].msgs, broadcasts[1].msgs, broadcasts[2].msgs, broadcasts[3].msgs})
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []EventType{EventBroadcast, EventBroadcast, EventBroadcast, EventBroadcast, EventConfirmed, EventConfirmed, EventConfirmed, EventConfirmed}, events)
+}
+
+func TestSubmitterResyncsSequence(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxMsgsPerTx = 1
+	s, node, signer := setupSubmitter(t, cfg, false)
+
+	// other txs of the account are in the mempool
+	node.checkSeq = 3
+	subs := submit(t, s, signer, 2)
+	runSubmitter(t, s)
+
+	for _, err := range waitAll(t, subs) {
+		require.NoError(t, err)
+	}
+
+	broadcasts := node.getBroadcasts()
+	require.Len(t, broadcasts, 3)
+	require.Equal(t, uint64(0), broadcasts[0].sequence)
+	require.Equal(t, uint64(3), broadcasts[1].sequence)
+	require.Equal(t, uint64(4), broadcasts[2].sequence)
+}
+
+func TestSubmitterRebroadcastsEvicted(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxMsgsPerTx = 1
+
+	var (
+		mu      sync.Mutex
+		retries int
+	)
+	cfg.EventHandler = func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		if e.Type == EventRetry {
+			retries++
+		}
+	}
+
+	s, node, signer := setupSubmitter(t, cfg, false)
+	node.setPaused(true)
+	runSubmitter(t, s)
+
+	subs := submit(t, s, signer, 2)
+	require.Eventually(t, func() bool { return len(node.getBroadcasts()) == 2 }, time.Second, 5*time.Millisecond)
+
+	node.evict()
+	node.setPaused(false)
+	for _, err := range waitAll(t, subs) {
+		require.NoError(t, err)
+	}
+
+	broadcasts := node.getBroadcasts()
+	require.Len(t, broadcasts, 4)
+	require.Equal(t, broadcasts[0], broadcasts[2])
+	require.Equal(t, broadcasts[1], broadcasts[3])
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 2, retries)
+}
+
+func TestSubmitterUnordered(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxMsgsPerTx = 1
+	s, node, signer := setupSubmitter(t, cfg, true)
+	node.setPaused(true)
+	runSubmitter(t, s)
+
+	subs := submit(t, s, signer, 3)
+	require.Eventually(t, func() bool { return len(node.getBroadcasts()) == 3 }, time.Second, 5*time.Millisecond)
+	node.setPaused(false)
+	for _, err := range waitAll(t, subs) {
+		require.NoError(t, err)
+	}
+
+	for _, tx := range node.getBroadcasts() {
+		require.True(t, tx.unordered)
+		require.Equal(t, uint64(0), tx.sequence)
+		require.True(t, tx.timeout.After(time.Now()))
+	}
+}
+
+func TestSubmitterUnorderedTimeout(t *testing.T) {
+	cfg := testConfig()
+	cfg.UnorderedTimeout = 50 * time.Millisecond
+	s, node, signer := setupSubmitter(t, cfg, true)
+	node.setPaused(true)
+	runSubmitter(t, s)
+
+	subs := submit(t, s, signer, 1)
+	require.Eventually(t, func() bool { return len(node.getBroadcasts()) == 1 }, time.Second, 5*time.Millisecond)
+	node.evict()
+
+	// the local clock passed the timeout, but no block committed after it.
+	time.Sleep(4 * cfg.UnorderedTimeout)
+	require.Len(t, node.getBroadcasts(), 1)
+
+	node.setBlockTime(time.Now())
+	require.Eventually(t, func() bool { return len(node.getBroadcasts()) == 2 }, time.Second, 5*time.Millisecond)
+	node.setPaused(false)
+	for _, err := range waitAll(t, subs) {
+		require.NoError(t, err)
+	}
+
+	broadcasts := node.getBroadcasts()
+	require.True(t, broadcasts[1].timeout.After(broadcasts[0].timeout))
+}
+
+func TestSubmitterFailures(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxMsgsPerTx = 1
+	cfg.MaxRetries = 1
+	s, node, signer := setupSubmitter(t, cfg, false)
+	node.checkTxErr = func(tx mockTx) *sdk.TxResponse {
+		switch tx.msgs {
+		case 2:
+			return &sdk.TxResponse{
+				Codespace: sdkerrors.ErrInsufficientFee.Codespace(),
+				Code:      sdkerrors.ErrInsufficientFee.ABCICode(),
+				RawLog:    "insufficient fee",
+			}
+		case 3:
+			return &sdk.TxResponse{
+				Codespace: sdkerrors.ErrMempoolIsFull.Codespace(),
+				Code:      sdkerrors.ErrMempoolIsFull.ABCICode(),
+			}
+		default:
+			return nil
+		}
+	}
+	runSubmitter(t, s)
+
+	msg := &countertypes.MsgIncreaseCounter{Signer: signer, Count: 1}
+	insufficientFee, err := s.Submit(msg, msg)
+	require.NoError(t, err)
+	mempoolFull, err := s.Submit(msg, msg, msg)
+	require.NoError(t, err)
+	ok, err := s.Submit(msg)
+	require.NoError(t, err)
+
+	errs := waitAll(t, []*Submission{insufficientFee, mempoolFull, ok})
+	require.ErrorIs(t, errs[0], sdkerrors.ErrInsufficientFee)
+	require.ErrorIs(t, errs[1], sdkerrors.ErrMempoolIsFull)
+	require.ErrorContains(t, errs[1], "max retries exceeded")
+	require.NoError(t, errs[2])
+
+	// the failed txs did not use any sequence
+	broadcasts := node.getBroadcasts()
+	require.Equal(t, uint64(0), broadcasts[len(broadcasts)-1].sequence)
+
+	_, err = s.Submit()
+	require.ErrorContains(t, err, "no messages to submit")
+}
+
+func TestParseExpectedSequence(t *testing.T) {
+	seq, ok := parseExpectedSequence("account sequence mismatch, expected 12, got 10: incorrect account sequence")
+	require.True(t, ok)
+	require.Equal(t, uint64(12), seq)
+
+	_, ok = parseExpectedSequence("insufficient fee")
+	require.False(t, ok)
+}
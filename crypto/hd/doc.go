@@ -10,4 +10,7 @@
 //
 // In particular, this package (together with bip39) provides all necessary functionality to derive
 // keys from mnemonics generated during the cosmos fundraiser.
+//
+// SplitMnemonic and CombineMnemonicShares split a mnemonic into share phrases with Shamir's secret
+// sharing, and recover it from a threshold of them.
 package hd
@@ -0,0 +1,279 @@
+package hd
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+)
+
+// Mnemonic shares split the entropy of a BIP 39 mnemonic with Shamir's secret sharing over GF(256),
+// so that any threshold of the shares recovers the mnemonic while fewer shares reveal nothing about it.
+//
+// Each share is written with the BIP 39 English wordlist, 11 bits per word, and encodes:
+//
+//	identifier (16 bits) | threshold - 1 (4 bits) | index - 1 (4 bits) | share value (entropy size) | checksum (32 bits)
+//
+// followed by zero bits up to the next word. The identifier is random and common to the shares of a
+// mnemonic, and the checksum is the first 4 bytes of the SHA-256 of the preceding bytes.
+//
+// The scheme follows the design of SLIP 39 for a single group of shares, but does not use its wordlist
+// or encryption of the master secret: the shares are not compatible with SLIP 39 wallets, and
+// recovering them yields the original BIP 39 mnemonic. The test vectors of this package were generated
+// by this implementation: they guard the format against regressions, but prove nothing about its
+// interoperability with any other implementation.
+const (
+	// MaxMnemonicShares is the maximum number of shares a mnemonic can be split into.
+	MaxMnemonicShares = 16
+
+	shareHeaderSize   = 3
+	shareChecksumSize = 4
+	shareWordBits     = 11
+)
+
+// MnemonicShare is a decoded mnemonic share.
+type MnemonicShare struct {
+	// Identifier is common to all the shares of a mnemonic.
+	Identifier uint16
+	// Threshold is the number of shares needed to recover the mnemonic.
+	Threshold int
+	// Index is the index of the share, starting from 1.
+	Index int
+	// Value is the share of the mnemonic entropy.
+	Value []byte
+}
+
+// SplitMnemonic splits a BIP 39 mnemonic into the given number of share phrases, any threshold of which
+// recovers it with CombineMnemonicShares. The threshold must be at least 2.
+func SplitMnemonic(mnemonic string, threshold, shares int) ([]string, error) {
+	return splitMnemonic(rand.Reader, mnemonic, threshold, shares)
+}
+
+func splitMnemonic(random io.Reader, mnemonic string, threshold, shares int) ([]string, error) {
+	// a single share would hold the entropy itself
+	if threshold < 2 || threshold > shares {
+		return nil, fmt.Errorf("threshold must be between 2 and the number of shares, got %d of %d", threshold, shares)
+	}
+	if shares > MaxMnemonicShares {
+		return nil, fmt.Errorf("a mnemonic can be split into at most %d shares, got %d", MaxMnemonicShares, shares)
+	}
+
+	words := strings.Fields(mnemonic)
+	if !bip39.IsMnemonicValid(strings.Join(words, " ")) {
+		return nil, errors.New("invalid mnemonic")
+	}
+	bz, _, err := wordsToBytes(words)
+	if err != nil {
+		return nil, err
+	}
+	// every 3 words encode 4 bytes of entropy and 1 bit of checksum
+	entropy := bz[:len(words)/3*4]
+
+	var id [2]byte
+	if _, err := io.ReadFull(random, id[:]); err != nil {
+		return nil, err
+	}
+
+	// coeffs[i] holds the i-th coefficient of the polynomials of every entropy byte,
+	// the constant term being the entropy itself.
+	coeffs := make([][]byte, threshold)
+	coeffs[0] = entropy
+	for i := 1; i < threshold; i++ {
+		coeffs[i] = make([]byte, len(entropy))
+		if _, err := io.ReadFull(random, coeffs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	phrases := make([]string, shares)
+	for i := range phrases {
+		share := MnemonicShare{
+			Identifier: binary.BigEndian.Uint16(id[:]),
+			Threshold:  threshold,
+			Index:      i + 1,
+			Value:      make([]byte, len(entropy)),
+		}
+		for j := range share.Value {
+			// Horner's method
+			var y byte
+			for k := threshold - 1; k >= 0; k-- {
+				y = gfMul(y, byte(share.Index)) ^ coeffs[k][j]
+			}
+			share.Value[j] = y
+		}
+		phrases[i] = share.String()
+	}
+
+	return phrases, nil
+}
+
+// CombineMnemonicShares recovers the BIP 39 mnemonic from at least threshold of its share phrases.
+func CombineMnemonicShares(phrases []string) (string, error) {
+	if len(phrases) == 0 {
+		return "", errors.New("no mnemonic shares")
+	}
+
+	shares := make([]*MnemonicShare, len(phrases))
+	for i, phrase := range phrases {
+		share, err := ParseMnemonicShare(phrase)
+		if err != nil {
+			return "", fmt.Errorf("share %d: %w", i+1, err)
+		}
+
+		if i > 0 {
+			if share.Identifier != shares[0].Identifier || share.Threshold != shares[0].Threshold ||
+				len(share.Value) != len(shares[0].Value) {
+				return "", fmt.Errorf("share %d belongs to a different mnemonic", i+1)
+			}
+			for _, other := range shares[:i] {
+				if share.Index == other.Index {
+					return "", fmt.Errorf("share %d is given twice", share.Index)
+				}
+			}
+		}
+		shares[i] = share
+	}
+
+	threshold := shares[0].Threshold
+	if len(shares) < threshold {
+		return "", fmt.Errorf("%d shares are needed to recover the mnemonic, got %d", threshold, len(shares))
+	}
+	shares = shares[:threshold]
+
+	// Lagrange interpolation at x = 0
+	entropy := make([]byte, len(shares[0].Value))
+	for i, share := range shares {
+		xi := byte(share.Index)
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+			xj := byte(other.Index)
+			basis = gfMul(basis, gfDiv(xj, xj^xi))
+		}
+		for k := range entropy {
+			entropy[k] ^= gfMul(basis, share.Value[k])
+		}
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// ParseMnemonicShare decodes a share phrase and verifies its checksum.
+func ParseMnemonicShare(phrase string) (*MnemonicShare, error) {
+	words := strings.Fields(phrase)
+
+	bitSize := len(words) * shareWordBits
+	size := bitSize / 8
+	valueSize := size - shareHeaderSize - shareChecksumSize
+	if valueSize < 16 || valueSize > 32 || valueSize%4 != 0 {
+		return nil, fmt.Errorf("invalid mnemonic share length of %d words", len(words))
+	}
+
+	bz, rest, err := wordsToBytes(words)
+	if err != nil {
+		return nil, err
+	}
+	if rest != 0 {
+		return nil, errors.New("invalid mnemonic share padding")
+	}
+
+	payload, checksum := bz[:size-shareChecksumSize], bz[size-shareChecksumSize:]
+	expected := sha256.Sum256(payload)
+	if !bytes.Equal(checksum, expected[:shareChecksumSize]) {
+		return nil, errors.New("invalid mnemonic share checksum")
+	}
+
+	return &MnemonicShare{
+		Identifier: binary.BigEndian.Uint16(payload[:2]),
+		Threshold:  int(payload[2]>>4) + 1,
+		Index:      int(payload[2]&0x0f) + 1,
+		Value:      payload[shareHeaderSize:],
+	}, nil
+}
+
+// String encodes the share as a phrase.
+func (s MnemonicShare) String() string {
+	payload := make([]byte, shareHeaderSize, shareHeaderSize+len(s.Value)+shareChecksumSize)
+	binary.BigEndian.PutUint16(payload, s.Identifier)
+	payload[2] = byte(s.Threshold-1)<<4 | byte(s.Index-1)&0x0f
+	payload = append(payload, s.Value...)
+	checksum := sha256.Sum256(payload)
+	payload = append(payload, checksum[:shareChecksumSize]...)
+
+	bitSize := len(payload) * 8
+	words := make([]string, 0, (bitSize+shareWordBits-1)/shareWordBits)
+	var acc uint32
+	var accBits int
+	for _, b := range payload {
+		acc = acc<<8 | uint32(b)
+		accBits += 8
+		for accBits >= shareWordBits {
+			accBits -= shareWordBits
+			words = append(words, bip39.WordList[acc>>accBits])
+			acc &= 1<<accBits - 1
+		}
+	}
+	if accBits > 0 {
+		words = append(words, bip39.WordList[acc<<(shareWordBits-accBits)])
+	}
+
+	return strings.Join(words, " ")
+}
+
+// wordsToBytes decodes the 11 bits words of the BIP 39 English wordlist into bytes, returning the bits
+// left over after the last full byte.
+func wordsToBytes(words []string) ([]byte, uint32, error) {
+	bz := make([]byte, 0, len(words)*shareWordBits/8)
+	var acc uint32
+	var accBits int
+	for _, w := range words {
+		idx, ok := bip39.ReverseWordMap[w]
+		if !ok {
+			return nil, 0, fmt.Errorf("word %q is not in the wordlist", w)
+		}
+		acc = acc<<shareWordBits | uint32(idx)
+		accBits += shareWordBits
+		for accBits >= 8 {
+			accBits -= 8
+			bz = append(bz, byte(acc>>accBits))
+		}
+		acc &= 1<<accBits - 1
+	}
+
+	return bz, acc, nil
+}
+
+// gfMul multiplies two elements of GF(256) with the reducing polynomial x^8 + x^4 + x^3 + x + 1.
+func gfMul(a, b byte) byte {
+	var p byte
+	for b > 0 {
+		if b&1 == 1 {
+			p ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+// gfDiv divides two elements of GF(256), b being non-zero.
+func gfDiv(a, b byte) byte {
+	// b^254 is the inverse of b
+	inv := byte(1)
+	for i := 0; i < 254; i++ {
+		inv = gfMul(inv, b)
+	}
+	return gfMul(a, inv)
+}
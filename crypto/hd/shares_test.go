@@ -0,0 +1,142 @@
+package hd_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+type mnemonicSharesVector struct {
+	Mnemonic  string   `json:"mnemonic"`
+	Threshold int      `json:"threshold"`
+	Shares    []string `json:"shares"`
+}
+
+// subsets returns the index subsets of the given size of n elements.
+func subsets(n, size int) [][]int {
+	if size == 0 {
+		return [][]int{{}}
+	}
+
+	var res [][]int
+	for i := size - 1; i < n; i++ {
+		for _, s := range subsets(i, size-1) {
+			res = append(res, append(s, i))
+		}
+	}
+	return res
+}
+
+func pick(shares []string, indexes []int) []string {
+	picked := make([]string, len(indexes))
+	for i, idx := range indexes {
+		picked[i] = shares[idx]
+	}
+	return picked
+}
+
+func TestMnemonicSharesVectors(t *testing.T) {
+	bz, err := os.ReadFile("testdata/mnemonic_shares.json")
+	require.NoError(t, err)
+
+	var vectors []mnemonicSharesVector
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		for i, phrase := range v.Shares {
+			share, err := hd.ParseMnemonicShare(phrase)
+			require.NoError(t, err)
+			require.Equal(t, v.Threshold, share.Threshold)
+			require.Equal(t, i+1, share.Index)
+			require.Equal(t, phrase, share.String())
+		}
+
+		for _, indexes := range subsets(len(v.Shares), v.Threshold) {
+			if len(indexes) > 3 && indexes[0] > 2 {
+				// enough combinations for the larger vectors
+				continue
+			}
+			mnemonic, err := hd.CombineMnemonicShares(pick(v.Shares, indexes))
+			require.NoError(t, err)
+			require.Equal(t, v.Mnemonic, mnemonic)
+		}
+
+		_, err := hd.CombineMnemonicShares(v.Shares[:v.Threshold-1])
+		require.ErrorContains(t, err, "shares are needed to recover the mnemonic")
+	}
+}
+
+func TestSplitMnemonic(t *testing.T) {
+	for _, bitSize := range []int{128, 160, 192, 224, 256} {
+		entropy, err := bip39.NewEntropy(bitSize)
+		require.NoError(t, err)
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		require.NoError(t, err)
+
+		shares, err := hd.SplitMnemonic(mnemonic, 3, 5)
+		require.NoError(t, err)
+		require.Len(t, shares, 5)
+
+		for _, indexes := range subsets(5, 3) {
+			recovered, err := hd.CombineMnemonicShares(pick(shares, indexes))
+			require.NoError(t, err)
+			require.Equal(t, mnemonic, recovered)
+		}
+
+		// more shares than the threshold are accepted
+		recovered, err := hd.CombineMnemonicShares(shares)
+		require.NoError(t, err)
+		require.Equal(t, mnemonic, recovered)
+	}
+}
+
+func TestSplitMnemonicErrors(t *testing.T) {
+	mnemonic := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	_, err := hd.SplitMnemonic(mnemonic, 0, 3)
+	require.ErrorContains(t, err, "threshold must be between 2 and the number of shares")
+	_, err = hd.SplitMnemonic(mnemonic, 1, 3)
+	require.ErrorContains(t, err, "threshold must be between 2 and the number of shares")
+	_, err = hd.SplitMnemonic(mnemonic, 4, 3)
+	require.ErrorContains(t, err, "threshold must be between 2 and the number of shares")
+	_, err = hd.SplitMnemonic(mnemonic, 2, hd.MaxMnemonicShares+1)
+	require.ErrorContains(t, err, "at most 16 shares")
+	_, err = hd.SplitMnemonic("legal winner thank year", 2, 3)
+	require.ErrorContains(t, err, "invalid mnemonic")
+}
+
+func TestCombineMnemonicSharesErrors(t *testing.T) {
+	mnemonic := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	shares, err := hd.SplitMnemonic(mnemonic, 2, 3)
+	require.NoError(t, err)
+	others, err := hd.SplitMnemonic(mnemonic, 2, 3)
+	require.NoError(t, err)
+
+	_, err = hd.CombineMnemonicShares(nil)
+	require.ErrorContains(t, err, "no mnemonic shares")
+
+	_, err = hd.CombineMnemonicShares([]string{shares[0], shares[0]})
+	require.ErrorContains(t, err, "share 1 is given twice")
+
+	_, err = hd.CombineMnemonicShares([]string{shares[0], others[1]})
+	require.ErrorContains(t, err, "belongs to a different mnemonic")
+
+	// a mistyped word breaks the checksum
+	words := strings.Fields(shares[1])
+	words[5] = bip39.WordList[(bip39.ReverseWordMap[words[5]]+1)%len(bip39.WordList)]
+	_, err = hd.CombineMnemonicShares([]string{shares[0], strings.Join(words, " ")})
+	require.ErrorContains(t, err, "share 2: invalid mnemonic share checksum")
+
+	_, err = hd.CombineMnemonicShares([]string{shares[0], shares[1] + " abandon"})
+	require.ErrorContains(t, err, "invalid mnemonic share length")
+
+	_, err = hd.CombineMnemonicShares([]string{shares[0], strings.Replace(shares[1], words[0], "notaword", 1)})
+	require.ErrorContains(t, err, `word "notaword" is not in the wordlist`)
+}
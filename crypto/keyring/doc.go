@@ -39,4 +39,13 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	Same instance as returned by NewRemote. This backend does not hold any private key: it
+//		lists keys and requests signatures from a signing service implementing the RemoteSigner
+//		gRPC service, whose address is set with the RemoteSigner option. The signer is reached
+//		over TLS unless the RemoteSignerInsecure option is set.
+//
+// # NewRemoteSigner
+//
+// The NewRemoteSigner constructor returns a reference implementation of the RemoteSigner gRPC
+// service backed by a local keyring, used to serve the keys of a keyring to the remote backend.
 package keyring
@@ -35,4 +35,6 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrRemoteUnsupported is raised when an operation needing the private keys is performed with the remote backend.
+	ErrRemoteUnsupported = errors.New("operation not supported by the remote keyring backend, perform it on the remote signer")
 )
@@ -6,6 +6,8 @@ package keyring
 import (
 	"io"
 
+	"google.golang.org/grpc"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
@@ -26,6 +28,13 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// RemoteSignerAddr is the gRPC address of the signer used by the remote backend.
+	RemoteSignerAddr string
+	// RemoteSignerDialOptions are the gRPC dial options used to connect to the remote signer.
+	// A TLS connection verified against the system roots is used if none is provided.
+	RemoteSignerDialOptions []grpc.DialOption
+	// RemoteSignerInsecure connects to the remote signer without TLS when no dial options are provided.
+	RemoteSignerInsecure bool
 }
 
 func New(
@@ -0,0 +1,315 @@
+package keyring
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"sort"
+
+	"github.com/99designs/keyring"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ Keyring = remoteKeystore{}
+
+// RemoteSigner sets the gRPC address of the signer used by the remote backend, and the options used to dial it.
+func RemoteSigner(addr string, dialOpts ...grpc.DialOption) Option {
+	return func(options *Options) {
+		options.RemoteSignerAddr = addr
+		options.RemoteSignerDialOptions = dialOpts
+	}
+}
+
+// RemoteSignerInsecure connects to the remote signer over plain gRPC instead of TLS.
+// It only applies when no dial options are given to RemoteSigner.
+func RemoteSignerInsecure() Option {
+	return func(options *Options) {
+		options.RemoteSignerInsecure = true
+	}
+}
+
+// remoteKeystore is a keyring whose keys are held by a remote signer implementing the RemoteSigner gRPC service.
+// Its keys are exposed as offline records, and operations needing the private keys fail with ErrRemoteUnsupported.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+// NewRemote creates a keyring delegating the key management and signing to the remote signer
+// set with the RemoteSigner option.
+func NewRemote(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSignerAddr == "" {
+		return nil, errors.New("remote keyring backend requires a signer address")
+	}
+
+	dialOpts := options.RemoteSignerDialOptions
+	if len(dialOpts) == 0 {
+		creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		if options.RemoteSignerInsecure {
+			creds = insecure.NewCredentials()
+		}
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	}
+
+	conn, err := grpc.NewClient(options.RemoteSignerAddr, dialOpts...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to connect to remote signer %s", options.RemoteSignerAddr)
+	}
+
+	return remoteKeystore{
+		client:  NewRemoteSignerClient(conn),
+		cdc:     cdc,
+		options: options,
+	}, nil
+}
+
+// Backend returns the keyring backend option used in the config
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+// DB returns nil, the keys are not stored locally.
+func (ks remoteKeystore) DB() keyring.Keyring {
+	return nil
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	res, err := ks.client.ListKeys(context.Background(), &ListKeysRequest{})
+	if err != nil {
+		return nil, wrapRemoteError(err)
+	}
+
+	records := make([]*Record, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		k, err := ks.toRecord(key)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, k)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+
+	return records, nil
+}
+
+// SupportedAlgorithms returns the keystore Options' supported signing algorithm.
+// for the keyring and Ledger.
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	return ks.getKey(&GetKeyRequest{Name: uid})
+}
+
+func (ks remoteKeystore) KeyByAddress(address []byte) (*Record, error) {
+	return ks.getKey(&GetKeyRequest{Address: address})
+}
+
+func (ks remoteKeystore) getKey(req *GetKeyRequest) (*Record, error) {
+	res, err := ks.client.GetKey(context.Background(), req)
+	if err != nil {
+		return nil, wrapRemoteError(err)
+	}
+
+	return ks.toRecord(res.Key)
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addr, err := k.GetAddress()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.sign(addr, &SignRequest{Name: uid, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) SignByAddress(address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(address, &SignRequest{Address: address, Msg: msg, SignMode: signMode})
+}
+
+// sign requests a signature from the remote signer, and checks that it was made over msg
+// by the key of the given address before returning it.
+func (ks remoteKeystore) sign(address []byte, req *SignRequest) ([]byte, types.PubKey, error) {
+	res, err := ks.client.Sign(context.Background(), req)
+	if err != nil {
+		return nil, nil, wrapRemoteError(err)
+	}
+
+	pk, err := ks.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !bytes.Equal(pk.Address(), address) {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "remote signer signed with %X instead of %X", pk.Address().Bytes(), address)
+	}
+
+	if !pk.VerifySignature(req.Msg, res.Signature) {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid signature returned by remote signer")
+	}
+
+	return res.Signature, pk, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address []byte) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) exportPubKeyArmor(k *Record) (string, error) {
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+// MigrateAll does nothing, the keys are not stored locally.
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return nil, nil
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (ks remoteKeystore) DeleteByAddress([]byte) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "rename")
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", errorsmod.Wrap(ErrRemoteUnsupported, "create key")
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "create key")
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save ledger key")
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save offline key")
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save multisig key")
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import public key")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress([]byte, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+// toRecord converts a key of the remote signer to an offline record.
+func (ks remoteKeystore) toRecord(key *RemoteKey) (*Record, error) {
+	if key == nil {
+		return nil, errors.New("remote signer returned no key")
+	}
+
+	pk, err := ks.unpackPubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOfflineRecord(key.Name, pk)
+}
+
+func (ks remoteKeystore) unpackPubKey(any *codectypes.Any) (types.PubKey, error) {
+	var pk types.PubKey
+	if err := ks.cdc.InterfaceRegistry().UnpackAny(any, &pk); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid public key returned by remote signer")
+	}
+
+	return pk, nil
+}
+
+// wrapRemoteError maps the gRPC status errors of the remote signer to keyring errors.
+func wrapRemoteError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, st.Message())
+	case codes.InvalidArgument:
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, st.Message())
+	default:
+		return errorsmod.Wrap(err, "remote signer")
+	}
+}
@@ -0,0 +1,109 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ RemoteSignerServer = remoteSigner{}
+
+// remoteSigner is the reference implementation of the RemoteSigner service, backed by a local keyring.
+type remoteSigner struct {
+	kr Keyring
+}
+
+// NewRemoteSigner returns a RemoteSigner service signing with the keys of the given keyring.
+// It is meant to be served by a signing service or to test the remote backend locally.
+func NewRemoteSigner(kr Keyring) RemoteSignerServer {
+	return remoteSigner{kr: kr}
+}
+
+func (s remoteSigner) ListKeys(_ context.Context, _ *ListKeysRequest) (*ListKeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	keys := make([]*RemoteKey, 0, len(records))
+	for _, k := range records {
+		keys = append(keys, &RemoteKey{Name: k.Name, PubKey: k.PubKey})
+	}
+
+	return &ListKeysResponse{Keys: keys}, nil
+}
+
+func (s remoteSigner) GetKey(_ context.Context, req *GetKeyRequest) (*GetKeyResponse, error) {
+	if err := validateKeySelector(req.Name, req.Address); err != nil {
+		return nil, err
+	}
+
+	var (
+		k   *Record
+		err error
+	)
+	if req.Name != "" {
+		k, err = s.kr.Key(req.Name)
+	} else {
+		k, err = s.kr.KeyByAddress(req.Address)
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &GetKeyResponse{Key: &RemoteKey{Name: k.Name, PubKey: k.PubKey}}, nil
+}
+
+func (s remoteSigner) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if err := validateKeySelector(req.Name, req.Address); err != nil {
+		return nil, err
+	}
+
+	var (
+		sig []byte
+		pk  types.PubKey
+		err error
+	)
+	if req.Name != "" {
+		sig, pk, err = s.kr.Sign(req.Name, req.Msg, req.SignMode)
+	} else {
+		sig, pk, err = s.kr.SignByAddress(req.Address, req.Msg, req.SignMode)
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pkAny}, nil
+}
+
+// validateKeySelector checks that a key is selected either by name or by address.
+func validateKeySelector(name string, address []byte) error {
+	if (name == "") == (len(address) == 0) {
+		return status.Error(codes.InvalidArgument, "exactly one of name and address must be set")
+	}
+
+	return nil
+}
+
+// toStatusError converts a keyring error to a gRPC status error.
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrOfflineSign), errors.Is(err, ErrInvalidSignMode):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,199 @@
+package keyring
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// newRemoteKeyring serves a reference signer backed by signerKr and returns a remote keyring connected to it.
+func newRemoteKeyring(t *testing.T, signerKr Keyring) Keyring {
+	t.Helper()
+
+	return newRemoteKeyringWithSigner(t, NewRemoteSigner(signerKr))
+}
+
+// newRemoteKeyringWithSigner serves the given signer over plain gRPC and returns a remote keyring connected to it.
+func newRemoteKeyringWithSigner(t *testing.T, signer RemoteSignerServer, opts ...Option) Keyring {
+	t.Helper()
+
+	addr := serveRemoteSigner(t, signer)
+	kr, err := New(t.Name(), BackendRemote, t.TempDir(), nil, getCodec(), append([]Option{RemoteSigner(addr), RemoteSignerInsecure()}, opts...)...)
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	return kr
+}
+
+func serveRemoteSigner(t *testing.T, signer RemoteSignerServer) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	RegisterRemoteSignerServer(srv, signer)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+// tamperingSigner is a remote signer returning the public key of another key, or a corrupted signature.
+type tamperingSigner struct {
+	RemoteSignerServer
+	otherPubKey *codectypes.Any
+	corruptSig  bool
+}
+
+func (s tamperingSigner) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	res, err := s.RemoteSignerServer.Sign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.otherPubKey != nil {
+		res.PubKey = s.otherPubKey
+	}
+	if s.corruptSig {
+		res.Signature[0] ^= 0xff
+	}
+
+	return res, nil
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	signerKr := NewInMemory(getCodec())
+	alice, _, err := signerKr.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = signerKr.NewMnemonic("bob", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signerKr.SaveOfflineKey("carol", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	kr := newRemoteKeyring(t, signerKr)
+	alicePk, err := alice.GetPubKey()
+	require.NoError(t, err)
+	aliceAddr, err := alice.GetAddress()
+	require.NoError(t, err)
+
+	// keys are listed as offline records
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, []string{"alice", "bob", "carol"}, []string{list[0].Name, list[1].Name, list[2].Name})
+	require.Equal(t, TypeOffline, list[0].GetType())
+
+	k, err := kr.Key("alice")
+	require.NoError(t, err)
+	pk, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, alicePk.Equals(pk))
+
+	k, err = kr.KeyByAddress(aliceAddr)
+	require.NoError(t, err)
+	require.Equal(t, "alice", k.Name)
+
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// signatures are made by the signer
+	msg := []byte("message to sign")
+	sig, pk, err := kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, alicePk.Equals(pk))
+	require.True(t, pk.VerifySignature(msg, sig))
+
+	sig, pk, err = kr.SignByAddress(aliceAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pk.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("carol", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, ErrOfflineSign.Error())
+
+	_, _, err = kr.Sign("unknown", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	armor, err := kr.ExportPubKeyArmor("alice")
+	require.NoError(t, err)
+	bz, algo, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Secp256k1Type), algo)
+	expected, err := getCodec().MarshalInterface(alicePk)
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+
+	// private keys never leave the signer
+	_, err = kr.ExportPrivKeyArmor("alice", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	_, _, err = kr.NewMnemonic("dave", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("alice"), ErrRemoteUnsupported)
+}
+
+func TestRemoteKeyringRequiresTLS(t *testing.T) {
+	addr := serveRemoteSigner(t, NewRemoteSigner(NewInMemory(getCodec())))
+
+	// the plain gRPC signer is rejected unless the insecure connection is explicitly allowed
+	kr, err := New(t.Name(), BackendRemote, t.TempDir(), nil, getCodec(), RemoteSigner(addr))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.Error(t, err)
+
+	kr, err = New(t.Name(), BackendRemote, t.TempDir(), nil, getCodec(), RemoteSigner(addr), RemoteSignerInsecure())
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.NoError(t, err)
+}
+
+func TestRemoteKeyringVerifiesSignature(t *testing.T) {
+	signerKr := NewInMemory(getCodec())
+	alice, _, err := signerKr.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	aliceAddr, err := alice.GetAddress()
+	require.NoError(t, err)
+	bob, _, err := signerKr.NewMnemonic("bob", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	msg := []byte("message to sign")
+
+	// the signer signs with another key than the requested one
+	kr := newRemoteKeyringWithSigner(t, tamperingSigner{RemoteSignerServer: NewRemoteSigner(signerKr), otherPubKey: bob.PubKey})
+	_, _, err = kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+	_, _, err = kr.SignByAddress(aliceAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	// the signer returns a signature which does not verify
+	kr = newRemoteKeyringWithSigner(t, tamperingSigner{RemoteSignerServer: NewRemoteSigner(signerKr), corruptSig: true})
+	_, _, err = kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, _, err = kr.SignByAddress(aliceAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestRemoteKeyringRequiresAddress(t *testing.T) {
+	_, err := New(t.Name(), BackendRemote, t.TempDir(), nil, getCodec())
+	require.ErrorContains(t, err, "remote keyring backend requires a signer address")
+}
+
+func TestRemoteSignerKeySelector(t *testing.T) {
+	signer := NewRemoteSigner(NewInMemory(getCodec()))
+
+	_, err := signer.GetKey(context.Background(), &GetKeyRequest{})
+	require.ErrorContains(t, err, "exactly one of name and address must be set")
+
+	_, err = signer.Sign(context.Background(), &SignRequest{Name: "alice", Address: []byte("alice"), Msg: []byte("msg")})
+	require.ErrorContains(t, err, "exactly one of name and address must be set")
+}
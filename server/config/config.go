@@ -42,6 +42,10 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// SimulationStateOverrides enables the state overrides of the Simulate
+	// gRPC request, which apply to the simulation only.
+	SimulationStateOverrides bool `mapstructure:"simulation-state-overrides"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
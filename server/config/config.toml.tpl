@@ -14,6 +14,12 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # If this is set to zero, the query can consume an unbounded amount of gas.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
+# SimulationStateOverrides enables the state overrides of the Simulate gRPC
+# request, letting callers override the value of store keys (e.g. balances,
+# sequences or params) for a single simulation, on a branch of the state which
+# is discarded afterwards.
+simulation-state-overrides = {{ .BaseConfig.SimulationStateOverrides }}
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -23,6 +23,7 @@ import (
 	_ "cosmossdk.io/x/protocolpool"
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	upgradecli "cosmossdk.io/x/upgrade/client/cli"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -48,8 +49,9 @@ import (
 var DefaultNodeHome string
 
 var (
-	_ runtime.AppI            = (*SimApp)(nil)
-	_ servertypes.Application = (*SimApp)(nil)
+	_ runtime.AppI                    = (*SimApp)(nil)
+	_ servertypes.Application         = (*SimApp)(nil)
+	_ upgradecli.PreflightApplication = (*SimApp)(nil)
 )
 
 // SimApp extends an ABCI application, but with most of its parameters exported.
@@ -321,6 +323,11 @@ func (app *SimApp) TxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetUpgradeKeeper returns the x/upgrade keeper, used to rehearse upgrades.
+func (app *SimApp) GetUpgradeKeeper() *upgradekeeper.Keeper {
+	return app.UpgradeKeeper
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -9,6 +9,7 @@ import (
 	bankv2types "cosmossdk.io/x/bank/v2/types"
 	epochstypes "cosmossdk.io/x/epochs/types"
 	protocolpooltypes "cosmossdk.io/x/protocolpool/types"
+	ratelimittypes "cosmossdk.io/x/ratelimit/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -48,6 +49,7 @@ func (app SimApp) RegisterUpgradeHandlers() {
 				protocolpooltypes.StoreKey,
 				epochstypes.StoreKey,
 				bankv2types.ModuleName,
+				ratelimittypes.StoreKey,
 			},
 			Deleted: []string{"crisis"}, // The SDK discontinued the crisis module in v0.52.0
 		}
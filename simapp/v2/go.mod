@@ -28,6 +28,7 @@ require (
 	cosmossdk.io/x/mint v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/nft v0.0.0-20230613133644-0a778132a60f
 	cosmossdk.io/x/protocolpool v0.0.0-20230925135524-a1bc045b3190
+	cosmossdk.io/x/ratelimit v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/slashing v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/staking v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/upgrade v0.0.0-20230613133644-0a778132a60f
@@ -266,6 +267,7 @@ replace (
 	cosmossdk.io/x/nft => ../../x/nft
 	cosmossdk.io/x/params => ../../x/params
 	cosmossdk.io/x/protocolpool => ../../x/protocolpool
+	cosmossdk.io/x/ratelimit => ../../x/ratelimit
 	cosmossdk.io/x/slashing => ../../x/slashing
 	cosmossdk.io/x/staking => ../../x/staking
 	cosmossdk.io/x/tx => ../../x/tx
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAutoCompound(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx.WithHeaderInfo(header.Info{Height: 1})
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	setupValidatorWithCommission(t, f, f.valAddr, 100)
+	assert.NilError(t, f.distrKeeper.Params.Set(ctx, distrtypes.DefaultParams()))
+
+	delAddr := sdk.AccAddress(f.valAddr)
+	delStr, err := f.accountKeeper.AddressCodec().BytesToString(delAddr)
+	assert.NilError(t, err)
+	valStr, err := f.stakingKeeper.ValidatorAddressCodec().BytesToString(f.valAddr)
+	assert.NilError(t, err)
+
+	// allocate rewards in the staking denom and another denom, half of them go to the commission
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foo", 20))
+	assert.NilError(t, f.bankKeeper.MintCoins(ctx, distrtypes.ModuleName, rewards))
+	validator, err := f.stakingKeeper.GetValidator(ctx, f.valAddr)
+	assert.NilError(t, err)
+	assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	delegationBefore, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, f.valAddr))
+	assert.NilError(t, err)
+	balanceBefore := f.bankKeeper.GetAllBalances(ctx, delAddr)
+
+	_, err = f.app.RunMsg(distrtypes.NewMsgSetAutoCompound(delStr, true))
+	assert.NilError(t, err)
+	res, err := f.queryClient.DelegatorAutoCompound(ctx, &distrtypes.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delStr})
+	assert.NilError(t, err)
+	assert.Assert(t, res.Enabled)
+
+	params, err := f.distrKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.AutoCompoundEpochIdentifier = "day"
+	assert.NilError(t, f.distrKeeper.Params.Set(ctx, params))
+	assert.NilError(t, f.distrKeeper.EpochHooks().AfterEpochEnd(ctx, params.AutoCompoundEpochIdentifier, 1))
+	assert.NilError(t, f.distrKeeper.BeginBlocker(ctx))
+
+	// the staking denom rewards are re-delegated, the other rewards are withdrawn
+	delegationAfter, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, f.valAddr))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, delegationBefore.Shares.Add(math.LegacyNewDec(50)), delegationAfter.Shares)
+	assert.DeepEqual(t, balanceBefore.Add(sdk.NewInt64Coin("foo", 10)), f.bankKeeper.GetAllBalances(ctx, delAddr))
+
+	var compounded bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != distrtypes.EventTypeAutoCompound {
+			continue
+		}
+		compounded = true
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		assert.Equal(t, delStr, attrs[distrtypes.AttributeKeyDelegator])
+		assert.Equal(t, valStr, attrs[distrtypes.AttributeKeyValidator])
+		assert.Equal(t, "10foo,50stake", attrs[sdk.AttributeKeyAmount])
+		assert.Equal(t, "50stake", attrs[distrtypes.AttributeKeyCompounded])
+	}
+	assert.Assert(t, compounded)
+
+	round, err := f.distrKeeper.AutoCompoundRound.Get(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, distrtypes.AutoCompoundRound{EpochNumber: 1, Processed: 1}, round)
+}
@@ -91,6 +91,7 @@ func initFixture(t *testing.T) *fixture {
 		pooltypes.ModuleName:               {},
 		pooltypes.StreamAccount:            {},
 		pooltypes.ProtocolPoolDistrAccount: {},
+		pooltypes.GrantAccount:             {},
 		distrtypes.ModuleName:              {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
@@ -141,7 +142,7 @@ func initFixture(t *testing.T) *fixture {
 	poolKeeper := poolkeeper.NewKeeper(cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), log.NewNopLogger()), accountKeeper, bankKeeper, stakingKeeper, authority.String())
 
 	distrKeeper := distrkeeper.NewKeeper(
-		cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger), accountKeeper, bankKeeper, stakingKeeper, cometService, distrtypes.ModuleName, authority.String(),
+		cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger, runtime.EnvWithMsgRouterService(msgRouter)), accountKeeper, bankKeeper, stakingKeeper, cometService, distrtypes.ModuleName, authority.String(),
 	)
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts, nil)
@@ -190,6 +191,7 @@ func initFixture(t *testing.T) *fixture {
 
 	// Register MsgServer and QueryServer
 	distrtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), distrkeeper.NewMsgServerImpl(distrKeeper))
+	stakingtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), stakingkeeper.NewMsgServerImpl(stakingKeeper))
 	distrtypes.RegisterQueryServer(integrationApp.QueryHelper(), distrkeeper.NewQuerier(distrKeeper))
 
 	qr := integrationApp.QueryHelper()
@@ -796,6 +798,10 @@ func TestMsgUpdateParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+
+					AutoCompoundEpochIdentifier:       distrtypes.DefaultAutoCompoundEpochIdentifier,
+					AutoCompoundMaxDelegatorsPerEpoch: distrtypes.DefaultAutoCompoundMaxDelegatorsPerEpoch,
+					AutoCompoundGasBudgetPerBlock:     distrtypes.DefaultAutoCompoundGasBudgetPerBlock,
 				},
 			},
 			expErr: false,
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
@@ -73,7 +74,7 @@ func TestImportExportQueues(t *testing.T) {
 	assert.NilError(t, err)
 
 	ctx := s1.app.BaseApp.NewContext(false)
-	addrs := simtestutil.AddTestAddrs(s1.BankKeeper, s1.StakingKeeper, ctx, 1, valTokens)
+	addrs := simtestutil.AddTestAddrs(s1.BankKeeper, s1.StakingKeeper, ctx, 2, valTokens)
 
 	_, err = s1.app.FinalizeBlock(&abci.FinalizeBlockRequest{
 		Height: s1.app.LastBlockHeight() + 1,
@@ -103,6 +104,9 @@ func TestImportExportQueues(t *testing.T) {
 	assert.Assert(t, proposal1.Status == v1.StatusDepositPeriod)
 	assert.Assert(t, proposal2.Status == v1.StatusVotingPeriod)
 
+	err = s1.GovKeeper.DelegateGovernance(ctx, addrs[0], addrs[1])
+	assert.NilError(t, err)
+
 	authGenState, err := s1.AccountKeeper.ExportGenesis(ctx)
 	require.NoError(t, err)
 	bankGenState, err := s1.BankKeeper.ExportGenesis(ctx)
@@ -174,6 +178,14 @@ func TestImportExportQueues(t *testing.T) {
 	assert.Assert(t, proposal1.Status == v1.StatusDepositPeriod)
 	assert.Assert(t, proposal2.Status == v1.StatusVotingPeriod)
 
+	// Make sure that the governance delegation and its governor index were imported
+	delegation, err := s2.GovKeeper.GovernanceDelegations.Get(ctx2, addrs[0])
+	assert.NilError(t, err)
+	assert.Equal(t, addrs[1].String(), delegation.GovernorAddress)
+	hasGovernorIndex, err := s2.GovKeeper.GovernorDelegations.Has(ctx2, collections.Join(addrs[1], addrs[0]))
+	assert.NilError(t, err)
+	assert.Assert(t, hasGovernorIndex)
+
 	macc := s2.GovKeeper.GetGovernanceAccount(ctx2)
 	assert.DeepEqual(t, sdk.Coins(params.MinDeposit), s2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
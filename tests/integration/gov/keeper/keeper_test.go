@@ -73,6 +73,7 @@ func initFixture(tb testing.TB) *fixture {
 		pooltypes.ModuleName:               {},
 		pooltypes.StreamAccount:            {},
 		pooltypes.ProtocolPoolDistrAccount: {},
+		pooltypes.GrantAccount:             {},
 		minttypes.ModuleName:               {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
@@ -140,6 +141,7 @@ func initFixture(tb testing.TB) *fixture {
 		keeper.DefaultConfig(),
 		authority.String(),
 	)
+	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(govKeeper.StakingHooks()))
 	assert.NilError(tb, govKeeper.ProposalID.Set(newCtx, 1))
 	govRouter := v1beta1.NewRouter()
 	govRouter.AddRoute(types.RouterKey, v1beta1.ProposalHandler)
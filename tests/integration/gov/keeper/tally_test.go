@@ -304,6 +304,43 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
 }
 
+func TestTallyDelegatorDelegatesAfterVoting(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	addrs, valAddrs := createValidators(t, f, []int64{5, 6, 7})
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	assert.NilError(t, f.govKeeper.ActivateVotingPeriod(ctx, proposal))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	// the delegation made after voting is tallied with the delegator's vote
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	_, err = f.stakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	passes, burnDeposits, tallyResults, err := f.govKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+	assert.Equal(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 18).String(), tallyResults.YesCount)
+	assert.Equal(t, delTokens.String(), tallyResults.NoCount)
+}
+
 func TestTallyDelgatorInherit(t *testing.T) {
 	t.Parallel()
 
@@ -200,6 +200,7 @@ func initFixture(tb testing.TB) *fixture {
 		accNum++
 		return currentNum, nil
 	})
+	acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
 
 	f := fixture{
 		app:           integrationApp,
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, valAddr, owner := addrs[0], valAddrs[0], addrs[2]
+	delStr, err := f.accountKeeper.AddressCodec().BytesToString(delAddr)
+	assert.NilError(t, err)
+	ownerStr, err := f.accountKeeper.AddressCodec().BytesToString(owner)
+	assert.NilError(t, err)
+	valStr, err := f.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	assert.NilError(t, err)
+
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+	amount := f.stakingKeeper.TokensFromConsensusPower(ctx, 3)
+
+	validatorBefore, err := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+
+	res, err := msgServer.TokenizeShares(ctx, &types.MsgTokenizeShares{
+		DelegatorAddress:    delStr,
+		ValidatorAddress:    valStr,
+		Amount:              sdk.NewCoin(bondDenom, amount),
+		TokenizedShareOwner: ownerStr,
+	})
+	assert.NilError(t, err)
+
+	record, err := f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, ownerStr, record.Owner)
+	assert.Equal(t, valStr, record.Validator)
+	assert.DeepEqual(t, sdk.NewCoin(record.GetShareTokenDenom(), amount), res.Amount)
+	assert.DeepEqual(t, res.Amount, f.bankKeeper.GetBalance(ctx, owner, record.GetShareTokenDenom()))
+
+	// the shares moved from the delegator to the record, the validator is unchanged
+	delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 6)), delegation.Shares)
+	recordDelegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), valAddr))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(amount), recordDelegation.Shares)
+	validatorAfter, err := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, validatorBefore.Tokens, validatorAfter.Tokens)
+	assert.DeepEqual(t, validatorBefore.DelegatorShares, validatorAfter.DelegatorShares)
+
+	totalLiquid, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, amount, totalLiquid)
+
+	// only the owner can transfer the record
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                delStr,
+		NewOwner:              delStr,
+	})
+	assert.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                ownerStr,
+		NewOwner:              delStr,
+	})
+	assert.NilError(t, err)
+	owned, err := f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(owned))
+
+	// the share tokens are transferable, the delegator redeems them in two steps
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, owner, delAddr, sdk.NewCoins(res.Amount)))
+
+	partial := sdk.NewCoin(record.GetShareTokenDenom(), f.stakingKeeper.TokensFromConsensusPower(ctx, 1))
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, &types.MsgRedeemTokensForShares{DelegatorAddress: delStr, Amount: partial})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(bondDenom, partial.Amount), redeemRes.Amount)
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.NilError(t, err)
+
+	rest := res.Amount.Sub(partial)
+	_, err = msgServer.RedeemTokensForShares(ctx, &types.MsgRedeemTokensForShares{DelegatorAddress: delStr, Amount: rest})
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotFound)
+	_, err = f.stakingKeeper.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), valAddr))
+	assert.ErrorIs(t, err, collections.ErrNotFound)
+	delegation, err = f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 9)), delegation.Shares)
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+
+	totalLiquid, err = f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, totalLiquid.IsZero())
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, valAddr := addrs[0], valAddrs[0]
+
+	params, err := f.stakingKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+
+	// 3 of the 9 shares of the validator is above a 10% validator cap
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delAddr, valAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 3), delAddr)
+	assert.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// 3 of the 16 bonded tokens is above a 15% global cap, 2 is below
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(15, 2)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delAddr, valAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 3), delAddr)
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delAddr, valAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 2), delAddr)
+	assert.NilError(t, err)
+}
+
+func TestTokenizeAllSharesOfUnbondedValidator(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, valAddr := addrs[2], valAddrs[2]
+
+	// the delegator holds all the shares of an unbonded validator
+	validator := testutil.NewValidator(t, valAddr, PKs[2])
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, validator))
+	assert.NilError(t, f.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	assert.NilError(t, f.stakingKeeper.SetNewValidatorByPowerIndex(ctx, validator))
+	amount := f.stakingKeeper.TokensFromConsensusPower(ctx, 5)
+	_, err := f.stakingKeeper.Delegate(ctx, delAddr, amount, types.Unbonded, validator, true)
+	assert.NilError(t, err)
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delAddr, valAddr, amount, delAddr)
+	assert.ErrorContains(t, err, "cannot tokenize all the shares of an unbonded validator")
+
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, validator.IsUnbonded())
+
+	// tokenizing part of the shares leaves the validator in place
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delAddr, valAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 2), delAddr)
+	assert.NilError(t, err)
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, amount, validator.Tokens)
+}
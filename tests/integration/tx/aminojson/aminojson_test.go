@@ -345,7 +345,7 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		},
 		"slashing/params/empty_dec": {
 			gogo:   &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7},
-			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}},
+			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}, DowntimeOffenseDecayWindow: &durationpb.Duration{}},
 		},
 		// This test cases demonstrates the expected contract and proper way to set a cosmos.Dec field represented
 		// as bytes in protobuf message, namely:
@@ -356,8 +356,9 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 				MinSignedPerWindow:   math.LegacyNewDec(10),
 			},
 			pulsar: &slashingapi.Params{
-				DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7},
-				MinSignedPerWindow:   dec10bz,
+				DowntimeJailDuration:       &durationpb.Duration{Seconds: 1, Nanos: 7},
+				MinSignedPerWindow:         dec10bz,
+				DowntimeOffenseDecayWindow: &durationpb.Duration{},
 			},
 		},
 		"staking/msg_update_params": {
@@ -171,6 +171,7 @@ func AuthModule() ModuleOption {
 					{Account: testutil.ProtocolPoolModuleName},
 					{Account: "stream_acc"},
 					{Account: "protocolpool_distr"},
+					{Account: "protocolpool_grants"},
 				},
 			}),
 		}
@@ -22,22 +22,26 @@ import (
 
 // environment variable names
 const (
-	EnvHome                     = "DAEMON_HOME"
-	EnvName                     = "DAEMON_NAME"
-	EnvDownloadBin              = "DAEMON_ALLOW_DOWNLOAD_BINARIES"
-	EnvDownloadMustHaveChecksum = "DAEMON_DOWNLOAD_MUST_HAVE_CHECKSUM"
-	EnvRestartUpgrade           = "DAEMON_RESTART_AFTER_UPGRADE"
-	EnvRestartDelay             = "DAEMON_RESTART_DELAY"
-	EnvShutdownGrace            = "DAEMON_SHUTDOWN_GRACE"
-	EnvSkipBackup               = "UNSAFE_SKIP_BACKUP"
-	EnvDataBackupPath           = "DAEMON_DATA_BACKUP_DIR"
-	EnvInterval                 = "DAEMON_POLL_INTERVAL"
-	EnvPreupgradeMaxRetries     = "DAEMON_PREUPGRADE_MAX_RETRIES"
-	EnvDisableLogs              = "COSMOVISOR_DISABLE_LOGS"
-	EnvColorLogs                = "COSMOVISOR_COLOR_LOGS"
-	EnvTimeFormatLogs           = "COSMOVISOR_TIMEFORMAT_LOGS"
-	EnvCustomPreupgrade         = "COSMOVISOR_CUSTOM_PREUPGRADE"
-	EnvDisableRecase            = "COSMOVISOR_DISABLE_RECASE"
+	EnvHome                      = "DAEMON_HOME"
+	EnvName                      = "DAEMON_NAME"
+	EnvDownloadBin               = "DAEMON_ALLOW_DOWNLOAD_BINARIES"
+	EnvDownloadMustHaveChecksum  = "DAEMON_DOWNLOAD_MUST_HAVE_CHECKSUM"
+	EnvDownloadMustHaveSignature = "DAEMON_DOWNLOAD_MUST_HAVE_SIGNATURE"
+	EnvRestartUpgrade            = "DAEMON_RESTART_AFTER_UPGRADE"
+	EnvRestartDelay              = "DAEMON_RESTART_DELAY"
+	EnvShutdownGrace             = "DAEMON_SHUTDOWN_GRACE"
+	EnvSkipBackup                = "UNSAFE_SKIP_BACKUP"
+	EnvDataBackupPath            = "DAEMON_DATA_BACKUP_DIR"
+	EnvInterval                  = "DAEMON_POLL_INTERVAL"
+	EnvPreupgradeMaxRetries      = "DAEMON_PREUPGRADE_MAX_RETRIES"
+	EnvDisableLogs               = "COSMOVISOR_DISABLE_LOGS"
+	EnvColorLogs                 = "COSMOVISOR_COLOR_LOGS"
+	EnvTimeFormatLogs            = "COSMOVISOR_TIMEFORMAT_LOGS"
+	EnvCustomPreupgrade          = "COSMOVISOR_CUSTOM_PREUPGRADE"
+	EnvDisableRecase             = "COSMOVISOR_DISABLE_RECASE"
+	EnvRollbackMinBlocks         = "COSMOVISOR_ROLLBACK_MIN_BLOCKS"
+	EnvRollbackDeadline          = "COSMOVISOR_ROLLBACK_DEADLINE"
+	EnvRollbackRPCAddress        = "COSMOVISOR_ROLLBACK_RPC_ADDRESS"
 )
 
 const (
@@ -46,28 +50,38 @@ const (
 	upgradesDir = "upgrades"
 	currentLink = "current"
 
+	rollbackWatchFileName    = "rollback-watch.json"
+	rollbackIncidentFileName = "rollback-incident.json"
+
+	// DefaultRollbackRPCAddress is the CometBFT RPC address queried for the height of the upgraded binary.
+	DefaultRollbackRPCAddress = "http://localhost:26657"
+
 	cfgFileName  = "config"
 	cfgExtension = "toml"
 )
 
 // Config is the information passed in to control the daemon
 type Config struct {
-	Home                     string        `toml:"daemon_home" mapstructure:"daemon_home"`
-	Name                     string        `toml:"daemon_name" mapstructure:"daemon_name"`
-	AllowDownloadBinaries    bool          `toml:"daemon_allow_download_binaries" mapstructure:"daemon_allow_download_binaries" default:"false"`
-	DownloadMustHaveChecksum bool          `toml:"daemon_download_must_have_checksum" mapstructure:"daemon_download_must_have_checksum" default:"false"`
-	RestartAfterUpgrade      bool          `toml:"daemon_restart_after_upgrade" mapstructure:"daemon_restart_after_upgrade" default:"true"`
-	RestartDelay             time.Duration `toml:"daemon_restart_delay" mapstructure:"daemon_restart_delay"`
-	ShutdownGrace            time.Duration `toml:"daemon_shutdown_grace" mapstructure:"daemon_shutdown_grace"`
-	PollInterval             time.Duration `toml:"daemon_poll_interval" mapstructure:"daemon_poll_interval" default:"300ms"`
-	UnsafeSkipBackup         bool          `toml:"unsafe_skip_backup" mapstructure:"unsafe_skip_backup" default:"false"`
-	DataBackupPath           string        `toml:"daemon_data_backup_dir" mapstructure:"daemon_data_backup_dir"`
-	PreUpgradeMaxRetries     int           `toml:"daemon_preupgrade_max_retries" mapstructure:"daemon_preupgrade_max_retries" default:"0"`
-	DisableLogs              bool          `toml:"cosmovisor_disable_logs" mapstructure:"cosmovisor_disable_logs" default:"false"`
-	ColorLogs                bool          `toml:"cosmovisor_color_logs" mapstructure:"cosmovisor_color_logs" default:"true"`
-	TimeFormatLogs           string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
-	CustomPreUpgrade         string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
-	DisableRecase            bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	Home                      string        `toml:"daemon_home" mapstructure:"daemon_home"`
+	Name                      string        `toml:"daemon_name" mapstructure:"daemon_name"`
+	AllowDownloadBinaries     bool          `toml:"daemon_allow_download_binaries" mapstructure:"daemon_allow_download_binaries" default:"false"`
+	DownloadMustHaveChecksum  bool          `toml:"daemon_download_must_have_checksum" mapstructure:"daemon_download_must_have_checksum" default:"false"`
+	DownloadMustHaveSignature bool          `toml:"daemon_download_must_have_signature" mapstructure:"daemon_download_must_have_signature" default:"false"`
+	RestartAfterUpgrade       bool          `toml:"daemon_restart_after_upgrade" mapstructure:"daemon_restart_after_upgrade" default:"true"`
+	RestartDelay              time.Duration `toml:"daemon_restart_delay" mapstructure:"daemon_restart_delay"`
+	ShutdownGrace             time.Duration `toml:"daemon_shutdown_grace" mapstructure:"daemon_shutdown_grace"`
+	PollInterval              time.Duration `toml:"daemon_poll_interval" mapstructure:"daemon_poll_interval" default:"300ms"`
+	UnsafeSkipBackup          bool          `toml:"unsafe_skip_backup" mapstructure:"unsafe_skip_backup" default:"false"`
+	DataBackupPath            string        `toml:"daemon_data_backup_dir" mapstructure:"daemon_data_backup_dir"`
+	PreUpgradeMaxRetries      int           `toml:"daemon_preupgrade_max_retries" mapstructure:"daemon_preupgrade_max_retries" default:"0"`
+	DisableLogs               bool          `toml:"cosmovisor_disable_logs" mapstructure:"cosmovisor_disable_logs" default:"false"`
+	ColorLogs                 bool          `toml:"cosmovisor_color_logs" mapstructure:"cosmovisor_color_logs" default:"true"`
+	TimeFormatLogs            string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
+	CustomPreUpgrade          string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
+	DisableRecase             bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	RollbackMinBlocks         int           `toml:"cosmovisor_rollback_min_blocks" mapstructure:"cosmovisor_rollback_min_blocks" default:"0"`
+	RollbackDeadline          time.Duration `toml:"cosmovisor_rollback_deadline" mapstructure:"cosmovisor_rollback_deadline"`
+	RollbackRPCAddress        string        `toml:"cosmovisor_rollback_rpc_address" mapstructure:"cosmovisor_rollback_rpc_address" default:"http://localhost:26657"`
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -109,6 +123,16 @@ func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", upgradetypes.UpgradeInfoFilename)
 }
 
+// RollbackWatchFilePath is the file recording the upgrade whose binary is watched for progress
+func (cfg *Config) RollbackWatchFilePath() string {
+	return filepath.Join(cfg.Root(), rollbackWatchFileName)
+}
+
+// RollbackIncidentFilePath is the incident report written when an upgrade is rolled back
+func (cfg *Config) RollbackIncidentFilePath() string {
+	return filepath.Join(cfg.Root(), rollbackIncidentFileName)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -190,6 +214,10 @@ func GetConfigFromFile(filePath string) (*Config, error) {
 		errs []error
 	)
 
+	if cfg.RollbackRPCAddress == "" {
+		cfg.RollbackRPCAddress = DefaultRollbackRPCAddress
+	}
+
 	if cfg.TimeFormatLogs, err = getTimeFormatOption(cfg.TimeFormatLogs); err != nil {
 		errs = append(errs, err)
 	}
@@ -207,16 +235,21 @@ func GetConfigFromFile(filePath string) (*Config, error) {
 func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 	var errs []error
 	cfg := &Config{
-		Home:             os.Getenv(EnvHome),
-		Name:             os.Getenv(EnvName),
-		DataBackupPath:   os.Getenv(EnvDataBackupPath),
-		CustomPreUpgrade: os.Getenv(EnvCustomPreupgrade),
+		Home:               os.Getenv(EnvHome),
+		Name:               os.Getenv(EnvName),
+		DataBackupPath:     os.Getenv(EnvDataBackupPath),
+		CustomPreUpgrade:   os.Getenv(EnvCustomPreupgrade),
+		RollbackRPCAddress: os.Getenv(EnvRollbackRPCAddress),
 	}
 
 	if cfg.DataBackupPath == "" {
 		cfg.DataBackupPath = cfg.Home
 	}
 
+	if cfg.RollbackRPCAddress == "" {
+		cfg.RollbackRPCAddress = DefaultRollbackRPCAddress
+	}
+
 	var err error
 	if cfg.AllowDownloadBinaries, err = BooleanOption(EnvDownloadBin, false); err != nil {
 		errs = append(errs, err)
@@ -224,6 +257,9 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 	if cfg.DownloadMustHaveChecksum, err = BooleanOption(EnvDownloadMustHaveChecksum, false); err != nil {
 		errs = append(errs, err)
 	}
+	if cfg.DownloadMustHaveSignature, err = BooleanOption(EnvDownloadMustHaveSignature, false); err != nil {
+		errs = append(errs, err)
+	}
 	if cfg.RestartAfterUpgrade, err = BooleanOption(EnvRestartUpgrade, true); err != nil {
 		errs = append(errs, err)
 	}
@@ -282,6 +318,20 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	envRollbackMinBlocksVal := os.Getenv(EnvRollbackMinBlocks)
+	if cfg.RollbackMinBlocks, err = strconv.Atoi(envRollbackMinBlocksVal); err != nil && envRollbackMinBlocksVal != "" {
+		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvRollbackMinBlocks, err))
+	}
+
+	if rollbackDeadline := os.Getenv(EnvRollbackDeadline); rollbackDeadline != "" {
+		val, err := parseEnvDuration(rollbackDeadline)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvRollbackDeadline, err))
+		} else {
+			cfg.RollbackDeadline = val
+		}
+	}
+
 	if !skipValidate {
 		errs = append(errs, cfg.validate()...)
 		if len(errs) > 0 {
@@ -345,6 +395,16 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	// validate the rollback options, the rollback restores the data backup
+	switch {
+	case cfg.RollbackMinBlocks < 0:
+		errs = append(errs, fmt.Errorf("%s must not be negative", EnvRollbackMinBlocks))
+	case cfg.RollbackMinBlocks > 0 && cfg.RollbackDeadline <= 0:
+		errs = append(errs, fmt.Errorf("%s must be set when %s is set", EnvRollbackDeadline, EnvRollbackMinBlocks))
+	case cfg.RollbackMinBlocks > 0 && cfg.UnsafeSkipBackup:
+		errs = append(errs, fmt.Errorf("%s cannot be set when %s is true", EnvRollbackMinBlocks, EnvSkipBackup))
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -536,6 +596,7 @@ func (cfg Config) DetailString() string {
 		{EnvName, cfg.Name},
 		{EnvDownloadBin, fmt.Sprintf("%t", cfg.AllowDownloadBinaries)},
 		{EnvDownloadMustHaveChecksum, fmt.Sprintf("%t", cfg.DownloadMustHaveChecksum)},
+		{EnvDownloadMustHaveSignature, fmt.Sprintf("%t", cfg.DownloadMustHaveSignature)},
 		{EnvRestartUpgrade, fmt.Sprintf("%t", cfg.RestartAfterUpgrade)},
 		{EnvRestartDelay, cfg.RestartDelay.String()},
 		{EnvShutdownGrace, cfg.ShutdownGrace.String()},
@@ -548,6 +609,9 @@ func (cfg Config) DetailString() string {
 		{EnvTimeFormatLogs, cfg.TimeFormatLogs},
 		{EnvCustomPreupgrade, cfg.CustomPreUpgrade},
 		{EnvDisableRecase, fmt.Sprintf("%t", cfg.DisableRecase)},
+		{EnvRollbackMinBlocks, fmt.Sprintf("%d", cfg.RollbackMinBlocks)},
+		{EnvRollbackDeadline, cfg.RollbackDeadline.String()},
+		{EnvRollbackRPCAddress, cfg.RollbackRPCAddress},
 	}
 
 	derivedEntries := []struct{ name, value string }{
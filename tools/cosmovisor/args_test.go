@@ -25,22 +25,26 @@ func TestArgsTestSuite(t *testing.T) {
 
 // cosmovisorEnv are the string values of environment variables used to configure Cosmovisor.
 type cosmovisorEnv struct {
-	Home                     string
-	Name                     string
-	DownloadBin              string
-	DownloadMustHaveChecksum string
-	RestartUpgrade           string
-	RestartDelay             string
-	SkipBackup               string
-	DataBackupPath           string
-	Interval                 string
-	PreupgradeMaxRetries     string
-	DisableLogs              string
-	ColorLogs                string
-	TimeFormatLogs           string
-	CustomPreupgrade         string
-	DisableRecase            string
-	ShutdownGrace            string
+	Home                      string
+	Name                      string
+	DownloadBin               string
+	DownloadMustHaveChecksum  string
+	RestartUpgrade            string
+	RestartDelay              string
+	SkipBackup                string
+	DataBackupPath            string
+	Interval                  string
+	PreupgradeMaxRetries      string
+	DisableLogs               string
+	ColorLogs                 string
+	TimeFormatLogs            string
+	CustomPreupgrade          string
+	DisableRecase             string
+	ShutdownGrace             string
+	DownloadMustHaveSignature string
+	RollbackMinBlocks         string
+	RollbackDeadline          string
+	RollbackRPCAddress        string
 }
 
 type envMap struct {
@@ -51,22 +55,26 @@ type envMap struct {
 // ToMap creates a map of the cosmovisorEnv where the keys are the env var names.
 func (c cosmovisorEnv) ToMap() map[string]envMap {
 	return map[string]envMap{
-		EnvHome:                     {val: c.Home, allowEmpty: false},
-		EnvName:                     {val: c.Name, allowEmpty: false},
-		EnvDownloadBin:              {val: c.DownloadBin, allowEmpty: false},
-		EnvDownloadMustHaveChecksum: {val: c.DownloadMustHaveChecksum, allowEmpty: false},
-		EnvRestartUpgrade:           {val: c.RestartUpgrade, allowEmpty: false},
-		EnvRestartDelay:             {val: c.RestartDelay, allowEmpty: false},
-		EnvShutdownGrace:            {val: c.ShutdownGrace, allowEmpty: false},
-		EnvSkipBackup:               {val: c.SkipBackup, allowEmpty: false},
-		EnvDataBackupPath:           {val: c.DataBackupPath, allowEmpty: false},
-		EnvInterval:                 {val: c.Interval, allowEmpty: false},
-		EnvPreupgradeMaxRetries:     {val: c.PreupgradeMaxRetries, allowEmpty: false},
-		EnvDisableLogs:              {val: c.DisableLogs, allowEmpty: false},
-		EnvColorLogs:                {val: c.ColorLogs, allowEmpty: false},
-		EnvTimeFormatLogs:           {val: c.TimeFormatLogs, allowEmpty: true},
-		EnvCustomPreupgrade:         {val: c.CustomPreupgrade, allowEmpty: true},
-		EnvDisableRecase:            {val: c.DisableRecase, allowEmpty: true},
+		EnvHome:                      {val: c.Home, allowEmpty: false},
+		EnvName:                      {val: c.Name, allowEmpty: false},
+		EnvDownloadBin:               {val: c.DownloadBin, allowEmpty: false},
+		EnvDownloadMustHaveChecksum:  {val: c.DownloadMustHaveChecksum, allowEmpty: false},
+		EnvRestartUpgrade:            {val: c.RestartUpgrade, allowEmpty: false},
+		EnvRestartDelay:              {val: c.RestartDelay, allowEmpty: false},
+		EnvShutdownGrace:             {val: c.ShutdownGrace, allowEmpty: false},
+		EnvSkipBackup:                {val: c.SkipBackup, allowEmpty: false},
+		EnvDataBackupPath:            {val: c.DataBackupPath, allowEmpty: false},
+		EnvInterval:                  {val: c.Interval, allowEmpty: false},
+		EnvPreupgradeMaxRetries:      {val: c.PreupgradeMaxRetries, allowEmpty: false},
+		EnvDisableLogs:               {val: c.DisableLogs, allowEmpty: false},
+		EnvColorLogs:                 {val: c.ColorLogs, allowEmpty: false},
+		EnvTimeFormatLogs:            {val: c.TimeFormatLogs, allowEmpty: true},
+		EnvCustomPreupgrade:          {val: c.CustomPreupgrade, allowEmpty: true},
+		EnvDisableRecase:             {val: c.DisableRecase, allowEmpty: true},
+		EnvDownloadMustHaveSignature: {val: c.DownloadMustHaveSignature, allowEmpty: true},
+		EnvRollbackMinBlocks:         {val: c.RollbackMinBlocks, allowEmpty: true},
+		EnvRollbackDeadline:          {val: c.RollbackDeadline, allowEmpty: true},
+		EnvRollbackRPCAddress:        {val: c.RollbackRPCAddress, allowEmpty: true},
 	}
 }
 
@@ -81,6 +89,8 @@ func (c *cosmovisorEnv) Set(envVar, envVal string) {
 		c.DownloadBin = envVal
 	case EnvDownloadMustHaveChecksum:
 		c.DownloadMustHaveChecksum = envVal
+	case EnvDownloadMustHaveSignature:
+		c.DownloadMustHaveSignature = envVal
 	case EnvRestartUpgrade:
 		c.RestartUpgrade = envVal
 	case EnvRestartDelay:
@@ -105,6 +115,12 @@ func (c *cosmovisorEnv) Set(envVar, envVal string) {
 		c.CustomPreupgrade = envVal
 	case EnvDisableRecase:
 		c.DisableRecase = envVal
+	case EnvRollbackMinBlocks:
+		c.RollbackMinBlocks = envVal
+	case EnvRollbackDeadline:
+		c.RollbackDeadline = envVal
+	case EnvRollbackRPCAddress:
+		c.RollbackRPCAddress = envVal
 	default:
 		panic(fmt.Errorf("Unknown environment variable [%s]. Cannot set field to [%s]. ", envVar, envVal))
 	}
@@ -422,6 +438,7 @@ func (s *argsTestSuite) TestDetailString() {
 		fmt.Sprintf("%s: %s", EnvName, name),
 		fmt.Sprintf("%s: %t", EnvDownloadBin, allowDownloadBinaries),
 		fmt.Sprintf("%s: %t", EnvDownloadMustHaveChecksum, downloadMustHaveChecksum),
+		fmt.Sprintf("%s: %t", EnvDownloadMustHaveSignature, cfg.DownloadMustHaveSignature),
 		fmt.Sprintf("%s: %t", EnvRestartUpgrade, restartAfterUpgrade),
 		fmt.Sprintf("%s: %s", EnvInterval, pollInterval),
 		fmt.Sprintf("%s: %t", EnvSkipBackup, unsafeSkipBackup),
@@ -430,6 +447,9 @@ func (s *argsTestSuite) TestDetailString() {
 		fmt.Sprintf("%s: %t", EnvDisableLogs, cfg.DisableLogs),
 		fmt.Sprintf("%s: %t", EnvColorLogs, cfg.ColorLogs),
 		fmt.Sprintf("%s: %s", EnvTimeFormatLogs, cfg.TimeFormatLogs),
+		fmt.Sprintf("%s: %d", EnvRollbackMinBlocks, cfg.RollbackMinBlocks),
+		fmt.Sprintf("%s: %s", EnvRollbackDeadline, cfg.RollbackDeadline),
+		fmt.Sprintf("%s: %s", EnvRollbackRPCAddress, cfg.RollbackRPCAddress),
 		"Derived Values:",
 		fmt.Sprintf("Root Dir: %s", home),
 		fmt.Sprintf("Upgrade Dir: %s", home),
@@ -459,24 +479,31 @@ var newConfig = func(
 	customPreUpgrade string,
 	disableRecase bool,
 	shutdownGrace int,
+	downloadMustHaveSignature bool,
+	rollbackMinBlocks, rollbackDeadline int,
+	rollbackRPCAddress string,
 ) *Config {
 	return &Config{
-		Home:                     home,
-		Name:                     name,
-		AllowDownloadBinaries:    downloadBin,
-		DownloadMustHaveChecksum: downloadMustHaveChecksum,
-		RestartAfterUpgrade:      restartUpgrade,
-		RestartDelay:             time.Millisecond * time.Duration(restartDelay),
-		PollInterval:             time.Millisecond * time.Duration(interval),
-		UnsafeSkipBackup:         skipBackup,
-		DataBackupPath:           dataBackupPath,
-		PreUpgradeMaxRetries:     preupgradeMaxRetries,
-		DisableLogs:              disableLogs,
-		ColorLogs:                colorLogs,
-		TimeFormatLogs:           timeFormatLogs,
-		CustomPreUpgrade:         customPreUpgrade,
-		DisableRecase:            disableRecase,
-		ShutdownGrace:            time.Duration(shutdownGrace),
+		Home:                      home,
+		Name:                      name,
+		AllowDownloadBinaries:     downloadBin,
+		DownloadMustHaveChecksum:  downloadMustHaveChecksum,
+		RestartAfterUpgrade:       restartUpgrade,
+		RestartDelay:              time.Millisecond * time.Duration(restartDelay),
+		PollInterval:              time.Millisecond * time.Duration(interval),
+		UnsafeSkipBackup:          skipBackup,
+		DataBackupPath:            dataBackupPath,
+		PreUpgradeMaxRetries:      preupgradeMaxRetries,
+		DisableLogs:               disableLogs,
+		ColorLogs:                 colorLogs,
+		TimeFormatLogs:            timeFormatLogs,
+		CustomPreUpgrade:          customPreUpgrade,
+		DisableRecase:             disableRecase,
+		ShutdownGrace:             time.Duration(shutdownGrace),
+		DownloadMustHaveSignature: downloadMustHaveSignature,
+		RollbackMinBlocks:         rollbackMinBlocks,
+		RollbackDeadline:          time.Millisecond * time.Duration(rollbackDeadline),
+		RollbackRPCAddress:        rollbackRPCAddress,
 	}
 }
 
@@ -497,33 +524,37 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 		{
 			name: "all bad",
 			envVals: cosmovisorEnv{
-				Home:                     "",
-				Name:                     "",
-				DownloadBin:              "bad",
-				DownloadMustHaveChecksum: "bad",
-				RestartUpgrade:           "bad",
-				RestartDelay:             "bad",
-				SkipBackup:               "bad",
-				DataBackupPath:           "bad",
-				Interval:                 "bad",
-				PreupgradeMaxRetries:     "bad",
-				TimeFormatLogs:           "bad",
-				CustomPreupgrade:         "",
-				DisableRecase:            "bad",
-				ShutdownGrace:            "bad",
+				Home:                      "",
+				Name:                      "",
+				DownloadBin:               "bad",
+				DownloadMustHaveChecksum:  "bad",
+				RestartUpgrade:            "bad",
+				RestartDelay:              "bad",
+				SkipBackup:                "bad",
+				DataBackupPath:            "bad",
+				Interval:                  "bad",
+				PreupgradeMaxRetries:      "bad",
+				TimeFormatLogs:            "bad",
+				CustomPreupgrade:          "",
+				DisableRecase:             "bad",
+				ShutdownGrace:             "bad",
+				DownloadMustHaveSignature: "bad",
+				RollbackMinBlocks:         "bad",
+				RollbackDeadline:          "bad",
+				RollbackRPCAddress:        "",
 			},
 			expectedCfg:      nil,
-			expectedErrCount: 13,
+			expectedErrCount: 16,
 		},
 		{
 			name:             "all good",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "true", "10s"},
-			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", true, 10000000000),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "true", "10s", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", true, 10000000000, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "nothing set",
-			envVals:          cosmovisorEnv{"", "", "", "", "", "", "", "", "", "", "false", "false", "", "", "", ""},
+			envVals:          cosmovisorEnv{"", "", "", "", "", "", "", "", "", "", "false", "false", "", "", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 3,
 		},
@@ -531,237 +562,258 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 		// timeformat tests are done in the TestTimeFormat
 		{
 			name:             "download bin bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "bad", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "bad", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "download bin not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "download bin true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "download bin false",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "download ensure checksum true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, false, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, false, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart upgrade bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "bad", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "bad", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart upgrade not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, true, true, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, true, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart upgrade true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "true", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, true, true, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "true", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, true, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart upgrade true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "skip unsafe backups bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "bad", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "bad", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "skip unsafe backups not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, false, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, false, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "skip unsafe backups true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "true", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, true, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "skip unsafe backups false",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, false, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "false", "600ms", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", true, true, false, 600, false, absPath, 303, 1, false, true, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "poll interval bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "bad", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "bad", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "poll interval 0",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "0", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "0", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "poll interval not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "", "1", "false", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 300, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "", "1", "false", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 300, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "poll interval 600",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "600", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "600", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "poll interval 1s",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "1s", "1", "false", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 1000, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "1s", "1", "false", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 1000, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "poll interval -3m",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "-3m", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "-3m", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "bad", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "bad", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay 0",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "0", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "0", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "", "false", "", "303ms", "1", "false", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 0, false, absPath, 303, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "", "false", "", "303ms", "1", "false", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 0, false, absPath, 303, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart delay 600",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600", "false", "", "300ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600", "false", "", "300ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay 1s",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "1s", "false", "", "303ms", "1", "false", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 1000, false, absPath, 303, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "1s", "false", "", "303ms", "1", "false", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 1000, false, absPath, 303, 1, false, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart delay -3m",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "-3m", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "-3m", "false", "", "303ms", "1", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "prepupgrade max retries bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "bad", "false", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "bad", "false", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "prepupgrade max retries 0",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "0", "false", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, false, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "0", "false", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, false, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "prepupgrade max retries not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "false", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, false, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "false", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, false, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "prepupgrade max retries 5",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "5", "false", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 5, false, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "5", "false", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 5, false, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "disable logs bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "5", "bad", "true", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "5", "bad", "true", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "disable logs good",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "disable logs color bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "5", "true", "bad", "kitchen", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "5", "true", "bad", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "disable logs color good",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "false", "kitchen", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, false, time.Kitchen, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "false", "kitchen", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, false, time.Kitchen, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "disable logs timestamp",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "false", "", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, false, "", "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "false", "", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, false, "", "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "enable rf3339 logs timestamp",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, true, time.RFC3339, "preupgrade.sh", false, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, true, time.RFC3339, "preupgrade.sh", false, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "invalid logs timestamp format",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "invalid", "preupgrade.sh", "", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "invalid", "preupgrade.sh", "", "", "", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "disable recase good",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", ""},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, true, time.RFC3339, "preupgrade.sh", true, 0),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", "", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, true, time.RFC3339, "preupgrade.sh", true, 0, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "disable recase bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "bad", ""},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "bad", "", "", "", "", ""},
 			expectedErrCount: 1,
 		},
 		{
 			name:             "shutdown grace good",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", "15s"},
-			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, true, time.RFC3339, "preupgrade.sh", true, 15000000000),
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", "15s", "", "", "", ""},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, true, time.RFC3339, "preupgrade.sh", true, 15000000000, false, 0, 0, DefaultRollbackRPCAddress),
 			expectedErrCount: 0,
 		},
+		{
+			name:             "rollback good",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", "15s", "", "10", "5m", "http://127.0.0.1:36657"},
+			expectedCfg:      newConfig(absPath, "testname", false, true, false, 600, false, absPath, 406, 0, true, true, time.RFC3339, "preupgrade.sh", true, 15000000000, false, 10, 300000, "http://127.0.0.1:36657"),
+			expectedErrCount: 0,
+		},
+		{
+			name:             "rollback min blocks bad",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", "15s", "", "-1", "5m", ""},
+			expectedErrCount: 1,
+		},
+		{
+			name:             "rollback without deadline",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "false", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", "15s", "", "10", "", ""},
+			expectedErrCount: 1,
+		},
+		{
+			name:             "rollback without backup",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "true", "false", "600ms", "true", "", "406ms", "", "true", "true", "rfc3339", "preupgrade.sh", "true", "15s", "", "10", "5m", ""},
+			expectedErrCount: 1,
+		},
 	}
 
 	for _, tc := range tests {
@@ -794,7 +846,7 @@ func (s *argsTestSuite) setUpDir() string {
 func (s *argsTestSuite) setupConfig(home string) string {
 	s.T().Helper()
 
-	cfg := newConfig(home, "test", true, true, true, 406, false, home, 8, 0, false, true, "kitchen", "", true, 10000000000)
+	cfg := newConfig(home, "test", true, true, true, 406, false, home, 8, 0, false, true, "kitchen", "", true, 10000000000, false, 0, 0, DefaultRollbackRPCAddress)
 	path := filepath.Join(home, rootName, "config.toml")
 	f, err := os.Create(path)
 	s.Require().NoError(err)
@@ -824,7 +876,7 @@ func (s *argsTestSuite) TestConfigFromFile() {
 		{
 			name: "valid config",
 			expectedCfg: func() *Config {
-				return newConfig(home, "test", true, true, true, 406, false, home, 8, 0, false, true, time.Kitchen, "", true, 10000000000)
+				return newConfig(home, "test", true, true, true, 406, false, home, 8, 0, false, true, time.Kitchen, "", true, 10000000000, false, 0, 0, DefaultRollbackRPCAddress)
 			},
 			filePath:      cfgFilePath,
 			expectedError: "",
@@ -839,18 +891,18 @@ func (s *argsTestSuite) TestConfigFromFile() {
 				os.Setenv(EnvName, "env-name")
 			},
 			expectedCfg: func() *Config {
-				return newConfig(home, "env-name", true, true, true, 406, false, home, 8, 0, false, true, time.Kitchen, "", true, 10000000000)
+				return newConfig(home, "env-name", true, true, true, 406, false, home, 8, 0, false, true, time.Kitchen, "", true, 10000000000, false, 0, 0, DefaultRollbackRPCAddress)
 			},
 		},
 		{
 			name: "empty config file path will load config from ENV variables",
 			expectedCfg: func() *Config {
-				return newConfig(home, "test", true, true, true, 406, false, home, 8, 0, false, true, time.Kitchen, "", true, 10000000000)
+				return newConfig(home, "test", true, true, true, 406, false, home, 8, 0, false, true, time.Kitchen, "", true, 10000000000, false, 0, 0, DefaultRollbackRPCAddress)
 			},
 			filePath:      "",
 			expectedError: "",
 			malleate: func() {
-				s.setEnv(s.T(), &cosmovisorEnv{home, "test", "true", "true", "true", "406ms", "false", home, "8ms", "0", "false", "true", "kitchen", "", "true", "10s"})
+				s.setEnv(s.T(), &cosmovisorEnv{home, "test", "true", "true", "true", "406ms", "false", home, "8ms", "0", "false", "true", "kitchen", "", "true", "10s", "", "", "", ""})
 			},
 		},
 	}
@@ -40,6 +40,10 @@ func NewLauncher(logger log.Logger, cfg *Config) (Launcher, error) {
 // exits (either when it dies, or *after* a successful upgrade.) and upgrade finished.
 // Returns true if the upgrade request was detected and the upgrade process started.
 func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer) (bool, error) {
+	if err := l.checkRollbackIncident(); err != nil {
+		return false, err
+	}
+
 	bin, err := l.cfg.CurrentBin()
 	if err != nil {
 		return false, fmt.Errorf("error creating symlink to genesis: %w", err)
@@ -49,6 +53,24 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 		return false, fmt.Errorf("current binary is invalid: %w", err)
 	}
 
+	// if the binary of the last upgrade has not made progress yet, it is watched and rolled back after the deadline
+	var watch *rollbackWatch
+	if l.cfg.RollbackMinBlocks > 0 {
+		if watch, err = l.loadRollbackWatch(); err != nil {
+			return false, err
+		}
+	}
+	if watch != nil {
+		if watch.Deadline.IsZero() {
+			watch.Deadline = time.Now().Add(l.cfg.RollbackDeadline)
+			if err := l.saveRollbackWatch(watch); err != nil {
+				return false, fmt.Errorf("error while saving rollback watch: %w", err)
+			}
+		} else if time.Now().After(watch.Deadline) {
+			return false, l.rollback(watch, 0, "upgraded binary was not running at the rollback deadline")
+		}
+	}
+
 	l.logger.Info("running app", "path", bin, "args", args)
 	cmd := exec.Command(bin, args...)
 	cmd.Stdin = stdin
@@ -68,17 +90,37 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	var stalled <-chan int64
+	if watch != nil {
+		l.logger.Info("watching upgraded binary progress", "upgrade", watch.Upgrade.Name, "target height", watch.TargetHeight, "deadline", watch.Deadline)
+		stop := make(chan struct{})
+		defer close(stop)
+		stalled = l.monitorProgress(watch, stop)
+	}
+
+	if needsUpdate, err := l.waitForUpgradeOrExit(cmd, stalled); err != nil || !needsUpdate {
+		var noProgress *noProgressError
+		if errors.As(err, &noProgress) {
+			return false, l.rollback(watch, noProgress.lastHeight, noProgress.Error())
+		}
 		return false, err
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		backupPath, err := l.doBackup()
+		if err != nil {
 			return false, err
 		}
 
+		var next *rollbackWatch
+		if l.cfg.RollbackMinBlocks > 0 {
+			if next, err = l.newRollbackWatch(l.fw.currentInfo, backupPath); err != nil {
+				return false, err
+			}
+		}
+
 		if err := l.doCustomPreUpgrade(); err != nil {
 			return false, err
 		}
@@ -91,6 +133,12 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 			return false, err
 		}
 
+		if next != nil {
+			if err := l.saveRollbackWatch(next); err != nil {
+				return false, fmt.Errorf("error while saving rollback watch: %w", err)
+			}
+		}
+
 		return true, nil
 	}
 
@@ -105,6 +153,12 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 // It returns (false, nil) if the process exited normally without triggering an upgrade. This is very unlikely
 // to happen with "start" but may happen with short-lived commands like `simd genesis export ...`
 func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
+	return l.waitForUpgradeOrExit(cmd, nil)
+}
+
+// waitForUpgradeOrExit is WaitForUpgradeOrExit, additionally killing the process and returning
+// a *noProgressError when the last observed height is received on stalled.
+func (l Launcher) waitForUpgradeOrExit(cmd *exec.Cmd, stalled <-chan int64) (bool, error) {
 	currentUpgrade, err := l.cfg.UpgradeInfo()
 	if err != nil {
 		// upgrade info not found do nothing
@@ -120,33 +174,12 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	case <-l.fw.MonitorUpdate(currentUpgrade):
 		// upgrade - kill the process and restart
 		l.logger.Info("daemon shutting down in an attempt to restart")
-
-		if l.cfg.ShutdownGrace > 0 {
-			// Interrupt signal
-			l.logger.Info("sent interrupt to app, waiting for exit")
-			_ = cmd.Process.Signal(os.Interrupt)
-
-			// Wait app exit
-			psChan := make(chan *os.ProcessState)
-			go func() {
-				pstate, _ := cmd.Process.Wait()
-				psChan <- pstate
-			}()
-
-			// Timeout and kill
-			select {
-			case <-psChan:
-				// Normal Exit
-				l.logger.Info("app exited normally")
-			case <-time.After(l.cfg.ShutdownGrace):
-				l.logger.Info("DAEMON_SHUTDOWN_GRACE exceeded, killing app")
-				// Kill after grace period
-				_ = cmd.Process.Kill()
-			}
-		} else {
-			// Default: Immediate app kill
-			_ = cmd.Process.Kill()
-		}
+		l.shutdown(cmd)
+	case lastHeight := <-stalled:
+		// no progress - kill the process and roll back
+		l.logger.Error("upgraded binary did not make progress before the rollback deadline, shutting down", "last observed height", lastHeight)
+		l.shutdown(cmd)
+		return false, &noProgressError{lastHeight: lastHeight}
 	case err := <-cmdDone:
 		l.fw.Stop()
 		// no error -> command exits normally (eg. short command like `gaiad version`)
@@ -162,22 +195,53 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// shutdown stops the process, waiting for it to exit up to the shutdown grace period if one is configured.
+func (l Launcher) shutdown(cmd *exec.Cmd) {
+	if l.cfg.ShutdownGrace > 0 {
+		// Interrupt signal
+		l.logger.Info("sent interrupt to app, waiting for exit")
+		_ = cmd.Process.Signal(os.Interrupt)
+
+		// Wait app exit
+		psChan := make(chan *os.ProcessState)
+		go func() {
+			pstate, _ := cmd.Process.Wait()
+			psChan <- pstate
+		}()
+
+		// Timeout and kill
+		select {
+		case <-psChan:
+			// Normal Exit
+			l.logger.Info("app exited normally")
+		case <-time.After(l.cfg.ShutdownGrace):
+			l.logger.Info("DAEMON_SHUTDOWN_GRACE exceeded, killing app")
+			// Kill after grace period
+			_ = cmd.Process.Kill()
+		}
+	} else {
+		// Default: Immediate app kill
+		_ = cmd.Process.Kill()
+	}
+}
+
+// doBackup backs up the data directory, unless UNSAFE_SKIP_BACKUP is set, and returns the backup path.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(l.cfg.UpgradeInfoFilePath())
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return errors.New("upgrade-info.json is empty")
+			return "", errors.New("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -189,15 +253,16 @@ func (l Launcher) doBackup() error {
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
 		et := time.Now()
 		l.logger.Info("backup completed", "backup saved at", dst, "backup completion time", et, "time taken to complete backup", et.Sub(st))
+		return dst, nil
 	}
 
-	return nil
+	return "", nil
 }
 
 // doCustomPreUpgrade executes the custom preupgrade script if provided.
@@ -0,0 +1,276 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+const (
+	// rollbackPollInterval is the interval at which the height of the upgraded binary is queried.
+	rollbackPollInterval = time.Second
+	// privValidatorStateFileName is the file of the data directory in which CometBFT records the last
+	// height, round and step signed by the validator.
+	privValidatorStateFileName = "priv_validator_state.json"
+)
+
+// rollbackWatch records an upgrade whose binary must make progress before the rollback deadline.
+// It is persisted so that the watch survives restarts of cosmovisor.
+type rollbackWatch struct {
+	Upgrade upgradetypes.Plan `json:"upgrade"`
+	// TargetHeight is the height the upgraded binary must commit.
+	TargetHeight int64 `json:"target_height"`
+	// Deadline is set when the upgraded binary is first launched.
+	Deadline time.Time `json:"deadline,omitempty"`
+	// PreviousDir is the directory the current link pointed to before the upgrade.
+	PreviousDir string `json:"previous_dir"`
+	// BackupPath is the data backup taken before the upgrade.
+	BackupPath string `json:"backup_path"`
+}
+
+// rollbackIncident is the report written when an upgrade is rolled back.
+type rollbackIncident struct {
+	Time               time.Time         `json:"time"`
+	Reason             string            `json:"reason"`
+	Upgrade            upgradetypes.Plan `json:"upgrade"`
+	TargetHeight       int64             `json:"target_height"`
+	LastObservedHeight int64             `json:"last_observed_height"`
+	Deadline           time.Time         `json:"deadline"`
+	FailedBinary       string            `json:"failed_binary"`
+	RestoredBinary     string            `json:"restored_binary"`
+	RestoredBackup     string            `json:"restored_backup"`
+	FailedDataDir      string            `json:"failed_data_dir"`
+	Action             string            `json:"action"`
+}
+
+// noProgressError is returned when the upgraded binary did not commit enough blocks before the rollback deadline.
+type noProgressError struct {
+	lastHeight int64
+}
+
+func (e *noProgressError) Error() string {
+	return fmt.Sprintf("upgraded binary did not make progress before the rollback deadline, last observed height %d", e.lastHeight)
+}
+
+// newRollbackWatch creates the watch of the given upgrade, to be persisted once the upgraded binary is installed.
+func (l Launcher) newRollbackWatch(u upgradetypes.Plan, backupPath string) (*rollbackWatch, error) {
+	bin, err := l.cfg.CurrentBin()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting current binary path: %w", err)
+	}
+
+	return &rollbackWatch{
+		Upgrade:      u,
+		TargetHeight: u.Height - 1 + int64(l.cfg.RollbackMinBlocks),
+		// bin is <dir>/bin/<name>
+		PreviousDir: filepath.Dir(filepath.Dir(bin)),
+		BackupPath:  backupPath,
+	}, nil
+}
+
+// loadRollbackWatch returns the persisted rollback watch, or nil if no upgrade is watched.
+func (l Launcher) loadRollbackWatch() (*rollbackWatch, error) {
+	bz, err := os.ReadFile(l.cfg.RollbackWatchFilePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error while reading %s: %w", rollbackWatchFileName, err)
+	}
+
+	var watch rollbackWatch
+	if err := json.Unmarshal(bz, &watch); err != nil {
+		return nil, fmt.Errorf("error while parsing %s: %w", rollbackWatchFileName, err)
+	}
+
+	return &watch, nil
+}
+
+// saveRollbackWatch persists the rollback watch.
+func (l Launcher) saveRollbackWatch(watch *rollbackWatch) error {
+	bz, err := json.MarshalIndent(watch, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(l.cfg.RollbackWatchFilePath(), bz, 0o600)
+}
+
+// checkRollbackIncident refuses to run the app while the report of a rollback is present.
+func (l Launcher) checkRollbackIncident() error {
+	path := l.cfg.RollbackIncidentFilePath()
+	switch _, err := os.Stat(path); {
+	case err == nil:
+		return fmt.Errorf("an upgrade was rolled back, see the incident report %s and remove it to run the app again", path)
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	default:
+		return fmt.Errorf("error while checking rollback incident report: %w", err)
+	}
+}
+
+// monitorProgress queries the height of the upgraded binary until it reaches the target height of the watch,
+// in which case the watch is removed, or until the deadline passes, in which case the last observed height is sent
+// on the returned channel.
+func (l Launcher) monitorProgress(watch *rollbackWatch, stop <-chan struct{}) <-chan int64 {
+	stalled := make(chan int64, 1)
+	go func() {
+		ticker := time.NewTicker(rollbackPollInterval)
+		defer ticker.Stop()
+
+		var lastHeight int64
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			height, err := queryLatestHeight(l.cfg.RollbackRPCAddress)
+			if err != nil {
+				l.logger.Debug("could not query height of upgraded binary", "error", err)
+			} else if height > lastHeight {
+				lastHeight = height
+			}
+
+			if lastHeight >= watch.TargetHeight {
+				l.logger.Info("upgraded binary made progress, rollback is no longer possible", "upgrade", watch.Upgrade.Name, "height", lastHeight)
+				if err := os.Remove(l.cfg.RollbackWatchFilePath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+					l.logger.Error("failed to remove rollback watch", "error", err)
+				}
+				return
+			}
+
+			if time.Now().After(watch.Deadline) {
+				stalled <- lastHeight
+				return
+			}
+		}
+	}()
+
+	return stalled
+}
+
+// queryLatestHeight returns the latest block height reported by the CometBFT RPC status endpoint.
+func queryLatestHeight(rpcAddress string) (int64, error) {
+	client := http.Client{Timeout: rollbackPollInterval}
+	resp, err := client.Get(strings.TrimSuffix(rpcAddress, "/") + "/status")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, fmt.Errorf("invalid status response: %w", err)
+	}
+
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// rollback restores the data backup taken before the upgrade of the watch, switches the current link back
+// to the previous binary and writes an incident report, halting cosmovisor until an operator removes it.
+func (l Launcher) rollback(watch *rollbackWatch, lastHeight int64, reason string) error {
+	l.logger.Error("rolling back upgrade", "upgrade", watch.Upgrade.Name, "reason", reason, "last observed height", lastHeight)
+
+	failedBin, err := l.cfg.CurrentBin()
+	if err != nil {
+		return fmt.Errorf("error while getting current binary path: %w", err)
+	}
+
+	dataDir := filepath.Join(l.cfg.Home, "data")
+	restoredDataDir := filepath.Join(l.cfg.Home, fmt.Sprintf("data-rollback-%s", watch.Upgrade.Name))
+	if err := os.RemoveAll(restoredDataDir); err != nil {
+		return fmt.Errorf("error while cleaning up %s: %w", restoredDataDir, err)
+	}
+	if err := copy.Copy(watch.BackupPath, restoredDataDir); err != nil {
+		return fmt.Errorf("error while restoring data backup %s: %w", watch.BackupPath, err)
+	}
+
+	// The backup holds the validator sign state from before the upgrade. Restoring it would let the
+	// validator sign again at the heights it already signed with the upgraded binary, i.e. double sign.
+	if err := copyPrivValidatorState(dataDir, restoredDataDir); err != nil {
+		return err
+	}
+
+	// keep the data of the upgraded binary for inspection
+	failedDataDir := filepath.Join(l.cfg.Home, fmt.Sprintf("data-failed-%s-%d", watch.Upgrade.Name, time.Now().Unix()))
+	if err := os.Rename(dataDir, failedDataDir); err != nil {
+		return fmt.Errorf("error while moving data directory of the upgraded binary: %w", err)
+	}
+	if err := os.Rename(restoredDataDir, dataDir); err != nil {
+		return fmt.Errorf("error while moving restored data directory: %w", err)
+	}
+
+	link := filepath.Join(l.cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove existing link: %w", err)
+	}
+	if err := os.Symlink(watch.PreviousDir, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+
+	incident := rollbackIncident{
+		Time:               time.Now(),
+		Reason:             reason,
+		Upgrade:            watch.Upgrade,
+		TargetHeight:       watch.TargetHeight,
+		LastObservedHeight: lastHeight,
+		Deadline:           watch.Deadline,
+		FailedBinary:       failedBin,
+		RestoredBinary:     filepath.Join(watch.PreviousDir, "bin", l.cfg.Name),
+		RestoredBackup:     watch.BackupPath,
+		FailedDataDir:      failedDataDir,
+		Action: fmt.Sprintf("the app state and binary were restored to before upgrade %q, replace the binary of the upgrade "+
+			"in %s and remove this report to run the app again", watch.Upgrade.Name, l.cfg.UpgradeDir(watch.Upgrade.Name)),
+	}
+	bz, err := json.MarshalIndent(incident, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(l.cfg.RollbackIncidentFilePath(), bz, 0o600); err != nil {
+		return fmt.Errorf("error while writing rollback incident report: %w", err)
+	}
+
+	if err := os.Remove(l.cfg.RollbackWatchFilePath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove rollback watch: %w", err)
+	}
+
+	return fmt.Errorf("upgrade %q was rolled back: %s, see the incident report %s", watch.Upgrade.Name, reason, l.cfg.RollbackIncidentFilePath())
+}
+
+// copyPrivValidatorState copies the validator sign state of the src data directory to the dst one.
+// It is a no-op if src has no sign state, e.g. the node is not a validator.
+func copyPrivValidatorState(srcDataDir, dstDataDir string) error {
+	bz, err := os.ReadFile(filepath.Join(srcDataDir, privValidatorStateFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error while reading %s: %w", privValidatorStateFileName, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dstDataDir, privValidatorStateFileName), bz, 0o600); err != nil {
+		return fmt.Errorf("error while writing %s: %w", privValidatorStateFileName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// newStatusServer returns a CometBFT RPC server reporting the given height as the latest block height.
+func newStatusServer(t *testing.T, height *atomic.Int64) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"sync_info":{"latest_block_height":"%d"}}}`, height.Load())
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// setupRollbackHome creates a home with a genesis and an upgrade binary, a data directory and a data backup.
+func setupRollbackHome(t *testing.T) *Config {
+	t.Helper()
+
+	home := t.TempDir()
+	cfg := &Config{
+		Home:              home,
+		Name:              "dummyd",
+		DataBackupPath:    home,
+		RollbackMinBlocks: 10,
+		RollbackDeadline:  time.Minute,
+	}
+
+	for _, bin := range []string{cfg.GenesisBin(), cfg.UpgradeBin("chain2")} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(bin), 0o755))
+		require.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\n"), 0o755))
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", "state"), []byte("upgraded"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data-backup"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data-backup", "state"), []byte("backup"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", privValidatorStateFileName), []byte(`{"height":"55"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data-backup", privValidatorStateFileName), []byte(`{"height":"49"}`), 0o600))
+
+	return cfg
+}
+
+func TestQueryLatestHeight(t *testing.T) {
+	var height atomic.Int64
+	height.Store(42)
+	srv := newStatusServer(t, &height)
+
+	got, err := queryLatestHeight(srv.URL + "/")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), got)
+
+	_, err = queryLatestHeight(srv.URL + "/unknown")
+	require.ErrorContains(t, err, "unexpected status code 404")
+}
+
+func TestMonitorProgress(t *testing.T) {
+	cfg := setupRollbackHome(t)
+	var height atomic.Int64
+	cfg.RollbackRPCAddress = newStatusServer(t, &height).URL
+	l := Launcher{logger: log.NewTestLogger(t), cfg: cfg}
+
+	// the upgraded binary reaches the target height: the watch is removed
+	watch := &rollbackWatch{TargetHeight: 10, Deadline: time.Now().Add(time.Minute)}
+	require.NoError(t, l.saveRollbackWatch(watch))
+	height.Store(10)
+
+	stop := make(chan struct{})
+	stalled := l.monitorProgress(watch, stop)
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(cfg.RollbackWatchFilePath())
+		return os.IsNotExist(err)
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Empty(t, stalled)
+	close(stop)
+
+	// the deadline passes before the target height: the last observed height is reported
+	watch = &rollbackWatch{TargetHeight: 10, Deadline: time.Now()}
+	require.NoError(t, l.saveRollbackWatch(watch))
+	height.Store(7)
+
+	stop = make(chan struct{})
+	defer close(stop)
+	select {
+	case lastHeight := <-l.monitorProgress(watch, stop):
+		require.Equal(t, int64(7), lastHeight)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no progress was not reported")
+	}
+	require.FileExists(t, cfg.RollbackWatchFilePath())
+}
+
+func TestRollback(t *testing.T) {
+	cfg := setupRollbackHome(t)
+	l := Launcher{logger: log.NewTestLogger(t), cfg: cfg}
+	upgrade := upgradetypes.Plan{Name: "chain2", Height: 50}
+
+	// the watch is created before the upgrade binary is installed
+	watch, err := l.newRollbackWatch(upgrade, filepath.Join(cfg.Home, "data-backup"))
+	require.NoError(t, err)
+	require.Equal(t, int64(59), watch.TargetHeight)
+	require.Equal(t, filepath.Join(cfg.Root(), genesisDir), watch.PreviousDir)
+
+	require.NoError(t, cfg.SetCurrentUpgrade(upgrade))
+	watch.Deadline = time.Now()
+	require.NoError(t, l.saveRollbackWatch(watch))
+
+	loaded, err := l.loadRollbackWatch()
+	require.NoError(t, err)
+	require.Equal(t, watch.TargetHeight, loaded.TargetHeight)
+	require.NoError(t, l.checkRollbackIncident())
+
+	err = l.rollback(loaded, 52, "no progress")
+	require.ErrorContains(t, err, `upgrade "chain2" was rolled back: no progress`)
+
+	// the backup is restored and the data of the upgraded binary is kept
+	bz, err := os.ReadFile(filepath.Join(cfg.Home, "data", "state"))
+	require.NoError(t, err)
+	require.Equal(t, "backup", string(bz))
+	failed, err := filepath.Glob(filepath.Join(cfg.Home, "data-failed-chain2-*", "state"))
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.NoDirExists(t, filepath.Join(cfg.Home, "data-rollback-chain2"))
+
+	// the sign state of the upgraded binary is kept, so that the validator does not double sign
+	bz, err = os.ReadFile(filepath.Join(cfg.Home, "data", privValidatorStateFileName))
+	require.NoError(t, err)
+	require.Equal(t, `{"height":"55"}`, string(bz))
+
+	// the previous binary is current again
+	bin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.GenesisBin(), bin)
+
+	// the incident report is written and halts cosmovisor
+	bz, err = os.ReadFile(cfg.RollbackIncidentFilePath())
+	require.NoError(t, err)
+	var incident rollbackIncident
+	require.NoError(t, json.Unmarshal(bz, &incident))
+	require.Equal(t, "chain2", incident.Upgrade.Name)
+	require.Equal(t, int64(52), incident.LastObservedHeight)
+	require.Equal(t, cfg.UpgradeBin("chain2"), incident.FailedBinary)
+	require.Equal(t, cfg.GenesisBin(), incident.RestoredBinary)
+	require.NoFileExists(t, cfg.RollbackWatchFilePath())
+	require.ErrorContains(t, l.checkRollbackIncident(), "an upgrade was rolled back")
+}
+
+func TestCopyPrivValidatorState(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+
+	// nothing to copy for a node which is not a validator
+	require.NoError(t, copyPrivValidatorState(src, dst))
+	require.NoFileExists(t, filepath.Join(dst, privValidatorStateFileName))
+
+	require.NoError(t, os.WriteFile(filepath.Join(src, privValidatorStateFileName), []byte(`{"height":"55"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dst, privValidatorStateFileName), []byte(`{"height":"49"}`), 0o600))
+	require.NoError(t, copyPrivValidatorState(src, dst))
+	bz, err := os.ReadFile(filepath.Join(dst, privValidatorStateFileName))
+	require.NoError(t, err)
+	require.Equal(t, `{"height":"55"}`, string(bz))
+}
@@ -0,0 +1,241 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+
+	"cosmossdk.io/x/upgrade/plan"
+)
+
+// The binary signatures follow the format of x/upgrade/plan. They are verified by cosmovisor itself,
+// so that it does not require a release of x/upgrade including them.
+
+const (
+	// minisignAlgEd is the minisign signature algorithm signing the file itself.
+	minisignAlgEd = "Ed"
+	// minisignAlgEdPrehashed is the minisign signature algorithm signing the
+	// BLAKE2b-512 hash of the file. It is the default since minisign 0.10.
+	minisignAlgEdPrehashed = "ED"
+
+	minisignKeyIDLen      = 8
+	minisignPublicKeyLen  = 2 + minisignKeyIDLen + ed25519.PublicKeySize
+	minisignSignatureLen  = 2 + minisignKeyIDLen + ed25519.SignatureSize
+	minisignTrustedPrefix = "trusted comment: "
+)
+
+// publicKey is a publisher public key used to verify the detached signatures of upgrade binaries.
+type publicKey struct {
+	// keyID is the minisign key id, it is empty for raw ed25519 public keys.
+	keyID []byte
+	key   ed25519.PublicKey
+}
+
+// parsePublicKey parses a publisher public key.
+// It can be either a base64 encoded raw ed25519 public key, or a minisign public key,
+// with or without its untrusted comment line.
+func parsePublicKey(s string) (*publicKey, error) {
+	lines := nonEmptyLines(s)
+	if len(lines) == 0 {
+		return nil, errors.New("public key must not be blank")
+	}
+
+	// The key is on the last line, after the optional untrusted comment.
+	bz, err := base64.StdEncoding.DecodeString(lines[len(lines)-1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key encoding: %w", err)
+	}
+
+	switch {
+	case len(bz) == ed25519.PublicKeySize:
+		return &publicKey{key: bz}, nil
+	case len(bz) == minisignPublicKeyLen && string(bz[:2]) == minisignAlgEd:
+		return &publicKey{keyID: bz[2 : 2+minisignKeyIDLen], key: bz[2+minisignKeyIDLen:]}, nil
+	default:
+		return nil, errors.New("public key is neither an ed25519 nor a minisign public key")
+	}
+}
+
+// verify checks the detached signature of the given data.
+// The signature can be either a base64 encoded raw ed25519 signature, or the content of a minisign signature file.
+func (pk publicKey) verify(data []byte, signature string) error {
+	lines := nonEmptyLines(signature)
+	switch len(lines) {
+	case 1:
+		sig, err := base64.StdEncoding.DecodeString(lines[0])
+		if err != nil {
+			return fmt.Errorf("invalid signature encoding: %w", err)
+		}
+		if len(sig) != ed25519.SignatureSize {
+			return errors.New("invalid ed25519 signature length")
+		}
+		if !ed25519.Verify(pk.key, data, sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case 4:
+		return pk.verifyMinisign(data, lines)
+	default:
+		return errors.New("signature is neither an ed25519 nor a minisign signature")
+	}
+}
+
+// upgradeSignatures are the optional publisher public key and binary signatures of an upgrade info.
+type upgradeSignatures struct {
+	// PublicKey is either a base64 encoded ed25519 public key or a minisign public key.
+	// When set, all binaries must have a detached signature made with the corresponding private key.
+	PublicKey string `json:"public_key,omitempty"`
+	// Signatures are the detached signatures of the binaries, by os/arch string.
+	Signatures map[string]string `json:"signatures,omitempty"`
+}
+
+// parseUpgradeInfo parses an upgrade info string into its binaries and signatures.
+// If the infoStr is a url, an GET request will be made to it, and its response will be parsed instead.
+func parseUpgradeInfo(infoStr string, enforceChecksum bool) (*plan.Info, upgradeSignatures, error) {
+	infoStr = strings.TrimSpace(infoStr)
+	if _, err := neturl.ParseRequestURI(infoStr); err == nil {
+		if err := plan.ValidateURL(infoStr, enforceChecksum); err != nil {
+			return nil, upgradeSignatures{}, err
+		}
+
+		if infoStr, err = plan.DownloadURL(infoStr); err != nil {
+			return nil, upgradeSignatures{}, err
+		}
+	}
+
+	info, err := plan.ParseInfo(infoStr, plan.ParseOptionEnforceChecksum(enforceChecksum))
+	if err != nil {
+		return nil, upgradeSignatures{}, err
+	}
+
+	var signatures upgradeSignatures
+	if err := json.Unmarshal([]byte(infoStr), &signatures); err != nil {
+		return nil, upgradeSignatures{}, fmt.Errorf("could not parse plan info: %w", err)
+	}
+
+	return info, signatures, nil
+}
+
+// validate does stateless validation of the public key and signatures against the given binaries.
+// It validates that:
+//   - When enforceSignature is set, a public key is present.
+//   - The public key, if any, is a valid ed25519 or minisign public key.
+//   - When there is a public key, all binaries have a signature, and no signature is present without a public key.
+//   - All signatures are for one of the binaries.
+func (s upgradeSignatures) validate(binaries plan.BinaryDownloadURLMap, enforceSignature bool) error {
+	if len(s.PublicKey) == 0 {
+		if enforceSignature {
+			return errors.New("missing publisher public key")
+		}
+		if len(s.Signatures) != 0 {
+			return errors.New("binary signatures require a publisher public key")
+		}
+		return nil
+	}
+
+	if _, err := parsePublicKey(s.PublicKey); err != nil {
+		return fmt.Errorf("invalid publisher public key: %w", err)
+	}
+	for key := range binaries {
+		if len(strings.TrimSpace(s.Signatures[key])) == 0 {
+			return fmt.Errorf("missing signature for binaries[%s]", key)
+		}
+	}
+	for key := range s.Signatures {
+		if _, ok := binaries[key]; !ok {
+			return fmt.Errorf("signature for unknown binaries[%s]", key)
+		}
+	}
+
+	return nil
+}
+
+// verifyBinary checks the binary at the given path against the signature of the given os/arch string.
+// It is a no-op when there is no public key.
+func (s upgradeSignatures) verifyBinary(path, osArch string) error {
+	if len(s.PublicKey) == 0 {
+		return nil
+	}
+
+	pk, err := parsePublicKey(s.PublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid publisher public key: %w", err)
+	}
+	if err := pk.verifyFile(path, s.Signatures[osArch]); err != nil {
+		return fmt.Errorf("could not verify signature of %s: %w", filepath.Base(path), err)
+	}
+
+	return nil
+}
+
+// verifyFile checks the detached signature of the file at the given path.
+func (pk publicKey) verifyFile(path, signature string) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return pk.verify(bz, signature)
+}
+
+// verifyMinisign checks a minisign signature, made of an untrusted comment, the signature,
+// a trusted comment and the global signature over the signature and the trusted comment.
+func (pk publicKey) verifyMinisign(data []byte, lines []string) error {
+	sig, err := base64.StdEncoding.DecodeString(lines[1])
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if len(sig) != minisignSignatureLen {
+		return errors.New("invalid minisign signature length")
+	}
+
+	alg, keyID, sig := string(sig[:2]), sig[2:2+minisignKeyIDLen], sig[2+minisignKeyIDLen:]
+	if pk.keyID != nil && !bytes.Equal(keyID, pk.keyID) {
+		return fmt.Errorf("signature key id %X does not match public key id %X", keyID, pk.keyID)
+	}
+
+	switch alg {
+	case minisignAlgEd:
+	case minisignAlgEdPrehashed:
+		hash := blake2b.Sum512(data)
+		data = hash[:]
+	default:
+		return fmt.Errorf("unsupported minisign signature algorithm %q", alg)
+	}
+	if !ed25519.Verify(pk.key, data, sig) {
+		return errors.New("invalid signature")
+	}
+
+	trustedComment, ok := strings.CutPrefix(lines[2], minisignTrustedPrefix)
+	if !ok {
+		return errors.New("missing minisign trusted comment")
+	}
+	globalSig, err := base64.StdEncoding.DecodeString(lines[3])
+	if err != nil {
+		return fmt.Errorf("invalid global signature encoding: %w", err)
+	}
+	if !ed25519.Verify(pk.key, append(bytes.Clone(sig), trustedComment...), globalSig) {
+		return errors.New("invalid global signature")
+	}
+
+	return nil
+}
+
+// nonEmptyLines returns the trimmed non-empty lines of s.
+func nonEmptyLines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); len(line) != 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
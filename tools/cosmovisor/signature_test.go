@@ -0,0 +1,159 @@
+package cosmovisor
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+// testSigner signs test binaries with an ed25519 key, both raw and in the minisign format.
+type testSigner struct {
+	keyID   []byte
+	private ed25519.PrivateKey
+	public  ed25519.PublicKey
+}
+
+func newTestSigner(t *testing.T, seed byte) testSigner {
+	t.Helper()
+	keySeed := make([]byte, ed25519.SeedSize)
+	keySeed[0] = seed
+	private := ed25519.NewKeyFromSeed(keySeed)
+	return testSigner{
+		keyID:   []byte{seed, 1, 2, 3, 4, 5, 6, 7},
+		private: private,
+		public:  private.Public().(ed25519.PublicKey),
+	}
+}
+
+// rawPublicKey returns the base64 encoded ed25519 public key.
+func (s testSigner) rawPublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.public)
+}
+
+// minisignPublicKey returns the content of a minisign public key file.
+func (s testSigner) minisignPublicKey() string {
+	bz := append(append([]byte(minisignAlgEd), s.keyID...), s.public...)
+	return fmt.Sprintf("untrusted comment: minisign public key %X\n%s\n", s.keyID, base64.StdEncoding.EncodeToString(bz))
+}
+
+// rawSignature returns the base64 encoded ed25519 signature of data.
+func (s testSigner) rawSignature(data []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.private, data))
+}
+
+// minisignSignature returns the content of a minisign signature file of data with the given algorithm.
+func (s testSigner) minisignSignature(data []byte, alg string) string {
+	if alg == minisignAlgEdPrehashed {
+		hash := blake2b.Sum512(data)
+		data = hash[:]
+	}
+	sig := ed25519.Sign(s.private, data)
+	trustedComment := "timestamp:1700000000\tfile:binary\thashed"
+	globalSig := ed25519.Sign(s.private, append(append([]byte{}, sig...), trustedComment...))
+	return fmt.Sprintf("untrusted comment: signature from minisign secret key\n%s\n%s%s\n%s\n",
+		base64.StdEncoding.EncodeToString(append(append([]byte(alg), s.keyID...), sig...)),
+		minisignTrustedPrefix, trustedComment,
+		base64.StdEncoding.EncodeToString(globalSig))
+}
+
+func TestParsePublicKey(t *testing.T) {
+	signer := newTestSigner(t, 1)
+
+	for _, tc := range []struct {
+		name   string
+		key    string
+		expErr string
+	}{
+		{name: "raw ed25519 key", key: signer.rawPublicKey()},
+		{name: "minisign key", key: signer.minisignPublicKey()},
+		{name: "blank", key: " \n ", expErr: "public key must not be blank"},
+		{name: "not base64", key: "not a key!", expErr: "invalid public key encoding"},
+		{name: "wrong length", key: base64.StdEncoding.EncodeToString([]byte("short")), expErr: "neither an ed25519 nor a minisign public key"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pk, err := parsePublicKey(tc.key)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, signer.public, pk.key)
+		})
+	}
+}
+
+func TestPublicKeyVerify(t *testing.T) {
+	signer := newTestSigner(t, 1)
+	other := newTestSigner(t, 2)
+	data := []byte("#!/usr/bin\necho 'I am a binary'\n")
+
+	rawKey, err := parsePublicKey(signer.rawPublicKey())
+	require.NoError(t, err)
+	minisignKey, err := parsePublicKey(signer.minisignPublicKey())
+	require.NoError(t, err)
+
+	tamperedGlobal := signer.minisignSignature(data, minisignAlgEdPrehashed)
+	tamperedGlobal = tamperedGlobal[:len(tamperedGlobal)-len("hashed")] + "edited"
+
+	for _, tc := range []struct {
+		name      string
+		key       *publicKey
+		data      []byte
+		signature string
+		expErr    string
+	}{
+		{name: "raw signature", key: rawKey, data: data, signature: signer.rawSignature(data)},
+		{name: "raw signature with minisign key", key: minisignKey, data: data, signature: signer.rawSignature(data)},
+		{name: "minisign signature", key: minisignKey, data: data, signature: signer.minisignSignature(data, minisignAlgEd)},
+		{name: "prehashed minisign signature", key: minisignKey, data: data, signature: signer.minisignSignature(data, minisignAlgEdPrehashed)},
+		{name: "minisign signature with raw key", key: rawKey, data: data, signature: signer.minisignSignature(data, minisignAlgEdPrehashed)},
+		{name: "tampered data", key: minisignKey, data: []byte("malware"), signature: signer.minisignSignature(data, minisignAlgEdPrehashed), expErr: "invalid signature"},
+		{name: "raw signature of other key", key: rawKey, data: data, signature: other.rawSignature(data), expErr: "invalid signature"},
+		{name: "minisign signature of other key", key: minisignKey, data: data, signature: other.minisignSignature(data, minisignAlgEd), expErr: "does not match public key id"},
+		{name: "tampered trusted comment", key: minisignKey, data: data, signature: tamperedGlobal, expErr: "invalid global signature"},
+		{name: "unknown algorithm", key: minisignKey, data: data, signature: signer.minisignSignature(data, "XX"), expErr: "unsupported minisign signature algorithm"},
+		{name: "empty signature", key: rawKey, data: data, signature: "", expErr: "neither an ed25519 nor a minisign signature"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.key.verify(tc.data, tc.signature)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpgradeSignaturesValidate(t *testing.T) {
+	signer := newTestSigner(t, 1)
+	binaries := map[string]string{"linux/amd64": "https://example.com/autod?checksum=sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"}
+
+	for _, tc := range []struct {
+		name       string
+		signatures upgradeSignatures
+		enforce    bool
+		expErr     string
+	}{
+		{name: "no public key"},
+		{name: "no public key enforced", enforce: true, expErr: "missing publisher public key"},
+		{name: "signatures without public key", signatures: upgradeSignatures{Signatures: map[string]string{"linux/amd64": "sig"}}, expErr: "require a publisher public key"},
+		{name: "invalid public key", signatures: upgradeSignatures{PublicKey: "not a key!"}, expErr: "invalid publisher public key"},
+		{name: "missing signature", signatures: upgradeSignatures{PublicKey: signer.rawPublicKey()}, expErr: "missing signature for binaries[linux/amd64]"},
+		{name: "signature for unknown binary", signatures: upgradeSignatures{PublicKey: signer.rawPublicKey(), Signatures: map[string]string{"linux/amd64": "sig", "any": "sig"}}, expErr: "signature for unknown binaries[any]"},
+		{name: "signed", signatures: upgradeSignatures{PublicKey: signer.rawPublicKey(), Signatures: map[string]string{"linux/amd64": "sig"}}, enforce: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.signatures.validate(binaries, tc.enforce)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
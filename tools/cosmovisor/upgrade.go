@@ -39,27 +39,40 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 		return fmt.Errorf("unhandled error: %w", err)
 	}
 
-	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	upgradeInfo, signatures, err := parseUpgradeInfo(p.Info, cfg.DownloadMustHaveChecksum)
 	if err != nil {
 		return fmt.Errorf("cannot parse upgrade info: %w", err)
 	}
 
+	if err := signatures.validate(upgradeInfo.Binaries, cfg.DownloadMustHaveSignature); err != nil {
+		return fmt.Errorf("invalid binary signatures: %w", err)
+	}
+
 	if err := upgradeInfo.ValidateFull(cfg.Name); err != nil {
 		return fmt.Errorf("invalid binaries: %w", err)
 	}
 
-	url, err := GetBinaryURL(upgradeInfo.Binaries)
+	osArch, err := GetBinaryOSArch(upgradeInfo.Binaries)
 	if err != nil {
 		return err
 	}
 
 	// If not there, then we try to download it... maybe
 	logger.Info("no upgrade binary found, beginning to download it")
-	if err := plan.DownloadUpgrade(cfg.UpgradeDir(p.Name), url, cfg.Name); err != nil {
+	if err := plan.DownloadUpgrade(cfg.UpgradeDir(p.Name), upgradeInfo.Binaries[osArch], cfg.Name); err != nil {
 		return fmt.Errorf("cannot download binary. %w", err)
 	}
 	logger.Info("downloading binary complete")
 
+	// The binary is verified against its signature if the plan has a publisher public key.
+	// The upgrade dir is removed when it doesn't verify, so that the binary is never installed.
+	if err := signatures.verifyBinary(cfg.UpgradeBin(p.Name), osArch); err != nil {
+		if rerr := os.RemoveAll(cfg.UpgradeDir(p.Name)); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		return err
+	}
+
 	// and then set the binary again
 	if err := plan.EnsureBinary(cfg.UpgradeBin(p.Name)); err != nil {
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
@@ -69,15 +82,24 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 }
 
 func GetBinaryURL(binaries plan.BinaryDownloadURLMap) (string, error) {
-	url, ok := binaries[OSArch()]
-	if !ok {
-		url, ok = binaries["any"]
+	osArch, err := GetBinaryOSArch(binaries)
+	if err != nil {
+		return "", err
+	}
+
+	return binaries[osArch], nil
+}
+
+// GetBinaryOSArch returns the key of the binary to download for the current os/arch: either OSArch() or "any".
+func GetBinaryOSArch(binaries plan.BinaryDownloadURLMap) (string, error) {
+	if _, ok := binaries[OSArch()]; ok {
+		return OSArch(), nil
 	}
-	if !ok {
-		return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
+	if _, ok := binaries["any"]; ok {
+		return "any", nil
 	}
 
-	return url, nil
+	return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
 }
 
 func OSArch() string {
@@ -4,6 +4,8 @@
 package cosmovisor_test
 
 import (
+	"crypto/ed25519"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -197,6 +199,78 @@ func (s *upgradeTestSuite) TestUpgradeBinary() {
 	}
 }
 
+func (s *upgradeTestSuite) TestUpgradeBinarySignature() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor")
+
+	// sha256sum ./testdata/repo/raw_binary/autod
+	url, err := filepath.Abs("./testdata/repo/raw_binary/autod?checksum=sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d")
+	s.Require().NoError(err)
+	binary, err := os.ReadFile("./testdata/repo/raw_binary/autod")
+	s.Require().NoError(err)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	encodedKey := base64.StdEncoding.EncodeToString(publicKey)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, binary))
+	otherSignature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("malware")))
+
+	cases := map[string]struct {
+		info             string
+		mustHaveSig      bool
+		canDownload      bool
+		expUpgradeDirErr bool
+	}{
+		"valid signature": {
+			info:        fmt.Sprintf(`{"binaries":{"%[1]s":"%[2]s"},"public_key":"%[3]s","signatures":{"%[1]s":"%[4]s"}}`, cosmovisor.OSArch(), url, encodedKey, signature),
+			mustHaveSig: true,
+			canDownload: true,
+		},
+		"invalid signature": {
+			info:             fmt.Sprintf(`{"binaries":{"%[1]s":"%[2]s"},"public_key":"%[3]s","signatures":{"%[1]s":"%[4]s"}}`, cosmovisor.OSArch(), url, encodedKey, otherSignature),
+			expUpgradeDirErr: true,
+		},
+		"missing signature": {
+			info:             fmt.Sprintf(`{"binaries":{"%s":"%s"},"public_key":"%s"}`, cosmovisor.OSArch(), url, encodedKey),
+			expUpgradeDirErr: true,
+		},
+		"unsigned": {
+			info:        fmt.Sprintf(`{"binaries":{"%s":"%s"}}`, cosmovisor.OSArch(), url),
+			canDownload: true,
+		},
+		"unsigned when signatures are required": {
+			info:             fmt.Sprintf(`{"binaries":{"%s":"%s"}}`, cosmovisor.OSArch(), url),
+			mustHaveSig:      true,
+			expUpgradeDirErr: true,
+		},
+	}
+
+	for label, tc := range cases {
+		s.Run(label, func() {
+			cfg := &cosmovisor.Config{
+				Home:                      copyTestData(s.T(), "download"),
+				Name:                      "autod",
+				AllowDownloadBinaries:     true,
+				DownloadMustHaveSignature: tc.mustHaveSig,
+			}
+
+			err := cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "amazonas", Info: tc.info})
+			if !tc.canDownload {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			// a binary failing its signature check is never installed
+			_, err = os.Stat(cfg.UpgradeDir("amazonas"))
+			if tc.expUpgradeDirErr {
+				s.Require().True(os.IsNotExist(err))
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux...
 	s.Require().Equal("linux/amd64", cosmovisor.OSArch())
@@ -390,6 +390,10 @@ type SimulateRequest struct {
 	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"` // Deprecated: Do not use.
 	// tx_bytes is the raw transaction.
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// state_overrides are applied to a branch of the state the transaction is
+	// simulated against, and discarded with it once the simulation ends. They are
+	// only accepted by nodes enabling simulation state overrides in their config.
+	StateOverrides []*StateOverride `protobuf:"bytes,3,rep,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -440,6 +444,79 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetStateOverrides() []*StateOverride {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+// StateOverride overrides the value of a key of a store for a simulation.
+// Account balances, sequences or module params are overridden through the keys
+// their modules store them under.
+type StateOverride struct {
+	// store_key is the name of the KV store of the key, e.g. "bank".
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// key is the key in the store.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value the key is set to. The key is deleted if it is empty.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *StateOverride) Reset()         { *m = StateOverride{} }
+func (m *StateOverride) String() string { return proto.CompactTextString(m) }
+func (*StateOverride) ProtoMessage()    {}
+func (*StateOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{5}
+}
+func (m *StateOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateOverride.Merge(m, src)
+}
+func (m *StateOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateOverride proto.InternalMessageInfo
+
+func (m *StateOverride) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StateOverride) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateOverride) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -453,7 +530,7 @@ func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
 func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
 func (*SimulateResponse) ProtoMessage()    {}
 func (*SimulateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{5}
+	return fileDescriptor_e0b00a618705eca7, []int{6}
 }
 func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +584,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{7}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +632,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +688,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +746,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +814,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +861,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +908,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +955,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1001,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1047,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1093,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1139,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,6 +1183,7 @@ func init() {
 	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
+	proto.RegisterType((*StateOverride)(nil), "cosmos.tx.v1beta1.StateOverride")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
@@ -1124,90 +1202,94 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xc7, 0xb3, 0x9b, 0x5f, 0xce, 0x73, 0x42, 0xcc, 0x24, 0x80, 0x31, 0xe0, 0x98, 0x85, 0x10,
-	0x13, 0x89, 0x5d, 0x62, 0x88, 0xa8, 0x50, 0xab, 0xca, 0x6b, 0x9b, 0x34, 0x50, 0x30, 0x5a, 0xa7,
-	0xaa, 0xa8, 0x2a, 0x59, 0x6b, 0x7b, 0xb0, 0xb7, 0xd8, 0xbb, 0xc6, 0x3b, 0x89, 0xd6, 0xa2, 0xa8,
-	0x55, 0x4f, 0x6d, 0xd5, 0xaa, 0x95, 0xfa, 0x17, 0xf4, 0xde, 0x23, 0x7f, 0x44, 0xc5, 0x09, 0xd1,
-	0x4b, 0xd5, 0x53, 0x05, 0x3d, 0xf5, 0xaf, 0xa8, 0x76, 0x66, 0xd6, 0xde, 0xb5, 0x77, 0x49, 0xe2,
-	0xde, 0x66, 0x76, 0xde, 0xbc, 0xf7, 0x99, 0xef, 0xcc, 0xbc, 0x37, 0x0b, 0x6b, 0x75, 0xcb, 0xee,
-	0x58, 0xb6, 0x42, 0x1c, 0xe5, 0x60, 0xab, 0x86, 0x89, 0xbe, 0xa5, 0xd8, 0xb8, 0x77, 0x60, 0xd4,
-	0xb1, 0xdc, 0xed, 0x59, 0xc4, 0x42, 0x27, 0x99, 0x81, 0x4c, 0x1c, 0x99, 0x1b, 0xa4, 0xce, 0x37,
-	0x2d, 0xab, 0xd9, 0xc6, 0x8a, 0xde, 0x35, 0x14, 0xdd, 0x34, 0x2d, 0xa2, 0x13, 0xc3, 0x32, 0x6d,
-	0x36, 0x21, 0x75, 0x89, 0x7b, 0xac, 0xe9, 0x36, 0x56, 0xf4, 0x5a, 0xdd, 0x18, 0x38, 0x76, 0x3b,
-	0xdc, 0x28, 0x35, 0x1e, 0x96, 0x38, 0x7c, 0x6c, 0xd3, 0xef, 0xe0, 0xe9, 0x3e, 0xee, 0xf5, 0x07,
-	0x36, 0x5d, 0xbd, 0x69, 0x98, 0x34, 0x1a, 0xb7, 0xbd, 0x50, 0xb7, 0x3a, 0x98, 0xd4, 0x1e, 0x13,
-	0x85, 0xf4, 0xbb, 0xd8, 0x56, 0x0e, 0xb6, 0x94, 0x5a, 0xdb, 0xaa, 0x3f, 0x89, 0x1e, 0xa6, 0x0d,
-	0x3e, 0x7c, 0x96, 0x45, 0xaa, 0xd2, 0x9e, 0xc2, 0x17, 0x4a, 0x3b, 0xd2, 0xb7, 0x22, 0xa0, 0x1d,
-	0x4c, 0xf6, 0x1c, 0xbb, 0x74, 0x80, 0x4d, 0xa2, 0xe1, 0xa7, 0xfb, 0xd8, 0x26, 0x28, 0x05, 0x73,
-	0xd8, 0xed, 0xdb, 0x49, 0x21, 0x33, 0x9d, 0x5d, 0x50, 0xc5, 0xa4, 0xa0, 0xf1, 0x2f, 0xe8, 0x2e,
-	0xc0, 0x90, 0x2f, 0x29, 0x66, 0x84, 0x6c, 0x3c, 0x77, 0x45, 0xe6, 0x5e, 0xdd, 0xc5, 0xc8, 0x74,
-	0x31, 0x9e, 0x8c, 0xf2, 0x43, 0xbd, 0x89, 0xb9, 0x5f, 0xea, 0xc7, 0x37, 0x1b, 0x6d, 0x43, 0xcc,
-	0xea, 0x35, 0x70, 0xaf, 0x5a, 0xeb, 0x27, 0xa7, 0x33, 0x42, 0xf6, 0x44, 0x2e, 0x25, 0x8f, 0x6d,
-	0x84, 0x5c, 0x76, 0x4d, 0xd4, 0xbe, 0x36, 0x6f, 0xb1, 0x06, 0x42, 0x30, 0xd3, 0xd5, 0x9b, 0x38,
-	0x39, 0x93, 0x11, 0xb2, 0x33, 0x1a, 0x6d, 0xa3, 0x55, 0x98, 0x6d, 0x1b, 0x1d, 0x83, 0x24, 0x67,
-	0xe9, 0x47, 0xd6, 0x41, 0x57, 0x61, 0x96, 0xd2, 0x24, 0xe7, 0x32, 0x42, 0x76, 0x41, 0x5d, 0xf9,
-	0xeb, 0xc5, 0xb5, 0x65, 0x16, 0xe0, 0x9a, 0xdd, 0x78, 0x92, 0xb9, 0x2e, 0x6f, 0x5f, 0xd7, 0x98,
-	0x85, 0xf4, 0xaf, 0x00, 0x2b, 0x01, 0x29, 0xec, 0xae, 0x65, 0xda, 0x18, 0x6d, 0xc0, 0x34, 0x71,
-	0x98, 0x10, 0xf1, 0xdc, 0xa9, 0x10, 0xbc, 0x3d, 0x47, 0x73, 0x2d, 0xd0, 0x0e, 0x2c, 0x12, 0xa7,
-	0xda, 0xe3, 0xf3, 0xec, 0xa4, 0x48, 0x67, 0x5c, 0x0e, 0x48, 0x43, 0xcf, 0x86, 0x6f, 0x22, 0x37,
-	0xd6, 0xe2, 0x64, 0xd0, 0xb6, 0xd1, 0xbd, 0x80, 0xc2, 0xd3, 0x54, 0xe1, 0x8d, 0x43, 0x15, 0x66,
-	0xb3, 0xc7, 0x24, 0x5e, 0x85, 0x59, 0x62, 0x11, 0xbd, 0xcd, 0xc5, 0x62, 0x1d, 0x09, 0x03, 0x52,
-	0x7b, 0x96, 0xde, 0xa8, 0xeb, 0x36, 0x71, 0x31, 0xd8, 0xb6, 0x9f, 0x85, 0x18, 0x71, 0xaa, 0xb5,
-	0x3e, 0xc1, 0xee, 0x7a, 0x85, 0xec, 0xa2, 0x36, 0x4f, 0x1c, 0xd5, 0xed, 0xa2, 0x9b, 0x30, 0xd3,
-	0xb1, 0x1a, 0x98, 0xee, 0xf7, 0x89, 0x5c, 0x26, 0x44, 0x86, 0x81, 0xbf, 0xfb, 0x56, 0x03, 0x6b,
-	0xd4, 0x5a, 0xfa, 0x1c, 0x56, 0x02, 0x61, 0xb8, 0xa4, 0x25, 0x88, 0xfb, 0x94, 0xa2, 0xa1, 0x8e,
-	0x2a, 0x14, 0x0c, 0x85, 0x92, 0xda, 0xb0, 0x5c, 0x31, 0x3a, 0xfb, 0x6d, 0x9d, 0x78, 0x07, 0x0c,
-	0x5d, 0x05, 0x91, 0x38, 0xdc, 0x61, 0xf8, 0x5e, 0x51, 0x81, 0x44, 0xe2, 0x20, 0xd9, 0xb7, 0x58,
-	0x77, 0x55, 0x8b, 0x21, 0xa7, 0xe3, 0xe6, 0x8d, 0x81, 0x02, 0xd2, 0xf7, 0x02, 0x24, 0x86, 0xe1,
-	0xf8, 0x4a, 0xde, 0x87, 0x58, 0x53, 0xb7, 0xab, 0x86, 0xf9, 0xd8, 0xe2, 0x51, 0x2f, 0x46, 0x2f,
-	0x63, 0x47, 0xb7, 0x77, 0xcd, 0xc7, 0x96, 0x36, 0xdf, 0x64, 0x0d, 0xf4, 0x1e, 0xcc, 0xf5, 0xb0,
-	0xbd, 0xdf, 0x26, 0xfc, 0x1a, 0x65, 0xa2, 0xe7, 0x6a, 0xd4, 0x4e, 0xe3, 0xf6, 0x92, 0x04, 0x8b,
-	0xf4, 0xac, 0x7a, 0xeb, 0x46, 0x30, 0xd3, 0xd2, 0xed, 0x16, 0x65, 0x58, 0xd0, 0x68, 0x5b, 0x7a,
-	0x0e, 0x4b, 0xdc, 0x86, 0xc3, 0xae, 0x1f, 0x2a, 0x0e, 0x15, 0x66, 0x64, 0x77, 0xc4, 0x09, 0x77,
-	0xe7, 0x27, 0x01, 0x4e, 0xef, 0x60, 0xa2, 0xba, 0x79, 0xea, 0x53, 0x83, 0xb4, 0xf6, 0x1c, 0xdb,
-	0xa3, 0x3d, 0x0d, 0x73, 0x2d, 0x6c, 0x34, 0x5b, 0x84, 0xc2, 0x4c, 0x6b, 0xbc, 0x87, 0xee, 0x4c,
-	0x9e, 0x5a, 0xfc, 0x67, 0xfe, 0xf6, 0xa9, 0xd7, 0x2f, 0xae, 0x9d, 0x0c, 0x6e, 0xe4, 0xb6, 0x9c,
-	0x93, 0xbe, 0x13, 0xe1, 0xcc, 0x18, 0xd1, 0x71, 0x6f, 0xf9, 0x36, 0xc4, 0x68, 0xea, 0xad, 0x1a,
-	0x0d, 0x4e, 0xe8, 0xa6, 0x2c, 0x96, 0x7e, 0x65, 0x96, 0x75, 0x0f, 0xb6, 0x64, 0x1a, 0x63, 0xb7,
-	0xa8, 0xcd, 0x53, 0xdb, 0xdd, 0x06, 0x92, 0x61, 0x96, 0x36, 0xf9, 0x75, 0x4e, 0x46, 0xcd, 0xd1,
-	0x98, 0x19, 0xda, 0x09, 0x48, 0x31, 0x73, 0xac, 0x1c, 0x70, 0x14, 0x2d, 0xf2, 0xb0, 0xbc, 0xe7,
-	0x14, 0x71, 0xdd, 0xbd, 0xab, 0x87, 0xde, 0xfe, 0xdb, 0x2b, 0xaf, 0xc7, 0x6e, 0xc6, 0x2d, 0xe9,
-	0x01, 0x24, 0x86, 0x2e, 0x8e, 0x75, 0xc4, 0xc2, 0xfd, 0xdd, 0x77, 0x91, 0x4a, 0xa6, 0x1f, 0xe9,
-	0xff, 0xb8, 0x53, 0x5d, 0x3c, 0xcf, 0x1d, 0xc7, 0x3b, 0xee, 0x12, 0xef, 0xc2, 0xaa, 0xe7, 0x23,
-	0xdf, 0x31, 0x4c, 0xcb, 0xe3, 0xba, 0x00, 0xa0, 0xbb, 0xfd, 0xea, 0x17, 0xb6, 0x65, 0xf2, 0x4b,
-	0xb7, 0x40, 0xbf, 0xdc, 0xb5, 0x2d, 0x33, 0xdc, 0x57, 0x19, 0x4e, 0x8d, 0xf8, 0xe2, 0x50, 0x17,
-	0x61, 0x91, 0x39, 0xab, 0x19, 0xa6, 0xde, 0xeb, 0x73, 0xb0, 0x38, 0xfd, 0xa6, 0xd2, 0x4f, 0x51,
-	0xfa, 0xaf, 0x7a, 0xfa, 0x07, 0xe0, 0x26, 0xf5, 0x77, 0xcf, 0x05, 0x0c, 0xf8, 0xe3, 0x80, 0x13,
-	0xac, 0x76, 0xf3, 0x23, 0x98, 0xe7, 0x65, 0x1b, 0x25, 0x61, 0xb5, 0xac, 0x15, 0x4b, 0x5a, 0x55,
-	0x7d, 0x54, 0xfd, 0xe4, 0x41, 0xe5, 0x61, 0xa9, 0xb0, 0x7b, 0x67, 0xb7, 0x54, 0x4c, 0x4c, 0xa1,
-	0x04, 0x2c, 0x0e, 0x46, 0xf2, 0x95, 0x42, 0x42, 0x40, 0x27, 0x61, 0x69, 0xf0, 0xa5, 0x58, 0xaa,
-	0x14, 0x12, 0xe2, 0xe6, 0xd7, 0x02, 0x2c, 0x05, 0x6a, 0x0b, 0x4a, 0x43, 0x4a, 0xd5, 0xca, 0xf9,
-	0x62, 0x21, 0x5f, 0xd9, 0xab, 0xde, 0x2f, 0x17, 0x4b, 0x23, 0x6e, 0xcf, 0xc3, 0xea, 0xc8, 0xb8,
-	0xfa, 0x71, 0xb9, 0x70, 0x2f, 0x21, 0xa4, 0xc4, 0x98, 0x80, 0xce, 0xc0, 0xca, 0xc8, 0x68, 0xe5,
-	0xd1, 0x83, 0x42, 0x42, 0x74, 0x39, 0x47, 0x06, 0xf2, 0x74, 0x64, 0x3a, 0xf7, 0x23, 0xc0, 0x7c,
-	0x85, 0x3d, 0x17, 0xd1, 0x33, 0x88, 0x79, 0x55, 0x00, 0x49, 0x21, 0x47, 0x72, 0xa4, 0x22, 0xa5,
-	0x2e, 0xbd, 0xd3, 0x86, 0xe7, 0xca, 0x2b, 0xdf, 0xfc, 0xf1, 0xcf, 0x2f, 0x62, 0xe6, 0xb6, 0xb0,
-	0x29, 0x9d, 0x53, 0x42, 0x9e, 0xaa, 0x5e, 0xc0, 0xa7, 0x30, 0x4b, 0x53, 0x3a, 0x5a, 0x0b, 0xf1,
-	0xea, 0x2f, 0x08, 0xa9, 0x4c, 0xb4, 0x01, 0x8f, 0xb9, 0x4e, 0x63, 0xae, 0xa1, 0x0b, 0x4a, 0xd8,
-	0x23, 0xd5, 0x56, 0x9e, 0xb9, 0x45, 0xe4, 0x39, 0xfa, 0x0a, 0xe2, 0xbe, 0x12, 0x8e, 0xd6, 0xdf,
-	0x55, 0xf9, 0x87, 0xe1, 0xaf, 0x1c, 0x66, 0xc6, 0x21, 0x2e, 0x52, 0x88, 0x73, 0xee, 0xc2, 0x4f,
-	0x87, 0x73, 0xa0, 0x2f, 0x21, 0xee, 0x7b, 0x96, 0x85, 0x02, 0x8c, 0xbf, 0x60, 0x43, 0x01, 0x42,
-	0x5e, 0x77, 0x52, 0x9a, 0x02, 0x24, 0x51, 0x54, 0xf4, 0xdf, 0x04, 0x58, 0x1e, 0xa9, 0x19, 0xe8,
-	0x6a, 0xb8, 0xef, 0x90, 0x4a, 0x97, 0xda, 0x3c, 0x8a, 0x29, 0x47, 0x51, 0x5f, 0x86, 0x65, 0x6a,
-	0xca, 0xb7, 0x81, 0xd6, 0x23, 0x76, 0x89, 0x56, 0x0b, 0xe5, 0x19, 0x2b, 0xa0, 0xcf, 0xd1, 0x0f,
-	0x02, 0xc4, 0xbc, 0x4b, 0x1c, 0x7a, 0x3c, 0x47, 0x92, 0x7e, 0xe8, 0xf1, 0x1c, 0xcd, 0xea, 0xd2,
-	0xad, 0x97, 0xe3, 0x37, 0x9c, 0x72, 0xa5, 0xdd, 0x8d, 0x3b, 0x1b, 0x82, 0xd6, 0x60, 0x04, 0x0c,
-	0x87, 0x25, 0xbd, 0x08, 0x9c, 0x40, 0xc2, 0x8f, 0xc0, 0x09, 0x66, 0xf1, 0x09, 0x70, 0x30, 0x23,
-	0xf8, 0x55, 0x80, 0xa5, 0x40, 0x0e, 0x46, 0x1b, 0xef, 0x88, 0xe7, 0x4f, 0xaa, 0xa9, 0xec, 0xe1,
-	0x86, 0x9c, 0xee, 0x83, 0x28, 0xba, 0xcb, 0x2e, 0xdd, 0x5a, 0x24, 0x9d, 0x42, 0x33, 0x2a, 0x67,
-	0xf4, 0xa5, 0xe1, 0x08, 0xc6, 0xf1, 0xc4, 0x1f, 0xc1, 0x18, 0x92, 0xd1, 0x27, 0x60, 0x64, 0x1b,
-	0xca, 0x18, 0xd5, 0x0f, 0x7f, 0x7f, 0x93, 0x16, 0x5e, 0xbd, 0x49, 0x0b, 0x7f, 0xbf, 0x49, 0x0b,
-	0x3f, 0xbf, 0x4d, 0x4f, 0xbd, 0x7a, 0x9b, 0x9e, 0xfa, 0xf3, 0x6d, 0x7a, 0xea, 0xb3, 0xf5, 0xa6,
-	0x41, 0x5a, 0xfb, 0x35, 0xf7, 0x75, 0xe3, 0x39, 0x19, 0x86, 0xe1, 0x7f, 0xa7, 0xc4, 0xa9, 0xcd,
-	0xd1, 0xbf, 0xcf, 0x1b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x1d, 0xd5, 0xa1, 0x97, 0x0f,
-	0x00, 0x00,
+	// 1390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0xf9, 0xe3, 0x3c, 0x3b, 0x8d, 0x3b, 0x49, 0x5a, 0x77, 0xdb, 0x3a, 0xee, 0xb6,
+	0x49, 0xdc, 0x48, 0xdd, 0x6d, 0xd2, 0x46, 0x45, 0x15, 0x08, 0x65, 0x63, 0x37, 0xa4, 0xa1, 0x75,
+	0xb5, 0x0e, 0x42, 0x45, 0x08, 0x6b, 0x6d, 0x4f, 0x9d, 0xa5, 0xce, 0x4e, 0xea, 0x99, 0x58, 0x6b,
+	0x95, 0x0a, 0xc4, 0x09, 0x10, 0x08, 0x24, 0x3e, 0x01, 0x77, 0x8e, 0xfd, 0x10, 0xa8, 0xe2, 0x50,
+	0x95, 0x0b, 0xe2, 0x84, 0x5a, 0x4e, 0x7c, 0x0a, 0xb4, 0xb3, 0xb3, 0xf1, 0xda, 0x5e, 0xdb, 0x49,
+	0xca, 0x6d, 0xfe, 0xfc, 0xe6, 0xbd, 0xdf, 0xfc, 0xde, 0xdb, 0xf7, 0x66, 0x61, 0xbe, 0x42, 0xe8,
+	0x1e, 0xa1, 0x1a, 0x73, 0xb4, 0xe6, 0x4a, 0x19, 0x33, 0x73, 0x45, 0xa3, 0xb8, 0xd1, 0xb4, 0x2a,
+	0x58, 0xdd, 0x6f, 0x10, 0x46, 0xd0, 0x69, 0x0f, 0xa0, 0x32, 0x47, 0x15, 0x00, 0xf9, 0x42, 0x8d,
+	0x90, 0x5a, 0x1d, 0x6b, 0xe6, 0xbe, 0xa5, 0x99, 0xb6, 0x4d, 0x98, 0xc9, 0x2c, 0x62, 0x53, 0xef,
+	0x80, 0x7c, 0x59, 0x58, 0x2c, 0x9b, 0x14, 0x6b, 0x66, 0xb9, 0x62, 0x1d, 0x1a, 0x76, 0x27, 0x02,
+	0x24, 0xf7, 0xba, 0x65, 0x8e, 0xd8, 0x5b, 0x0e, 0x1a, 0x78, 0x72, 0x80, 0x1b, 0xad, 0x43, 0xcc,
+	0xbe, 0x59, 0xb3, 0x6c, 0xee, 0x4d, 0x60, 0x2f, 0x56, 0xc8, 0x1e, 0x66, 0xe5, 0x47, 0x4c, 0x63,
+	0xad, 0x7d, 0x4c, 0xb5, 0xe6, 0x8a, 0x56, 0xae, 0x93, 0xca, 0xe3, 0xfe, 0xdb, 0x7c, 0x20, 0xb6,
+	0xcf, 0x79, 0x9e, 0x4a, 0x7c, 0xa6, 0x79, 0x13, 0x6f, 0x4b, 0xf9, 0x26, 0x02, 0x68, 0x13, 0xb3,
+	0x1d, 0x87, 0xe6, 0x9b, 0xd8, 0x66, 0x06, 0x7e, 0x72, 0x80, 0x29, 0x43, 0x32, 0x8c, 0x63, 0x77,
+	0x4e, 0x53, 0x52, 0x26, 0x9a, 0x9d, 0xd4, 0x23, 0x29, 0xc9, 0x10, 0x2b, 0xe8, 0x2e, 0x40, 0x9b,
+	0x5f, 0x2a, 0x92, 0x91, 0xb2, 0xf1, 0xd5, 0x45, 0x55, 0x58, 0x75, 0x2f, 0xa3, 0xf2, 0xcb, 0xf8,
+	0x32, 0xaa, 0x0f, 0xcc, 0x1a, 0x16, 0x76, 0xb9, 0x9d, 0xc0, 0x69, 0xb4, 0x06, 0x31, 0xd2, 0xa8,
+	0xe2, 0x46, 0xa9, 0xdc, 0x4a, 0x45, 0x33, 0x52, 0xf6, 0xd4, 0xaa, 0xac, 0xf6, 0x04, 0x42, 0x2d,
+	0xb8, 0x10, 0xbd, 0x65, 0x4c, 0x10, 0x6f, 0x80, 0x10, 0x8c, 0xee, 0x9b, 0x35, 0x9c, 0x1a, 0xcd,
+	0x48, 0xd9, 0x51, 0x83, 0x8f, 0xd1, 0x2c, 0x8c, 0xd5, 0xad, 0x3d, 0x8b, 0xa5, 0xc6, 0xf8, 0xa2,
+	0x37, 0x41, 0x57, 0x61, 0x8c, 0xb3, 0x49, 0x8d, 0x67, 0xa4, 0xec, 0xa4, 0x3e, 0xf3, 0xd7, 0xf3,
+	0x6b, 0xd3, 0x9e, 0x83, 0x6b, 0xb4, 0xfa, 0x38, 0x73, 0x5d, 0x5d, 0xbb, 0x6e, 0x78, 0x08, 0xe5,
+	0x5f, 0x09, 0x66, 0x3a, 0xa4, 0xa0, 0xfb, 0xc4, 0xa6, 0x18, 0x2d, 0x41, 0x94, 0x39, 0x9e, 0x10,
+	0xf1, 0xd5, 0xb9, 0x10, 0x7a, 0x3b, 0x8e, 0xe1, 0x22, 0xd0, 0x26, 0x24, 0x98, 0x53, 0x6a, 0x88,
+	0x73, 0x34, 0x15, 0xe1, 0x27, 0xae, 0x74, 0x48, 0xc3, 0x73, 0x23, 0x70, 0x50, 0x80, 0x8d, 0x38,
+	0x3b, 0x1c, 0x53, 0xb4, 0xdd, 0xa1, 0x70, 0x94, 0x2b, 0xbc, 0x34, 0x54, 0x61, 0xef, 0x74, 0x8f,
+	0xc4, 0xb3, 0x30, 0xc6, 0x08, 0x33, 0xeb, 0x42, 0x2c, 0x6f, 0xa2, 0x60, 0x40, 0x7a, 0x83, 0x98,
+	0xd5, 0x8a, 0x49, 0xd9, 0x8e, 0x23, 0xc2, 0x83, 0xce, 0x41, 0x8c, 0x39, 0xa5, 0x72, 0x8b, 0x61,
+	0xf7, 0xbe, 0x52, 0x36, 0x61, 0x4c, 0x30, 0x47, 0x77, 0xa7, 0xe8, 0x26, 0x8c, 0xee, 0x91, 0x2a,
+	0xe6, 0xf1, 0x3e, 0xb5, 0x9a, 0x09, 0x91, 0xe1, 0xd0, 0xde, 0x3d, 0x52, 0xc5, 0x06, 0x47, 0x2b,
+	0x9f, 0xc2, 0x4c, 0x87, 0x1b, 0x21, 0x69, 0x1e, 0xe2, 0x01, 0xa5, 0xb8, 0xab, 0xa3, 0x0a, 0x05,
+	0x6d, 0xa1, 0x94, 0xdf, 0x25, 0x98, 0x2e, 0x5a, 0x7b, 0x07, 0x75, 0x93, 0xf9, 0x19, 0x86, 0xae,
+	0x42, 0x84, 0x39, 0xc2, 0x62, 0x78, 0xb0, 0xb8, 0x42, 0x11, 0xe6, 0x20, 0x35, 0x70, 0x5b, 0xf7,
+	0x5a, 0x89, 0x90, 0xf4, 0xb8, 0x79, 0xa3, 0x2d, 0xc1, 0x67, 0x30, 0x4d, 0x99, 0xc9, 0x70, 0x89,
+	0x34, 0x71, 0xa3, 0x61, 0x55, 0x31, 0x4d, 0x45, 0x79, 0x88, 0xc3, 0xd4, 0x28, 0xba, 0xc8, 0x82,
+	0x00, 0x86, 0xe5, 0xdd, 0x0d, 0xe3, 0x14, 0x0d, 0x62, 0xa8, 0x62, 0xc1, 0x54, 0xc7, 0x29, 0x74,
+	0x1e, 0x26, 0x29, 0x23, 0x0d, 0x5c, 0x7a, 0x8c, 0x5b, 0xfc, 0x4a, 0x93, 0x46, 0x8c, 0x2f, 0x6c,
+	0xe3, 0x16, 0x4a, 0x42, 0xd4, 0x5d, 0xe6, 0xc4, 0x0d, 0x77, 0xe8, 0x46, 0xba, 0x69, 0xd6, 0x0f,
+	0x30, 0xcf, 0x98, 0x84, 0xe1, 0x4d, 0x6e, 0xcf, 0xbc, 0xea, 0x75, 0xad, 0x7c, 0x27, 0x41, 0xb2,
+	0xad, 0x9c, 0x88, 0xca, 0xbb, 0x10, 0xab, 0x99, 0xb4, 0x64, 0xd9, 0x8f, 0x88, 0x10, 0xf0, 0x52,
+	0xff, 0x90, 0x6c, 0x9a, 0x74, 0xcb, 0x7e, 0x44, 0x8c, 0x89, 0x9a, 0x37, 0x40, 0xef, 0xc0, 0x78,
+	0x03, 0xd3, 0x83, 0x3a, 0x13, 0x25, 0x21, 0xd3, 0xff, 0xac, 0xc1, 0x71, 0x86, 0xc0, 0x2b, 0x0a,
+	0x24, 0xf8, 0x77, 0xe7, 0x87, 0x10, 0xc1, 0xe8, 0xae, 0x49, 0x77, 0xc5, 0x8d, 0xf9, 0x58, 0x79,
+	0x06, 0x53, 0x02, 0x23, 0xc8, 0x2e, 0x0c, 0x8d, 0x33, 0x8f, 0x71, 0x57, 0xa6, 0x45, 0x4e, 0x98,
+	0x69, 0x3f, 0x4a, 0x70, 0x66, 0x13, 0x33, 0xdd, 0xad, 0xb9, 0x1f, 0x5b, 0x6c, 0x77, 0xc7, 0xa1,
+	0x3e, 0xdb, 0x33, 0x30, 0xbe, 0x8b, 0xad, 0xda, 0x2e, 0xe3, 0x64, 0xa2, 0x86, 0x98, 0xa1, 0x3b,
+	0x27, 0x2f, 0x93, 0xc1, 0xef, 0xf7, 0xf6, 0xdc, 0xab, 0xe7, 0xd7, 0x4e, 0x77, 0xe6, 0xe4, 0x9a,
+	0xba, 0xaa, 0x7c, 0x1b, 0x81, 0xb3, 0x3d, 0x8c, 0x8e, 0x5b, 0xb1, 0xd6, 0x20, 0xc6, 0xdb, 0x48,
+	0xc9, 0xaa, 0x0a, 0x86, 0xb2, 0xea, 0xb7, 0x12, 0xd5, 0xeb, 0x20, 0xcd, 0x15, 0x95, 0xfb, 0xd8,
+	0xca, 0x19, 0x13, 0x1c, 0xbb, 0x55, 0x45, 0x2a, 0x8c, 0xf1, 0xa1, 0x28, 0x4d, 0xa9, 0x7e, 0x67,
+	0x0c, 0x0f, 0x86, 0x36, 0x3b, 0xa4, 0x18, 0x3d, 0x56, 0x3d, 0x3b, 0x8a, 0x16, 0xeb, 0x30, 0xbd,
+	0xe3, 0xe4, 0x70, 0x85, 0x54, 0x7d, 0x05, 0x07, 0x54, 0xb2, 0x90, 0x0f, 0xe2, 0xe6, 0x2d, 0xe5,
+	0x3e, 0x24, 0xdb, 0x26, 0x8e, 0x95, 0x62, 0xe1, 0xf6, 0xee, 0xb9, 0x94, 0xf2, 0x76, 0x90, 0xd2,
+	0xdb, 0x98, 0xd3, 0x21, 0xd9, 0x36, 0x27, 0xe8, 0x1d, 0xf7, 0x8a, 0x77, 0x61, 0xd6, 0xb7, 0xb1,
+	0xbe, 0x67, 0xd9, 0xc4, 0xe7, 0x75, 0x11, 0xc0, 0x74, 0xe7, 0xa5, 0xcf, 0x29, 0xb1, 0xc5, 0x47,
+	0x37, 0xc9, 0x57, 0xee, 0x52, 0x62, 0x87, 0xdb, 0x2a, 0xc0, 0x5c, 0x97, 0x2d, 0x41, 0xea, 0x12,
+	0x24, 0x3c, 0x63, 0x65, 0xcb, 0x36, 0x1b, 0x2d, 0x41, 0x2c, 0xce, 0xd7, 0x74, 0xbe, 0xd4, 0x4f,
+	0xff, 0x59, 0x5f, 0xff, 0x0e, 0x72, 0x27, 0xb5, 0xb7, 0x0d, 0x73, 0x5d, 0xf6, 0x04, 0xc1, 0x13,
+	0xdc, 0x76, 0xf9, 0x03, 0x98, 0x10, 0x4f, 0x10, 0x94, 0x82, 0xd9, 0x82, 0x91, 0xcb, 0x1b, 0x25,
+	0xfd, 0x61, 0xe9, 0xa3, 0xfb, 0xc5, 0x07, 0xf9, 0x8d, 0xad, 0x3b, 0x5b, 0xf9, 0x5c, 0x72, 0x04,
+	0x25, 0x21, 0x71, 0xb8, 0xb3, 0x5e, 0xdc, 0x48, 0x4a, 0xe8, 0x34, 0x4c, 0x1d, 0xae, 0xe4, 0xf2,
+	0xc5, 0x8d, 0x64, 0x64, 0xf9, 0x2b, 0x09, 0xa6, 0x3a, 0xfa, 0x24, 0x4a, 0x83, 0xac, 0x1b, 0x85,
+	0xf5, 0xdc, 0xc6, 0x7a, 0x71, 0xa7, 0x74, 0xaf, 0x90, 0xcb, 0x77, 0x99, 0xbd, 0x00, 0xb3, 0x5d,
+	0xfb, 0xfa, 0x87, 0x85, 0x8d, 0xed, 0xa4, 0x24, 0x47, 0x62, 0x12, 0x3a, 0x0b, 0x33, 0x5d, 0xbb,
+	0xc5, 0x87, 0xf7, 0x37, 0x92, 0x11, 0x97, 0x67, 0xd7, 0xc6, 0x3a, 0xdf, 0x89, 0xae, 0xfe, 0x00,
+	0x30, 0x51, 0xf4, 0x9e, 0xbe, 0xe8, 0x29, 0xc4, 0xfc, 0x2e, 0x80, 0x94, 0xb0, 0x26, 0xd6, 0xd9,
+	0x5c, 0xe5, 0xcb, 0x03, 0x31, 0xa2, 0x56, 0x2e, 0x7e, 0xfd, 0xc7, 0x3f, 0x3f, 0x47, 0x32, 0xb7,
+	0xa5, 0x65, 0xe5, 0xbc, 0x16, 0xf2, 0xec, 0xf6, 0x1d, 0x3e, 0x81, 0x31, 0x5e, 0xd2, 0xd1, 0x7c,
+	0x88, 0xd5, 0x60, 0x43, 0x90, 0x33, 0xfd, 0x01, 0xc2, 0xe7, 0x02, 0xf7, 0x39, 0x8f, 0x2e, 0x6a,
+	0x61, 0x0f, 0x6e, 0xaa, 0x3d, 0x75, 0x9b, 0xc8, 0x33, 0xf4, 0x25, 0xc4, 0x03, 0xcf, 0x11, 0xb4,
+	0x30, 0xe8, 0x15, 0xd3, 0x76, 0xbf, 0x38, 0x0c, 0x26, 0x48, 0x5c, 0xe2, 0x24, 0xce, 0xbb, 0x17,
+	0x3f, 0x13, 0xce, 0x03, 0x7d, 0x01, 0xf1, 0xc0, 0x13, 0x33, 0x94, 0x40, 0xef, 0x6b, 0x5c, 0x5e,
+	0x1c, 0x06, 0x13, 0x04, 0xd2, 0x9c, 0x40, 0x0a, 0xf5, 0xf3, 0xfe, 0xab, 0x04, 0xd3, 0x5d, 0x3d,
+	0x03, 0x5d, 0x0d, 0xb7, 0x1d, 0xd2, 0xe9, 0xe4, 0xe5, 0xa3, 0x40, 0x05, 0x15, 0xfd, 0x45, 0x58,
+	0xa5, 0xe6, 0xfc, 0x96, 0xd0, 0x42, 0x9f, 0x28, 0xf1, 0x6e, 0xa1, 0x3d, 0xf5, 0x1a, 0xe8, 0x33,
+	0xf4, 0xbd, 0x04, 0x31, 0xff, 0x23, 0x0e, 0x4d, 0xcf, 0xae, 0xa2, 0x2f, 0x5f, 0x1e, 0x88, 0x11,
+	0xcc, 0x6e, 0xbd, 0xe8, 0xfd, 0xc2, 0x39, 0xaf, 0xb4, 0x1b, 0xb8, 0x73, 0x21, 0xd4, 0xaa, 0x1e,
+	0x03, 0x8f, 0x4e, 0xde, 0x1e, 0x40, 0x27, 0x6f, 0x0f, 0xa7, 0x93, 0xb7, 0xdf, 0x92, 0x0e, 0xf6,
+	0x18, 0xfc, 0x22, 0xc1, 0x54, 0x47, 0x0d, 0x46, 0x4b, 0x03, 0xfc, 0x05, 0x8b, 0xaa, 0x9c, 0x1d,
+	0x0e, 0x14, 0xec, 0xde, 0xeb, 0xc7, 0xee, 0x8a, 0xcb, 0x6e, 0xbe, 0x2f, 0x3b, 0x8d, 0x57, 0x54,
+	0xc1, 0x31, 0x87, 0x87, 0x71, 0xcc, 0xe1, 0x23, 0x72, 0xcc, 0xe1, 0xff, 0x83, 0x63, 0x15, 0xb7,
+	0x39, 0xea, 0xef, 0xff, 0xf6, 0x3a, 0x2d, 0xbd, 0x7c, 0x9d, 0x96, 0xfe, 0x7e, 0x9d, 0x96, 0x7e,
+	0x7a, 0x93, 0x1e, 0x79, 0xf9, 0x26, 0x3d, 0xf2, 0xe7, 0x9b, 0xf4, 0xc8, 0x27, 0x0b, 0x35, 0x8b,
+	0xed, 0x1e, 0x94, 0xdd, 0xd7, 0x8d, 0x6f, 0xa4, 0xed, 0x46, 0xfc, 0x69, 0x33, 0xa7, 0x3c, 0xce,
+	0xff, 0xa4, 0x6f, 0xfc, 0x37, 0x00, 0x3e, 0x50, 0x3f, 0xa8, 0x63, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1821,6 +1903,20 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StateOverrides) > 0 {
+		for iNdEx := len(m.StateOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -1843,6 +1939,50 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StateOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2444,6 +2584,33 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.StateOverrides) > 0 {
+		for _, e := range m.StateOverrides {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
@@ -3315,6 +3482,190 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides, &StateOverride{})
+			if err := m.StateOverrides[len(m.StateOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -232,20 +232,44 @@ func (a Account) loadPubKey(ctx context.Context) (PubKey, error) {
 	return pubKey, nil
 }
 
-func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+// SetPubKey validates and saves the pubkey of the account, without checking the sender.
+// It is meant for account types extending the base account, which authorize the change themselves.
+func (a Account) SetPubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	return a.savePubKey(ctx, anyPk)
+}
+
+// ValidatePubKey checks that the pubkey is of a supported type and valid.
+func (a Account) ValidatePubKey(anyPk *codectypes.Any) error {
+	_, err := a.validatePubKey(anyPk)
+	return err
+}
+
+// validatePubKey validates the pubkey and returns the name of its type.
+func (a Account) validatePubKey(anyPk *codectypes.Any) (string, error) {
+	if anyPk == nil {
+		return "", errors.New("pubkey must be specified")
+	}
 	// check if known
 	name := nameFromTypeURL(anyPk.TypeUrl)
 	impl, exists := a.supportedPubKeys[name]
 	if !exists {
-		return fmt.Errorf("unknown pubkey type %s", name)
+		return "", fmt.Errorf("unknown pubkey type %s", name)
 	}
 	pk, err := impl.decode(anyPk.Value)
 	if err != nil {
-		return fmt.Errorf("unable to decode pubkey: %w", err)
+		return "", fmt.Errorf("unable to decode pubkey: %w", err)
 	}
 	err = impl.validate(pk)
 	if err != nil {
-		return fmt.Errorf("unable to validate pubkey: %w", err)
+		return "", fmt.Errorf("unable to validate pubkey: %w", err)
+	}
+	return name, nil
+}
+
+func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	name, err := a.validatePubKey(anyPk)
+	if err != nil {
+		return err
 	}
 
 	// save into state
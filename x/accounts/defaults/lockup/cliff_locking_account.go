@@ -0,0 +1,235 @@
+package lockup
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*CliffLockingAccount)(nil)
+)
+
+// NewCliffLockingAccount creates a new CliffLockingAccount object.
+func NewCliffLockingAccount(d accountstd.Dependencies) (*CliffLockingAccount, error) {
+	baseLockup := newBaseLockup(d)
+
+	cliffLockingAccount := CliffLockingAccount{
+		BaseLockup: baseLockup,
+		StartTime:  collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		CliffTime:  collections.NewItem(d.SchemaBuilder, CliffTimePrefix, "cliff_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+	}
+
+	return &cliffLockingAccount, nil
+}
+
+type CliffLockingAccount struct {
+	*BaseLockup
+	StartTime collections.Item[time.Time]
+	CliffTime collections.Item[time.Time]
+}
+
+func (clva CliffLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitCliffLockingAccount) (*lockuptypes.MsgInitCliffLockingAccountResponse, error) {
+	if msg.EndTime.IsZero() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid end time %s", msg.EndTime.String())
+	}
+
+	hs := clva.headerService.HeaderInfo(ctx)
+
+	start := msg.StartTime
+	if msg.StartTime.IsZero() {
+		start = hs.Time
+	}
+
+	if !msg.EndTime.After(start) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid start and end time (must be start before end)")
+	}
+
+	if msg.CliffTime.Before(start) || msg.CliffTime.After(msg.EndTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid cliff time (must be between start and end)")
+	}
+
+	err := clva.StartTime.Set(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+
+	err = clva.CliffTime.Set(ctx, msg.CliffTime)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = clva.BaseLockup.Init(ctx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     msg.Owner,
+		EndTime:   msg.EndTime,
+		StartTime: start,
+		Admin:     msg.Admin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitCliffLockingAccountResponse{}, nil
+}
+
+func (clva *CliffLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return clva.BaseLockup.Delegate(ctx, msg, clva.GetLockedCoinsWithDenoms)
+}
+
+func (clva *CliffLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return clva.BaseLockup.SendCoins(ctx, msg, clva.GetLockedCoinsWithDenoms)
+}
+
+func (clva *CliffLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (
+	*lockuptypes.MsgWithdrawResponse, error,
+) {
+	return clva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, clva.GetLockedCoinsWithDenoms)
+}
+
+func (clva *CliffLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return clva.BaseLockup.Clawback(ctx, msg, clva.GetLockedCoinsWithDenoms)
+}
+
+// GetLockCoinsInfo returns the total number of unlocked and locked coins.
+func (clva CliffLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
+	unlockedCoins = sdk.Coins{}
+	lockedCoins = sdk.Coins{}
+
+	err = clva.IterateCoinEntries(ctx, clva.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+		unlockedCoin, lockedCoin, err := clva.GetLockCoinInfoWithDenom(ctx, blockTime, key)
+		if err != nil {
+			return true, err
+		}
+		unlockedCoins = append(unlockedCoins, *unlockedCoin)
+		lockedCoins = append(lockedCoins, *lockedCoin)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unlockedCoins, lockedCoins, nil
+}
+
+// GetLockCoinInfoWithDenom returns the number of unlocked and locked coin for a specific denom. No coins are
+// unlocked before the cliff time, after which the coins unlock continuously from the start time until the end time.
+func (clva CliffLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	startTime, err := clva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	cliffTime, err := clva.CliffTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	endTime, err := clva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	originalLockingAmt, err := clva.OriginalLocking.Get(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	originalLocking := sdk.NewCoin(denom, originalLockingAmt)
+	if cliffTime.After(blockTime) {
+		return &sdk.Coin{}, &originalLocking, nil
+	} else if endTime.Before(blockTime) {
+		return &originalLocking, &sdk.Coin{}, nil
+	}
+
+	// calculate the locking scalar
+	x := blockTime.Unix() - startTime.Unix()
+	y := endTime.Unix() - startTime.Unix()
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	unlockedAmt := math.LegacyNewDecFromInt(originalLocking.Amount).Mul(s).RoundInt()
+	unlocked := sdk.NewCoin(originalLocking.Denom, unlockedAmt)
+
+	locked := originalLocking.Sub(unlocked)
+
+	return &unlocked, &locked, nil
+}
+
+// GetLockedCoins returns the total number of locked coins.
+func (clva CliffLockingAccount) GetLockedCoins(ctx context.Context, blockTime time.Time) (sdk.Coins, error) {
+	_, lockedCoins, err := clva.GetLockCoinsInfo(ctx, blockTime)
+	if err != nil {
+		return nil, err
+	}
+	return lockedCoins, nil
+}
+
+// GetLockedCoinsWithDenoms returns the number of locked coin for a specific denom.
+func (clva CliffLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	lockedCoins := sdk.Coins{}
+	for _, denom := range denoms {
+		_, lockedCoin, err := clva.GetLockCoinInfoWithDenom(ctx, blockTime, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = append(lockedCoins, *lockedCoin)
+	}
+
+	return lockedCoins, nil
+}
+
+func (clva CliffLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
+	*lockuptypes.QueryLockupAccountInfoResponse, error,
+) {
+	resp, err := clva.BaseLockup.QueryLockupAccountBaseInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := clva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cliffTime, err := clva.CliffTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hs := clva.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, err := clva.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	resp.StartTime = &startTime
+	resp.CliffTime = &cliffTime
+	resp.LockedCoins = lockedCoins
+	resp.UnlockedCoins = unlockedCoins
+	return resp, nil
+}
+
+// Implement smart account interface
+func (clva CliffLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, clva.Init)
+}
+
+func (clva CliffLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, clva.Delegate)
+	accountstd.RegisterExecuteHandler(builder, clva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, clva.Clawback)
+	accountstd.RegisterExecuteHandler(builder, clva.WithdrawUnlockedCoins)
+	clva.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (clva CliffLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, clva.QueryLockupAccountInfo)
+}
@@ -0,0 +1,203 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func setupCliffAccount(t *testing.T, ctx context.Context, ss store.KVStoreService, startTime time.Time) *CliffLockingAccount {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	owner := "owner" //nolint:goconst // adding constants for this would impede readability
+
+	acc, err := NewCliffLockingAccount(deps)
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     owner,
+		StartTime: startTime,
+		CliffTime: startTime.Add(time.Minute),
+		EndTime:   startTime.Add(time.Minute * 4),
+		Admin:     "admin",
+	})
+	require.NoError(t, err)
+
+	return acc
+}
+
+func TestCliffAccountInit(t *testing.T) {
+	startTime := time.Now()
+
+	testcases := []struct {
+		name   string
+		msg    lockuptypes.MsgInitCliffLockingAccount
+		expErr string
+	}{
+		{
+			"cliff before start",
+			lockuptypes.MsgInitCliffLockingAccount{
+				Owner:     "owner",
+				StartTime: startTime,
+				CliffTime: startTime.Add(-time.Second),
+				EndTime:   startTime.Add(time.Minute),
+			},
+			"invalid cliff time",
+		},
+		{
+			"cliff after end",
+			lockuptypes.MsgInitCliffLockingAccount{
+				Owner:     "owner",
+				StartTime: startTime,
+				CliffTime: startTime.Add(time.Minute * 2),
+				EndTime:   startTime.Add(time.Minute),
+			},
+			"invalid cliff time",
+		},
+		{
+			"end before start",
+			lockuptypes.MsgInitCliffLockingAccount{
+				Owner:     "owner",
+				StartTime: startTime,
+				CliffTime: startTime,
+				EndTime:   startTime.Add(-time.Minute),
+			},
+			"invalid start and end time",
+		},
+		{
+			"successfully init",
+			lockuptypes.MsgInitCliffLockingAccount{
+				Owner:     "owner",
+				StartTime: startTime,
+				CliffTime: startTime.Add(time.Second),
+				EndTime:   startTime.Add(time.Minute),
+			},
+			"",
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+				Time: startTime,
+			})
+
+			acc, err := NewCliffLockingAccount(makeMockDependencies(ss))
+			require.NoError(t, err)
+			_, err = acc.Init(sdkCtx, &test.msg)
+			if test.expErr != "" {
+				require.ErrorContains(t, err, test.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCliffAccountSendCoins(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc := setupCliffAccount(t, sdkCtx, ss, startTime)
+
+	// nothing is unlocked before the cliff
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Second * 59),
+	})
+	_, err := acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))),
+	})
+	require.Error(t, err)
+
+	// a quarter of the original locking amount is unlocked at the cliff
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))),
+	})
+	require.NoError(t, err)
+}
+
+func TestCliffAccountGetLockCoinInfo(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc := setupCliffAccount(t, sdkCtx, ss, startTime)
+
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Second*59))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.ZeroInt()))
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(10)))
+
+	// unlocked a quarter of the locked token at the cliff, rounded
+	unlocked, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(2)))
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(8)))
+
+	// unlocked half locked token
+	unlocked, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*2))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(5)))
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(5)))
+
+	// unlocked full locked token
+	unlocked, locked, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*4))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
+	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
+
+	info, err := acc.QueryLockupAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "admin", info.Admin)
+	require.True(t, info.CliffTime.Equal(startTime.Add(time.Minute)))
+}
+
+func TestCliffAccountClawbackBeforeCliff(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc := setupCliffAccount(t, sdkCtx, ss, startTime)
+
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Second * 30),
+	})
+	sdkCtx = sdkCtx.WithContext(accountstd.SetSender(sdkCtx.Context(), []byte("admin")))
+	res, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "admin",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10))), res.Amount)
+
+	// nothing unlocks after the clawback
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*2))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.ZeroInt()))
+	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
+}
@@ -78,6 +78,12 @@ func (cva *ContinuousLockingAccount) WithdrawUnlockedCoins(ctx context.Context,
 	return cva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, cva.GetLockedCoinsWithDenoms)
 }
 
+func (cva *ContinuousLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cva.BaseLockup.Clawback(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
 // GetLockCoinsInfo returns the total number of unlocked and locked coins.
 func (cva ContinuousLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
 	unlockedCoins = sdk.Coins{}
@@ -194,6 +200,7 @@ func (cva ContinuousLockingAccount) RegisterInitHandler(builder *accountstd.Init
 func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, cva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, cva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, cva.Clawback)
 	accountstd.RegisterExecuteHandler(builder, cva.WithdrawUnlockedCoins)
 	cva.BaseLockup.RegisterExecuteHandlers(builder)
 }
@@ -55,6 +55,12 @@ func (dva *DelayedLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg
 	return dva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, dva.GetLockedCoinsWithDenoms)
 }
 
+func (dva *DelayedLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return dva.BaseLockup.Clawback(ctx, msg, dva.GetLockedCoinsWithDenoms)
+}
+
 // GetLockCoinsInfo returns the total number of unlocked and locked coins.
 func (dva DelayedLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (sdk.Coins, sdk.Coins, error) {
 	endTime, err := dva.EndTime.Get(ctx)
@@ -143,6 +149,7 @@ func (dva DelayedLockingAccount) RegisterInitHandler(builder *accountstd.InitBui
 func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, dva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, dva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, dva.Clawback)
 	accountstd.RegisterExecuteHandler(builder, dva.WithdrawUnlockedCoins)
 	dva.BaseLockup.RegisterExecuteHandlers(builder)
 }
@@ -36,6 +36,9 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	AdminPrefix            = collections.NewPrefix(8)
+	PendingClawbackPrefix  = collections.NewPrefix(9)
+	CliffTimePrefix        = collections.NewPrefix(10)
 )
 
 var (
@@ -43,6 +46,7 @@ var (
 	DELAYED_LOCKING_ACCOUNT    = "delayed-locking-account"
 	PERIODIC_LOCKING_ACCOUNT   = "periodic-locking-account"
 	PERMANENT_LOCKING_ACCOUNT  = "permanent-locking-account"
+	CLIFF_LOCKING_ACCOUNT      = "cliff-locking-account"
 )
 
 type getLockedCoinsFunc = func(ctx context.Context, time time.Time, denoms ...string) (sdk.Coins, error)
@@ -55,6 +59,8 @@ func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
 		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
 		WithdrawedCoins:  collections.NewMap(d.SchemaBuilder, WithdrawedCoinsPrefix, "withdrawed_coins", collections.StringKey, sdk.IntValue),
+		Admin:            collections.NewItem(d.SchemaBuilder, AdminPrefix, "admin", collections.BytesValue),
+		PendingClawback:  collections.NewMap(d.SchemaBuilder, PendingClawbackPrefix, "pending_clawback", collections.StringKey, sdk.IntValue),
 		addressCodec:     d.AddressCodec,
 		headerService:    d.Environment.HeaderService,
 		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
@@ -70,8 +76,12 @@ type BaseLockup struct {
 	DelegatedFree    collections.Map[string, math.Int]
 	DelegatedLocking collections.Map[string, math.Int]
 	WithdrawedCoins  collections.Map[string, math.Int]
-	addressCodec     address.Codec
-	headerService    header.Service
+	// Admin is the optional address which can claw back the locked coins.
+	Admin collections.Item[[]byte]
+	// PendingClawback tracks the coins being unbonded since a clawback, which remain locked until sent to the admin.
+	PendingClawback collections.Map[string, math.Int]
+	addressCodec    address.Codec
+	headerService   header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
 }
@@ -88,6 +98,11 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	err = bva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
 	funds := accountstd.Funds(ctx)
 
 	sortedAmt := funds.Sort()
@@ -129,6 +144,20 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
 }
 
+// setAdmin sets the admin of the lockup account, if any.
+func (bva *BaseLockup) setAdmin(ctx context.Context, admin string) error {
+	if admin == "" {
+		return nil
+	}
+
+	adminBytes, err := bva.addressCodec.StringToBytes(admin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'admin' address: %s", err)
+	}
+
+	return bva.Admin.Set(ctx, adminBytes)
+}
+
 func (bva *BaseLockup) Delegate(
 	ctx context.Context, msg *lockuptypes.MsgDelegate, getLockedCoinsFunc getLockedCoinsFunc,
 ) (
@@ -155,6 +184,16 @@ func (bva *BaseLockup) Delegate(
 		return nil, err
 	}
 
+	// the coins pending clawback must stay in the account until sent to the admin
+	pendingAmt, err := bva.getPendingClawback(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if pendingAmt.IsPositive() && balance.Amount.Sub(pendingAmt).LT(msg.Amount.Amount) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
+			"%s%s are pending clawback and can't be delegated", pendingAmt, msg.Amount.Denom)
+	}
+
 	err = bva.TrackDelegation(
 		ctx,
 		sdk.Coins{*balance},
@@ -381,6 +420,212 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 	}, nil
 }
 
+// Clawback allows the admin of the lockup account to take back the coins which are still locked, sending them to an
+// account of choice. It ends the lockup: the coins left in the account are unlocked. The locked coins which are
+// delegated are undelegated, and remain locked until sent by a later clawback once unbonded.
+func (bva *BaseLockup) Clawback(
+	ctx context.Context, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	admin, err := bva.Admin.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errors.New("clawback is not enabled for this lockup account")
+	} else if err != nil {
+		return nil, err
+	}
+	if !accountstd.HasSender(ctx, admin) {
+		return nil, errors.New("sender is not the admin of this lockup account")
+	}
+
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+
+	var denoms []string
+	err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, _ math.Int) (stop bool, err error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	amount, pending := sdk.Coins{}, sdk.Coins{}
+	for _, denom := range denoms {
+		lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedAmt := lockedCoins.AmountOf(denom)
+
+		// end the lockup, the coins which are not clawed back are unlocked
+		originalLockingAmt, err := bva.OriginalLocking.Get(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		originalLockingAmt = originalLockingAmt.Sub(lockedAmt)
+		err = bva.OriginalLocking.Set(ctx, denom, originalLockingAmt)
+		if err != nil {
+			return nil, err
+		}
+		withdrawedAmt, err := bva.WithdrawedCoins.Get(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		err = bva.WithdrawedCoins.Set(ctx, denom, math.MinInt(withdrawedAmt, originalLockingAmt))
+		if err != nil {
+			return nil, err
+		}
+
+		clawbackAmt := lockedAmt
+		if denom == bondDenom {
+			clawbackAmt, err = bva.clawbackDelegations(ctx, fromAddress, lockedAmt, bondDenom)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		balance, err := bva.getBalance(ctx, fromAddress, denom)
+		if err != nil {
+			return nil, err
+		}
+		sendAmt := math.MinInt(clawbackAmt, balance.Amount)
+
+		if denom == bondDenom {
+			// the coins which can't be sent yet remain pending, along with the undelegated ones
+			pendingAmt, err := bva.getPendingClawback(ctx, bondDenom)
+			if err != nil {
+				return nil, err
+			}
+			pendingAmt = pendingAmt.Add(clawbackAmt.Sub(sendAmt))
+			err = bva.PendingClawback.Set(ctx, bondDenom, pendingAmt)
+			if err != nil {
+				return nil, err
+			}
+			if pendingAmt.IsPositive() {
+				pending = append(pending, sdk.NewCoin(bondDenom, pendingAmt))
+			}
+		}
+
+		if sendAmt.IsPositive() {
+			amount = append(amount, sdk.NewCoin(denom, sendAmt))
+		}
+	}
+	if amount.IsZero() && pending.IsZero() {
+		return nil, errors.New("no tokens available for clawback")
+	}
+
+	endTime, err := bva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if endTime.IsZero() || endTime.After(hs.Time) {
+		err = bva.EndTime.Set(ctx, hs.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !amount.IsZero() {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: fromAddress,
+			ToAddress:   msg.ToAddress,
+			Amount:      amount,
+		}
+		_, err = sendMessage(ctx, msgSend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgClawbackResponse{
+		Amount:  amount,
+		Pending: pending,
+	}, nil
+}
+
+// clawbackDelegations returns the amount of staking denom to claw back. The first clawback undelegates the
+// locked amount which is delegated, tracked as pending clawback until unbonded, and returns the rest of it.
+// The later ones return the pending clawback, which is reset, and undelegate nothing: the delegations left
+// are the owner's.
+func (bva *BaseLockup) clawbackDelegations(
+	ctx context.Context, delegatorAddress string, lockedAmt math.Int, bondDenom string,
+) (math.Int, error) {
+	pendingAmt, err := bva.PendingClawback.Get(ctx, bondDenom)
+	if err == nil {
+		return lockedAmt.Add(pendingAmt), bva.PendingClawback.Set(ctx, bondDenom, math.ZeroInt())
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return math.Int{}, err
+	}
+
+	delLockingAmt, err := bva.DelegatedLocking.Get(ctx, bondDenom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	delClawbackAmt := math.MinInt(lockedAmt, delLockingAmt)
+
+	undelegatedAmt := math.ZeroInt()
+	if delClawbackAmt.IsPositive() {
+		resp, err := accountstd.QueryModule[*stakingtypes.QueryDelegatorDelegationsResponse](
+			ctx, &stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: delegatorAddress},
+		)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		for _, delegation := range resp.DelegationResponses {
+			amt := math.MinInt(delClawbackAmt.Sub(undelegatedAmt), delegation.Balance.Amount)
+			if !amt.IsPositive() {
+				continue
+			}
+
+			msgUndelegate := &stakingtypes.MsgUndelegate{
+				DelegatorAddress: delegatorAddress,
+				ValidatorAddress: delegation.Delegation.ValidatorAddress,
+				Amount:           sdk.NewCoin(bondDenom, amt),
+			}
+			_, err = sendMessage(ctx, msgUndelegate)
+			if err != nil {
+				return math.Int{}, err
+			}
+			undelegatedAmt = undelegatedAmt.Add(amt)
+		}
+	}
+
+	if undelegatedAmt.IsPositive() {
+		err = bva.TrackUndelegation(ctx, sdk.NewCoins(sdk.NewCoin(bondDenom, undelegatedAmt)))
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	// the undelegated coins are sent once unbonded, the delegations which were slashed can't be clawed back
+	err = bva.PendingClawback.Set(ctx, bondDenom, undelegatedAmt)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return lockedAmt.Sub(delClawbackAmt), nil
+}
+
+func (bva BaseLockup) getPendingClawback(ctx context.Context, denom string) (math.Int, error) {
+	pendingAmt, err := bva.PendingClawback.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return pendingAmt, err
+}
+
 func (bva *BaseLockup) checkSender(ctx context.Context, sender string) error {
 	owner, err := bva.Owner.Get(ctx)
 	if err != nil {
@@ -595,7 +840,8 @@ func (bva BaseLockup) IterateCoinEntries(
 }
 
 // GetNotBondedLockedCoin returns the coin that are not spendable that are not bonded by denom
-// for a lockup account. If the coin by the provided denom are not locked, an coin with zero amount is returned.
+// for a lockup account, including the coins pending clawback. If the coin by the provided denom
+// are not locked, an coin with zero amount is returned.
 func (bva BaseLockup) GetNotBondedLockedCoin(ctx context.Context, lockedCoin sdk.Coin, denom string) (sdk.Coin, error) {
 	bondDenom, err := getStakingDenom(ctx)
 	if err != nil {
@@ -615,7 +861,13 @@ func (bva BaseLockup) GetNotBondedLockedCoin(ctx context.Context, lockedCoin sdk
 	x := math.MinInt(lockedCoin.Amount, delegatedLockingAmt)
 	lockedAmt := lockedCoin.Amount.Sub(x)
 
-	return sdk.NewCoin(denom, lockedAmt), nil
+	// the coins pending clawback are undelegated, so not bonded
+	pendingAmt, err := bva.getPendingClawback(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, lockedAmt.Add(pendingAmt)), nil
 }
 
 // QueryLockupAccountBaseInfo returns a lockup account's info
@@ -663,12 +915,31 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 	}
 	delegatedFree := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatedFreeAmt))
 
+	adminAddress := ""
+	admin, err := bva.Admin.Get(ctx)
+	if err == nil {
+		adminAddress, err = bva.addressCodec.BytesToString(admin)
+		if err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	pendingClawbackAmt, err := bva.getPendingClawback(ctx, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+	pendingClawback := sdk.NewCoins(sdk.NewCoin(bondDenom, pendingClawbackAmt))
+
 	return &lockuptypes.QueryLockupAccountInfoResponse{
 		Owner:            ownerAddress,
 		OriginalLocking:  originalLocking,
 		DelegatedLocking: delegatedLocking,
 		DelegatedFree:    delegatedFree,
 		EndTime:          &endTime,
+		Admin:            adminAddress,
+		PendingClawback:  pendingClawback,
 	}, nil
 }
 
@@ -7,8 +7,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -277,3 +280,172 @@ func TestQueryLockupAccountBaseInfo(t *testing.T) {
 	require.Equal(t, res.Owner, "owner")
 	require.NoError(t, err)
 }
+
+func TestClawback(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Minute * 2),
+		Admin:     "admin",
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(4)),
+	})
+	require.NoError(t, err)
+
+	// the admin set in the message is not trusted, only the sender is
+	ownerCtx := sdkCtx.WithContext(accountstd.SetSender(sdkCtx.Context(), []byte("owner")))
+	_, err = acc.Clawback(ownerCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "owner",
+	})
+	require.ErrorContains(t, err, "sender is not the admin of this lockup account")
+
+	// claw back the half which is still locked, including the delegated part
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+	sdkCtx = sdkCtx.WithContext(accountstd.SetSender(sdkCtx.Context(), []byte("admin")))
+	res, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))), res.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4))), res.Pending)
+
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.IsZero())
+
+	// the lockup ended, the coins being unbonded remain locked
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*2))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(5)))
+	require.True(t, locked.AmountOf("test").IsZero())
+
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(7))),
+	})
+	require.ErrorContains(t, err, "spendable balance 6test is smaller than 7test")
+
+	info, err := acc.QueryLockupAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "admin", info.Admin)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4))), info.PendingClawback)
+
+	// once unbonded, the pending coins are sent by a later clawback
+	res, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4))), res.Amount)
+	require.True(t, res.Pending.IsZero())
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+	})
+	require.ErrorContains(t, err, "no tokens available for clawback")
+}
+
+func TestClawbackUndelegatesOnce(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Minute * 2),
+		Admin:     "admin",
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(6)),
+	})
+	require.NoError(t, err)
+
+	// the 5 locked coins are all delegated and undelegated, the owner keeps 1 delegated coin
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+	sdkCtx = sdkCtx.WithContext(accountstd.SetSender(sdkCtx.Context(), []byte("admin")))
+	res, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+	})
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))), res.Pending)
+
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1), delLocking)
+
+	// the coins pending clawback can't be delegated nor sent
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(6)),
+	})
+	require.ErrorContains(t, err, "5test are pending clawback and can't be delegated")
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6))),
+	})
+	require.ErrorContains(t, err, "spendable balance 5test is smaller than 6test")
+
+	// a later clawback only sends the pending coins, the owner's delegation is left untouched
+	res, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))), res.Amount)
+	require.True(t, res.Pending.IsZero())
+
+	delLocking, err = acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1), delLocking)
+	pending, err := acc.PendingClawback.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, pending.IsZero())
+}
+
+func TestClawbackDisabled(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinousAccount(t, sdkCtx, ss)
+	_, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "owner",
+		ToAddress: "owner",
+	})
+	require.ErrorContains(t, err, "clawback is not enabled for this lockup account")
+}
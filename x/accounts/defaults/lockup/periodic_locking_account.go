@@ -118,6 +118,10 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 	if err != nil {
 		return nil, err
 	}
+	err = pva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
 
 	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
 }
@@ -140,6 +144,12 @@ func (pva *PeriodicLockingAccount) WithdrawUnlockedCoins(ctx context.Context, ms
 	return pva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, pva.GetLockedCoinsWithDenoms)
 }
 
+func (pva *PeriodicLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return pva.BaseLockup.Clawback(ctx, msg, pva.GetLockedCoinsWithDenoms)
+}
+
 // IteratePeriods iterates over all the Periods entries.
 func (pva PeriodicLockingAccount) IteratePeriods(
 	ctx context.Context,
@@ -336,6 +346,7 @@ func (pva PeriodicLockingAccount) RegisterInitHandler(builder *accountstd.InitBu
 func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, pva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, pva.Clawback)
 	accountstd.RegisterExecuteHandler(builder, pva.WithdrawUnlockedCoins)
 	pva.BaseLockup.RegisterExecuteHandlers(builder)
 }
@@ -84,6 +84,13 @@ type QueryLockupAccountInfoResponse struct {
 	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
 	// owner defines the value of the owner of the lockup account.
 	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// admin defines the value of the admin of the lockup account, which can claw back the locked coins.
+	Admin string `protobuf:"bytes,9,opt,name=admin,proto3" json:"admin,omitempty"`
+	// pending_clawback defines the value of the account coins being unbonded since a clawback, which remain locked
+	// until sent to the admin.
+	PendingClawback github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=pending_clawback,json=pendingClawback,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_clawback"`
+	// cliff_time defines the value of the cliff lockup account cliff time.
+	CliffTime *time.Time `protobuf:"bytes,11,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
 }
 
 func (m *QueryLockupAccountInfoResponse) Reset()         { *m = QueryLockupAccountInfoResponse{} }
@@ -175,6 +182,27 @@ func (m *QueryLockupAccountInfoResponse) GetOwner() string {
 	return ""
 }
 
+func (m *QueryLockupAccountInfoResponse) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *QueryLockupAccountInfoResponse) GetPendingClawback() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingClawback
+	}
+	return nil
+}
+
+func (m *QueryLockupAccountInfoResponse) GetCliffTime() *time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return nil
+}
+
 // QueryLockingPeriodsRequest is used to query the periodic lockup account locking periods.
 type QueryLockingPeriodsRequest struct {
 }
@@ -270,39 +298,42 @@ func init() {
 }
 
 var fileDescriptor_f06fad50e16c8e9b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0xda, 0xf4, 0x63, 0x03, 0x6d, 0x89, 0x7a, 0x30, 0x01, 0xec, 0x28, 0x17, 0x22,
-	0x41, 0x77, 0x69, 0x39, 0x72, 0x40, 0x04, 0x09, 0x84, 0xd4, 0x43, 0xb1, 0x38, 0x71, 0xb1, 0xfc,
-	0x31, 0x31, 0xab, 0x38, 0x3b, 0xae, 0x77, 0x5d, 0xda, 0xb7, 0xe8, 0x73, 0xf0, 0x24, 0x3d, 0xf6,
-	0xc8, 0x89, 0xa2, 0xe4, 0x3d, 0x10, 0xb2, 0x77, 0x37, 0xa8, 0x12, 0x55, 0x39, 0xa4, 0xa7, 0xfd,
-	0x9a, 0x99, 0xdf, 0xcc, 0xfe, 0x77, 0x96, 0x3c, 0x4f, 0x50, 0x4e, 0x51, 0xb2, 0x28, 0x49, 0xb0,
-	0x12, 0x4a, 0xb2, 0x14, 0xc6, 0x51, 0x95, 0x2b, 0xc9, 0x72, 0x4c, 0x26, 0x55, 0xc1, 0x8e, 0x2b,
-	0x28, 0xcf, 0x68, 0x51, 0xa2, 0xc2, 0xae, 0xaf, 0x8d, 0xa9, 0x35, 0xa6, 0xd6, 0x98, 0x6a, 0xe3,
-	0xde, 0x8b, 0xdb, 0xa2, 0xe9, 0x41, 0x87, 0xeb, 0x79, 0xc6, 0x3a, 0x8e, 0x24, 0xb0, 0x93, 0xfd,
-	0x18, 0x54, 0xb4, 0xcf, 0x12, 0xe4, 0xc2, 0x9c, 0xef, 0x66, 0x98, 0x61, 0x33, 0x65, 0xf5, 0xcc,
-	0xec, 0xfa, 0x19, 0x62, 0x96, 0x03, 0x6b, 0x56, 0x71, 0x35, 0x66, 0x8a, 0x4f, 0x41, 0xaa, 0x68,
-	0x6a, 0xc2, 0x0e, 0x7c, 0xf2, 0xf4, 0x53, 0x9d, 0xf4, 0x61, 0xc3, 0x7a, 0xab, 0x53, 0xf9, 0x28,
-	0xc6, 0x18, 0xc0, 0x71, 0x05, 0x52, 0x0d, 0x7e, 0xb7, 0x89, 0x77, 0x93, 0x85, 0x2c, 0x50, 0x48,
-	0xe8, 0x9e, 0x90, 0x1d, 0x2c, 0x79, 0xc6, 0x45, 0x94, 0x87, 0x75, 0xce, 0x5c, 0x64, 0xae, 0xd3,
-	0x5f, 0x19, 0x76, 0x0e, 0x1e, 0x51, 0x73, 0x09, 0x75, 0xd6, 0xd4, 0x64, 0x4d, 0xdf, 0x21, 0x17,
-	0xa3, 0x97, 0x17, 0x3f, 0xfd, 0xd6, 0xf7, 0x2b, 0x7f, 0x98, 0x71, 0xf5, 0xb5, 0x8a, 0x69, 0x82,
-	0x53, 0x66, 0x4a, 0xd4, 0xc3, 0x9e, 0x4c, 0x27, 0x4c, 0x9d, 0x15, 0x20, 0x1b, 0x07, 0x19, 0x6c,
-	0x5b, 0xc8, 0xa1, 0x66, 0x74, 0x4b, 0xb2, 0x95, 0x42, 0x0e, 0x59, 0xa4, 0x20, 0x0d, 0xc7, 0x25,
-	0x80, 0x7b, 0x6f, 0xf9, 0xd4, 0x07, 0x0b, 0xc4, 0xfb, 0x12, 0xa0, 0x7b, 0x4a, 0x1e, 0xfe, 0x65,
-	0xda, 0x62, 0x57, 0x96, 0x8f, 0xdd, 0x59, 0x50, 0x6c, 0xb5, 0x6f, 0x08, 0x91, 0x2a, 0x2a, 0x55,
-	0x58, 0x4b, 0xe8, 0xae, 0xf6, 0x9d, 0x61, 0xe7, 0xa0, 0x47, 0xb5, 0xbe, 0xd4, 0xea, 0x4b, 0x3f,
-	0x5b, 0x7d, 0x47, 0xab, 0xe7, 0x57, 0xbe, 0x13, 0x6c, 0x36, 0x3e, 0xf5, 0x6e, 0xf7, 0x35, 0xd9,
-	0x00, 0x91, 0x6a, 0xf7, 0xf6, 0x7f, 0xba, 0xaf, 0x83, 0x48, 0x1b, 0x67, 0x41, 0xee, 0xd7, 0xd5,
-	0x42, 0x1a, 0xd6, 0x6f, 0x4e, 0xba, 0x6b, 0xcb, 0x2f, 0xb9, 0xa3, 0x01, 0xcd, 0xa2, 0xd6, 0xb6,
-	0x12, 0xd7, 0x88, 0xeb, 0x77, 0xa0, 0xad, 0x45, 0x68, 0xe6, 0x2e, 0x69, 0xe3, 0x37, 0x01, 0xa5,
-	0xbb, 0xd1, 0x77, 0x86, 0x9b, 0x81, 0x5e, 0x0c, 0x9e, 0x90, 0xde, 0xe2, 0xfd, 0x73, 0x91, 0x1d,
-	0x41, 0xc9, 0x31, 0x95, 0xb6, 0x3d, 0x90, 0x3c, 0xfe, 0xe7, 0xa9, 0x69, 0x8d, 0x23, 0xb2, 0x6d,
-	0x1e, 0x49, 0x58, 0xe8, 0x23, 0xd3, 0x19, 0xcf, 0xe8, 0x2d, 0xdf, 0x03, 0xd5, 0xa1, 0x82, 0xad,
-	0xfc, 0x5a, 0xe4, 0xd1, 0x87, 0x8b, 0x99, 0xe7, 0x5c, 0xce, 0x3c, 0xe7, 0xd7, 0xcc, 0x73, 0xce,
-	0xe7, 0x5e, 0xeb, 0x72, 0xee, 0xb5, 0x7e, 0xcc, 0xbd, 0xd6, 0x97, 0x3d, 0x1d, 0x51, 0xa6, 0x13,
-	0xca, 0x91, 0x9d, 0xde, 0xfc, 0xaf, 0x34, 0x57, 0x10, 0xaf, 0x35, 0xa2, 0xbf, 0xfa, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x8d, 0xa3, 0x8b, 0xf7, 0xd5, 0x04, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc0, 0xe3, 0x7f, 0xbf, 0x37, 0x7f, 0xda, 0x12, 0xf5, 0x60, 0x02, 0xd8, 0x51, 0x2e, 0x44,
+	0x82, 0xee, 0xd2, 0x72, 0xe4, 0x80, 0x48, 0x25, 0x10, 0x52, 0x0f, 0x25, 0xe2, 0xc4, 0xc5, 0xb2,
+	0xbd, 0x63, 0xb3, 0x8a, 0xb3, 0xeb, 0x7a, 0xd7, 0xfd, 0x78, 0x8b, 0x3e, 0x07, 0x4f, 0xd2, 0x0b,
+	0x52, 0x8f, 0x9c, 0x28, 0x4a, 0x5e, 0x04, 0xed, 0x87, 0x83, 0x2a, 0x51, 0xb5, 0x87, 0xf4, 0x64,
+	0xcf, 0xec, 0xcc, 0xfc, 0x66, 0x76, 0x66, 0x16, 0xbd, 0x4c, 0x85, 0x9c, 0x08, 0x49, 0xe2, 0x34,
+	0x15, 0x35, 0x57, 0x92, 0x50, 0xc8, 0xe2, 0xba, 0x50, 0x92, 0x14, 0x22, 0x1d, 0xd7, 0x25, 0x39,
+	0xae, 0xa1, 0x3a, 0xc7, 0x65, 0x25, 0x94, 0xe8, 0x84, 0xd6, 0x18, 0x37, 0xc6, 0xb8, 0x31, 0xc6,
+	0xd6, 0xb8, 0xfb, 0xea, 0xae, 0x68, 0xf6, 0x63, 0xc3, 0x75, 0x03, 0x67, 0x9d, 0xc4, 0x12, 0xc8,
+	0xc9, 0x5e, 0x02, 0x2a, 0xde, 0x23, 0xa9, 0x60, 0xdc, 0x9d, 0xef, 0xe4, 0x22, 0x17, 0xe6, 0x97,
+	0xe8, 0x3f, 0xa7, 0x0d, 0x73, 0x21, 0xf2, 0x02, 0x88, 0x91, 0x92, 0x3a, 0x23, 0x8a, 0x4d, 0x40,
+	0xaa, 0x78, 0xe2, 0xc2, 0xf6, 0x43, 0xf4, 0xfc, 0xb3, 0x4e, 0xfa, 0xd0, 0xb0, 0xde, 0xdb, 0x54,
+	0x3e, 0xf1, 0x4c, 0x8c, 0xe0, 0xb8, 0x06, 0xa9, 0xfa, 0x3f, 0xd6, 0x50, 0x70, 0x9b, 0x85, 0x2c,
+	0x05, 0x97, 0xd0, 0x39, 0x41, 0xdb, 0xa2, 0x62, 0x39, 0xe3, 0x71, 0x11, 0xe9, 0x9c, 0x19, 0xcf,
+	0x7d, 0xaf, 0xb7, 0x34, 0x68, 0xef, 0x3f, 0xc1, 0xee, 0x12, 0x74, 0xd6, 0xd8, 0x65, 0x8d, 0x0f,
+	0x04, 0xe3, 0xc3, 0xd7, 0x97, 0xbf, 0xc2, 0xd6, 0xf7, 0xeb, 0x70, 0x90, 0x33, 0xf5, 0xad, 0x4e,
+	0x70, 0x2a, 0x26, 0xc4, 0x95, 0x68, 0x3f, 0xbb, 0x92, 0x8e, 0x89, 0x3a, 0x2f, 0x41, 0x1a, 0x07,
+	0x39, 0xda, 0x6a, 0x20, 0x87, 0x96, 0xd1, 0xa9, 0xd0, 0x26, 0x85, 0x02, 0xf2, 0x58, 0x01, 0x8d,
+	0xb2, 0x0a, 0xc0, 0xff, 0x6f, 0xf1, 0xd4, 0x47, 0x73, 0xc4, 0x87, 0x0a, 0xa0, 0x73, 0x86, 0x1e,
+	0xff, 0x65, 0x36, 0xc5, 0x2e, 0x2d, 0x1e, 0xbb, 0x3d, 0xa7, 0x34, 0xd5, 0xbe, 0x43, 0x48, 0xaa,
+	0xb8, 0x52, 0x91, 0x6e, 0xa1, 0xbf, 0xdc, 0xf3, 0x06, 0xed, 0xfd, 0x2e, 0xb6, 0xfd, 0xc5, 0x4d,
+	0x7f, 0xf1, 0x97, 0xa6, 0xbf, 0xc3, 0xe5, 0x8b, 0xeb, 0xd0, 0x1b, 0x6d, 0x18, 0x1f, 0xad, 0xed,
+	0xbc, 0x45, 0xeb, 0xc0, 0xa9, 0x75, 0x5f, 0xb9, 0xa7, 0xfb, 0x1a, 0x70, 0x6a, 0x9c, 0x39, 0xfa,
+	0x5f, 0x57, 0x0b, 0x34, 0xd2, 0x33, 0x27, 0xfd, 0xd5, 0xc5, 0x97, 0xdc, 0xb6, 0x00, 0x23, 0xe8,
+	0xde, 0xd6, 0xfc, 0x06, 0x71, 0xed, 0x01, 0x7a, 0xdb, 0x20, 0x2c, 0x73, 0x07, 0xad, 0x88, 0x53,
+	0x0e, 0x95, 0xbf, 0xde, 0xf3, 0x06, 0x1b, 0x23, 0x2b, 0x68, 0x6d, 0x4c, 0x27, 0x8c, 0xfb, 0x1b,
+	0x56, 0x6b, 0x04, 0x3d, 0xf3, 0x25, 0x70, 0xca, 0x78, 0x1e, 0xa5, 0x45, 0x7c, 0x9a, 0xc4, 0xe9,
+	0xd8, 0x47, 0x0f, 0x30, 0xf3, 0x0e, 0x72, 0xe0, 0x18, 0x7a, 0x0a, 0xd2, 0x82, 0x65, 0x99, 0x6d,
+	0x63, 0xfb, 0xbe, 0x53, 0x60, 0x7c, 0xb4, 0xb6, 0xff, 0x0c, 0x75, 0xe7, 0xeb, 0xcc, 0x78, 0x7e,
+	0x04, 0x15, 0x13, 0x54, 0x36, 0xdb, 0x2e, 0xd0, 0xd3, 0x7f, 0x9e, 0xba, 0x4d, 0x3f, 0x42, 0x5b,
+	0x6e, 0xe6, 0xa3, 0xd2, 0x1e, 0xb9, 0x45, 0x7f, 0x81, 0xef, 0x78, 0xed, 0xb0, 0x0d, 0x35, 0xda,
+	0x2c, 0x6e, 0x44, 0x1e, 0x7e, 0xbc, 0x9c, 0x06, 0xde, 0xd5, 0x34, 0xf0, 0x7e, 0x4f, 0x03, 0xef,
+	0x62, 0x16, 0xb4, 0xae, 0x66, 0x41, 0xeb, 0xe7, 0x2c, 0x68, 0x7d, 0xdd, 0xb5, 0x11, 0x25, 0x1d,
+	0x63, 0x26, 0xc8, 0xd9, 0xed, 0xcf, 0xa4, 0xb9, 0xaf, 0x64, 0xd5, 0x14, 0xff, 0xe6, 0xcf, 0x00,
+	0xcc, 0xd0, 0xa1, 0x4a, 0xa4, 0x05, 0x00, 0x00,
 }
 
 func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -348,6 +379,37 @@ func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.CliffTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PendingClawback) > 0 {
+		for iNdEx := len(m.PendingClawback) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingClawback[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -384,22 +446,22 @@ func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		}
 	}
 	if m.EndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.StartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -576,6 +638,20 @@ func (m *QueryLockupAccountInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PendingClawback) > 0 {
+		for _, e := range m.PendingClawback {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -962,6 +1038,108 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClawback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingClawback = append(m.PendingClawback, types.Coin{})
+			if err := m.PendingClawback[len(m.PendingClawback)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -86,6 +86,21 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 				return nil, errors.New("unrecognized request type")
 			}
 		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			if _, ok := req.(*stakingtypes.QueryDelegatorDelegationsRequest); ok {
+				return &stakingtypes.QueryDelegatorDelegationsResponse{
+					DelegationResponses: stakingtypes.DelegationResponses{
+						{
+							Delegation: stakingtypes.Delegation{ValidatorAddress: "val_address"},
+							Balance:    sdk.NewCoin("test", math.NewInt(3)),
+						},
+						{
+							Delegation: stakingtypes.Delegation{ValidatorAddress: "val_address_2"},
+							Balance:    sdk.NewCoin("test", math.NewInt(3)),
+						},
+					},
+				}, nil
+			}
+
 			_, ok := req.(*banktypes.QueryBalanceRequest)
 			if !ok {
 				_, ok = req.(*stakingtypes.QueryParamsRequest)
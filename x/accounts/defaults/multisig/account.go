@@ -14,6 +14,7 @@ import (
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var MULTISIG_ACCOUNT = "multisig-account"
@@ -24,8 +25,15 @@ var (
 	ConfigPrefix    = collections.NewPrefix(2)
 	ProposalsPrefix = collections.NewPrefix(3)
 	VotesPrefix     = collections.NewPrefix(4)
+	ExpiryPrefix    = collections.NewPrefix(5)
+	BackfillPrefix  = collections.NewPrefix(6)
 )
 
+// maxPrunedProposals is the maximum number of expired proposals pruned, and of
+// proposals backfilled into the Expiry index, by a single CreateProposal or
+// ExecuteProposal call, the next calls handling the rest.
+const maxPrunedProposals = 100
+
 // Compile-time type assertions
 var (
 	_ accountstd.Interface = (*Account)(nil)
@@ -42,6 +50,12 @@ type Account struct {
 
 	Proposals collections.Map[uint64, v1.Proposal]
 	Votes     collections.Map[collections.Pair[uint64, []byte], int32] // key: proposalID + voter address
+	// Expiry indexes the proposals by the unix time their expiry counts from,
+	// see proposalExpiryStart.
+	Expiry collections.KeySet[collections.Pair[int64, uint64]] // key: expiry start + proposalID
+	// Backfill is the ID from which the proposals may be missing from the Expiry index, as they
+	// were stored before it existed, see backfillExpiry.
+	Backfill collections.Item[uint64]
 }
 
 // NewAccount returns a new multisig account creator function.
@@ -52,6 +66,8 @@ func NewAccount(deps accountstd.Dependencies) (*Account, error) {
 		Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
 		Proposals:     collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
 		Votes:         collections.NewMap(deps.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+		Expiry:        collections.NewKeySet(deps.SchemaBuilder, ExpiryPrefix, "expiry", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		Backfill:      collections.NewItem(deps.SchemaBuilder, BackfillPrefix, "backfill", collections.Uint64Value),
 		addrCodec:     deps.AddressCodec,
 		headerService: deps.Environment.HeaderService,
 		eventService:  deps.Environment.EventService,
@@ -188,12 +204,18 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		return nil, err
 	}
 
-	// create the proposal
+	if err = a.pruneExpiredProposals(ctx, config, a.headerService.HeaderInfo(ctx).Time.Unix()); err != nil {
+		return nil, err
+	}
+
+	// create the proposal, its execution delay can't be lower than the one from the config
 	proposal := v1.Proposal{
-		Title:    msg.Proposal.Title,
-		Summary:  msg.Proposal.Summary,
-		Messages: msg.Proposal.Messages,
-		Status:   v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+		Id:             seq,
+		Title:          msg.Proposal.Title,
+		Summary:        msg.Proposal.Summary,
+		Messages:       msg.Proposal.Messages,
+		Status:         v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+		ExecutionDelay: max(msg.Proposal.ExecutionDelay, config.ExecutionDelay),
 	}
 
 	// set the voting period, if not specified, use the default
@@ -203,7 +225,7 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		proposal.VotingPeriodEnd = a.headerService.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(config.VotingPeriod)).Unix()
 	}
 
-	if err = a.Proposals.Set(ctx, seq, proposal); err != nil {
+	if err = a.setProposal(ctx, proposal); err != nil {
 		return nil, err
 	}
 
@@ -222,8 +244,37 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 	return &v1.MsgCreateProposalResponse{ProposalId: seq}, nil
 }
 
+// setProposal stores a proposal and indexes it by the start of its expiry.
+func (a Account) setProposal(ctx context.Context, prop v1.Proposal) error {
+	if err := a.unindexProposal(ctx, prop.Id); err != nil {
+		return err
+	}
+
+	if err := a.Expiry.Set(ctx, collections.Join(proposalExpiryStart(prop), prop.Id)); err != nil {
+		return err
+	}
+
+	return a.Proposals.Set(ctx, prop.Id, prop)
+}
+
+// unindexProposal removes the expiry index entry of a proposal, if it is stored.
+func (a Account) unindexProposal(ctx context.Context, proposalID uint64) error {
+	prop, err := a.Proposals.Get(ctx, proposalID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return a.Expiry.Remove(ctx, collections.Join(proposalExpiryStart(prop), proposalID))
+}
+
 // deleteProposalAndVotes deletes a proposal and its votes, pruning the state.
 func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64) error {
+	if err := a.unindexProposal(ctx, proposalID); err != nil {
+		return err
+	}
+
 	// delete the proposal
 	if err := a.Proposals.Remove(ctx, proposalID); err != nil {
 		return err
@@ -234,8 +285,88 @@ func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64)
 	return a.Votes.Clear(ctx, rng)
 }
 
+// proposalExpiryStart returns the unix time the proposal expiry of the config counts from.
+func proposalExpiryStart(prop v1.Proposal) int64 {
+	return max(prop.VotingPeriodEnd, prop.ExecutableAfter)
+}
+
+// proposalExpired returns true if the proposal expired at the given unix time, as per the proposal expiry of the config.
+func proposalExpired(cfg v1.Config, prop v1.Proposal, now int64) bool {
+	if cfg.ProposalExpiry == 0 {
+		return false
+	}
+
+	return now > proposalExpiryStart(prop)+cfg.ProposalExpiry
+}
+
+// backfillExpiry adds up to maxPrunedProposals proposals from the Backfill ID to the Expiry index, so that
+// the proposals stored before the index existed are eventually pruned too. The proposals stored since
+// are already indexed, and are only visited once by the backfill as it moves past them.
+func (a Account) backfillExpiry(ctx context.Context) error {
+	start, err := a.Backfill.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	next, indexed := start, 0
+	rng := new(collections.Range[uint64]).StartInclusive(start)
+	err = a.Proposals.Walk(ctx, rng, func(proposalID uint64, prop v1.Proposal) (stop bool, err error) {
+		if indexed == maxPrunedProposals {
+			return true, nil
+		}
+		next, indexed = proposalID+1, indexed+1
+		return false, a.Expiry.Set(ctx, collections.Join(proposalExpiryStart(prop), proposalID))
+	})
+	if err != nil || next == start {
+		return err
+	}
+
+	return a.Backfill.Set(ctx, next)
+}
+
+// pruneExpiredProposals deletes the proposals which expired at the given unix time, along with their votes.
+// The proposals are walked in expiry order, so only the expired ones are visited, and at most
+// maxPrunedProposals are deleted per call.
+func (a Account) pruneExpiredProposals(ctx context.Context, cfg v1.Config, now int64) error {
+	if err := a.backfillExpiry(ctx); err != nil {
+		return err
+	}
+
+	if cfg.ProposalExpiry == 0 {
+		return nil
+	}
+
+	var expired []uint64
+	err := a.Expiry.Walk(ctx, nil, func(key collections.Pair[int64, uint64]) (stop bool, err error) {
+		if now <= key.K1()+cfg.ProposalExpiry || len(expired) == maxPrunedProposals {
+			return true, nil
+		}
+		expired = append(expired, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, proposalID := range expired {
+		if err := a.deleteProposalAndVotes(ctx, proposalID); err != nil {
+			return err
+		}
+
+		if err := a.eventService.EventManager(ctx).EmitKV("proposal_expired",
+			event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ExecuteProposal tallies the votes for a proposal and executes it if it passes. If early execution is enabled, it will
 // ignore the voting period and tally the votes without deleting them if the proposal has not passed.
+// A passing proposal with an execution delay is queued instead, and executed by calling ExecuteProposal again
+// once its delay has ended.
 func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
 	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
@@ -247,12 +378,25 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 		return nil, err
 	}
 
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	if proposalExpired(config, prop, now) {
+		return nil, errors.New("proposal has expired")
+	}
+
+	if prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return a.executeQueuedProposal(ctx, msg.ProposalId, prop, config, now)
+	}
+
 	// check if voting period is still active and early execution is disabled
-	votingPeriodEnded := a.headerService.HeaderInfo(ctx).Time.Unix() > prop.VotingPeriodEnd
+	votingPeriodEnded := now > prop.VotingPeriodEnd
 	if !votingPeriodEnded && !config.EarlyExecution {
 		return nil, errors.New("voting period has not ended yet, and early execution is not enabled")
 	}
 
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return nil, errors.New("proposal has already been finalized")
+	}
+
 	// perform tally
 	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.ProposalId)
 	yesVotes := uint64(0)
@@ -296,14 +440,19 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	} else if yesVotes < uint64(config.Threshold) {
 		rejectErr = errors.New("threshold not reached")
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+	} else if prop.ExecutionDelay > 0 {
+		// we have quorum and threshold, queue the proposal until its execution delay ends
+		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_QUEUED
+		prop.ExecutableAfter = now + prop.ExecutionDelay
 	} else {
 		// we have quorum and threshold, execute the proposal
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+		prop.ExecutableAfter = now
 		resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
 	}
 
 	// if early execution is enabled, we return early if the proposal has NOT passed
-	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED {
+	if config.EarlyExecution && prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_REJECTED {
 		return nil, errors.New("early execution attempted and proposal has not passed")
 	}
 
@@ -317,20 +466,52 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 		event.NewAttribute("no_votes", fmt.Sprint(noVotes)),
 		event.NewAttribute("abstain_votes", fmt.Sprint(abstainVotes)),
 		event.NewAttribute("status", prop.Status.String()),
+		event.NewAttribute("executable_after", fmt.Sprint(prop.ExecutableAfter)),
 		event.NewAttribute("reject_err", fmt.Sprint(rejectErr)),
 		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
 	); err != nil {
 		return nil, err
 	}
 
-	err = a.Proposals.Set(ctx, msg.ProposalId, prop)
+	err = a.setProposal(ctx, prop)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = a.pruneExpiredProposals(ctx, config, now); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
 
+// executeQueuedProposal executes a proposal which passed, once its execution delay has ended.
+func (a Account) executeQueuedProposal(ctx context.Context, proposalID uint64, prop v1.Proposal, config v1.Config, now int64) (*v1.MsgExecuteProposalResponse, error) {
+	if now < prop.ExecutableAfter {
+		return nil, errors.New("execution delay has not ended yet")
+	}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	responses, execErr := accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_executed",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
+	); err != nil {
+		return nil, err
+	}
+
+	if err := a.setProposal(ctx, prop); err != nil {
+		return nil, err
+	}
+
+	if err := a.pruneExpiredProposals(ctx, config, now); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteProposalResponse{Responses: responses}, nil
+}
+
 // QuerySequence returns the current sequence number, used for proposal IDs.
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
 	seq, err := a.Sequence.Peek(ctx)
@@ -349,6 +530,17 @@ func (a Account) QueryProposal(ctx context.Context, q *v1.QueryProposal) (*v1.Qu
 	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
+// QueryProposals returns the proposals which haven't been pruned yet, ordered by ID.
+func (a Account) QueryProposals(ctx context.Context, q *v1.QueryProposals) (*v1.QueryProposalsResponse, error) {
+	proposals, pageRes, err := query.CollectionPaginate(ctx, a.Proposals, q.Pagination, func(_ uint64, prop v1.Proposal) (*v1.Proposal, error) {
+		return &prop, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
 // QueryConfig returns the current multisig configuration.
 func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
 	cfg, err := a.Config.Get(ctx)
@@ -389,6 +581,7 @@ func (a *Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposals)
 	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
 }
 
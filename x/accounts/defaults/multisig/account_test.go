@@ -2,6 +2,7 @@ package multisig
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -9,11 +10,14 @@ import (
 	types "github.com/cosmos/gogoproto/types/any"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func setup(t *testing.T, _ context.Context, ss store.KVStoreService, timefn func() time.Time) *Account {
@@ -710,3 +714,329 @@ func TestWeightOverflow(t *testing.T) {
 	_, err = acc.Init(ctx, startAcc)
 	require.ErrorContains(t, err, "overflow")
 }
+
+func TestProposalExecutionDelay(t *testing.T) {
+	executed := 0
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds, func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			executed++
+			return &v1.MsgUpdateConfigResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      2000,
+			Quorum:         2000,
+			VotingPeriod:   60,
+			ExecutionDelay: 120,
+		},
+		Members: []*v1.Member{
+			{
+				Address: "addr1",
+				Weight:  1000,
+			},
+			{
+				Address: "addr2",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	anymsg, err := accountstd.PackAny(&v1.MsgUpdateConfig{})
+	require.NoError(t, err)
+
+	// the execution delay of the proposal can't be lower than the one from the config
+	createRes, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{
+			Title:          "test",
+			Summary:        "test",
+			Messages:       []*types.Any{anymsg},
+			ExecutionDelay: 30,
+		},
+	})
+	require.NoError(t, err)
+	propId := createRes.ProposalId
+
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, propId, prop.Proposal.Id)
+	require.Equal(t, int64(120), prop.Proposal.ExecutionDelay)
+
+	_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: propId, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+	_, err = acc.Vote(accountstd.SetSender(ctx, []byte("addr2")), &v1.MsgVote{ProposalId: propId, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	// the passing proposal is queued
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, 0, executed)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_QUEUED, prop.Proposal.Status)
+	require.Equal(t, currentTime.Unix()+120, prop.Proposal.ExecutableAfter)
+
+	_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: propId, Vote: v1.VoteOption_VOTE_OPTION_NO})
+	require.ErrorContains(t, err, "voting period has ended")
+
+	currentTime = currentTime.Add(119 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "execution delay has not ended yet")
+
+	currentTime = currentTime.Add(time.Second)
+	res, err := acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Len(t, res.Responses, 1)
+	require.Equal(t, 1, executed)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, prop.Proposal.Status)
+
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal has already been finalized")
+	require.Equal(t, 1, executed)
+}
+
+func TestProposalExpiry(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	ctx = accountstd.SetSender(ctx, []byte("addr1"))
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      1000,
+			Quorum:         1000,
+			VotingPeriod:   60,
+			ExecutionDelay: 60,
+			ProposalExpiry: 300,
+		},
+		Members: []*v1.Member{
+			{
+				Address: "addr1",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	createProposal := func() uint64 {
+		t.Helper()
+		res, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{Title: "test", Summary: "test"},
+		})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+
+	// a proposal which is never executed
+	stale := createProposal()
+	_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: stale, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	// a proposal queued at the end of its voting period
+	queued := createProposal()
+	_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: queued, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: queued})
+	require.NoError(t, err)
+
+	// the expiry of the queued proposal now counts from the end of its execution delay
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: queued})
+	require.NoError(t, err)
+	has, err := acc.Expiry.Has(ctx, collections.Join(prop.Proposal.VotingPeriodEnd, queued))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = acc.Expiry.Has(ctx, collections.Join(prop.Proposal.ExecutableAfter, queued))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the stale proposal expired after the expiry following its voting period
+	currentTime = currentTime.Add(300 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: stale})
+	require.ErrorContains(t, err, "proposal has expired")
+
+	// creating a proposal prunes the stale proposal and its votes, while the queued proposal expires later
+	createProposal()
+	_, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: stale})
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	has, err = acc.Votes.Has(ctx, collections.Join(stale, []byte("addr1")))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: queued})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_QUEUED, prop.Proposal.Status)
+
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: queued})
+	require.ErrorContains(t, err, "proposal has expired")
+}
+
+func TestPruneExpiredProposalsBounded(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	ctx = accountstd.SetSender(ctx, []byte("addr1"))
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      1000,
+			Quorum:         1000,
+			VotingPeriod:   60,
+			ProposalExpiry: 300,
+		},
+		Members: []*v1.Member{
+			{
+				Address: "addr1",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < maxPrunedProposals+1; i++ {
+		_, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{Title: "test"},
+		})
+		require.NoError(t, err)
+	}
+
+	countProposals := func() int {
+		t.Helper()
+		res, err := acc.QueryProposals(ctx, &v1.QueryProposals{Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		return int(res.Pagination.Total)
+	}
+
+	// a call prunes at most maxPrunedProposals, the next one prunes the rest
+	currentTime = currentTime.Add(361 * time.Second)
+	_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{Proposal: &v1.Proposal{Title: "test"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, countProposals())
+
+	_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{Proposal: &v1.Proposal{Title: "test"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, countProposals())
+}
+
+func TestBackfillExpiry(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	ctx = accountstd.SetSender(ctx, []byte("addr1"))
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      1000,
+			Quorum:         1000,
+			VotingPeriod:   60,
+			ProposalExpiry: 300,
+		},
+		Members: []*v1.Member{
+			{
+				Address: "addr1",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// proposals stored before the expiry index existed are not indexed
+	for i := 0; i < maxPrunedProposals+1; i++ {
+		id, err := acc.Sequence.Next(ctx)
+		require.NoError(t, err)
+		require.NoError(t, acc.Proposals.Set(ctx, id, v1.Proposal{
+			Id:              id,
+			Title:           "legacy",
+			Status:          v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+			VotingPeriodEnd: currentTime.Add(60 * time.Second).Unix(),
+		}))
+		require.NoError(t, acc.Votes.Set(ctx, collections.Join(id, []byte("addr1")), int32(v1.VoteOption_VOTE_OPTION_YES)))
+	}
+
+	countProposals := func() int {
+		t.Helper()
+		res, err := acc.QueryProposals(ctx, &v1.QueryProposals{Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		return int(res.Pagination.Total)
+	}
+
+	// they are backfilled into the index and pruned once expired, maxPrunedProposals per call
+	currentTime = currentTime.Add(361 * time.Second)
+	_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{Proposal: &v1.Proposal{Title: "test"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, countProposals())
+
+	_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{Proposal: &v1.Proposal{Title: "test"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, countProposals())
+
+	has, err := acc.Votes.Has(ctx, collections.Join(uint64(maxPrunedProposals), []byte("addr1")))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the backfill moved past the proposals, which are indexed when stored
+	backfill, err := acc.Backfill.Get(ctx)
+	require.NoError(t, err)
+	seq, err := acc.Sequence.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, seq-1, backfill)
+}
+
+func TestQueryProposals(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	ctx = accountstd.SetSender(ctx, []byte("addr1"))
+	acc := setup(t, ctx, ss, time.Now)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:    1000,
+			Quorum:       1000,
+			VotingPeriod: 60,
+		},
+		Members: []*v1.Member{
+			{
+				Address: "addr1",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{Title: fmt.Sprint("proposal ", i)},
+		})
+		require.NoError(t, err)
+	}
+
+	res, err := acc.QueryProposals(ctx, &v1.QueryProposals{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 3)
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.Equal(t, "proposal 0", res.Proposals[0].Title)
+
+	res, err = acc.QueryProposals(ctx, &v1.QueryProposals{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 2)
+	require.Equal(t, uint64(3), res.Proposals[0].Id)
+	require.Equal(t, "proposal 4", res.Proposals[1].Title)
+}
@@ -74,6 +74,10 @@ func validateConfig(cfg v1.Config, totalWeight uint64) error {
 		return errors.New("threshold, quorum and voting period must be greater than zero")
 	}
 
+	if cfg.ExecutionDelay < 0 || cfg.ProposalExpiry < 0 {
+		return errors.New("execution delay and proposal expiry must not be negative")
+	}
+
 	// threshold must be less than or equal to the total weight
 	if totalWeight < uint64(cfg.Threshold) {
 		return errors.New("threshold must be less than or equal to the total weight")
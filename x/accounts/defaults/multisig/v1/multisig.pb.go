@@ -6,6 +6,7 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -36,6 +37,9 @@ const (
 	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 2
 	// PROPOSAL_STATUS_REJECTED defines the proposal status when the proposal was rejected.
 	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 3
+	// PROPOSAL_STATUS_QUEUED defines the proposal status when the proposal passed and waits for its execution delay
+	// to end before being executed.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 4
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -43,6 +47,7 @@ var ProposalStatus_name = map[int32]string{
 	1: "PROPOSAL_STATUS_VOTING_PERIOD",
 	2: "PROPOSAL_STATUS_PASSED",
 	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_QUEUED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -50,6 +55,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_VOTING_PERIOD": 1,
 	"PROPOSAL_STATUS_PASSED":        2,
 	"PROPOSAL_STATUS_REJECTED":      3,
+	"PROPOSAL_STATUS_QUEUED":        4,
 }
 
 func (x ProposalStatus) String() string {
@@ -611,6 +617,12 @@ type Config struct {
 	Revote bool `protobuf:"varint,4,opt,name=revote,proto3" json:"revote,omitempty"`
 	// early_execution defines if the multisig can be executed before the voting period ends.
 	EarlyExecution bool `protobuf:"varint,5,opt,name=early_execution,json=earlyExecution,proto3" json:"early_execution,omitempty"`
+	// execution_delay is the minimum duration in seconds between a proposal passing and its execution.
+	ExecutionDelay int64 `protobuf:"varint,6,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// proposal_expiry is the duration in seconds after which a proposal is pruned along with its votes, counted from
+	// the end of its voting period, or from the end of its execution delay once it passed. Expired proposals can no
+	// longer be executed. Zero disables the expiry.
+	ProposalExpiry int64 `protobuf:"varint,7,opt,name=proposal_expiry,json=proposalExpiry,proto3" json:"proposal_expiry,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -681,6 +693,20 @@ func (m *Config) GetEarlyExecution() bool {
 	return false
 }
 
+func (m *Config) GetExecutionDelay() int64 {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *Config) GetProposalExpiry() int64 {
+	if m != nil {
+		return m.ProposalExpiry
+	}
+	return 0
+}
+
 // Proposal defines the structure of a proposal.
 type Proposal struct {
 	Title    string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -689,6 +715,14 @@ type Proposal struct {
 	// voting_period_end will be set by the account when the proposal is created.
 	VotingPeriodEnd int64          `protobuf:"varint,4,opt,name=voting_period_end,json=votingPeriodEnd,proto3" json:"voting_period_end,omitempty"`
 	Status          ProposalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// execution_delay is the duration in seconds between the proposal passing and its execution.
+	// The execution delay of the config is used if not set or lower.
+	ExecutionDelay int64 `protobuf:"varint,6,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// executable_after will be set by the account when the proposal passes, to the unix time from which it can be
+	// executed.
+	ExecutableAfter int64 `protobuf:"varint,7,opt,name=executable_after,json=executableAfter,proto3" json:"executable_after,omitempty"`
+	// id will be set by the account when the proposal is created.
+	Id uint64 `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -759,6 +793,27 @@ func (m *Proposal) GetStatus() ProposalStatus {
 	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
+func (m *Proposal) GetExecutionDelay() int64 {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *Proposal) GetExecutableAfter() int64 {
+	if m != nil {
+		return m.ExecutableAfter
+	}
+	return 0
+}
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 // QuerySequence is the request for the account sequence.
 type QuerySequence struct {
 }
@@ -1022,6 +1077,104 @@ func (m *QueryProposalResponse) GetProposal() *Proposal {
 	return nil
 }
 
+// QueryProposals is the request for the proposals of the account.
+type QueryProposals struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposals) Reset()         { *m = QueryProposals{} }
+func (m *QueryProposals) String() string { return proto.CompactTextString(m) }
+func (*QueryProposals) ProtoMessage()    {}
+func (*QueryProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{19}
+}
+func (m *QueryProposals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposals.Merge(m, src)
+}
+func (m *QueryProposals) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposals proto.InternalMessageInfo
+
+func (m *QueryProposals) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsResponse returns the proposals of the account, ordered by ID.
+type QueryProposalsResponse struct {
+	Proposals  []*Proposal         `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{20}
+}
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+}
+func (m *QueryProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1044,6 +1197,8 @@ func init() {
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse")
 	proto.RegisterType((*QueryProposal)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposal")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse")
+	proto.RegisterType((*QueryProposals)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposals")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalsResponse")
 }
 
 func init() {
@@ -1051,61 +1206,72 @@ func init() {
 }
 
 var fileDescriptor_e6da8796717704d7 = []byte{
-	// 859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x6e, 0x9a, 0xbe, 0xd2, 0x24, 0x9d, 0x96, 0xad, 0x9b, 0x5d, 0xb2, 0xc5, 0x20,
-	0xb1, 0xaa, 0x16, 0xa7, 0x9b, 0xc2, 0x8d, 0x4b, 0xda, 0xb8, 0x28, 0xab, 0x4d, 0x6c, 0xc6, 0x69,
-	0x25, 0xb8, 0x58, 0x6e, 0x3c, 0x75, 0xad, 0x8d, 0x3d, 0x5e, 0xcf, 0xb8, 0x6c, 0xfe, 0x04, 0xe2,
-	0xc6, 0x0f, 0x80, 0x23, 0x47, 0x7e, 0x04, 0xc7, 0x15, 0x27, 0x8e, 0xa8, 0x95, 0xf8, 0x1d, 0xa8,
-	0xe3, 0xb1, 0x9b, 0xa6, 0xd2, 0x6e, 0x10, 0x3d, 0x70, 0xf3, 0x7b, 0xf3, 0xbe, 0xef, 0x7d, 0xf3,
-	0xcd, 0x9b, 0x91, 0x61, 0x7f, 0x44, 0x59, 0x48, 0x59, 0xcb, 0x1d, 0x8d, 0x68, 0x1a, 0x71, 0xd6,
-	0xf2, 0xc8, 0x99, 0x9b, 0x8e, 0x39, 0x6b, 0x85, 0xe9, 0x98, 0x07, 0x2c, 0xf0, 0x5b, 0x17, 0xcf,
-	0x8b, 0x6f, 0x3d, 0x4e, 0x28, 0xa7, 0xe8, 0xd3, 0x0c, 0xa4, 0xe7, 0x20, 0x3d, 0x07, 0xe9, 0x45,
-	0xe1, 0xc5, 0xf3, 0xc6, 0xb6, 0x4f, 0xa9, 0x3f, 0x26, 0x2d, 0x81, 0x39, 0x4d, 0xcf, 0x5a, 0x6e,
-	0x34, 0xc9, 0x08, 0x1a, 0xdb, 0x19, 0x81, 0x23, 0xa2, 0x96, 0x64, 0x13, 0x81, 0xf6, 0x93, 0x02,
-	0xcb, 0x7d, 0xe6, 0xf7, 0xa2, 0x80, 0xa3, 0x23, 0x58, 0x0e, 0x49, 0x78, 0x4a, 0x12, 0xa6, 0x2a,
-	0x3b, 0xa5, 0xa7, 0xab, 0xed, 0x67, 0xfa, 0x3c, 0x9d, 0xf5, 0xbe, 0x00, 0xe1, 0x1c, 0x8c, 0xba,
-	0x50, 0x1e, 0xd1, 0xe8, 0x2c, 0xf0, 0xd5, 0xc5, 0x1d, 0x65, 0x7e, 0x9a, 0x43, 0x81, 0xc1, 0x12,
-	0xab, 0xad, 0x43, 0x4d, 0x0a, 0xc3, 0x84, 0xc5, 0x34, 0x62, 0x44, 0x73, 0x60, 0xbd, 0xcf, 0xfc,
-	0xc3, 0x84, 0xb8, 0x9c, 0x58, 0x09, 0x8d, 0x29, 0x73, 0xc7, 0xe8, 0x05, 0x54, 0x62, 0xf9, 0xad,
-	0x2a, 0xa2, 0x9f, 0x3e, 0x5f, 0xbf, 0x9c, 0x01, 0x17, 0x78, 0xed, 0x2b, 0xd8, 0xbe, 0xd3, 0x20,
-	0xef, 0x8e, 0x9e, 0xc0, 0x6a, 0x5e, 0xe8, 0x04, 0x9e, 0xe8, 0xb5, 0x84, 0x21, 0x4f, 0xf5, 0x3c,
-	0x2d, 0x16, 0x56, 0x9e, 0x50, 0xfe, 0xfe, 0x5a, 0xd4, 0x85, 0xa5, 0x0b, 0xca, 0x89, 0x70, 0xa8,
-	0xda, 0xde, 0x9b, 0x4f, 0xf1, 0x35, 0xb5, 0x19, 0xf3, 0x80, 0x46, 0x58, 0xa0, 0xa5, 0x47, 0xd7,
-	0xe9, 0xc2, 0xa3, 0x2f, 0x01, 0xf5, 0x99, 0x6f, 0xbc, 0x21, 0xa3, 0x74, 0xca, 0xa4, 0xf7, 0x6a,
-	0xb7, 0xa0, 0x71, 0x17, 0x56, 0x6c, 0xbd, 0x0d, 0x2b, 0x89, 0xfc, 0xce, 0x67, 0x63, 0x53, 0xcf,
-	0xe6, 0x4d, 0xcf, 0xe7, 0x4d, 0xef, 0x44, 0x13, 0x7c, 0x53, 0xa6, 0xfd, 0xaa, 0x08, 0x71, 0xc7,
-	0xb1, 0xe7, 0x72, 0x92, 0x9d, 0x2d, 0xb2, 0xa1, 0x9a, 0x8a, 0xd8, 0xf9, 0x2f, 0x83, 0xb6, 0x96,
-	0x71, 0xf4, 0xef, 0x75, 0xdc, 0xb6, 0x61, 0x6b, 0x46, 0x6d, 0x61, 0xe9, 0x10, 0xca, 0x59, 0x2f,
-	0xd4, 0x86, 0x65, 0xd7, 0xf3, 0x12, 0xc2, 0x98, 0xb0, 0x70, 0xe5, 0x40, 0xfd, 0xe3, 0xb7, 0xcf,
-	0x37, 0x65, 0xbb, 0x4e, 0xb6, 0x62, 0xf3, 0x24, 0x88, 0x7c, 0x9c, 0x17, 0xa2, 0x87, 0x50, 0xfe,
-	0x9e, 0x04, 0xfe, 0x39, 0x17, 0xf2, 0x96, 0xb0, 0x8c, 0xb4, 0x5f, 0x14, 0x28, 0x4b, 0x5b, 0x1e,
-	0xc3, 0x0a, 0x3f, 0x4f, 0x08, 0x3b, 0xa7, 0xe3, 0xec, 0x6c, 0x4a, 0xf8, 0x26, 0x71, 0x4d, 0xf0,
-	0x3a, 0xa5, 0x49, 0x1a, 0x0a, 0x82, 0x12, 0x96, 0x11, 0xfa, 0x04, 0xd6, 0x2e, 0x28, 0x0f, 0x22,
-	0xdf, 0x89, 0x49, 0x12, 0x50, 0x4f, 0x2d, 0x89, 0xe5, 0x0f, 0xb2, 0xa4, 0x25, 0x72, 0xd7, 0xe0,
-	0x84, 0x88, 0x49, 0x5b, 0xda, 0x51, 0x9e, 0x56, 0xb0, 0x8c, 0xd0, 0x67, 0x50, 0x23, 0x6e, 0x32,
-	0x9e, 0x38, 0x44, 0x1c, 0x79, 0x40, 0x23, 0xf5, 0x81, 0x28, 0xa8, 0x8a, 0xb4, 0x91, 0x67, 0xb5,
-	0xbf, 0x15, 0xa8, 0x14, 0x63, 0xb4, 0x09, 0x0f, 0x78, 0xc0, 0xc7, 0x24, 0xdb, 0x3d, 0xce, 0x02,
-	0xa4, 0xc2, 0x32, 0x4b, 0xc3, 0xd0, 0x4d, 0x26, 0x42, 0xe1, 0x0a, 0xce, 0x43, 0xb4, 0x07, 0x95,
-	0x90, 0x30, 0xe6, 0xfa, 0x84, 0xa9, 0xa5, 0x77, 0x8c, 0x4d, 0x51, 0x85, 0x76, 0x61, 0xfd, 0xd6,
-	0xa6, 0x1c, 0x12, 0x79, 0x42, 0x7a, 0x09, 0xd7, 0xa6, 0x37, 0x66, 0x44, 0x1e, 0x7a, 0x09, 0x65,
-	0xc6, 0x5d, 0x9e, 0x32, 0x21, 0xbd, 0xda, 0xfe, 0xe2, 0xdf, 0xdd, 0x7b, 0x5b, 0x60, 0xb1, 0xe4,
-	0xd0, 0x6a, 0xb0, 0xf6, 0x4d, 0x4a, 0x92, 0x89, 0x4d, 0x5e, 0xa7, 0x24, 0x1a, 0x11, 0x6d, 0x1f,
-	0x3e, 0xbc, 0x95, 0x28, 0x6e, 0x43, 0x03, 0x2a, 0x4c, 0xe6, 0xe4, 0x4d, 0x2a, 0x62, 0x6d, 0x0d,
-	0x56, 0x05, 0x28, 0x3b, 0x59, 0xed, 0x67, 0x05, 0x36, 0xa6, 0xe2, 0x82, 0xe2, 0xff, 0xf5, 0xd4,
-	0xee, 0xc9, 0xad, 0xcf, 0xff, 0x5c, 0x8c, 0xa4, 0x37, 0x77, 0x5e, 0x8a, 0x7b, 0x7c, 0x8d, 0x77,
-	0x7f, 0x50, 0xa0, 0x7a, 0xfb, 0xb0, 0xd0, 0x13, 0x78, 0x64, 0x61, 0xd3, 0x32, 0xed, 0xce, 0x4b,
-	0xc7, 0x1e, 0x76, 0x86, 0xc7, 0xb6, 0x73, 0x3c, 0xb0, 0x2d, 0xe3, 0xb0, 0x77, 0xd4, 0x33, 0xba,
-	0xf5, 0x05, 0xf4, 0x31, 0x7c, 0x34, 0x5b, 0x70, 0x62, 0x0e, 0x7b, 0x83, 0xaf, 0x1d, 0xcb, 0xc0,
-	0x3d, 0xb3, 0x5b, 0x57, 0x50, 0x03, 0x1e, 0xce, 0x96, 0x58, 0x1d, 0xdb, 0x36, 0xba, 0xf5, 0x45,
-	0xf4, 0x18, 0xd4, 0xd9, 0x35, 0x6c, 0xbc, 0x30, 0x0e, 0x87, 0x46, 0xb7, 0x5e, 0xda, 0x7d, 0x05,
-	0x70, 0xf3, 0x04, 0xa3, 0x47, 0xb0, 0x75, 0x62, 0x0e, 0x0d, 0xc7, 0xb4, 0x86, 0x3d, 0x73, 0x30,
-	0xa3, 0x63, 0x03, 0x6a, 0xd3, 0x8b, 0xdf, 0x1a, 0x76, 0x5d, 0x41, 0x5b, 0xb0, 0x31, 0x9d, 0xec,
-	0x1c, 0xd8, 0xc3, 0x4e, 0x6f, 0x50, 0x5f, 0x44, 0x08, 0xaa, 0xd3, 0x0b, 0x03, 0xb3, 0x5e, 0x3a,
-	0x38, 0xfa, 0xfd, 0xb2, 0xa9, 0xbc, 0xbd, 0x6c, 0x2a, 0x7f, 0x5d, 0x36, 0x95, 0x1f, 0xaf, 0x9a,
-	0x0b, 0x6f, 0xaf, 0x9a, 0x0b, 0x7f, 0x5e, 0x35, 0x17, 0xbe, 0x7b, 0x96, 0x19, 0xca, 0xbc, 0x57,
-	0x7a, 0x40, 0x5b, 0x6f, 0xde, 0xfd, 0x33, 0x71, 0x5a, 0x16, 0x37, 0x6d, 0xff, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x6b, 0x50, 0x07, 0xb0, 0x7b, 0x08, 0x00, 0x00,
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0xec, 0xd4, 0x71, 0x5e, 0x89, 0xed, 0x6c, 0xd2, 0x44, 0x49, 0x8b, 0x1b, 0x04, 0x43,
+	0x4b, 0xa6, 0x48, 0xf9, 0x03, 0x37, 0x2e, 0x4e, 0xac, 0x74, 0xdc, 0x49, 0x62, 0x77, 0xe5, 0x64,
+	0x80, 0x8b, 0x46, 0xb6, 0x36, 0x8a, 0xa6, 0xb6, 0xd6, 0xd1, 0xae, 0x42, 0xfc, 0x2d, 0xb8, 0xf1,
+	0x01, 0xb8, 0x31, 0xcc, 0x70, 0xe1, 0x43, 0x30, 0x9c, 0x3a, 0x9c, 0x38, 0x32, 0xc9, 0x77, 0xe0,
+	0xcc, 0x68, 0x57, 0x92, 0xed, 0x94, 0x69, 0xdc, 0xa1, 0x07, 0x6e, 0x7e, 0xbf, 0x7d, 0xbf, 0xdf,
+	0x7b, 0xfb, 0xdb, 0xa7, 0x5d, 0xc3, 0x6e, 0x97, 0xb2, 0x3e, 0x65, 0x86, 0xd3, 0xed, 0xd2, 0x28,
+	0xe0, 0xcc, 0x70, 0xc9, 0x99, 0x13, 0xf5, 0x38, 0x33, 0xfa, 0x51, 0x8f, 0xfb, 0xcc, 0xf7, 0x8c,
+	0xcb, 0xed, 0xec, 0xb7, 0x3e, 0x08, 0x29, 0xa7, 0xe8, 0x13, 0x49, 0xd2, 0x53, 0x92, 0x9e, 0x92,
+	0xf4, 0x2c, 0xf1, 0x72, 0x7b, 0x7d, 0xcd, 0xa3, 0xd4, 0xeb, 0x11, 0x43, 0x70, 0x3a, 0xd1, 0x99,
+	0xe1, 0x04, 0x43, 0x29, 0xb0, 0xbe, 0x26, 0x05, 0x6c, 0x11, 0x19, 0x89, 0x9a, 0x5c, 0xda, 0x4c,
+	0x1a, 0xea, 0x38, 0x8c, 0x18, 0x17, 0x11, 0x09, 0x87, 0xc6, 0xe5, 0x76, 0x87, 0x70, 0x67, 0xdb,
+	0x18, 0x38, 0x9e, 0x1f, 0x38, 0xdc, 0xa7, 0x81, 0xcc, 0xd5, 0x7e, 0x50, 0x60, 0xee, 0x88, 0x79,
+	0x8d, 0xc0, 0xe7, 0xe8, 0x00, 0xe6, 0xfa, 0xa4, 0xdf, 0x21, 0x21, 0x53, 0x95, 0x8d, 0xfc, 0xd3,
+	0xfb, 0x3b, 0xcf, 0xf4, 0x69, 0xba, 0xd4, 0x8f, 0x04, 0x09, 0xa7, 0x64, 0x54, 0x87, 0x42, 0x97,
+	0x06, 0x67, 0xbe, 0xa7, 0xe6, 0x36, 0x94, 0xe9, 0x65, 0xf6, 0x05, 0x07, 0x27, 0x5c, 0x6d, 0x11,
+	0xca, 0x49, 0x63, 0x98, 0xb0, 0x01, 0x0d, 0x18, 0xd1, 0x6c, 0x58, 0x3c, 0x62, 0xde, 0x7e, 0x48,
+	0x1c, 0x4e, 0x5a, 0x21, 0x1d, 0x50, 0xe6, 0xf4, 0xd0, 0x0b, 0x28, 0x0e, 0x92, 0xdf, 0xaa, 0x22,
+	0xea, 0xe9, 0xd3, 0xd5, 0x4b, 0x15, 0x70, 0xc6, 0xd7, 0xbe, 0x82, 0xb5, 0x37, 0x0a, 0xa4, 0xd5,
+	0xd1, 0x63, 0xb8, 0x9f, 0x26, 0xda, 0xbe, 0x2b, 0x6a, 0xcd, 0x62, 0x48, 0xa1, 0x86, 0xab, 0x0d,
+	0x84, 0x95, 0xa7, 0x94, 0xdf, 0x9d, 0x8b, 0xea, 0x30, 0x7b, 0x49, 0x39, 0x11, 0x0e, 0x95, 0x76,
+	0xb6, 0xa6, 0xeb, 0x38, 0x96, 0x6e, 0x0e, 0xe2, 0xd3, 0xc3, 0x82, 0x9d, 0x78, 0x14, 0xc3, 0x99,
+	0x47, 0x5f, 0x02, 0x3a, 0x62, 0x9e, 0x79, 0x45, 0xba, 0xd1, 0x98, 0x49, 0x77, 0xf6, 0xde, 0x82,
+	0xf5, 0x37, 0x69, 0xd9, 0xd6, 0x77, 0x60, 0x3e, 0x4c, 0x7e, 0xa7, 0xb3, 0xb1, 0xac, 0xcb, 0xd9,
+	0xd4, 0xd3, 0xd9, 0xd4, 0x6b, 0xc1, 0x10, 0x8f, 0xd2, 0xb4, 0x9f, 0x15, 0xd1, 0xdc, 0xc9, 0xc0,
+	0x75, 0x38, 0x91, 0x67, 0x8b, 0x2c, 0x28, 0x45, 0x22, 0xb6, 0xff, 0xcb, 0xa0, 0x2d, 0x48, 0x8d,
+	0xa3, 0xf7, 0x3a, 0x6e, 0x6b, 0xb0, 0x7a, 0xab, 0xdb, 0xcc, 0xd2, 0x36, 0x14, 0x64, 0x2d, 0xb4,
+	0x03, 0x73, 0x8e, 0xeb, 0x86, 0x84, 0x31, 0x61, 0xe1, 0xfc, 0x9e, 0xfa, 0xc7, 0xaf, 0x9f, 0x2f,
+	0x27, 0xe5, 0x6a, 0x72, 0xc5, 0xe2, 0xa1, 0x1f, 0x78, 0x38, 0x4d, 0x44, 0x2b, 0x50, 0xf8, 0x8e,
+	0xf8, 0xde, 0x39, 0x17, 0xed, 0xcd, 0xe2, 0x24, 0xd2, 0xfe, 0x56, 0xa0, 0x90, 0xd8, 0xf2, 0x08,
+	0xe6, 0xf9, 0x79, 0x48, 0xd8, 0x39, 0xed, 0xc9, 0xb3, 0xc9, 0xe3, 0x11, 0x10, 0x0b, 0x5c, 0x44,
+	0x34, 0x8c, 0xfa, 0x42, 0x20, 0x8f, 0x93, 0x08, 0x7d, 0x0c, 0x0b, 0x97, 0x94, 0xfb, 0x81, 0x67,
+	0x0f, 0x48, 0xe8, 0x53, 0x57, 0xcd, 0x8b, 0xe5, 0x0f, 0x24, 0xd8, 0x12, 0x58, 0x4c, 0x0e, 0x89,
+	0x98, 0xb4, 0xd9, 0x0d, 0xe5, 0x69, 0x11, 0x27, 0x11, 0x7a, 0x02, 0x65, 0xe2, 0x84, 0xbd, 0xa1,
+	0x4d, 0xc4, 0x91, 0xfb, 0x34, 0x50, 0xef, 0x89, 0x84, 0x92, 0x80, 0xcd, 0x14, 0x15, 0x89, 0x69,
+	0x60, 0xbb, 0xa4, 0xe7, 0x0c, 0xd5, 0x82, 0xa8, 0x53, 0xca, 0xe0, 0x7a, 0x8c, 0xc6, 0x89, 0xd9,
+	0x88, 0x91, 0xab, 0x81, 0x1f, 0x0e, 0xd5, 0x39, 0x99, 0x98, 0xc2, 0xa6, 0x40, 0xb5, 0xdf, 0x73,
+	0x50, 0xcc, 0x06, 0x73, 0x19, 0xee, 0x71, 0x9f, 0xf7, 0x88, 0xf4, 0x13, 0xcb, 0x00, 0xa9, 0x30,
+	0xc7, 0xa2, 0x7e, 0xdf, 0x09, 0x87, 0x62, 0xcf, 0xf3, 0x38, 0x0d, 0xd1, 0x16, 0x14, 0xfb, 0x84,
+	0x31, 0xc7, 0x23, 0x4c, 0xcd, 0xbf, 0x65, 0x10, 0xb3, 0x2c, 0xb4, 0x09, 0x8b, 0x13, 0x36, 0xd9,
+	0x24, 0x70, 0x85, 0x19, 0x79, 0x5c, 0x1e, 0xb7, 0xca, 0x0c, 0x5c, 0x74, 0x08, 0x05, 0xc6, 0x1d,
+	0x1e, 0x31, 0x61, 0x46, 0x69, 0xe7, 0x8b, 0x77, 0xbb, 0x49, 0x2c, 0xc1, 0xc5, 0x89, 0xc6, 0xf4,
+	0xd6, 0x7d, 0x06, 0x15, 0x89, 0x38, 0x9d, 0x1e, 0xb1, 0x9d, 0x33, 0x4e, 0xc2, 0xc4, 0xbb, 0xf2,
+	0x08, 0xaf, 0xc5, 0x30, 0x2a, 0x41, 0xce, 0x77, 0xd5, 0xa2, 0x98, 0xa4, 0x9c, 0xef, 0x6a, 0x65,
+	0x58, 0x78, 0x19, 0xdf, 0xf0, 0x16, 0xb9, 0x88, 0x48, 0xd0, 0x25, 0xda, 0x2e, 0x3c, 0x98, 0x00,
+	0xb2, 0x6f, 0x78, 0x1d, 0x8a, 0x2c, 0xc1, 0x92, 0xef, 0x3f, 0x8b, 0xb5, 0x05, 0xb8, 0x2f, 0x48,
+	0x72, 0x1e, 0xb5, 0x1f, 0x15, 0x58, 0x1a, 0x8b, 0x33, 0x89, 0xff, 0xd7, 0x03, 0xb1, 0x95, 0x6c,
+	0x7d, 0xfa, 0x4b, 0xae, 0x0b, 0x0f, 0x26, 0x18, 0xd9, 0xc6, 0xde, 0xe7, 0x1b, 0xf2, 0x35, 0x94,
+	0x26, 0x8a, 0x30, 0x74, 0x00, 0x30, 0x7a, 0x77, 0x13, 0xfd, 0x4f, 0x53, 0xfd, 0xf8, 0x91, 0xd6,
+	0xc5, 0x23, 0xad, 0x27, 0x8f, 0xb4, 0xde, 0x72, 0x3c, 0x82, 0xe3, 0xa3, 0x61, 0x1c, 0x8f, 0x31,
+	0xb5, 0x5f, 0x14, 0x58, 0x99, 0x94, 0xce, 0x36, 0x70, 0x08, 0xf3, 0x69, 0x03, 0xe9, 0xd9, 0xbc,
+	0xeb, 0x0e, 0x46, 0x02, 0xe8, 0xf9, 0x44, 0xc3, 0xf2, 0x8c, 0x9e, 0xdc, 0xd9, 0xb0, 0x6c, 0x65,
+	0xbc, 0xe3, 0xcd, 0x9f, 0x14, 0x28, 0x4d, 0x7e, 0x1c, 0xe8, 0x31, 0x3c, 0x6c, 0xe1, 0x66, 0xab,
+	0x69, 0xd5, 0x0e, 0x6d, 0xab, 0x5d, 0x6b, 0x9f, 0x58, 0xf6, 0xc9, 0xb1, 0xd5, 0x32, 0xf7, 0x1b,
+	0x07, 0x0d, 0xb3, 0x5e, 0x99, 0x41, 0x1f, 0xc1, 0x87, 0xb7, 0x13, 0x4e, 0x9b, 0xed, 0xc6, 0xf1,
+	0x73, 0xbb, 0x65, 0xe2, 0x46, 0xb3, 0x5e, 0x51, 0xd0, 0x3a, 0xac, 0xdc, 0x4e, 0x69, 0xd5, 0x2c,
+	0xcb, 0xac, 0x57, 0x72, 0xe8, 0x11, 0xa8, 0xb7, 0xd7, 0xb0, 0xf9, 0xc2, 0xdc, 0x6f, 0x9b, 0xf5,
+	0x4a, 0xfe, 0xdf, 0x98, 0x2f, 0x4f, 0xcc, 0x13, 0xb3, 0x5e, 0x99, 0xdd, 0x7c, 0x05, 0x30, 0x7a,
+	0x60, 0xd1, 0x43, 0x58, 0x3d, 0x6d, 0xb6, 0x4d, 0xbb, 0xd9, 0x6a, 0x37, 0x9a, 0xc7, 0xb7, 0x7a,
+	0x5c, 0x82, 0xf2, 0xf8, 0xe2, 0x37, 0xa6, 0x55, 0x51, 0xd0, 0x2a, 0x2c, 0x8d, 0x83, 0xb5, 0x3d,
+	0xab, 0x5d, 0x6b, 0x1c, 0x57, 0x72, 0x08, 0x41, 0x69, 0x7c, 0xe1, 0xb8, 0x59, 0xc9, 0xef, 0x1d,
+	0xfc, 0x76, 0x5d, 0x55, 0x5e, 0x5f, 0x57, 0x95, 0xbf, 0xae, 0xab, 0xca, 0xf7, 0x37, 0xd5, 0x99,
+	0xd7, 0x37, 0xd5, 0x99, 0x3f, 0x6f, 0xaa, 0x33, 0xdf, 0x3e, 0x93, 0x3e, 0x33, 0xf7, 0x95, 0xee,
+	0x53, 0xe3, 0xea, 0xed, 0x7f, 0x2b, 0x3b, 0x05, 0x71, 0xeb, 0xed, 0xfe, 0x33, 0x00, 0xf1, 0xca,
+	0xf6, 0x22, 0x85, 0x0a, 0x00, 0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -1491,6 +1657,16 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalExpiry != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalExpiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutionDelay != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutionDelay))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EarlyExecution {
 		i--
 		if m.EarlyExecution {
@@ -1549,6 +1725,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExecutableAfter != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutableAfter))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutionDelay != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutionDelay))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Status != 0 {
 		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
 		i--
@@ -1776,6 +1967,90 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMultisig(v)
 	base := offset
@@ -1956,6 +2231,12 @@ func (m *Config) Size() (n int) {
 	if m.EarlyExecution {
 		n += 2
 	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutionDelay))
+	}
+	if m.ProposalExpiry != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalExpiry))
+	}
 	return n
 }
 
@@ -1985,6 +2266,15 @@ func (m *Proposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovMultisig(uint64(m.Status))
 	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutionDelay))
+	}
+	if m.ExecutableAfter != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutableAfter))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMultisig(uint64(m.Id))
+	}
 	return n
 }
 
@@ -2062,6 +2352,38 @@ func (m *QueryProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
 func sovMultisig(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3081,6 +3403,44 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EarlyExecution = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExpiry", wireType)
+			}
+			m.ProposalExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3267,6 +3627,63 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAfter", wireType)
+			}
+			m.ExecutableAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3732,6 +4149,212 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMultisig(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,302 @@
+package passkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	ConfigPrefix      = collections.NewPrefix(0)
+	CredentialsPrefix = collections.NewPrefix(1)
+	SequencePrefix    = collections.NewPrefix(2)
+)
+
+// NewAccount returns a new passkey account creator function.
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Config:          collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Credentials:     collections.NewMap(deps.SchemaBuilder, CredentialsPrefix, "credentials", collections.BytesKey, codec.CollValue[v1.Credential](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			addrCodec:       deps.AddressCodec,
+			hs:              deps.Environment.HeaderService,
+			signingHandlers: handlerMap,
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by WebAuthn passkeys, signing transactions with the
+// secp256r1 credentials of their authenticators. The signature of a transaction is a v1.Assertion,
+// whose challenge is the SHA-256 hash of the sign bytes of the transaction.
+type Account struct {
+	Config      collections.Item[v1.Config]
+	Credentials collections.Map[[]byte, v1.Credential]
+
+	Sequence collections.Sequence
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil {
+		return nil, errors.New("config must be specified")
+	}
+	if msg.Config.RpId == "" {
+		return nil, errors.New("relying party ID must be specified")
+	}
+	if len(msg.Credentials) == 0 {
+		return nil, errors.New("credentials must be specified")
+	}
+
+	for _, cred := range msg.Credentials {
+		if err := a.addCredential(ctx, cred); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.InitSequence != 0 {
+		if err := a.Sequence.Set(ctx, msg.InitSequence); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgInitResponse{}, a.Config.Set(ctx, *msg.Config)
+}
+
+// AddCredential adds a credential to the account. It can only be called by the account itself.
+func (a Account) AddCredential(ctx context.Context, msg *v1.MsgAddCredential) (*v1.MsgAddCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgAddCredentialResponse{}, a.addCredential(ctx, msg.Credential)
+}
+
+// RemoveCredential removes a credential from the account. It can only be called by the account itself,
+// and does not remove the last credential of the account.
+func (a Account) RemoveCredential(ctx context.Context, msg *v1.MsgRemoveCredential) (*v1.MsgRemoveCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	exists, err := a.Credentials.Has(ctx, msg.CredentialId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("credential %X not found", msg.CredentialId)
+	}
+
+	// at least another credential must remain to authenticate the account
+	iter, err := a.Credentials.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 1 {
+		return nil, errors.New("cannot remove the last credential")
+	}
+
+	return &v1.MsgRemoveCredentialResponse{}, a.Credentials.Remove(ctx, msg.CredentialId)
+}
+
+func (a Account) addCredential(ctx context.Context, cred *v1.Credential) error {
+	if cred == nil {
+		return errors.New("credential must be specified")
+	}
+	if len(cred.Id) == 0 {
+		return errors.New("credential ID must be specified")
+	}
+	if _, err := parsePublicKey(cred.PublicKey); err != nil {
+		return fmt.Errorf("invalid public key of credential %X: %w", cred.Id, err)
+	}
+
+	exists, err := a.Credentials.Has(ctx, cred.Id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("credential %X already exists", cred.Id)
+	}
+
+	return a.Credentials.Set(ctx, cred.Id, *cred)
+}
+
+// Authenticate implements the authentication flow of an abstracted passkey account.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute signer data: %w", err)
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get tx data: %w", err)
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	assertion := new(v1.Assertion)
+	if err := gogoproto.Unmarshal(msg.Tx.Signatures[msg.SignerIndex], assertion); err != nil {
+		return nil, fmt.Errorf("unable to decode assertion: %w", err)
+	}
+
+	cred, err := a.Credentials.Get(ctx, assertion.CredentialId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("unknown credential %X", assertion.CredentialId)
+		}
+		return nil, err
+	}
+
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get sign bytes: %w", err)
+	}
+
+	signCount, err := verifyAssertion(cfg, cred, assertion, signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	cred.SignCount = signCount
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.Credentials.Set(ctx, cred.Id, cred)
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := accountstd.QueryModule[*accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum.Number,
+		Sequence:      wantSequence,
+	}, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryCredentials(ctx context.Context, _ *v1.QueryCredentials) (*v1.QueryCredentialsResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var credentials []*v1.Credential
+	err = a.Credentials.Walk(ctx, nil, func(_ []byte, cred v1.Credential) (bool, error) {
+		credentials = append(credentials, &cred)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryCredentialsResponse{Credentials: credentials, Config: &cfg}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddCredential)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveCredential)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryCredentials)
+}
@@ -0,0 +1,366 @@
+package passkey
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	testRpID   = "wallet.example.com"
+	testOrigin = "https://wallet.example.com"
+)
+
+// authenticator simulates a WebAuthn authenticator holding a passkey.
+type authenticator struct {
+	id        []byte
+	key       *ecdsa.PrivateKey
+	signCount uint32
+	flags     byte
+}
+
+func newAuthenticator(t *testing.T, id string) *authenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &authenticator{id: []byte(id), key: key, flags: flagUserPresent | flagUserVerified}
+}
+
+func (a *authenticator) credential() *v1.Credential {
+	return &v1.Credential{
+		Id:        a.id,
+		PublicKey: elliptic.MarshalCompressed(elliptic.P256(), a.key.X, a.key.Y),
+		SignCount: a.signCount,
+	}
+}
+
+// assert returns the assertion of the authenticator for the challenge, as returned by navigator.credentials.get.
+func (a *authenticator) assert(t *testing.T, rpID, origin string, challenge []byte) *v1.Assertion {
+	t.Helper()
+	a.signCount++
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], a.flags)
+	authData = binary.BigEndian.AppendUint32(authData, a.signCount)
+
+	clientDataJSON, err := json.Marshal(map[string]any{
+		"type":        clientDataTypeGet,
+		"challenge":   base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":      origin,
+		"crossOrigin": false,
+	})
+	require.NoError(t, err)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return &v1.Assertion{
+		CredentialId:      a.id,
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}
+}
+
+func setupPasskeyAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	_, acc, err := NewAccount("passkey", signing.NewHandlerMap(directHandler{}))(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func TestInit(t *testing.T) {
+	auth := newAuthenticator(t, "cred")
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{
+				Config:      &v1.Config{RpId: testRpID},
+				Credentials: []*v1.Credential{auth.credential()},
+			},
+			"",
+		},
+		{
+			"no config",
+			&v1.MsgInit{
+				Credentials: []*v1.Credential{auth.credential()},
+			},
+			"config must be specified",
+		},
+		{
+			"no relying party ID",
+			&v1.MsgInit{
+				Config:      &v1.Config{},
+				Credentials: []*v1.Credential{auth.credential()},
+			},
+			"relying party ID must be specified",
+		},
+		{
+			"no credentials",
+			&v1.MsgInit{
+				Config: &v1.Config{RpId: testRpID},
+			},
+			"credentials must be specified",
+		},
+		{
+			"invalid public key",
+			&v1.MsgInit{
+				Config:      &v1.Config{RpId: testRpID},
+				Credentials: []*v1.Credential{{Id: []byte("cred"), PublicKey: []byte("invalid")}},
+			},
+			"invalid secp256r1 compressed public key",
+		},
+		{
+			"duplicate credentials",
+			&v1.MsgInit{
+				Config:      &v1.Config{RpId: testRpID},
+				Credentials: []*v1.Credential{auth.credential(), auth.credential()},
+			},
+			"already exists",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupPasskeyAccount(t, ss)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddRemoveCredential(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPasskeyAccount(t, ss)
+	first, second := newAuthenticator(t, "first"), newAuthenticator(t, "second")
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config:      &v1.Config{RpId: testRpID},
+		Credentials: []*v1.Credential{first.credential()},
+	})
+	require.NoError(t, err)
+
+	// only the account can manage its credentials
+	_, err = acc.AddCredential(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgAddCredential{Credential: second.credential()})
+	require.Equal(t, errors.New("unauthorized"), err)
+	_, err = acc.RemoveCredential(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgRemoveCredential{CredentialId: first.id})
+	require.Equal(t, errors.New("unauthorized"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte("mock_passkey_account"))
+	_, err = acc.AddCredential(ctx, &v1.MsgAddCredential{Credential: second.credential()})
+	require.NoError(t, err)
+	_, err = acc.AddCredential(ctx, &v1.MsgAddCredential{Credential: second.credential()})
+	require.ErrorContains(t, err, "already exists")
+
+	res, err := acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Len(t, res.Credentials, 2)
+	require.Equal(t, testRpID, res.Config.RpId)
+
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: []byte("unknown")})
+	require.ErrorContains(t, err, "not found")
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: first.id})
+	require.NoError(t, err)
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: second.id})
+	require.ErrorContains(t, err, "cannot remove the last credential")
+
+	res, err = acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Credential{second.credential()}, res.Credentials)
+}
+
+// makeTx returns a transaction of the account with the given sequence and its sign bytes.
+func makeTx(t *testing.T, sequence uint64) (*tx.Tx, *tx.TxRaw, []byte) {
+	t.Helper()
+	transaction := &tx.Tx{
+		Body: &tx.TxBody{Memo: "passkey"},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+		},
+	}
+
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+
+	return transaction, &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes}, signBytes
+}
+
+func TestAuthenticate(t *testing.T) {
+	auth := newAuthenticator(t, "cred")
+
+	testcases := []struct {
+		name   string
+		cfg    v1.Config
+		modify func(assertion *v1.Assertion, signBytes []byte) *v1.Assertion
+		expErr string
+	}{
+		{
+			name: "valid assertion",
+			cfg:  v1.Config{RpId: testRpID, Origins: []string{testOrigin}, RequireUserVerification: true},
+		},
+		{
+			name: "unknown credential",
+			cfg:  v1.Config{RpId: testRpID},
+			modify: func(assertion *v1.Assertion, _ []byte) *v1.Assertion {
+				assertion.CredentialId = []byte("unknown")
+				return assertion
+			},
+			expErr: "unknown credential",
+		},
+		{
+			name: "challenge of another transaction",
+			cfg:  v1.Config{RpId: testRpID},
+			modify: func(_ *v1.Assertion, signBytes []byte) *v1.Assertion {
+				return auth.assert(t, testRpID, testOrigin, Challenge(append(signBytes, 0)))
+			},
+			expErr: "client data challenge does not match the transaction",
+		},
+		{
+			name: "other relying party",
+			cfg:  v1.Config{RpId: testRpID},
+			modify: func(_ *v1.Assertion, signBytes []byte) *v1.Assertion {
+				return auth.assert(t, "evil.example.com", testOrigin, Challenge(signBytes))
+			},
+			expErr: "authenticator data does not match the relying party ID",
+		},
+		{
+			name:   "origin not allowed",
+			cfg:    v1.Config{RpId: testRpID, Origins: []string{"https://other.example.com"}},
+			expErr: "origin https://wallet.example.com is not allowed",
+		},
+		{
+			name: "user not verified",
+			cfg:  v1.Config{RpId: testRpID, RequireUserVerification: true},
+			modify: func(_ *v1.Assertion, signBytes []byte) *v1.Assertion {
+				auth.flags = flagUserPresent
+				defer func() { auth.flags = flagUserPresent | flagUserVerified }()
+				return auth.assert(t, testRpID, testOrigin, Challenge(signBytes))
+			},
+			expErr: "user is not verified",
+		},
+		{
+			name: "tampered authenticator data",
+			cfg:  v1.Config{RpId: testRpID},
+			modify: func(assertion *v1.Assertion, _ []byte) *v1.Assertion {
+				binary.BigEndian.PutUint32(assertion.AuthenticatorData[33:], 1<<30)
+				return assertion
+			},
+			expErr: "signature verification failed",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupPasskeyAccount(t, ss)
+			cfg := tc.cfg
+			_, err := acc.Init(ctx, &v1.MsgInit{Config: &cfg, Credentials: []*v1.Credential{auth.credential()}})
+			require.NoError(t, err)
+			ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+			transaction, rawTx, signBytes := makeTx(t, 0)
+			assertion := auth.assert(t, testRpID, testOrigin, Challenge(signBytes))
+			if tc.modify != nil {
+				assertion = tc.modify(assertion, signBytes)
+			}
+			sig, err := gogoproto.Marshal(assertion)
+			require.NoError(t, err)
+			transaction.Signatures = [][]byte{sig}
+			rawTx.Signatures = transaction.Signatures
+
+			_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{RawTx: rawTx, Tx: transaction})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), seq.Sequence)
+		})
+	}
+}
+
+func TestAuthenticateSignCount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPasskeyAccount(t, ss)
+	auth := newAuthenticator(t, "cred")
+	_, err := acc.Init(ctx, &v1.MsgInit{Config: &v1.Config{RpId: testRpID}, Credentials: []*v1.Credential{auth.credential()}})
+	require.NoError(t, err)
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	authenticate := func(ctx context.Context, sequence uint64) error {
+		transaction, rawTx, signBytes := makeTx(t, sequence)
+		sig, err := gogoproto.Marshal(auth.assert(t, testRpID, testOrigin, Challenge(signBytes)))
+		require.NoError(t, err)
+		transaction.Signatures = [][]byte{sig}
+		rawTx.Signatures = transaction.Signatures
+		_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{RawTx: rawTx, Tx: transaction})
+		return err
+	}
+
+	require.NoError(t, authenticate(ctx, 0))
+	res, err := acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Credentials[0].SignCount)
+
+	// a cloned authenticator reports a counter which does not increase
+	auth.signCount = 0
+	require.ErrorContains(t, authenticate(ctx, 1), "signature counter 1 is not greater than 1")
+}
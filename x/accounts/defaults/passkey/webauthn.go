@@ -0,0 +1,106 @@
+package passkey
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+)
+
+const (
+	// clientDataTypeGet is the type of the client data of an assertion.
+	clientDataTypeGet = "webauthn.get"
+
+	// authenticatorDataMinSize is the size of the rpIdHash, flags and signCount of the authenticator data.
+	authenticatorDataMinSize = 32 + 1 + 4
+
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+)
+
+// clientData is the part of the WebAuthn client data JSON checked by the account.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Challenge returns the WebAuthn challenge of a transaction, to be passed to navigator.credentials.get.
+// It is the SHA-256 hash of the sign bytes of the transaction.
+func Challenge(signBytes []byte) []byte {
+	h := sha256.Sum256(signBytes)
+	return h[:]
+}
+
+// parsePublicKey parses a SEC1 compressed secp256r1 public key.
+func parsePublicKey(bz []byte) (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), bz)
+	if x == nil {
+		return nil, errors.New("invalid secp256r1 compressed public key")
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// verifyAssertion verifies a WebAuthn assertion of the credential over the sign bytes, following the
+// verification procedure of an authentication assertion of the WebAuthn specification, and returns the
+// signature counter reported by the authenticator.
+func verifyAssertion(cfg v1.Config, cred v1.Credential, assertion *v1.Assertion, signBytes []byte) (uint32, error) {
+	var cd clientData
+	if err := json.Unmarshal(assertion.ClientDataJson, &cd); err != nil {
+		return 0, fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != clientDataTypeGet {
+		return 0, fmt.Errorf("invalid client data type %q, expected %q", cd.Type, clientDataTypeGet)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil {
+		return 0, fmt.Errorf("invalid client data challenge: %w", err)
+	}
+	if !bytes.Equal(challenge, Challenge(signBytes)) {
+		return 0, errors.New("client data challenge does not match the transaction")
+	}
+	if len(cfg.Origins) != 0 && !slices.Contains(cfg.Origins, cd.Origin) {
+		return 0, fmt.Errorf("origin %s is not allowed", cd.Origin)
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < authenticatorDataMinSize {
+		return 0, fmt.Errorf("authenticator data is too short: %d bytes", len(authData))
+	}
+	rpIDHash := sha256.Sum256([]byte(cfg.RpId))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return 0, errors.New("authenticator data does not match the relying party ID")
+	}
+	flags := authData[32]
+	if flags&flagUserPresent == 0 {
+		return 0, errors.New("user is not present")
+	}
+	if cfg.RequireUserVerification && flags&flagUserVerified == 0 {
+		return 0, errors.New("user is not verified")
+	}
+	// a counter which does not increase may signal a cloned authenticator, if the authenticator has one
+	signCount := binary.BigEndian.Uint32(authData[33:37])
+	if (signCount != 0 || cred.SignCount != 0) && signCount <= cred.SignCount {
+		return 0, fmt.Errorf("signature counter %d is not greater than %d", signCount, cred.SignCount)
+	}
+
+	pubKey, err := parsePublicKey(cred.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+	clientDataHash := sha256.Sum256(assertion.ClientDataJson)
+	digest := sha256.Sum256(append(slices.Clone(authData), clientDataHash[:]...))
+	if !ecdsa.VerifyASN1(pubKey, digest[:], assertion.Signature) {
+		return 0, errors.New("signature verification failed")
+	}
+
+	return signCount, nil
+}
@@ -0,0 +1,326 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	// the prefixes 0 to 2 are used by the base account.
+	ConfigPrefix           = collections.NewPrefix(3)
+	RecoveriesPrefix       = collections.NewPrefix(4)
+	RecoverySequencePrefix = collections.NewPrefix(5)
+)
+
+// NewAccount returns a new recovery account creator function. The options set the public keys supported by the
+// account, as for the base account.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, Account{
+			Account:          baseAcc.(base.Account),
+			Config:           collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Recoveries:       collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.Uint64Key, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			RecoverySequence: collections.NewSequence(deps.SchemaBuilder, RecoverySequencePrefix, "recovery_sequence"),
+			addrCodec:        deps.AddressCodec,
+			headerService:    deps.Environment.HeaderService,
+			eventService:     deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements a base account whose public key can be rotated by a quorum of guardians, when its key
+// is lost. A recovery takes effect after a delay, during which the current key can cancel it.
+type Account struct {
+	base.Account
+
+	Config           collections.Item[v1.Config]
+	Recoveries       collections.Map[uint64, v1.Recovery]
+	RecoverySequence collections.Sequence
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if err := a.validateConfig(msg.Config); err != nil {
+		return nil, err
+	}
+
+	_, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, a.Config.Set(ctx, *msg.Config)
+}
+
+// UpdateConfig changes the guardians of the account. It can only be called by the account itself.
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if err := a.validateConfig(msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateConfigResponse{}, a.Config.Set(ctx, *msg.Config)
+}
+
+// InitiateRecovery initiates the rotation of the public key of the account. It can only be called by a guardian,
+// whose approval is counted.
+func (a Account) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	guardian, cfg, err := a.checkGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.ValidatePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	id, err := a.RecoverySequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery := v1.Recovery{
+		Id:        id,
+		NewPubKey: msg.NewPubKey,
+		Approvals: []string{guardian},
+	}
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_initiated",
+		event.NewAttribute("recovery_id", fmt.Sprint(id)),
+		event.NewAttribute("guardian", guardian),
+	); err != nil {
+		return nil, err
+	}
+	if err := a.checkThreshold(ctx, cfg, &recovery); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitiateRecoveryResponse{RecoveryId: id}, a.Recoveries.Set(ctx, id, recovery)
+}
+
+// ApproveRecovery approves a recovery. It can only be called by a guardian.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	guardian, cfg, err := a.checkGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.getRecovery(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, errors.New("recovery already approved by the guardian")
+	}
+
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+		event.NewAttribute("recovery_id", fmt.Sprint(recovery.Id)),
+		event.NewAttribute("guardian", guardian),
+	); err != nil {
+		return nil, err
+	}
+	if err := a.checkThreshold(ctx, cfg, &recovery); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgApproveRecoveryResponse{}, a.Recoveries.Set(ctx, recovery.Id, recovery)
+}
+
+// CancelRecovery cancels a recovery. It can only be called by the account itself, that is with its current key.
+func (a Account) CancelRecovery(ctx context.Context, msg *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if _, err := a.getRecovery(ctx, msg.RecoveryId); err != nil {
+		return nil, err
+	}
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_cancelled",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelRecoveryResponse{}, a.Recoveries.Remove(ctx, msg.RecoveryId)
+}
+
+// ExecuteRecovery rotates the public key of the account, once the recovery is approved by the threshold of the
+// current guardians and its delay passed. It can be called by anyone. The other pending recoveries are removed.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	recovery, err := a.getRecovery(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// guardians may have been removed since they approved the recovery
+	if approvals := countApprovals(cfg, recovery); approvals < cfg.Threshold {
+		return nil, fmt.Errorf("recovery approved by %d guardians, threshold is %d", approvals, cfg.Threshold)
+	}
+	if recovery.ExecuteAfter == 0 || a.headerService.HeaderInfo(ctx).Time.Unix() < recovery.ExecuteAfter {
+		return nil, errors.New("recovery delay has not passed")
+	}
+
+	if err := a.SetPubKey(ctx, recovery.NewPubKey); err != nil {
+		return nil, err
+	}
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("recovery_id", fmt.Sprint(recovery.Id)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, nil
+}
+
+// checkThreshold starts the delay of the recovery when it reaches the threshold.
+func (a Account) checkThreshold(ctx context.Context, cfg v1.Config, recovery *v1.Recovery) error {
+	if recovery.ExecuteAfter != 0 || countApprovals(cfg, *recovery) < cfg.Threshold {
+		return nil
+	}
+
+	recovery.ExecuteAfter = a.headerService.HeaderInfo(ctx).Time.Unix() + cfg.RecoveryDelay
+	return a.eventService.EventManager(ctx).EmitKV("recovery_threshold_reached",
+		event.NewAttribute("recovery_id", fmt.Sprint(recovery.Id)),
+		event.NewAttribute("execute_after", fmt.Sprint(recovery.ExecuteAfter)),
+	)
+}
+
+// countApprovals returns the number of current guardians who approved the recovery.
+func countApprovals(cfg v1.Config, recovery v1.Recovery) uint32 {
+	var approvals uint32
+	for _, guardian := range recovery.Approvals {
+		if slices.Contains(cfg.Guardians, guardian) {
+			approvals++
+		}
+	}
+	return approvals
+}
+
+// checkGuardian checks that the sender is a guardian of the account, and returns its address and the config.
+func (a Account) checkGuardian(ctx context.Context) (string, v1.Config, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return "", v1.Config{}, err
+	}
+
+	sender, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return "", v1.Config{}, err
+	}
+	if !slices.Contains(cfg.Guardians, sender) {
+		return "", v1.Config{}, errors.New("unauthorized: sender is not a guardian of the account")
+	}
+
+	return sender, cfg, nil
+}
+
+func (a Account) getRecovery(ctx context.Context, id uint64) (v1.Recovery, error) {
+	recovery, err := a.Recoveries.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return v1.Recovery{}, fmt.Errorf("recovery %d not found", id)
+		}
+		return v1.Recovery{}, err
+	}
+	return recovery, nil
+}
+
+func (a Account) validateConfig(cfg *v1.Config) error {
+	if cfg == nil {
+		return errors.New("config must be specified")
+	}
+	if len(cfg.Guardians) == 0 {
+		return errors.New("guardians must be specified")
+	}
+
+	guardians := map[string]struct{}{} // to check for duplicates
+	for _, guardian := range cfg.Guardians {
+		if _, ok := guardians[guardian]; ok {
+			return fmt.Errorf("duplicate guardian %s", guardian)
+		}
+		guardians[guardian] = struct{}{}
+
+		if _, err := a.addrCodec.StringToBytes(guardian); err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+	}
+
+	if cfg.Threshold == 0 || int(cfg.Threshold) > len(cfg.Guardians) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians %d", len(cfg.Guardians))
+	}
+	if cfg.RecoveryDelay < 0 {
+		return errors.New("recovery delay must not be negative")
+	}
+
+	return nil
+}
+
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryConfigResponse{Config: &cfg}, nil
+}
+
+func (a Account) QueryRecoveries(ctx context.Context, _ *v1.QueryRecoveries) (*v1.QueryRecoveriesResponse, error) {
+	var recoveries []*v1.Recovery
+	err := a.Recoveries.Walk(ctx, nil, func(_ uint64, recovery v1.Recovery) (bool, error) {
+		recoveries = append(recoveries, &recovery)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecoveries)
+}
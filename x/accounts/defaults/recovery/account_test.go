@@ -0,0 +1,223 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+var testTime = time.Unix(1_700_000_000, 0).UTC()
+
+func setupRecoveryAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	_, acc, err := NewAccount("recovery", signing.NewHandlerMap(directHandler{}), base.WithSecp256K1PubKey())(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func toAnyPb(t *testing.T, pm gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	pb, err := codectypes.NewAnyWithValue(pm)
+	require.NoError(t, err)
+	return pb
+}
+
+func testConfig() *v1.Config {
+	return &v1.Config{
+		Guardians:     []string{"guardian1", "guardian2", "guardian3"},
+		Threshold:     2,
+		RecoveryDelay: 3600,
+	}
+}
+
+func initAccount(t *testing.T) (context.Context, Account) {
+	t.Helper()
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: testConfig(),
+	})
+	require.NoError(t, err)
+	return ctx, acc
+}
+
+func asGuardian(ctx context.Context, guardian string) context.Context {
+	return accountstd.SetSender(ctx, []byte(guardian))
+}
+
+func TestInit(t *testing.T) {
+	testcases := []struct {
+		name   string
+		cfg    func(cfg *v1.Config) *v1.Config
+		expErr string
+	}{
+		{
+			"valid init",
+			func(cfg *v1.Config) *v1.Config { return cfg },
+			"",
+		},
+		{
+			"no config",
+			func(*v1.Config) *v1.Config { return nil },
+			"config must be specified",
+		},
+		{
+			"no guardians",
+			func(cfg *v1.Config) *v1.Config { cfg.Guardians = nil; return cfg },
+			"guardians must be specified",
+		},
+		{
+			"duplicate guardian",
+			func(cfg *v1.Config) *v1.Config { cfg.Guardians[2] = cfg.Guardians[0]; return cfg },
+			"duplicate guardian guardian1",
+		},
+		{
+			"zero threshold",
+			func(cfg *v1.Config) *v1.Config { cfg.Threshold = 0; return cfg },
+			"threshold must be between 1 and the number of guardians 3",
+		},
+		{
+			"threshold above the number of guardians",
+			func(cfg *v1.Config) *v1.Config { cfg.Threshold = 4; return cfg },
+			"threshold must be between 1 and the number of guardians 3",
+		},
+		{
+			"negative delay",
+			func(cfg *v1.Config) *v1.Config { cfg.RecoveryDelay = -1; return cfg },
+			"recovery delay must not be negative",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupRecoveryAccount(t, ss)
+			_, err := acc.Init(ctx, &v1.MsgInit{
+				PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Config: tc.cfg(testConfig()),
+			})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+			require.NoError(t, err)
+			require.Equal(t, testConfig(), res.Config)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, acc := initAccount(t)
+	newPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+
+	_, err := acc.InitiateRecovery(asGuardian(ctx, "stranger"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "sender is not a guardian of the account")
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "guardian1"), &v1.MsgInitiateRecovery{
+		NewPubKey: toAnyPb(t, &secp256k1.PubKey{Key: []byte("invalid")}),
+	})
+	require.ErrorContains(t, err, "unable to validate pubkey")
+
+	res, err := acc.InitiateRecovery(asGuardian(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	id := res.RecoveryId
+
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "recovery approved by 1 guardians, threshold is 2")
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "guardian1"), &v1.MsgApproveRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "recovery already approved by the guardian")
+
+	// reaching the threshold starts the delay
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "guardian2"), &v1.MsgApproveRecovery{RecoveryId: id})
+	require.NoError(t, err)
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Len(t, recoveries.Recoveries, 1)
+	require.Equal(t, []string{"guardian1", "guardian2"}, recoveries.Recoveries[0].Approvals)
+	require.Equal(t, testTime.Unix()+3600, recoveries.Recoveries[0].ExecuteAfter)
+
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "recovery delay has not passed")
+
+	// another pending recovery, removed once the first one is executed
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "guardian3"), &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	acc.headerService = headerService{time: testTime.Add(time.Hour)}
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.NoError(t, err)
+
+	pubKey, err := acc.QueryPubKey(ctx, &basev1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, newPubKey.Value, pubKey.PubKey.Value)
+
+	recoveries, err = acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, recoveries.Recoveries)
+}
+
+func TestCancelRecovery(t *testing.T) {
+	ctx, acc := initAccount(t)
+
+	res, err := acc.InitiateRecovery(asGuardian(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "guardian2"), &v1.MsgApproveRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+
+	// only the current key of the account can cancel the recovery
+	_, err = acc.CancelRecovery(asGuardian(ctx, "guardian1"), &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.Equal(t, errors.New("unauthorized"), err)
+
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_recovery_account"))
+	_, err = acc.CancelRecovery(selfCtx, &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+	_, err = acc.CancelRecovery(selfCtx, &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.ErrorContains(t, err, "recovery 0 not found")
+
+	acc.headerService = headerService{time: testTime.Add(time.Hour)}
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: res.RecoveryId})
+	require.ErrorContains(t, err, "recovery 0 not found")
+}
+
+func TestUpdateConfig(t *testing.T) {
+	ctx, acc := initAccount(t)
+
+	res, err := acc.InitiateRecovery(asGuardian(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "guardian2"), &v1.MsgApproveRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+
+	cfg := &v1.Config{Guardians: []string{"guardian2", "guardian4"}, Threshold: 2}
+	_, err = acc.UpdateConfig(asGuardian(ctx, "guardian1"), &v1.MsgUpdateConfig{Config: cfg})
+	require.Equal(t, errors.New("unauthorized"), err)
+	_, err = acc.UpdateConfig(accountstd.SetSender(ctx, []byte("mock_recovery_account")), &v1.MsgUpdateConfig{Config: cfg})
+	require.NoError(t, err)
+
+	// the approvals of removed guardians no longer count
+	acc.headerService = headerService{time: testTime.Add(time.Hour)}
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: res.RecoveryId})
+	require.ErrorContains(t, err, "recovery approved by 1 guardians, threshold is 2")
+
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.ErrorContains(t, err, "sender is not a guardian of the account")
+}
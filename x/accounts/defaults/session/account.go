@@ -0,0 +1,420 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/session/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	OwnerPrefix    = collections.NewPrefix(0)
+	SessionsPrefix = collections.NewPrefix(1)
+	SequencePrefix = collections.NewPrefix(2)
+)
+
+// NewAccount returns a new session account creator function.
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Owner:           collections.NewItem(deps.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+			Sessions:        collections.NewMap(deps.SchemaBuilder, SessionsPrefix, "sessions", collections.BytesKey, codec.CollValue[v1.Session](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			addrCodec:       deps.AddressCodec,
+			hs:              deps.Environment.HeaderService,
+			signingHandlers: handlerMap,
+		}, nil
+	}
+}
+
+// Account implements an account whose owner can register session keys, letting hot keys act for the
+// account until their expiration, restricted to some message types and to a spend limit.
+//
+// A session acts for the account either by signing transactions of the account, checked by Authenticate,
+// or by sending MsgExecute from the address of its public key.
+type Account struct {
+	Owner    collections.Item[[]byte]
+	Sessions collections.Map[[]byte, v1.Session] // key: address of the session public key
+
+	Sequence collections.Sequence
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	owner, err := a.addrCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	return &v1.MsgInitResponse{}, a.Owner.Set(ctx, owner)
+}
+
+// AddSession adds a session to the account. It can only be called by the owner.
+func (a Account) AddSession(ctx context.Context, msg *v1.MsgAddSession) (*v1.MsgAddSessionResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+	if msg.Session == nil {
+		return nil, errors.New("session must be specified")
+	}
+
+	pubKey, err := parsePubKey(msg.Session.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !a.hs.HeaderInfo(ctx).Time.Before(msg.Session.Expiration) {
+		return nil, errors.New("session expiration must be in the future")
+	}
+	if len(msg.Session.AllowedMsgs) == 0 {
+		return nil, errors.New("allowed messages must be specified")
+	}
+	if err := msg.Session.SpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid spend limit: %w", err)
+	}
+
+	exists, err := a.Sessions.Has(ctx, pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("session already exists")
+	}
+
+	return &v1.MsgAddSessionResponse{}, a.Sessions.Set(ctx, pubKey.Address(), *msg.Session)
+}
+
+// RevokeSession removes a session from the account. It can be called by the owner, or by the address
+// of the session itself.
+func (a Account) RevokeSession(ctx context.Context, msg *v1.MsgRevokeSession) (*v1.MsgRevokeSessionResponse, error) {
+	pubKey, err := parsePubKey(msg.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(accountstd.Sender(ctx), pubKey.Address()) {
+		if err := a.checkOwner(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	exists, err := a.Sessions.Has(ctx, pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New("session not found")
+	}
+
+	return &v1.MsgRevokeSessionResponse{}, a.Sessions.Remove(ctx, pubKey.Address())
+}
+
+// Execute executes messages on behalf of the account. The owner can execute any message, while a session,
+// sending from the address of its public key, can only execute the messages within its scope.
+func (a Account) Execute(ctx context.Context, msg *v1.MsgExecute) (*v1.MsgExecuteResponse, error) {
+	if len(msg.Messages) == 0 {
+		return nil, errors.New("messages must be specified")
+	}
+
+	if err := a.checkOwner(ctx); err != nil {
+		session, err := a.getSession(ctx, accountstd.Sender(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := a.spend(ctx, accountstd.Sender(ctx), session, msg.Messages, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	responses, err := accountstd.ExecModuleAnys(ctx, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteResponse{Responses: responses}, nil
+}
+
+// Authenticate implements the authentication flow of an abstracted session account. The transaction must
+// be signed by an active session, and its messages and fees must be within the scope of the session.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+	if signerInfo.PublicKey == nil {
+		return nil, errors.New("signer info must include the public key of the session")
+	}
+	pkName := gogoproto.MessageName(&secp256k1.PubKey{})
+	if signerInfo.PublicKey.TypeUrl != "/"+pkName {
+		return nil, fmt.Errorf("unsupported public key type %s, expected %s", signerInfo.PublicKey.TypeUrl, pkName)
+	}
+	pubKey := new(secp256k1.PubKey)
+	if err := gogoproto.Unmarshal(signerInfo.PublicKey.Value, pubKey); err != nil {
+		return nil, fmt.Errorf("unable to decode public key: %w", err)
+	}
+	if len(pubKey.Key) != secp256k1.PubKeySize {
+		return nil, errors.New("invalid public key size")
+	}
+
+	session, err := a.getSession(ctx, pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	signerData, err := a.computeSignerData(ctx, signerInfo.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute signer data: %w", err)
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get tx data: %w", err)
+	}
+
+	if signerInfo.Sequence != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, signerInfo.Sequence)
+	}
+
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get sign bytes: %w", err)
+	}
+
+	if !pubKey.VerifySignature(signBytes, msg.Tx.Signatures[msg.SignerIndex]) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	// the fees are spent by the account if it is the fee payer, that is the first signer without an explicit payer
+	var fees sdk.Coins
+	if fee := msg.Tx.AuthInfo.Fee; fee != nil && (fee.Payer == signerData.Address || (fee.Payer == "" && msg.SignerIndex == 0)) {
+		fees = fee.Amount
+	}
+
+	err = a.spend(ctx, pubKey.Address(), session, msg.Tx.Body.Messages, fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// spend checks that the messages are allowed by the session, and deducts what they spend from the account,
+// together with the fees, from the spend limit of the session.
+func (a Account) spend(ctx context.Context, sessionAddr []byte, session v1.Session, msgs []*codectypes.Any, fees sdk.Coins) error {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	if err := fees.Validate(); err != nil {
+		return fmt.Errorf("invalid fees: %w", err)
+	}
+
+	spent := fees
+	for _, msg := range msgs {
+		if !slices.Contains(session.AllowedMsgs, msg.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed by the session", msg.TypeUrl)
+		}
+
+		coins, err := spentCoins(addrStr, msg)
+		if err != nil {
+			return err
+		}
+		if err := coins.Validate(); err != nil {
+			return fmt.Errorf("invalid coins spent by %s: %w", msg.TypeUrl, err)
+		}
+		spent = spent.Add(coins...)
+	}
+
+	if spent.IsZero() {
+		return nil
+	}
+
+	remaining, hasNeg := session.SpendLimit.SafeSub(spent...)
+	if hasNeg {
+		return fmt.Errorf("spending %s exceeds the spend limit %s of the session", spent, session.SpendLimit)
+	}
+	session.SpendLimit = remaining
+
+	return a.Sessions.Set(ctx, sessionAddr, session)
+}
+
+// getSession returns the session of the address, if it did not expire.
+func (a Account) getSession(ctx context.Context, sessionAddr []byte) (v1.Session, error) {
+	session, err := a.Sessions.Get(ctx, sessionAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return v1.Session{}, errors.New("unauthorized: not the owner or a session of the account")
+		}
+		return v1.Session{}, err
+	}
+
+	if !a.hs.HeaderInfo(ctx).Time.Before(session.Expiration) {
+		return v1.Session{}, errors.New("session expired")
+	}
+
+	return session, nil
+}
+
+func (a Account) checkOwner(ctx context.Context) error {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(accountstd.Sender(ctx), owner) {
+		return errors.New("unauthorized: sender is not the owner of the account")
+	}
+	return nil
+}
+
+// parsePubKey parses a compressed secp256k1 public key.
+func parsePubKey(bz []byte) (*secp256k1.PubKey, error) {
+	if len(bz) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key size, expected %d bytes, got %d", secp256k1.PubKeySize, len(bz))
+	}
+	if _, err := dcrd_secp256k1.ParsePubKey(bz); err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return &secp256k1.PubKey{Key: bz}, nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, pubKey *codectypes.Any) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := accountstd.QueryModule[*accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum.Number,
+		Sequence:      wantSequence,
+		PubKey: &anypb.Any{
+			TypeUrl: pubKey.TypeUrl,
+			Value:   pubKey.Value,
+		},
+	}, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+// QuerySessions returns the owner and the sessions of the account which did not expire.
+func (a Account) QuerySessions(ctx context.Context, _ *v1.QuerySessions) (*v1.QuerySessionsResponse, error) {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerStr, err := a.addrCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.hs.HeaderInfo(ctx).Time
+	var sessions []v1.Session
+	err = a.Sessions.Walk(ctx, nil, func(_ []byte, session v1.Session) (bool, error) {
+		if now.Before(session.Expiration) {
+			sessions = append(sessions, session)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QuerySessionsResponse{Owner: ownerStr, Sessions: sessions}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddSession)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSession)
+	accountstd.RegisterExecuteHandler(builder, a.Execute)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessions)
+}
@@ -0,0 +1,294 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/session/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const accountAddr = "mock_session_account"
+
+var (
+	testTime = time.Unix(1_700_000_000, 0).UTC()
+	owner    = []byte("owner")
+)
+
+func setupSessionAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	_, acc, err := NewAccount("session", signing.NewHandlerMap(directHandler{}))(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func newSession(pubKey *secp256k1.PubKey, spendLimit sdk.Coins, allowedMsgs ...string) *v1.Session {
+	return &v1.Session{
+		PublicKey:   pubKey.Key,
+		Expiration:  testTime.Add(time.Hour),
+		AllowedMsgs: allowedMsgs,
+		SpendLimit:  spendLimit,
+	}
+}
+
+func msgSend(t *testing.T, amount int64) *codectypes.Any {
+	t.Helper()
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: accountAddr,
+		ToAddress:   "receiver",
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(amount))),
+	})
+	require.NoError(t, err)
+	return msg
+}
+
+// initAccount returns an initialized session account with a session of the key, allowed to send 100stake.
+func initAccount(t *testing.T, sessionKey *secp256k1.PrivKey) (context.Context, Account) {
+	t.Helper()
+	ctx, ss := newMockContext(t)
+	acc := setupSessionAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{Owner: string(owner)})
+	require.NoError(t, err)
+
+	session := newSession(sessionKey.PubKey().(*secp256k1.PubKey), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), msgSendTypeURL)
+	_, err = acc.AddSession(accountstd.SetSender(ctx, owner), &v1.MsgAddSession{Session: session})
+	require.NoError(t, err)
+
+	return ctx, acc
+}
+
+func querySession(t *testing.T, ctx context.Context, acc Account) v1.Session {
+	t.Helper()
+	res, err := acc.QuerySessions(ctx, &v1.QuerySessions{})
+	require.NoError(t, err)
+	require.Len(t, res.Sessions, 1)
+	return res.Sessions[0]
+}
+
+func TestAddRevokeSession(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{Owner: string(owner)})
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey()
+	sessionPubKey := sessionKey.PubKey().(*secp256k1.PubKey)
+
+	testcases := []struct {
+		name    string
+		sender  []byte
+		session *v1.Session
+		expErr  string
+	}{
+		{
+			"not the owner",
+			[]byte("sender"),
+			newSession(sessionPubKey, nil, msgSendTypeURL),
+			"sender is not the owner of the account",
+		},
+		{
+			"invalid public key",
+			owner,
+			newSession(&secp256k1.PubKey{Key: []byte("invalid")}, nil, msgSendTypeURL),
+			"invalid public key size",
+		},
+		{
+			"expired",
+			owner,
+			&v1.Session{PublicKey: sessionPubKey.Key, Expiration: testTime, AllowedMsgs: []string{msgSendTypeURL}},
+			"session expiration must be in the future",
+		},
+		{
+			"no allowed messages",
+			owner,
+			newSession(sessionPubKey, nil),
+			"allowed messages must be specified",
+		},
+		{
+			"invalid spend limit",
+			owner,
+			newSession(sessionPubKey, sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}, msgSendTypeURL),
+			"invalid spend limit",
+		},
+		{
+			"valid session",
+			owner,
+			newSession(sessionPubKey, nil, msgSendTypeURL),
+			"",
+		},
+		{
+			"duplicate session",
+			owner,
+			newSession(sessionPubKey, nil, msgSendTypeURL),
+			"session already exists",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.AddSession(accountstd.SetSender(ctx, tc.sender), &v1.MsgAddSession{Session: tc.session})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	res, err := acc.QuerySessions(ctx, &v1.QuerySessions{})
+	require.NoError(t, err)
+	require.Equal(t, string(owner), res.Owner)
+	require.Equal(t, []v1.Session{*newSession(sessionPubKey, nil, msgSendTypeURL)}, res.Sessions)
+
+	// only the owner and the session can revoke the session
+	_, err = acc.RevokeSession(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgRevokeSession{PublicKey: sessionPubKey.Key})
+	require.ErrorContains(t, err, "sender is not the owner of the account")
+	_, err = acc.RevokeSession(accountstd.SetSender(ctx, sessionPubKey.Address()), &v1.MsgRevokeSession{PublicKey: sessionPubKey.Key})
+	require.NoError(t, err)
+	_, err = acc.RevokeSession(accountstd.SetSender(ctx, owner), &v1.MsgRevokeSession{PublicKey: sessionPubKey.Key})
+	require.ErrorContains(t, err, "session not found")
+
+	res, err = acc.QuerySessions(ctx, &v1.QuerySessions{})
+	require.NoError(t, err)
+	require.Empty(t, res.Sessions)
+}
+
+func TestExecute(t *testing.T) {
+	sessionKey := secp256k1.GenPrivKey()
+	sessionAddr := sessionKey.PubKey().Address()
+	ctx, acc := initAccount(t, sessionKey)
+
+	// the owner executes any message, without spending the limit of the sessions
+	multiSend, err := codectypes.NewAnyWithValue(&banktypes.MsgMultiSend{})
+	require.NoError(t, err)
+	_, err = acc.Execute(accountstd.SetSender(ctx, owner), &v1.MsgExecute{Messages: []*codectypes.Any{multiSend, msgSend(t, 1000)}})
+	require.NoError(t, err)
+
+	// a session executes the allowed messages within its spend limit
+	sessionCtx := accountstd.SetSender(ctx, sessionAddr)
+	res, err := acc.Execute(sessionCtx, &v1.MsgExecute{Messages: []*codectypes.Any{msgSend(t, 30), msgSend(t, 30)}})
+	require.NoError(t, err)
+	require.Len(t, res.Responses, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), querySession(t, ctx, acc).SpendLimit)
+
+	_, err = acc.Execute(sessionCtx, &v1.MsgExecute{Messages: []*codectypes.Any{msgSend(t, 41)}})
+	require.ErrorContains(t, err, "spending 41stake exceeds the spend limit 40stake of the session")
+
+	_, err = acc.Execute(sessionCtx, &v1.MsgExecute{Messages: []*codectypes.Any{multiSend}})
+	require.ErrorContains(t, err, "message /cosmos.bank.v1beta1.MsgMultiSend is not allowed by the session")
+
+	_, err = acc.Execute(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgExecute{Messages: []*codectypes.Any{msgSend(t, 1)}})
+	require.ErrorContains(t, err, "unauthorized: not the owner or a session of the account")
+
+	// the session can no longer act once expired
+	acc.hs = headerService{time: testTime.Add(time.Hour)}
+	_, err = acc.Execute(sessionCtx, &v1.MsgExecute{Messages: []*codectypes.Any{msgSend(t, 1)}})
+	require.ErrorContains(t, err, "session expired")
+
+	queryRes, err := acc.QuerySessions(ctx, &v1.QuerySessions{})
+	require.NoError(t, err)
+	require.Empty(t, queryRes.Sessions)
+}
+
+// signTx returns a transaction of the account signed by the key, and its raw form.
+func signTx(t *testing.T, key *secp256k1.PrivKey, sequence uint64, fee sdk.Coins, msgs ...*codectypes.Any) (*tx.Tx, *tx.TxRaw) {
+	t.Helper()
+	pkAny, err := codectypes.NewAnyWithValue(key.PubKey())
+	require.NoError(t, err)
+
+	transaction := &tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: pkAny,
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+			Fee: &tx.Fee{Amount: fee},
+		},
+	}
+
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+	sig, err := key.Sign(signBytes)
+	require.NoError(t, err)
+
+	transaction.Signatures = [][]byte{sig}
+	return transaction, &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: transaction.Signatures}
+}
+
+func TestAuthenticate(t *testing.T) {
+	sessionKey := secp256k1.GenPrivKey()
+	ctx, acc := initAccount(t, sessionKey)
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	authenticate := func(transaction *tx.Tx, rawTx *tx.TxRaw) error {
+		_, err := acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{RawTx: rawTx, Tx: transaction})
+		return err
+	}
+
+	// the fees and the sends are deducted from the spend limit
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+	require.NoError(t, authenticate(signTx(t, sessionKey, 0, fee, msgSend(t, 20))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 75)), querySession(t, ctx, acc).SpendLimit)
+
+	// a key without a session cannot sign for the account
+	err := authenticate(signTx(t, secp256k1.GenPrivKey(), 1, nil, msgSend(t, 1)))
+	require.ErrorContains(t, err, "unauthorized: not the owner or a session of the account")
+
+	// nor a session outside of its scope
+	err = authenticate(signTx(t, sessionKey, 1, fee, msgSend(t, 71)))
+	require.ErrorContains(t, err, "spending 76stake exceeds the spend limit 75stake of the session")
+	multiSend, err := codectypes.NewAnyWithValue(&banktypes.MsgMultiSend{})
+	require.NoError(t, err)
+	err = authenticate(signTx(t, sessionKey, 2, nil, multiSend))
+	require.ErrorContains(t, err, "message /cosmos.bank.v1beta1.MsgMultiSend is not allowed by the session")
+
+	// an invalid signature
+	transaction, rawTx := signTx(t, sessionKey, 3, nil, msgSend(t, 1))
+	transaction.Signatures[0][0] ^= 1
+	require.ErrorContains(t, authenticate(transaction, rawTx), "signature verification failed")
+
+	// an unexpected sequence
+	require.ErrorContains(t, authenticate(signTx(t, sessionKey, 0, nil, msgSend(t, 1))), "unexpected sequence number")
+
+	// an expired session
+	acc.hs = headerService{time: testTime.Add(2 * time.Hour)}
+	require.ErrorContains(t, authenticate(signTx(t, sessionKey, 5, nil, msgSend(t, 1))), "session expired")
+}
@@ -0,0 +1,43 @@
+package session
+
+import (
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	banktypes "cosmossdk.io/x/bank/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	msgSendTypeURL      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+// spentCoins returns the coins a message sends from the account. Only bank sends are accounted for, so the
+// allowed messages of a session should not include other messages moving funds of the account.
+func spentCoins(account string, msg *codectypes.Any) (sdk.Coins, error) {
+	switch msg.TypeUrl {
+	case msgSendTypeURL:
+		send := new(banktypes.MsgSend)
+		if err := gogoproto.Unmarshal(msg.Value, send); err != nil {
+			return nil, err
+		}
+		if send.FromAddress == account {
+			return send.Amount, nil
+		}
+	case msgMultiSendTypeURL:
+		multiSend := new(banktypes.MsgMultiSend)
+		if err := gogoproto.Unmarshal(msg.Value, multiSend); err != nil {
+			return nil, err
+		}
+		var coins sdk.Coins
+		for _, input := range multiSend.Inputs {
+			if input.Address == account {
+				coins = coins.Add(input.Coins...)
+			}
+		}
+		return coins, nil
+	}
+	return nil, nil
+}
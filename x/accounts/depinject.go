@@ -69,6 +69,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account,
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
+		accountstd.AddAccount(lockup.CLIFF_LOCKING_ACCOUNT, lockup.NewCliffLockingAccount),
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		accountstd.AddAccount(multisig.MULTISIG_ACCOUNT, multisig.NewAccount),
@@ -0,0 +1,37 @@
+package signing
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+// verifyEIP712Signature verifies an Ethereum style secp256k1 signature of the keccak256 hash of the sign bytes.
+// It follows eip712.VerifySignature of x/tx, which is not imported so that modules depending on a released
+// x/tx can still use this package: the signature is R ‖ S, optionally followed by the ignored recovery ID,
+// and signatures with a high S are rejected as malleable.
+func verifyEIP712Signature(pubKey, signBytes, sig []byte) bool {
+	if len(sig) == 65 {
+		sig = sig[:64]
+	}
+	if len(sig) != 64 {
+		return false
+	}
+
+	pk, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sig[:32]); overflow || r.IsZero() {
+		return false
+	}
+	if overflow := s.SetByteSlice(sig[32:]); overflow || s.IsZero() || s.IsOverHalfOrder() {
+		return false
+	}
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write(signBytes)
+	return ecdsa.NewSignature(&r, &s).Verify(h.Sum(nil), pk)
+}
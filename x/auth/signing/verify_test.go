@@ -0,0 +1,135 @@
+package signing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestVerifySignatureEIP712(t *testing.T) {
+	interfaceRegistry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	signingCtx := interfaceRegistry.SigningContext()
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(),
+		[]signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712})
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr)))
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	builder.SetGasLimit(testdata.NewTestGasLimit())
+	builder.SetMemo("memo")
+
+	signerData := authsign.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        privKey.PubKey(),
+	}
+	signBytes, err := authsign.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(),
+		signing.SignMode_SIGN_MODE_EIP_712, signerData, builder.GetTx())
+	require.NoError(t, err)
+
+	// sign the keccak256 hash of the sign bytes like an Ethereum wallet
+	h := sha3.NewLegacyKeccak256()
+	h.Write(signBytes)
+	compact := ecdsa.SignCompact(dcrsecp256k1.PrivKeyFromBytes(privKey.Key), h.Sum(nil), true)
+	sigData := &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_EIP_712,
+		Signature: compact[1:],
+	}
+
+	txData := builder.GetTx().(authsign.V2AdaptableTx).GetSigningTxData()
+	txSignerData := txsigning.SignerData{
+		ChainID:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+		Sequence:      signerData.Sequence,
+		Address:       signerData.Address,
+	}
+	handler := txConfig.SignModeHandler()
+	require.NoError(t, authsign.VerifySignature(context.Background(), privKey.PubKey(), txSignerData, sigData, handler, txData))
+
+	// a secp256k1 signature of the sign bytes themselves does not verify
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	err = authsign.VerifySignature(context.Background(), privKey.PubKey(), txSignerData,
+		&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_712, Signature: sig}, handler, txData)
+	require.ErrorContains(t, err, "unable to verify single signer signature")
+
+	// the signature is bound to the sequence
+	txSignerData.Sequence++
+	err = authsign.VerifySignature(context.Background(), privKey.PubKey(), txSignerData, sigData, handler, txData)
+	require.ErrorContains(t, err, "unable to verify single signer signature")
+
+	// only secp256k1 keys sign EIP-712 typed data
+	txSignerData.Sequence--
+	err = authsign.VerifySignature(context.Background(), ed25519.GenPrivKey().PubKey(), txSignerData, sigData, handler, txData)
+	require.ErrorContains(t, err, "requires a secp256k1 public key")
+}
+
+func TestVerifySignatureEIP712Unordered(t *testing.T) {
+	interfaceRegistry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	signingCtx := interfaceRegistry.SigningContext()
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(),
+		[]signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712})
+	handler := txConfig.SignModeHandler()
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	signerData := txsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+	}
+
+	timeout := time.Unix(1_700_000_000, 0)
+	newTxData := func(unordered bool, timeout time.Time) txsigning.TxData {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr)))
+		builder.SetFeeAmount(testdata.NewTestFeeAmount())
+		builder.SetGasLimit(testdata.NewTestGasLimit())
+		builder.SetUnordered(unordered)
+		builder.SetTimeoutTimestamp(timeout)
+		return builder.GetTx().(authsign.V2AdaptableTx).GetSigningTxData()
+	}
+
+	txData := newTxData(true, timeout)
+	signBytes, err := handler.GetSignBytes(context.Background(), signingv1beta1.SignMode(signing.SignMode_SIGN_MODE_EIP_712), signerData, txData)
+	require.NoError(t, err)
+	h := sha3.NewLegacyKeccak256()
+	h.Write(signBytes)
+	compact := ecdsa.SignCompact(dcrsecp256k1.PrivKeyFromBytes(privKey.Key), h.Sum(nil), true)
+	sigData := &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_EIP_712,
+		Signature: compact[1:],
+	}
+	require.NoError(t, authsign.VerifySignature(context.Background(), privKey.PubKey(), signerData, sigData, handler, txData))
+
+	// the signature is bound to the timeout timestamp, so that it cannot be replayed once it is passed
+	err = authsign.VerifySignature(context.Background(), privKey.PubKey(), signerData, sigData, handler, newTxData(true, timeout.Add(time.Hour)))
+	require.ErrorContains(t, err, "unable to verify single signer signature")
+
+	// and to the unordered flag
+	err = authsign.VerifySignature(context.Background(), privKey.PubKey(), signerData, sigData, handler, newTxData(false, timeout))
+	require.ErrorContains(t, err, "unable to verify single signer signature")
+}
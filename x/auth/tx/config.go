@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip712"
 	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -47,6 +48,12 @@ type ConfigOptions struct {
 	// TextualCoinMetadataQueryFn is the function that will be used to query coin metadata when constructing
 	// textual sign mode handler. This is required if SIGN_MODE_TEXTUAL is enabled.
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// EIP712DomainName is the name of the EIP-712 domain when SIGN_MODE_EIP_712 is enabled,
+	// eip712.DefaultDomainName if empty.
+	EIP712DomainName string
+	// EIP712EthChainID is the chain ID of the EIP-712 domain when SIGN_MODE_EIP_712 is enabled. Wallets
+	// require it to match the chain they are connected to. It is omitted from the domain if zero.
+	EIP712EthChainID uint64
 	// CustomSignModes are the custom sign modes that will be added to the txsigning.HandlerMap.
 	CustomSignModes []txsigning.SignModeHandler
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
@@ -65,6 +72,7 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 	// signingtypes.SignMode_SIGN_MODE_TEXTUAL is not enabled by default, as it requires a x/bank keeper or gRPC connection.
+	// signingtypes.SignMode_SIGN_MODE_EIP_712 is not enabled by default, as it is only needed to sign with Ethereum wallets.
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
@@ -142,6 +150,13 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				FileResolver: signingOpts.FileResolver,
 				TypeResolver: signingOpts.TypeResolver,
 			})
+		case signingtypes.SignMode_SIGN_MODE_EIP_712:
+			handlers[i] = eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+				FileResolver: signingOpts.FileResolver,
+				TypeResolver: signingOpts.TypeResolver,
+				DomainName:   configOpts.EIP712DomainName,
+				EthChainID:   configOpts.EIP712EthChainID,
+			})
 		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
 			handlers[i], err = textual.NewSignModeHandler(textual.SignModeOptions{
 				CoinMetadataQuerier: configOpts.TextualCoinMetadataQueryFn,
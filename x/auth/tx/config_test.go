@@ -1,6 +1,7 @@
 package tx_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,11 +10,14 @@ import (
 	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
 	coretransaction "cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip712"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txtestutil "github.com/cosmos/cosmos-sdk/x/auth/tx/testutil"
 )
@@ -40,3 +44,41 @@ func TestConfigOptions(t *testing.T) {
 	handler := txConfig.SignModeHandler()
 	require.NotNil(t, handler)
 }
+
+func TestConfigOptionsEIP712(t *testing.T) {
+	interfaceRegistry := testutil.CodecOptions{}.NewInterfaceRegistry()
+	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+	txConfig, err := tx.NewTxConfigWithOptions(protoCodec, tx.ConfigOptions{
+		EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712},
+		SigningOptions: &signing.Options{
+			AddressCodec:          interfaceRegistry.SigningContext().AddressCodec(),
+			ValidatorAddressCodec: interfaceRegistry.SigningContext().ValidatorAddressCodec(),
+		},
+		EIP712DomainName: "Test Chain",
+		EIP712EthChainID: 9001,
+	})
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr)))
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	builder.SetGasLimit(testdata.NewTestGasLimit())
+	txData := builder.GetTx().(authsigning.V2AdaptableTx).GetSigningTxData()
+	signerData := signing.SignerData{Address: addr.String(), ChainID: "test-chain", AccountNumber: 1}
+
+	// the handler of the tx config signs with the configured domain
+	td, err := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+		FileResolver: interfaceRegistry,
+		DomainName:   "Test Chain",
+		EthChainID:   9001,
+	}).GetTypedData(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, "9001", td.Domain["chainId"])
+	expected, err := td.SignBytes()
+	require.NoError(t, err)
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(context.Background(), eip712.SignMode, signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, expected, signBytes)
+}
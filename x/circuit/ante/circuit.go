@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	corecontext "cosmossdk.io/core/context"
 	"cosmossdk.io/core/transaction"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,14 @@ type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
 
+// RateLimiter is an optional interface a CircuitBreaker can implement to throttle
+// the execution of messages instead of disabling them entirely.
+type RateLimiter interface {
+	// CheckMsgRateLimit returns an error if an execution of the msg by its signers
+	// would exceed the rate limit, without recording it.
+	CheckMsgRateLimit(ctx context.Context, msg transaction.Msg) error
+}
+
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
 type CircuitBreakerDecorator struct {
 	circuitKeeper CircuitBreaker
@@ -30,14 +39,34 @@ func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
 // - or error early if a nested authz grant is found.
 // The circuit AnteHandler handles this with baseapp's service router: https://github.com/cosmos/cosmos-sdk/issues/18632.
 func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	if err := cbd.ValidateTx(ctx, tx); err != nil {
+	if err := cbd.checkAllowed(ctx, tx); err != nil {
 		return ctx, err
 	}
 
+	if ctx.ExecMode() == sdk.ExecModeCheck || ctx.ExecMode() == sdk.ExecModeReCheck {
+		if err := cbd.checkRateLimits(ctx, tx); err != nil {
+			return ctx, err
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
 
 func (cbd CircuitBreakerDecorator) ValidateTx(ctx context.Context, tx transaction.Tx) error {
+	if err := cbd.checkAllowed(ctx, tx); err != nil {
+		return err
+	}
+
+	if execMode, ok := ctx.Value(corecontext.ExecModeKey).(transaction.ExecMode); !ok ||
+		(execMode != transaction.ExecModeCheck && execMode != transaction.ExecModeReCheck) {
+		return nil
+	}
+
+	return cbd.checkRateLimits(ctx, tx)
+}
+
+// checkAllowed returns an error if the type of any of the messages of the tx is disabled.
+func (cbd CircuitBreakerDecorator) checkAllowed(ctx context.Context, tx transaction.Tx) error {
 	// loop through all the messages and check if the message type is allowed
 	msgs, err := tx.GetMessages()
 	if err != nil {
@@ -54,5 +83,30 @@ func (cbd CircuitBreakerDecorator) ValidateTx(ctx context.Context, tx transactio
 			return errors.New("tx type not allowed")
 		}
 	}
+
+	return nil
+}
+
+// checkRateLimits returns an error if any of the messages of the tx is rate limited for
+// its signers, so that such txs do not enter the mempool. It is only called in CheckTx and
+// ReCheckTx: the rate limits are consumed by the msg router when the messages, including
+// nested ones, are executed.
+func (cbd CircuitBreakerDecorator) checkRateLimits(ctx context.Context, tx transaction.Tx) error {
+	rateLimiter, ok := cbd.circuitKeeper.(RateLimiter)
+	if !ok {
+		return nil
+	}
+
+	msgs, err := tx.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if err := rateLimiter.CheckMsgRateLimit(ctx, msg); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/transaction"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit/ante"
 	cbtypes "cosmossdk.io/x/circuit/types"
@@ -91,3 +92,51 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+type MockRateLimiter struct {
+	MockCircuitBreaker
+	checked []string
+	limited bool
+}
+
+func (m *MockRateLimiter) CheckMsgRateLimit(_ context.Context, msg transaction.Msg) error {
+	if m.limited {
+		return cbtypes.ErrRateLimited
+	}
+	m.checked = append(m.checked, sdk.MsgTypeURL(msg))
+	return nil
+}
+
+func TestCircuitBreakerDecoratorRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	msg := &cbtypes.MsgAuthorizeCircuitBreaker{
+		Grantee: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+		Granter: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+	}
+	require.NoError(t, f.txBuilder.SetMsgs(msg, msg))
+	tx := f.txBuilder.GetTx()
+
+	rateLimiter := &MockRateLimiter{}
+	decorator := ante.NewCircuitBreakerDecorator(rateLimiter)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+		return ctx, nil
+	}
+
+	// rate limits are checked by CheckTx and ReCheckTx
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	for _, mode := range []sdk.ExecMode{sdk.ExecModeCheck, sdk.ExecModeReCheck} {
+		_, err := decorator.AnteHandle(ctx.WithExecMode(mode), tx, false, next)
+		require.NoError(t, err)
+	}
+	require.Len(t, rateLimiter.checked, 4)
+
+	// and left to the msg router when the tx is executed
+	rateLimiter.limited = true
+	_, err := decorator.AnteHandle(ctx.WithExecMode(sdk.ExecModeFinalize), tx, false, next)
+	require.NoError(t, err)
+
+	_, err = decorator.AnteHandle(ctx.WithExecMode(sdk.ExecModeCheck), tx, false, next)
+	require.ErrorIs(t, err, cbtypes.ErrRateLimited)
+}
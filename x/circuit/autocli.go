@@ -30,6 +30,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "disabled-list",
 					Short:     "Query a list of all disabled message types",
 				},
+				{
+					RpcMethod: "Trips",
+					Use:       "trips",
+					Short:     "Query the disabled message types that expire and their remaining time",
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query the rate limited message types and their remaining time",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -53,7 +63,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "TripCircuitBreaker",
 					Use:       "disable <msg_type_urls>",
 					Short:     "Disable a message from being executed",
-					Example:   fmt.Sprintf(`%s circuit disable "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+					Example: fmt.Sprintf(`%s circuit disable "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"
+%s circuit disable /cosmos.bank.v1beta1.MsgSend --expiry '{"height":"1000"}'`, version.AppName, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
@@ -67,6 +78,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
 				},
+				{
+					RpcMethod:      "SetRateLimit",
+					Use:            "set-rate-limit <rate_limit_json>",
+					Short:          "Throttle the execution of a message",
+					Example:        fmt.Sprintf(`%s circuit set-rate-limit '{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","max_per_block":"100","max_per_account":"10","window":"3600s"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rate_limit"}},
+				},
+				{
+					RpcMethod:      "RemoveRateLimit",
+					Use:            "remove-rate-limit <msg_type_urls>",
+					Short:          "Remove the rate limit of a message",
+					Example:        fmt.Sprintf(`%s circuit remove-rate-limit /cosmos.bank.v1beta1.MsgSend`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_urls", Varargs: true}},
+				},
 			},
 		},
 	}
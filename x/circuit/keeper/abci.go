@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/circuit/types"
+)
+
+// BeginBlocker lifts the trips and rate limits that have expired, and prunes
+// the per account usage whose window has ended.
+func (k *Keeper) BeginBlocker(ctx context.Context) error {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+
+	var expiredTrips []string
+	err := k.TripExpiries.Walk(ctx, nil, func(msgURL string, trip types.TripExpiry) (bool, error) {
+		if trip.Expiry.IsExpired(headerInfo.Height, headerInfo.Time) {
+			expiredTrips = append(expiredTrips, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, msgURL := range expiredTrips {
+		if err := k.DisableList.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+
+		if err := k.TripExpiries.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			"circuit_breaker_expired",
+			event.NewAttribute("msg_url", msgURL),
+		); err != nil {
+			return err
+		}
+	}
+
+	var expiredRateLimits []string
+	err = k.RateLimits.Walk(ctx, nil, func(msgURL string, rateLimit types.RateLimit) (bool, error) {
+		if rateLimit.Expiry.IsExpired(headerInfo.Height, headerInfo.Time) {
+			expiredRateLimits = append(expiredRateLimits, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, msgURL := range expiredRateLimits {
+		if err := k.removeRateLimit(ctx, msgURL); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			"rate_limit_expired",
+			event.NewAttribute("msg_url", msgURL),
+		); err != nil {
+			return err
+		}
+	}
+
+	return k.pruneAccountUsage(ctx, headerInfo.Time)
+}
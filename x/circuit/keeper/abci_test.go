@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/circuit/types"
+)
+
+func TestBeginBlocker(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	sender := []byte("sender")
+
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, "permanent"))
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, "expired"))
+	require.NoError(t, f.keeper.TripExpiries.Set(f.ctx, "expired", types.TripExpiry{MsgTypeUrl: "expired", Expiry: types.Expiry{Height: 5}}))
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, "pending"))
+	require.NoError(t, f.keeper.TripExpiries.Set(f.ctx, "pending", types.TripExpiry{MsgTypeUrl: "pending", Expiry: types.Expiry{Height: 6}}))
+
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, "limited", types.RateLimit{MsgTypeUrl: "limited", MaxPerAccount: 1, Window: time.Hour, Expiry: types.Expiry{Height: 5}}))
+	require.NoError(t, f.keeper.ConsumeRateLimit(withHeader(f.ctx, 4, now), "limited", [][]byte{sender}))
+
+	require.NoError(t, f.keeper.BeginBlocker(withHeader(f.ctx, 5, now)))
+
+	disabled, err := f.keeper.DisableList.Has(f.ctx, "permanent")
+	require.NoError(t, err)
+	require.True(t, disabled)
+
+	disabled, err = f.keeper.DisableList.Has(f.ctx, "expired")
+	require.NoError(t, err)
+	require.False(t, disabled)
+
+	has, err := f.keeper.TripExpiries.Has(f.ctx, "expired")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	has, err = f.keeper.TripExpiries.Has(f.ctx, "pending")
+	require.NoError(t, err)
+	require.True(t, has)
+
+	has, err = f.keeper.RateLimits.Has(f.ctx, "limited")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	has, err = f.keeper.AccountUsage.Has(f.ctx, collections.Join("limited", sender))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestBeginBlockerPrunesAccountUsage(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	sender1, sender2 := []byte("sender_1"), []byte("sender_2")
+
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, "limited", types.RateLimit{MsgTypeUrl: "limited", MaxPerAccount: 1, Window: time.Minute}))
+	require.NoError(t, f.keeper.ConsumeRateLimit(withHeader(f.ctx, 1, now), "limited", [][]byte{sender1}))
+	require.NoError(t, f.keeper.ConsumeRateLimit(withHeader(f.ctx, 2, now.Add(30*time.Second)), "limited", [][]byte{sender2}))
+
+	hasUsage := func(sender []byte) bool {
+		t.Helper()
+		has, err := f.keeper.AccountUsage.Has(f.ctx, collections.Join("limited", sender))
+		require.NoError(t, err)
+		return has
+	}
+
+	// the usage is kept while its window is running
+	require.NoError(t, f.keeper.BeginBlocker(withHeader(f.ctx, 3, now.Add(time.Minute-time.Second))))
+	require.True(t, hasUsage(sender1))
+	require.True(t, hasUsage(sender2))
+
+	// and pruned once it ended
+	require.NoError(t, f.keeper.BeginBlocker(withHeader(f.ctx, 4, now.Add(time.Minute))))
+	require.False(t, hasUsage(sender1))
+	require.True(t, hasUsage(sender2))
+}
@@ -37,9 +37,29 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 		return nil, err
 	}
 
+	var tripExpiries []types.TripExpiry
+	err = k.TripExpiries.Walk(ctx, nil, func(_ string, trip types.TripExpiry) (stop bool, err error) {
+		tripExpiries = append(tripExpiries, trip)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rateLimits []types.RateLimit
+	err = k.RateLimits.Walk(ctx, nil, func(_ string, rateLimit types.RateLimit) (stop bool, err error) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		TripExpiries:       tripExpiries,
+		RateLimits:         rateLimits,
 	}, nil
 }
 
@@ -62,6 +82,18 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			return err
 		}
 	}
+	for _, trip := range genState.TripExpiries {
+		// Set the expiries of the disabled type urls
+		if err := k.TripExpiries.Set(ctx, trip.MsgTypeUrl, trip); err != nil {
+			return err
+		}
+	}
+	for _, rateLimit := range genState.RateLimits {
+		// Set the rate limits
+		if err := k.RateLimits.Set(ctx, rateLimit.MsgTypeUrl, rateLimit); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
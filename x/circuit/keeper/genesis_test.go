@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -76,6 +77,8 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		TripExpiries:       []types.TripExpiry{{MsgTypeUrl: url, Expiry: types.Expiry{Height: 100}}},
+		RateLimits:         []types.RateLimit{{MsgTypeUrl: "limited_url", MaxPerAccount: 1, Window: time.Hour}},
 	}
 
 	err = s.keeper.InitGenesis(s.ctx, genesisState)
@@ -92,4 +95,6 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.TripExpiries, exportedGenesisState.TripExpiries)
+	s.Require().Equal(genesisState.RateLimits, exportedGenesisState.RateLimits)
 }
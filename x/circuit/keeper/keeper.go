@@ -2,20 +2,26 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the circuit module's keeper.
 type Keeper struct {
 	appmodule.Environment
 
-	cdc          codec.BinaryCodec
+	cdc          codec.Codec
 	addressCodec address.Codec
 	authority    []byte
 
@@ -24,10 +30,24 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// TripExpiries contains the expiries of the disabled message URLs that are
+	// automatically lifted
+	TripExpiries collections.Map[string, types.TripExpiry]
+	// RateLimits contains the rate limits of message URLs
+	RateLimits collections.Map[string, types.RateLimit]
+	// BlockUsage contains the executions of rate limited message URLs in the
+	// current block
+	BlockUsage collections.Map[string, types.BlockUsage]
+	// AccountUsage contains the executions of rate limited message URLs per
+	// account in the current window
+	AccountUsage collections.Map[collections.Pair[string, []byte], types.AccountUsage]
+	// AccountUsageQueue contains the end of the windows of the AccountUsage
+	// entries, which are pruned once their window has elapsed
+	AccountUsageQueue collections.KeySet[collections.Triple[time.Time, string, []byte]]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
-func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority string, addressCodec address.Codec) Keeper {
+func NewKeeper(env appmodule.Environment, cdc codec.Codec, authority string, addressCodec address.Codec) Keeper {
 	auth, err := addressCodec.StringToBytes(authority)
 	if err != nil {
 		panic(err)
@@ -53,6 +73,40 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 			"disable_list",
 			collections.StringKey,
 		),
+		TripExpiries: collections.NewMap(
+			sb,
+			types.TripExpiryPrefix,
+			"trip_expiries",
+			collections.StringKey,
+			codec.CollValue[types.TripExpiry](cdc),
+		),
+		RateLimits: collections.NewMap(
+			sb,
+			types.RateLimitPrefix,
+			"rate_limits",
+			collections.StringKey,
+			codec.CollValue[types.RateLimit](cdc),
+		),
+		BlockUsage: collections.NewMap(
+			sb,
+			types.BlockUsagePrefix,
+			"block_usage",
+			collections.StringKey,
+			codec.CollValue[types.BlockUsage](cdc),
+		),
+		AccountUsage: collections.NewMap(
+			sb,
+			types.AccountUsagePrefix,
+			"account_usage",
+			collections.PairKeyCodec(collections.StringKey, collections.BytesKey),
+			codec.CollValue[types.AccountUsage](cdc),
+		),
+		AccountUsageQueue: collections.NewKeySet(
+			sb,
+			types.AccountUsageQueuePrefix,
+			"account_usage_queue",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.BytesKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -68,8 +122,208 @@ func (k *Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
-// IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
+// IsAllowed returns true when msg URL is not found in the DisableList for given context
+// or when its trip has expired, else false.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
-	return !has, err
+	if err != nil || !has {
+		return !has, err
+	}
+
+	trip, err := k.TripExpiries.Get(ctx, msgURL)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	return trip.Expiry.IsExpired(headerInfo.Height, headerInfo.Time), nil
+}
+
+// ConsumeMsgRateLimit records an execution of the msg by its signers and returns an
+// error if it exceeds the rate limit in place for the msg type URL.
+func (k *Keeper) ConsumeMsgRateLimit(ctx context.Context, msg transaction.Msg) error {
+	return k.msgRateLimit(ctx, msg, true)
+}
+
+// CheckMsgRateLimit returns an error if an execution of the msg by its signers would
+// exceed the rate limit in place for the msg type URL, without recording it.
+func (k *Keeper) CheckMsgRateLimit(ctx context.Context, msg transaction.Msg) error {
+	return k.msgRateLimit(ctx, msg, false)
+}
+
+func (k *Keeper) msgRateLimit(ctx context.Context, msg transaction.Msg, consume bool) error {
+	msgURL := sdk.MsgTypeURL(msg)
+	has, err := k.RateLimits.Has(ctx, msgURL)
+	if err != nil || !has {
+		return err
+	}
+
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return err
+	}
+
+	return k.rateLimit(ctx, msgURL, signers, consume)
+}
+
+// ConsumeRateLimit records an execution of the msg URL by the given senders and returns
+// an error if it exceeds the rate limit in place for the msg URL.
+func (k *Keeper) ConsumeRateLimit(ctx context.Context, msgURL string, senders [][]byte) error {
+	return k.rateLimit(ctx, msgURL, senders, true)
+}
+
+// rateLimit returns an error if an execution of the msg URL by the given senders exceeds
+// the rate limit in place for the msg URL. The execution is only recorded when consume is true.
+func (k *Keeper) rateLimit(ctx context.Context, msgURL string, senders [][]byte, consume bool) error {
+	rateLimit, err := k.RateLimits.Get(ctx, msgURL)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	if rateLimit.Expiry.IsExpired(headerInfo.Height, headerInfo.Time) {
+		return nil
+	}
+
+	if rateLimit.MaxPerBlock > 0 {
+		usage, err := k.BlockUsage.Get(ctx, msgURL)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if usage.Height != headerInfo.Height {
+			usage = types.BlockUsage{Height: headerInfo.Height}
+		}
+
+		if usage.Count >= rateLimit.MaxPerBlock {
+			return k.rateLimited(ctx, msgURL, "", errorsmod.Wrapf(types.ErrRateLimited, "%s is limited to %d executions per block", msgURL, rateLimit.MaxPerBlock))
+		}
+
+		if consume {
+			usage.Count++
+			if err := k.BlockUsage.Set(ctx, msgURL, usage); err != nil {
+				return err
+			}
+		}
+	}
+
+	if rateLimit.MaxPerAccount == 0 {
+		return nil
+	}
+
+	for _, sender := range senders {
+		key := collections.Join(msgURL, sender)
+		usage, err := k.AccountUsage.Get(ctx, key)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if errors.Is(err, collections.ErrNotFound) || !headerInfo.Time.Before(usage.WindowStart.Add(rateLimit.Window)) {
+			usage = types.AccountUsage{WindowStart: headerInfo.Time}
+			if !consume {
+				continue
+			}
+			if err := k.AccountUsageQueue.Set(ctx, collections.Join3(headerInfo.Time.Add(rateLimit.Window), msgURL, sender)); err != nil {
+				return err
+			}
+		}
+
+		if usage.Count >= rateLimit.MaxPerAccount {
+			addr, err := k.addressCodec.BytesToString(sender)
+			if err != nil {
+				return err
+			}
+
+			return k.rateLimited(ctx, msgURL, addr, errorsmod.Wrapf(types.ErrRateLimited, "%s is limited to %d executions per account every %s", msgURL, rateLimit.MaxPerAccount, rateLimit.Window))
+		}
+
+		if !consume {
+			continue
+		}
+
+		usage.Count++
+		if err := k.AccountUsage.Set(ctx, key, usage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rateLimited emits a rate limit event and returns the given error.
+func (k *Keeper) rateLimited(ctx context.Context, msgURL, account string, rateLimitErr error) error {
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		"rate_limited",
+		event.NewAttribute("msg_url", msgURL),
+		event.NewAttribute("account", account),
+	); err != nil {
+		return err
+	}
+
+	return rateLimitErr
+}
+
+// removeRateLimit removes the rate limit of the msg URL along with its usage.
+func (k *Keeper) removeRateLimit(ctx context.Context, msgURL string) error {
+	if err := k.RateLimits.Remove(ctx, msgURL); err != nil {
+		return err
+	}
+
+	if err := k.BlockUsage.Remove(ctx, msgURL); err != nil {
+		return err
+	}
+
+	// the queued entries of the usage are left to be pruned once their window ends
+	return k.AccountUsage.Clear(ctx, collections.NewPrefixedPairRange[string, []byte](msgURL))
+}
+
+// pruneAccountUsage removes the account usage whose window has ended at the given time.
+func (k *Keeper) pruneAccountUsage(ctx context.Context, now time.Time) error {
+	// the keys are collected first, as the store must not be written while iterating
+	var expired []collections.Triple[time.Time, string, []byte]
+	if err := k.AccountUsageQueue.Walk(ctx, nil, func(key collections.Triple[time.Time, string, []byte]) (bool, error) {
+		if key.K1().After(now) {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.AccountUsageQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		usageKey := collections.Join(key.K2(), key.K3())
+		usage, err := k.AccountUsage.Get(ctx, usageKey)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		// the usage is reset when its rate limit is replaced, in which case
+		// its new window may still be running and is queued again
+		rateLimit, err := k.RateLimits.Get(ctx, key.K2())
+		if err == nil && now.Before(usage.WindowStart.Add(rateLimit.Window)) {
+			continue
+		} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.AccountUsage.Remove(ctx, usageKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit"
@@ -18,6 +21,7 @@ import (
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -204,3 +208,110 @@ func TestIsAllowed(t *testing.T) {
 		})
 	}
 }
+
+func withHeader(ctx context.Context, height int64, t time.Time) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Height: height, Time: t})
+}
+
+func TestIsAllowedExpiredTrip(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	expireTime := now.Add(time.Hour)
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, "by_height"))
+	require.NoError(t, f.keeper.TripExpiries.Set(f.ctx, "by_height", types.TripExpiry{MsgTypeUrl: "by_height", Expiry: types.Expiry{Height: 10}}))
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, "by_time"))
+	require.NoError(t, f.keeper.TripExpiries.Set(f.ctx, "by_time", types.TripExpiry{MsgTypeUrl: "by_time", Expiry: types.Expiry{Time: &expireTime}}))
+
+	testCases := []struct {
+		name     string
+		height   int64
+		time     time.Time
+		msgURL   string
+		expected bool
+	}{
+		{"height not reached", 9, now, "by_height", false},
+		{"height reached", 10, now, "by_height", true},
+		{"time not reached", 100, now, "by_time", false},
+		{"time reached", 1, expireTime, "by_time", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, err := f.keeper.IsAllowed(withHeader(f.ctx, tc.height, tc.time), tc.msgURL)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, allowed)
+		})
+	}
+}
+
+func TestConsumeRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	sender1, sender2 := []byte("sender_1"), []byte("sender_2")
+
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, "per_block", types.RateLimit{MsgTypeUrl: "per_block", MaxPerBlock: 2}))
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, "per_account", types.RateLimit{MsgTypeUrl: "per_account", MaxPerAccount: 1, Window: time.Minute}))
+
+	// messages without rate limit are never throttled
+	ctx := withHeader(f.ctx, 1, now)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, f.keeper.ConsumeRateLimit(ctx, "unlimited", [][]byte{sender1}))
+	}
+
+	// per block limit is shared by all accounts and resets on the next block
+	require.NoError(t, f.keeper.ConsumeRateLimit(ctx, "per_block", [][]byte{sender1}))
+	require.NoError(t, f.keeper.ConsumeRateLimit(ctx, "per_block", [][]byte{sender2}))
+	require.ErrorIs(t, f.keeper.ConsumeRateLimit(ctx, "per_block", [][]byte{sender1}), types.ErrRateLimited)
+	require.NoError(t, f.keeper.ConsumeRateLimit(withHeader(f.ctx, 2, now), "per_block", [][]byte{sender1}))
+
+	// per account limit is tracked per sender and resets once the window elapsed
+	require.NoError(t, f.keeper.ConsumeRateLimit(ctx, "per_account", [][]byte{sender1}))
+	require.NoError(t, f.keeper.ConsumeRateLimit(ctx, "per_account", [][]byte{sender2}))
+	require.ErrorIs(t, f.keeper.ConsumeRateLimit(withHeader(f.ctx, 2, now.Add(time.Second)), "per_account", [][]byte{sender1}), types.ErrRateLimited)
+	require.NoError(t, f.keeper.ConsumeRateLimit(withHeader(f.ctx, 3, now.Add(time.Minute)), "per_account", [][]byte{sender1}))
+
+	// expired rate limits are not enforced
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, "expired", types.RateLimit{MsgTypeUrl: "expired", MaxPerBlock: 1, Expiry: types.Expiry{Height: 2}}))
+	ctx = withHeader(f.ctx, 2, now)
+	require.NoError(t, f.keeper.ConsumeRateLimit(ctx, "expired", [][]byte{sender1}))
+	require.NoError(t, f.keeper.ConsumeRateLimit(ctx, "expired", [][]byte{sender1}))
+}
+
+func TestConsumeMsgRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	msgURL := sdk.MsgTypeURL(&types.MsgAuthorizeCircuitBreaker{})
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, msgURL, types.RateLimit{MsgTypeUrl: msgURL, MaxPerAccount: 1, Window: time.Minute}))
+
+	granter, err := f.ac.StringToBytes(addresses[0])
+	require.NoError(t, err)
+	grantee, err := f.ac.StringToBytes(addresses[1])
+	require.NoError(t, err)
+
+	// checking the rate limit does not consume it
+	ctx := withHeader(f.ctx, 1, now)
+	require.NoError(t, f.keeper.CheckMsgRateLimit(ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1]}))
+	has, err := f.keeper.AccountUsage.Has(ctx, collections.Join(msgURL, granter))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// only the signer of the msg is charged
+	require.NoError(t, f.keeper.ConsumeMsgRateLimit(ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1]}))
+
+	has, err = f.keeper.AccountUsage.Has(ctx, collections.Join(msgURL, granter))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.AccountUsage.Has(ctx, collections.Join(msgURL, grantee))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, f.keeper.ConsumeMsgRateLimit(ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[1], Grantee: addresses[0]}))
+	require.ErrorIs(t, f.keeper.CheckMsgRateLimit(ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1]}), types.ErrRateLimited)
+	require.ErrorIs(t, f.keeper.ConsumeMsgRateLimit(ctx, &types.MsgAuthorizeCircuitBreaker{Granter: addresses[0], Grantee: addresses[1]}), types.ErrRateLimited)
+}
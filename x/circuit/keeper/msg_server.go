@@ -89,6 +89,11 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	headerInfo := srv.HeaderService.HeaderInfo(ctx)
+	if err := msg.Expiry.Validate(headerInfo.Height, headerInfo.Time); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	for _, msgTypeURL := range msg.MsgTypeUrls {
 		// check if the message is in the list of allowed messages
 		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
@@ -116,15 +121,28 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, err
 		}
 
+		// a trip without expiry overwrites the expiry of a previous, expired, trip
+		if msg.Expiry.IsEmpty() {
+			err = srv.TripExpiries.Remove(ctx, msgTypeURL)
+		} else {
+			err = srv.TripExpiries.Set(ctx, msgTypeURL, types.TripExpiry{MsgTypeUrl: msgTypeURL, Expiry: msg.Expiry})
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
 
-	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
-		"trip_circuit_breaker",
+	attrs := []event.Attribute{
 		event.NewAttribute("authority", msg.Authority),
 		event.NewAttribute("msg_url", urls),
-	); err != nil {
+	}
+	if !msg.Expiry.IsEmpty() {
+		attrs = append(attrs, event.NewAttribute("expiry", msg.Expiry.String()))
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV("trip_circuit_breaker", attrs...); err != nil {
 		return nil, err
 	}
 
@@ -174,6 +192,10 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
+
+		if err = srv.TripExpiries.Remove(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
@@ -189,6 +211,110 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// SetRateLimit throttles the processing of a Msg type URL in the state machine.
+func (srv msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.RateLimit.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	headerInfo := srv.HeaderService.HeaderInfo(ctx)
+	if err := msg.RateLimit.Expiry.Validate(headerInfo.Height, headerInfo.Time); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := srv.checkMsgPermission(ctx, address, msg.RateLimit.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	// reset the usage so that the new limits apply from a clean slate
+	if err := srv.removeRateLimit(ctx, msg.RateLimit.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	if err := srv.RateLimits.Set(ctx, msg.RateLimit.MsgTypeUrl, msg.RateLimit); err != nil {
+		return nil, err
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
+		"set_rate_limit",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("msg_url", msg.RateLimit.MsgTypeUrl),
+		event.NewAttribute("rate_limit", msg.RateLimit.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes the rate limits of Msg type URLs.
+func (srv msgServer) RemoveRateLimit(ctx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		has, err := srv.RateLimits.Has(ctx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if !has {
+			return nil, fmt.Errorf("message %s is not rate limited", msgTypeURL)
+		}
+
+		if err := srv.checkMsgPermission(ctx, address, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		if err := srv.removeRateLimit(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
+		"remove_rate_limit",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("msg_url", strings.Join(msg.MsgTypeUrls, ",")),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// checkMsgPermission returns an error if the account is not allowed to trip or
+// reset the circuit breaker for the message.
+func (srv msgServer) checkMsgPermission(ctx context.Context, address []byte, msgTypeURL string) error {
+	// the module authority does not need any permissions
+	if bytes.Equal(address, srv.GetAuthority()) {
+		return nil
+	}
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+
+	switch perms.Level {
+	case types.Permissions_LEVEL_SUPER_ADMIN, types.Permissions_LEVEL_ALL_MSGS:
+		return nil
+	case types.Permissions_LEVEL_SOME_MSGS:
+		if !hasPermissionForMsg(perms, msgTypeURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to rate limit message %s", msgTypeURL)
+		}
+		return nil
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to rate limit messages")
+	}
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
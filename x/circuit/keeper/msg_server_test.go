@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -373,3 +374,86 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestTripCircuitBreakerWithExpiry(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	ctx := withHeader(ft.ctx, 10, time.Unix(1_700_000_000, 0).UTC())
+
+	// expiry in the past is rejected
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, Expiry: types.Expiry{Height: 10}})
+	require.ErrorContains(t, err, "expiry must be in the future")
+
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, Expiry: types.Expiry{Height: 20}})
+	require.NoError(t, err)
+
+	trip, err := ft.keeper.TripExpiries.Get(ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, types.TripExpiry{MsgTypeUrl: msgSend, Expiry: types.Expiry{Height: 20}}, trip)
+
+	allowed, err := ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// resetting removes the expiry
+	_, err = srv.ResetCircuitBreaker(ctx, &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+
+	has, err := ft.keeper.TripExpiries.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSetAndRemoveRateLimit(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	rateLimit := types.RateLimit{MsgTypeUrl: msgSend, MaxPerAccount: 5, Window: time.Hour}
+
+	// invalid rate limit
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: types.RateLimit{MsgTypeUrl: msgSend}})
+	require.Error(t, err)
+
+	// account without permissions
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: addresses[1], RateLimit: rateLimit})
+	require.ErrorContains(t, err, "does not have permission")
+
+	// account with permissions for another message
+	add1, err := ft.ac.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	require.NoError(t, ft.keeper.Permissions.Set(ft.ctx, add1, types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"other"}}))
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: addresses[1], RateLimit: rateLimit})
+	require.ErrorContains(t, err, "does not have permission")
+
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: rateLimit})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"set_rate_limit",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("rate_limit", rateLimit.String()),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	stored, err := ft.keeper.RateLimits.Get(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, rateLimit, stored)
+
+	_, err = srv.RemoveRateLimit(ft.ctx, &types.MsgRemoveRateLimit{Authority: authority, MsgTypeUrls: []string{"unknown"}})
+	require.ErrorContains(t, err, "is not rate limited")
+
+	_, err = srv.RemoveRateLimit(ft.ctx, &types.MsgRemoveRateLimit{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+
+	has, err := ft.keeper.RateLimits.Has(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -73,3 +75,56 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// Trips returns the disabled message urls that expire along with their remaining time.
+func (qs QueryServer) Trips(ctx context.Context, req *types.QueryTripsRequest) (*types.TripsResponse, error) {
+	headerInfo := qs.keeper.HeaderService.HeaderInfo(ctx)
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.TripExpiries,
+		req.Pagination,
+		func(_ string, trip types.TripExpiry) (types.ActiveTrip, error) {
+			return types.ActiveTrip{
+				Trip:      trip,
+				Remaining: trip.Expiry.Remaining(headerInfo.Height, headerInfo.Time),
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TripsResponse{Trips: results, Pagination: pageRes}, nil
+}
+
+// RateLimits returns the active rate limits along with their remaining time.
+func (qs QueryServer) RateLimits(ctx context.Context, req *types.QueryRateLimitsRequest) (*types.RateLimitsResponse, error) {
+	headerInfo := qs.keeper.HeaderService.HeaderInfo(ctx)
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.RateLimits,
+		req.Pagination,
+		func(msgURL string, rateLimit types.RateLimit) (types.ActiveRateLimit, error) {
+			usage, err := qs.keeper.BlockUsage.Get(ctx, msgURL)
+			if err != nil && !errors.Is(err, collections.ErrNotFound) {
+				return types.ActiveRateLimit{}, err
+			}
+
+			var blockCount uint64
+			if usage.Height == headerInfo.Height {
+				blockCount = usage.Count
+			}
+
+			return types.ActiveRateLimit{
+				RateLimit:  rateLimit,
+				Remaining:  rateLimit.Expiry.Remaining(headerInfo.Height, headerInfo.Time),
+				BlockCount: blockCount,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RateLimitsResponse{RateLimits: results, Pagination: pageRes}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -98,3 +99,47 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryTrips(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	expireTime := now.Add(time.Hour)
+	trip := types.TripExpiry{MsgTypeUrl: f.mockMsgURL, Expiry: types.Expiry{Time: &expireTime, Height: 15}}
+	require.NoError(t, f.keeper.DisableList.Set(f.ctx, f.mockMsgURL))
+	require.NoError(t, f.keeper.TripExpiries.Set(f.ctx, f.mockMsgURL, trip))
+
+	qs := keeper.NewQueryServer(f.keeper)
+	res, err := qs.Trips(withHeader(f.ctx, 10, now), &types.QueryTripsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Trips, 1)
+	require.Equal(t, trip, res.Trips[0].Trip)
+	require.Equal(t, time.Hour, *res.Trips[0].Remaining.Duration)
+	require.Equal(t, int64(5), res.Trips[0].Remaining.Blocks)
+}
+
+func TestQueryRateLimits(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	rateLimit := types.RateLimit{MsgTypeUrl: f.mockMsgURL, MaxPerBlock: 10}
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, f.mockMsgURL, rateLimit))
+
+	ctx := withHeader(f.ctx, 10, now)
+	require.NoError(t, f.keeper.ConsumeRateLimit(ctx, f.mockMsgURL, nil))
+
+	qs := keeper.NewQueryServer(f.keeper)
+	res, err := qs.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.RateLimits, 1)
+	require.Equal(t, rateLimit, res.RateLimits[0].RateLimit)
+	require.Equal(t, uint64(1), res.RateLimits[0].BlockCount)
+	require.Nil(t, res.RateLimits[0].Remaining.Duration)
+
+	// usage of a previous block is not reported
+	res, err = qs.RateLimits(withHeader(f.ctx, 11, now), &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.RateLimits[0].BlockCount)
+}
@@ -29,8 +29,10 @@ var (
 
 	_ appmodule.AppModule                        = AppModule{}
 	_ appmodule.HasGenesis                       = AppModule{}
+	_ appmodule.HasBeginBlocker                  = AppModule{}
 	_ appmodule.HasRegisterInterfaces            = AppModule{}
 	_ appmodulev2.HasTxValidator[transaction.Tx] = AppModule{}
+	_ appmodulev2.HasPreMsgHandlers              = AppModule{}
 )
 
 // AppModule implements an application module for the circuit module.
@@ -112,8 +114,19 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// BeginBlock lifts the expired trips and rate limits.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.BeginBlocker(ctx)
+}
+
 // TxValidator implements appmodule.HasTxValidator.
 func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 	validator := ante.NewCircuitBreakerDecorator(&am.keeper)
 	return validator.ValidateTx(ctx, tx)
 }
+
+// RegisterPreMsgHandlers registers a global pre msg handler consuming the rate limits
+// of every executed message, including nested ones.
+func (am AppModule) RegisterPreMsgHandlers(router appmodulev2.PreMsgRouter) {
+	router.RegisterGlobalPreMsgHandler(am.keeper.ConsumeMsgRateLimit)
+}
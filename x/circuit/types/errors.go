@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/circuit module sentinel errors
+var (
+	ErrRateLimited = errors.Register(ModuleName, 2, "rate limit exceeded")
+)
@@ -20,6 +20,31 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	disabled := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		disabled[url] = true
+	}
+
+	for _, trip := range gs.TripExpiries {
+		if !disabled[trip.MsgTypeUrl] {
+			return fmt.Errorf("trip expiry for %s which is not disabled", trip.MsgTypeUrl)
+		}
+		if trip.Expiry.IsEmpty() {
+			return fmt.Errorf("trip expiry for %s is empty", trip.MsgTypeUrl)
+		}
+	}
+
+	seen := make(map[string]bool, len(gs.RateLimits))
+	for _, rl := range gs.RateLimits {
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+		if seen[rl.MsgTypeUrl] {
+			return fmt.Errorf("duplicate rate limit for %s", rl.MsgTypeUrl)
+		}
+		seen[rl.MsgTypeUrl] = true
+	}
+
 	return nil
 }
 
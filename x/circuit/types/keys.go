@@ -19,4 +19,9 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	TripExpiryPrefix        = collections.NewPrefix(3)
+	RateLimitPrefix         = collections.NewPrefix(4)
+	BlockUsagePrefix        = collections.NewPrefix(5)
+	AccountUsagePrefix      = collections.NewPrefix(6)
+	AccountUsageQueuePrefix = collections.NewPrefix(7)
 )
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// IsEmpty returns true if the expiry never lifts the rule.
+func (e Expiry) IsEmpty() bool {
+	return e.Time == nil && e.Height == 0
+}
+
+// IsExpired returns true if the rule is lifted at the given block height and time.
+func (e Expiry) IsExpired(height int64, now time.Time) bool {
+	if e.Height > 0 && height >= e.Height {
+		return true
+	}
+
+	return e.Time != nil && !now.Before(*e.Time)
+}
+
+// Validate checks that the expiry is well formed and not already reached at
+// the given block height and time.
+func (e Expiry) Validate(height int64, now time.Time) error {
+	if e.Height < 0 {
+		return fmt.Errorf("expiry height cannot be negative: %d", e.Height)
+	}
+
+	if !e.IsEmpty() && e.IsExpired(height, now) {
+		return errors.New("expiry must be in the future")
+	}
+
+	return nil
+}
+
+// Remaining returns the time and number of blocks left before the expiry is
+// reached at the given block height and time.
+func (e Expiry) Remaining(height int64, now time.Time) Remaining {
+	var remaining Remaining
+	if e.Time != nil {
+		d := max(e.Time.Sub(now), 0)
+		remaining.Duration = &d
+	}
+
+	if e.Height > 0 {
+		remaining.Blocks = max(e.Height-height, 0)
+	}
+
+	return remaining
+}
+
+// Validate performs a stateless validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if r.MsgTypeUrl == "" {
+		return errors.New("rate limit msg type url cannot be empty")
+	}
+
+	if r.MaxPerBlock == 0 && r.MaxPerAccount == 0 {
+		return fmt.Errorf("rate limit for %s must set max_per_block or max_per_account", r.MsgTypeUrl)
+	}
+
+	if r.MaxPerAccount > 0 && r.Window <= 0 {
+		return fmt.Errorf("rate limit for %s must set a positive window with max_per_account", r.MsgTypeUrl)
+	}
+
+	if r.Expiry.Height < 0 {
+		return fmt.Errorf("rate limit for %s has a negative expiry height", r.MsgTypeUrl)
+	}
+
+	return nil
+}
@@ -79,6 +79,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompound",
+					Use:       "auto-compound <delegator-addr>",
+					Short:     "Query whether a delegator opted in to auto-compounding of their rewards",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -107,6 +116,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards <record-id>",
+					Short:     "Withdraw the rewards of the delegation of a tokenize share record (must be the record owner)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound <enabled>",
+					Short:     "Opt in or out of the periodic withdrawal and re-delegation of staking rewards",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod: "WithdrawValidatorCommission",
 					Use:       "withdraw-validator-commission <validator-addr>",
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	staking "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -44,6 +45,7 @@ type ModuleOutputs struct {
 	DistrKeeper keeper.Keeper
 	Module      appmodule.AppModule
 	Hooks       staking.StakingHooksWrapper
+	EpochHooks  epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -80,5 +82,6 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		DistrKeeper: k,
 		Module:      m,
 		Hooks:       staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		EpochHooks:  epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()},
 	}
 }
@@ -9,7 +9,8 @@ import (
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block.
+// and distribute rewards for the previous block. It then compounds the rewards
+// of the delegators of the active auto-compounding round.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyBeginBlocker)
@@ -37,5 +38,5 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return k.processAutoCompound(ctx)
 }
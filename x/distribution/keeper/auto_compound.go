@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetAutoCompound opts a delegator in or out of auto-compounding.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, enabled bool) error {
+	var err error
+	if enabled {
+		err = k.AutoCompoundDelegators.Set(ctx, delAddr)
+	} else {
+		err = k.AutoCompoundDelegators.Remove(ctx, delAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// startAutoCompoundRound starts the auto-compounding round of an epoch. The
+// round resumes from the delegator the previous round stopped at, so that the
+// delegators past the per-epoch maximum are compounded in the next epoch.
+func (k Keeper) startAutoCompoundRound(ctx context.Context, epochNumber int64) error {
+	round, err := k.AutoCompoundRound.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	round.EpochNumber = epochNumber
+	round.Active = true
+	round.Processed = 0
+
+	return k.AutoCompoundRound.Set(ctx, round)
+}
+
+// processAutoCompound compounds the rewards of the delegators of the active
+// round until either the per-block gas budget or the per-epoch maximum of
+// delegators is reached.
+func (k Keeper) processAutoCompound(ctx context.Context) error {
+	round, err := k.AutoCompoundRound.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !round.Active {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// auto-compounding was disabled while the round was in progress
+	if params.AutoCompoundEpochIdentifier == "" {
+		round.Active = false
+		round.NextDelegator = ""
+		return k.AutoCompoundRound.Set(ctx, round)
+	}
+
+	var ranger collections.Ranger[sdk.AccAddress]
+	if round.NextDelegator != "" {
+		start, err := k.authKeeper.AddressCodec().StringToBytes(round.NextDelegator)
+		if err != nil {
+			return err
+		}
+		ranger = new(collections.Range[sdk.AccAddress]).StartInclusive(start)
+	}
+
+	// the round is over unless the walk stops early
+	round.Active = false
+	round.NextDelegator = ""
+
+	var gasUsed uint64
+	err = k.AutoCompoundDelegators.Walk(ctx, ranger, func(delAddr sdk.AccAddress) (stop bool, err error) {
+		delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+
+		// the remaining delegators are compounded in the next epoch
+		if round.Processed >= params.AutoCompoundMaxDelegatorsPerEpoch {
+			round.NextDelegator = delegator
+			return true, nil
+		}
+
+		// the remaining delegators are compounded in the next block
+		if gasUsed >= params.AutoCompoundGasBudgetPerBlock {
+			round.Active = true
+			round.NextDelegator = delegator
+			return true, nil
+		}
+
+		gas, err := k.BranchService.ExecuteWithGasLimit(ctx, params.AutoCompoundGasBudgetPerBlock-gasUsed, func(ctx context.Context) error {
+			return k.compoundDelegator(ctx, delAddr)
+		})
+		gasUsed += gas
+		if errors.Is(err, sdkerrors.ErrOutOfGas) && gasUsed > gas {
+			// retry the delegator in the next block with the full budget
+			round.Active = true
+			round.NextDelegator = delegator
+			return true, nil
+		} else if err != nil {
+			// a failure only affects the delegator, it must not halt the chain
+			k.Logger.Error("failed to auto-compound rewards", "delegator", delegator, "err", err)
+		}
+
+		round.Processed++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.AutoCompoundRound.Set(ctx, round)
+}
+
+// compoundDelegator withdraws the rewards of all the delegations of a delegator
+// and re-delegates their staking denom portion to the same validators. Rewards
+// sent to a withdraw address other than the delegator are not re-delegated.
+func (k Keeper) compoundDelegator(ctx context.Context, delAddr sdk.AccAddress) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		compounded := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+		if !withdrawAddr.Equals(delAddr) {
+			compounded = sdk.NewInt64Coin(bondDenom, 0)
+		}
+
+		if compounded.IsPositive() {
+			if _, err := k.MsgRouterService.Invoke(ctx, stakingtypes.NewMsgDelegate(delegator, delegation.ValidatorAddress, compounded)); err != nil {
+				return err
+			}
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoCompound,
+			event.NewAttribute(types.AttributeKeyDelegator, delegator),
+			event.NewAttribute(types.AttributeKeyValidator, delegation.ValidatorAddress),
+			event.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			event.NewAttribute(types.AttributeKeyCompounded, compounded.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+// EpochHooks wrapper struct
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the x/epochs hooks of the distribution keeper.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart is a noop
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd starts an auto-compounding round at the end of the configured
+// epoch. It is a noop while auto-compounding is disabled.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundEpochIdentifier == "" || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return h.k.startAutoCompoundRound(ctx, epochNumber)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -113,6 +114,19 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, delegator := range data.AutoCompoundDelegators {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegator)
+		if err != nil {
+			return err
+		}
+		if err := k.AutoCompoundDelegators.Set(ctx, delegatorAddress); err != nil {
+			return err
+		}
+	}
+	if err := k.AutoCompoundRound.Set(ctx, data.AutoCompoundRound); err != nil {
+		return err
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -285,5 +299,27 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	autoCompound := make([]string, 0)
+	err = k.AutoCompoundDelegators.Walk(ctx, nil, func(key sdk.AccAddress) (stop bool, err error) {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(key)
+		if err != nil {
+			return true, err
+		}
+
+		autoCompound = append(autoCompound, delAddr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := k.AutoCompoundRound.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	genesis := types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes)
+	genesis.AutoCompoundDelegators = autoCompound
+	genesis.AutoCompoundRound = round
+	return genesis, nil
 }
@@ -369,6 +369,28 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: addr}, nil
 }
 
+// DelegatorAutoCompound queries Query/delegatorAutoCompound
+func (k Querier) DelegatorAutoCompound(ctx context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := k.AutoCompoundDelegators.Has(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{Enabled: enabled}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
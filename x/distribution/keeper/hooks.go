@@ -189,6 +189,17 @@ func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
 
+// pay the rewards accrued by the record to its current owner
+func (h Hooks) BeforeTokenizeShareRecordModified(ctx context.Context, recordID uint64) error {
+	record, err := h.k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.k.withdrawTokenizeShareRecordReward(ctx, record)
+	return err
+}
+
 func (h Hooks) AfterConsensusPubKeyUpdate(_ context.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
 	return nil
 }
@@ -52,6 +52,10 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegators key: delAddr | value: none, the delegators that opted in to auto-compounding
+	AutoCompoundDelegators collections.KeySet[sdk.AccAddress]
+	// AutoCompoundRound stores the progress of auto-compounding
+	AutoCompoundRound collections.Item[types.AutoCompoundRound]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -133,6 +137,8 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegators: collections.NewKeySet(sb, types.AutoCompoundDelegatorsPrefix, "auto_compound_delegators", sdk.AccAddressKey),
+		AutoCompoundRound:      collections.NewItem(sb, types.AutoCompoundRoundKey, "auto_compound_round", codec.CollValue[types.AutoCompoundRound](cdc)),
 	}
 
 	schema, err := sb.Build()
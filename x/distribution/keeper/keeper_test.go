@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
@@ -15,6 +16,7 @@ import (
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -152,3 +154,86 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	owner, other := addrs[0], addrs[1]
+	ownerStr, err := dep.accountKeeper.AddressCodec().BytesToString(owner)
+	require.NoError(t, err)
+	valStr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(sdk.ValAddress(addrs[1]))
+	require.NoError(t, err)
+
+	record := stakingtypes.NewTokenizeShareRecord(1, ownerStr, valStr)
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), record.Id).Return(record, nil).AnyTimes()
+
+	// only the owner can withdraw the rewards of the record
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, other, record.Id)
+	require.ErrorIs(t, err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	// the record delegation was fully redeemed, the rewards withdrawn by then are sent to the owner
+	rewards := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10)))
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), record.GetModuleAddress(), sdk.ValAddress(addrs[1])).Return(nil, collections.ErrNotFound)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), record.GetModuleAddress()).Return(rewards)
+	dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), record.GetModuleAddress(), owner, rewards).Return(nil)
+
+	withdrawn, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, rewards, withdrawn)
+}
+
+func TestAutoCompoundRound(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	delegators := []sdk.AccAddress{
+		sdk.AccAddress("addr1_______________"),
+		sdk.AccAddress("addr2_______________"),
+		sdk.AccAddress("addr3_______________"),
+		sdk.AccAddress("addr4_______________"),
+	}
+	for _, delAddr := range delegators {
+		require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, true))
+	}
+	// opted out delegators are not compounded
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delegators[3], false))
+
+	// auto-compounding is disabled by default
+	params := types.DefaultParams()
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "day", 1))
+	_, err := distrKeeper.AutoCompoundRound.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	params.AutoCompoundEpochIdentifier = "day"
+	params.AutoCompoundMaxDelegatorsPerEpoch = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+
+	// other epochs do not start a round
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "week", 1))
+	_, err = distrKeeper.AutoCompoundRound.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, params.AutoCompoundEpochIdentifier, 1))
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+
+	// the round stops at the per-epoch maximum and the next one resumes from there
+	round, err := distrKeeper.AutoCompoundRound.Get(ctx)
+	require.NoError(t, err)
+	nextDelegator, err := dep.accountKeeper.AddressCodec().BytesToString(delegators[2])
+	require.NoError(t, err)
+	require.Equal(t, types.AutoCompoundRound{EpochNumber: 1, NextDelegator: nextDelegator, Processed: 2}, round)
+
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, params.AutoCompoundEpochIdentifier, 2))
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+
+	round, err = distrKeeper.AutoCompoundRound.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.AutoCompoundRound{EpochNumber: 2, Processed: 1}, round)
+
+	// no round is active until the end of the next epoch
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+}
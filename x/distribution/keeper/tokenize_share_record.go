@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation of a tokenize
+// share record to its owner. It returns the amount paid to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(owner, ownerAddr) {
+		return nil, errorsmod.Wrapf(stakingtypes.ErrNotTokenizeShareRecordOwner, "record %d is owned by %s", recordID, record.Owner)
+	}
+
+	return k.withdrawTokenizeShareRecordReward(ctx, record)
+}
+
+// withdrawTokenizeShareRecordReward withdraws the rewards of the delegation of a record to its
+// account, then sends all the balances of the account to the owner of the record. The account
+// also holds the rewards withdrawn by the previous modifications of the delegation.
+func (k Keeper) withdrawTokenizeShareRecordReward(ctx context.Context, record stakingtypes.TokenizeShareRecord) (sdk.Coins, error) {
+	recordAddr := record.GetModuleAddress()
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.stakingKeeper.Delegation(ctx, recordAddr, valAddr)
+	switch {
+	case err == nil:
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+		return nil, err
+	}
+
+	err = k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawTokenizeShareReward,
+		event.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		event.NewAttribute(types.AttributeKeyRecordOwner, record.Owner),
+		event.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(record.Id, 10)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
@@ -38,6 +38,16 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_identifier is the x/epochs identifier of the epoch at the end
+	// of which the rewards of the delegators that opted in are compounded. An empty
+	// identifier disables auto-compounding.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,5,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// auto_compound_max_delegators_per_epoch is the maximum number of delegators
+	// compounded per epoch.
+	AutoCompoundMaxDelegatorsPerEpoch uint64 `protobuf:"varint,6,opt,name=auto_compound_max_delegators_per_epoch,json=autoCompoundMaxDelegatorsPerEpoch,proto3" json:"auto_compound_max_delegators_per_epoch,omitempty"`
+	// auto_compound_gas_budget_per_block is the maximum amount of gas spent
+	// compounding rewards in a single block.
+	AutoCompoundGasBudgetPerBlock uint64 `protobuf:"varint,7,opt,name=auto_compound_gas_budget_per_block,json=autoCompoundGasBudgetPerBlock,proto3" json:"auto_compound_gas_budget_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +90,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundMaxDelegatorsPerEpoch() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxDelegatorsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundGasBudgetPerBlock() uint64 {
+	if m != nil {
+		return m.AutoCompoundGasBudgetPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -637,6 +668,81 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundRound tracks the progress of auto-compounding over the set of
+// delegators that opted in.
+type AutoCompoundRound struct {
+	// epoch_number is the number of the epoch that started the round.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// active is true while the round has delegators left to process.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// next_delegator is the delegator processing resumes from. It is empty when
+	// processing starts from the first delegator.
+	NextDelegator string `protobuf:"bytes,3,opt,name=next_delegator,json=nextDelegator,proto3" json:"next_delegator,omitempty"`
+	// processed is the number of delegators processed in the round.
+	Processed uint64 `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+}
+
+func (m *AutoCompoundRound) Reset()         { *m = AutoCompoundRound{} }
+func (m *AutoCompoundRound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRound) ProtoMessage()    {}
+func (*AutoCompoundRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRound.Merge(m, src)
+}
+func (m *AutoCompoundRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRound proto.InternalMessageInfo
+
+func (m *AutoCompoundRound) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *AutoCompoundRound) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *AutoCompoundRound) GetNextDelegator() string {
+	if m != nil {
+		return m.NextDelegator
+	}
+	return ""
+}
+
+func (m *AutoCompoundRound) GetProcessed() uint64 {
+	if m != nil {
+		return m.Processed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -650,6 +756,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundRound)(nil), "cosmos.distribution.v1beta1.AutoCompoundRound")
 }
 
 func init() {
@@ -657,73 +764,85 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
+	// 1233 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	0x14, 0xce, 0xa4, 0xae, 0xd3, 0x4e, 0xd2, 0xb4, 0x9d, 0x26, 0xad, 0x9b, 0xb6, 0x4e, 0x62, 0x51,
+	0x08, 0x81, 0xd8, 0xb4, 0x95, 0x10, 0xca, 0xa5, 0x6a, 0x9c, 0x16, 0x2a, 0xb5, 0x25, 0x6c, 0x10,
+	0x48, 0x70, 0x58, 0x8d, 0x67, 0x27, 0xf6, 0x90, 0xdd, 0x99, 0x65, 0x66, 0xd6, 0x4d, 0x4e, 0x70,
+	0x2c, 0x1c, 0x80, 0x1b, 0x88, 0x53, 0x05, 0x97, 0x0a, 0x09, 0x29, 0x87, 0xfc, 0x11, 0x15, 0xa7,
+	0xaa, 0x02, 0x84, 0x38, 0x14, 0x48, 0x0f, 0x41, 0xfc, 0x0f, 0x48, 0x68, 0x7e, 0x78, 0x6d, 0x87,
+	0x80, 0x42, 0xab, 0x88, 0x8b, 0xe5, 0x79, 0xf3, 0xf6, 0xfb, 0xbe, 0xf7, 0xe6, 0xbd, 0x99, 0x07,
+	0xab, 0x44, 0xa8, 0x44, 0xa8, 0x5a, 0xc4, 0x94, 0x96, 0xac, 0x91, 0x69, 0x26, 0x78, 0xad, 0x7d,
+	0xa1, 0x41, 0x35, 0xbe, 0xd0, 0x67, 0xac, 0xa6, 0x52, 0x68, 0x81, 0xce, 0x38, 0xff, 0x6a, 0xdf,
+	0x96, 0xf7, 0x9f, 0x18, 0x6b, 0x8a, 0xa6, 0xb0, 0x7e, 0x35, 0xf3, 0xcf, 0x7d, 0x32, 0x51, 0xf6,
+	0x14, 0x0d, 0xac, 0x68, 0x0e, 0x4d, 0x04, 0xf3, 0x90, 0x13, 0xa7, 0xdd, 0x7e, 0xe8, 0x3e, 0xf4,
+	0xf8, 0x6e, 0xeb, 0x38, 0x4e, 0x18, 0x17, 0x35, 0xfb, 0xeb, 0x4c, 0x95, 0x3f, 0x0b, 0xb0, 0xb8,
+	0x84, 0x25, 0x4e, 0x14, 0x7a, 0x17, 0x1e, 0x21, 0x22, 0x49, 0x32, 0xce, 0xf4, 0x7a, 0xa8, 0xf1,
+	0x5a, 0x09, 0x4c, 0x81, 0x99, 0xc3, 0x0b, 0x2f, 0xdf, 0x7f, 0x34, 0x39, 0xf0, 0xf3, 0xa3, 0x49,
+	0x2f, 0x55, 0x45, 0xab, 0x55, 0x26, 0x6a, 0x09, 0xd6, 0xad, 0xea, 0x0d, 0xda, 0xc4, 0x64, 0x7d,
+	0x91, 0x92, 0x87, 0x9b, 0x73, 0xd0, 0x33, 0x2d, 0x52, 0x72, 0x6f, 0x7b, 0x63, 0x16, 0x04, 0x23,
+	0x39, 0xd8, 0x9b, 0x78, 0x0d, 0xbd, 0x07, 0xc7, 0x8c, 0x60, 0xa3, 0x2a, 0x15, 0x8a, 0xca, 0x50,
+	0xd2, 0xdb, 0x58, 0x46, 0xa5, 0x41, 0xcb, 0xf1, 0xca, 0x93, 0x71, 0x94, 0x40, 0x80, 0x0c, 0xea,
+	0x92, 0x07, 0x0d, 0x2c, 0x26, 0x8a, 0xe1, 0x78, 0x43, 0xf0, 0x4c, 0xfd, 0x8d, 0xec, 0xc0, 0x53,
+	0x92, 0x9d, 0xb0, 0xb0, 0x3b, 0xd8, 0x2e, 0xc2, 0xf1, 0xdb, 0x4c, 0xb7, 0x22, 0x89, 0x6f, 0x87,
+	0x38, 0x8a, 0x64, 0x48, 0x39, 0x6e, 0xc4, 0x34, 0x2a, 0x15, 0xa6, 0xc0, 0xcc, 0xa1, 0xe0, 0x44,
+	0x67, 0xf3, 0x4a, 0x14, 0xc9, 0xab, 0x6e, 0x0b, 0xd5, 0x61, 0x19, 0x67, 0x5a, 0x84, 0x44, 0x24,
+	0xa9, 0xc8, 0x78, 0x14, 0xd2, 0x54, 0x90, 0x56, 0xc8, 0x22, 0xca, 0x35, 0x5b, 0x61, 0x54, 0x96,
+	0x0e, 0x1a, 0xa9, 0xc1, 0x19, 0xe3, 0x55, 0xf7, 0x4e, 0x57, 0x8d, 0xcf, 0xf5, 0xdc, 0x05, 0xbd,
+	0x01, 0x9f, 0xed, 0x07, 0x49, 0xf0, 0x5a, 0x18, 0xd1, 0x98, 0x36, 0xb1, 0x16, 0x52, 0x85, 0x29,
+	0x95, 0x0e, 0xb7, 0x54, 0x9c, 0x02, 0x33, 0x85, 0x60, 0xba, 0x17, 0xec, 0x26, 0x5e, 0x5b, 0xcc,
+	0x5d, 0x97, 0xa8, 0xb4, 0xe0, 0xe8, 0x3a, 0xac, 0xf4, 0x43, 0x36, 0xb1, 0x0a, 0x1b, 0x59, 0xd4,
+	0xa4, 0xda, 0xc2, 0x35, 0x62, 0x41, 0x56, 0x4b, 0x43, 0x16, 0xee, 0x5c, 0x2f, 0xdc, 0xab, 0x58,
+	0x2d, 0x58, 0xb7, 0x25, 0x2a, 0x17, 0x8c, 0xd3, 0xfc, 0xf9, 0x8f, 0xb7, 0x37, 0x66, 0xa7, 0x5c,
+	0x0e, 0xe7, 0x54, 0xb4, 0x5a, 0x5b, 0xeb, 0x6f, 0x0a, 0x57, 0x74, 0x95, 0x1f, 0x01, 0x9c, 0x78,
+	0x0b, 0xc7, 0x2c, 0x32, 0x42, 0x5e, 0x63, 0x4a, 0x0b, 0xc9, 0x08, 0x8e, 0x5d, 0x6e, 0x15, 0xfa,
+	0x04, 0xc0, 0x53, 0x24, 0x4b, 0xb2, 0x18, 0x6b, 0xd6, 0xa6, 0xfe, 0x1c, 0x43, 0x89, 0x35, 0x13,
+	0x25, 0x30, 0x75, 0x60, 0x66, 0xf8, 0xe2, 0x59, 0xdf, 0x72, 0x55, 0x53, 0x08, 0x9d, 0xd6, 0x31,
+	0x87, 0x56, 0x17, 0x8c, 0xbb, 0xb3, 0xfe, 0xe6, 0x97, 0xc9, 0x17, 0x9a, 0x4c, 0xb7, 0xb2, 0x46,
+	0x95, 0x88, 0xc4, 0xb7, 0x44, 0xad, 0x47, 0x9a, 0x5e, 0x4f, 0xa9, 0xea, 0x7c, 0xa3, 0x5c, 0xf9,
+	0x8e, 0x77, 0x69, 0x9d, 0x98, 0xc0, 0x90, 0xa2, 0xe7, 0xe0, 0x51, 0x49, 0x57, 0xa8, 0xa4, 0x9c,
+	0xd0, 0x90, 0x88, 0x8c, 0x6b, 0x5b, 0xc2, 0x47, 0x82, 0xd1, 0xdc, 0x5c, 0x37, 0xd6, 0xca, 0xd7,
+	0x00, 0x9e, 0xca, 0x03, 0xab, 0x67, 0x52, 0x52, 0xae, 0x3b, 0x51, 0xa5, 0x70, 0xc8, 0x45, 0xa2,
+	0xf6, 0x39, 0x88, 0x0e, 0x0d, 0x3a, 0x09, 0x8b, 0x29, 0x95, 0x4c, 0xb8, 0x86, 0x2b, 0x04, 0x7e,
+	0x55, 0xf9, 0x02, 0xc0, 0x72, 0xae, 0xf2, 0x0a, 0xf1, 0x31, 0xd3, 0xa8, 0x2e, 0x92, 0x84, 0x29,
+	0xc5, 0x04, 0x47, 0x6d, 0x08, 0x49, 0xbe, 0xda, 0x67, 0xbd, 0x3d, 0x4c, 0x95, 0x4f, 0x01, 0x3c,
+	0x93, 0x4b, 0x7b, 0x3d, 0xd3, 0x4a, 0x63, 0x1e, 0x31, 0xde, 0xfc, 0xdf, 0x92, 0x68, 0x14, 0x9d,
+	0xc8, 0x15, 0x2d, 0xc7, 0x58, 0xb5, 0xae, 0xb6, 0x29, 0xd7, 0xe8, 0x79, 0x78, 0xac, 0xdd, 0x31,
+	0x87, 0x3e, 0xcd, 0xc0, 0xa6, 0xf9, 0x68, 0x6e, 0x5f, 0xb2, 0x66, 0x74, 0x13, 0x1e, 0x5a, 0x91,
+	0x98, 0x98, 0x0e, 0xf0, 0x57, 0xdf, 0x85, 0xff, 0x7c, 0x1b, 0x05, 0x39, 0x44, 0xe5, 0x23, 0x00,
+	0xc7, 0x76, 0x51, 0xa4, 0xd0, 0xfb, 0xf0, 0x64, 0x57, 0x92, 0x32, 0x1b, 0x21, 0xb5, 0x3b, 0x3e,
+	0x57, 0x2f, 0x55, 0xff, 0xe5, 0xe1, 0xa9, 0xee, 0x02, 0xb9, 0x70, 0xd8, 0xe8, 0x74, 0x09, 0x19,
+	0x6b, 0xef, 0x42, 0x59, 0xf9, 0x70, 0x10, 0x0e, 0x5d, 0xa3, 0x74, 0x49, 0x88, 0x18, 0x7d, 0x00,
+	0x47, 0xbb, 0x4f, 0x49, 0x2a, 0x44, 0xbc, 0xa7, 0x23, 0x9a, 0x7f, 0xd2, 0x23, 0x2a, 0x81, 0xa0,
+	0xfb, 0x74, 0x59, 0x01, 0x1a, 0x8e, 0x44, 0x94, 0xb0, 0x04, 0xc7, 0x8e, 0x7e, 0x70, 0x0f, 0xf4,
+	0x97, 0x9e, 0x80, 0x3e, 0x18, 0xf6, 0x34, 0x86, 0xb5, 0xf2, 0xf9, 0x20, 0x9c, 0xa8, 0xf7, 0xea,
+	0x58, 0x4e, 0x29, 0x8f, 0xdc, 0x7b, 0x81, 0x63, 0x34, 0x06, 0x0f, 0x6a, 0xa6, 0x63, 0xea, 0x1e,
+	0xd6, 0xc0, 0x2d, 0xd0, 0x14, 0x1c, 0x8e, 0xa8, 0x22, 0x92, 0xa5, 0xdd, 0xaa, 0x08, 0x7a, 0x4d,
+	0xe8, 0x2c, 0x3c, 0x2c, 0x29, 0x61, 0x29, 0xa3, 0x5c, 0xbb, 0x37, 0x2c, 0xe8, 0x1a, 0xd0, 0x3a,
+	0x2c, 0xe2, 0xc4, 0x5e, 0x44, 0x05, 0x1b, 0xe4, 0xe9, 0x5d, 0x83, 0xb4, 0x11, 0x5e, 0xf3, 0x11,
+	0xce, 0xec, 0x21, 0x42, 0x1b, 0xde, 0x97, 0xdb, 0x1b, 0xb3, 0x23, 0xb1, 0x2d, 0xc3, 0x90, 0x74,
+	0x3b, 0xc2, 0x13, 0xce, 0xcf, 0xdc, 0xb9, 0x3b, 0x39, 0xf0, 0xfb, 0xdd, 0xc9, 0x81, 0xef, 0x36,
+	0xe7, 0x26, 0x3c, 0x6b, 0x53, 0xb4, 0x7b, 0x48, 0xb9, 0x36, 0x9a, 0x41, 0xe5, 0x7b, 0x00, 0xc7,
+	0xf3, 0xf7, 0x66, 0x59, 0x63, 0xa9, 0x19, 0x6f, 0x5e, 0xe7, 0x2b, 0xf6, 0x42, 0x4d, 0x25, 0x6d,
+	0x33, 0x91, 0xa9, 0xfe, 0xde, 0x19, 0xed, 0x98, 0x7d, 0xeb, 0xdc, 0x80, 0x07, 0x95, 0xc6, 0xab,
+	0xb4, 0x34, 0xf8, 0x54, 0x63, 0x89, 0x03, 0x41, 0x8b, 0xb0, 0xd8, 0xa2, 0xac, 0xd9, 0x72, 0x09,
+	0x2d, 0x2c, 0xbc, 0xf8, 0xc7, 0xa3, 0xc9, 0xa3, 0x44, 0x52, 0x73, 0xc9, 0xf3, 0xd0, 0x6d, 0x7d,
+	0xb5, 0xbd, 0x31, 0xbb, 0xd3, 0xe6, 0x13, 0xe0, 0x16, 0x95, 0xdf, 0x00, 0x3c, 0xed, 0xc3, 0x62,
+	0x82, 0xe7, 0x01, 0xfa, 0xc9, 0xe0, 0x16, 0x3c, 0xde, 0x6d, 0x42, 0x33, 0x1a, 0x50, 0xa5, 0xfc,
+	0x50, 0x35, 0xfd, 0x70, 0x73, 0xee, 0x9c, 0x97, 0xd6, 0xbd, 0x7f, 0x9d, 0xcb, 0xb2, 0x96, 0xe6,
+	0x9a, 0x3b, 0xd6, 0xde, 0x61, 0x47, 0x1c, 0x16, 0xf3, 0xa9, 0x69, 0x3f, 0x2f, 0x3c, 0xcf, 0x32,
+	0x5f, 0x30, 0xc7, 0x5b, 0xf9, 0x01, 0xc0, 0xf3, 0xff, 0x5c, 0xd4, 0x6f, 0x33, 0xdd, 0x5a, 0xa4,
+	0xa9, 0x50, 0x4c, 0xef, 0x53, 0x7d, 0x9f, 0xec, 0xa9, 0x6f, 0xb3, 0xe5, 0x57, 0xa8, 0x04, 0x87,
+	0x22, 0x47, 0xec, 0x87, 0xa5, 0xce, 0x72, 0xfe, 0x99, 0x3b, 0x7b, 0x29, 0xc9, 0x6f, 0x01, 0x3c,
+	0x7e, 0xa5, 0x67, 0x84, 0x09, 0xcc, 0x0f, 0x9a, 0x86, 0x23, 0x6e, 0x16, 0xe3, 0x59, 0xd2, 0xa0,
+	0xd2, 0x86, 0x72, 0x20, 0x18, 0xb6, 0xb6, 0x5b, 0xd6, 0x64, 0x05, 0x11, 0x33, 0x17, 0xd8, 0x58,
+	0x0e, 0x05, 0x7e, 0x85, 0x2e, 0xc3, 0x51, 0x4e, 0xd7, 0x74, 0x77, 0x04, 0xf3, 0xf3, 0x66, 0xe9,
+	0xe1, 0xe6, 0xdc, 0x98, 0x97, 0xd2, 0x7f, 0xc4, 0x47, 0x8c, 0x7f, 0x5e, 0x35, 0x26, 0x0f, 0xa9,
+	0x14, 0x84, 0x2a, 0xe5, 0xa7, 0xc7, 0x42, 0xd0, 0x35, 0x2c, 0x5c, 0xbe, 0xb7, 0x55, 0x06, 0xf7,
+	0xb7, 0xca, 0xe0, 0xc1, 0x56, 0x19, 0xfc, 0xba, 0x55, 0x06, 0x9f, 0x3d, 0x2e, 0x0f, 0x3c, 0x78,
+	0x5c, 0x1e, 0xf8, 0xe9, 0x71, 0x79, 0xe0, 0x9d, 0xe9, 0xbe, 0x36, 0xd8, 0x31, 0x6b, 0xd9, 0x43,
+	0x6e, 0x14, 0xed, 0xc4, 0x7f, 0xe9, 0xaf, 0x01, 0x00, 0x44, 0xc5, 0x96, 0xb4, 0xa4, 0x0c, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +876,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.AutoCompoundMaxDelegatorsPerEpoch != that1.AutoCompoundMaxDelegatorsPerEpoch {
+		return false
+	}
+	if this.AutoCompoundGasBudgetPerBlock != that1.AutoCompoundGasBudgetPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1072,6 +1200,39 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *AutoCompoundRound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundRound)
+	if !ok {
+		that2, ok := that.(AutoCompoundRound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.Active != that1.Active {
+		return false
+	}
+	if this.NextDelegator != that1.NextDelegator {
+		return false
+	}
+	if this.Processed != that1.Processed {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1092,6 +1253,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundGasBudgetPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundGasBudgetPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompoundMaxDelegatorsPerEpoch != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxDelegatorsPerEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1622,6 +1800,56 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Processed != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Processed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextDelegator) > 0 {
+		i -= len(m.NextDelegator)
+		copy(dAtA[i:], m.NextDelegator)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextDelegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1648,6 +1876,16 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.AutoCompoundMaxDelegatorsPerEpoch != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxDelegatorsPerEpoch))
+	}
+	if m.AutoCompoundGasBudgetPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundGasBudgetPerBlock))
+	}
 	return n
 }
 
@@ -1859,6 +2097,28 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovDistribution(uint64(m.EpochNumber))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.NextDelegator)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Processed != 0 {
+		n += 1 + sovDistribution(uint64(m.Processed))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2016,6 +2276,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxDelegatorsPerEpoch", wireType)
+			}
+			m.AutoCompoundMaxDelegatorsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxDelegatorsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasBudgetPerBlock", wireType)
+			}
+			m.AutoCompoundGasBudgetPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundGasBudgetPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3344,6 +3674,146 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDelegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextDelegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			m.Processed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,7 +9,16 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeAutoCompound                = "auto_compound"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyRecordOwner     = "record_owner"
+	AttributeKeyRecordID        = "record_id"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyCompounded      = "compounded"
+	AttributeKeyEpochNumber     = "epoch_number"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
@@ -30,6 +32,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegators:          []string{},
 	}
 }
 
@@ -38,5 +41,14 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seen := make(map[string]struct{}, len(gs.AutoCompoundDelegators))
+	for _, delegator := range gs.AutoCompoundDelegators {
+		if _, ok := seen[delegator]; ok {
+			return fmt.Errorf("duplicate auto-compound delegator %s", delegator)
+		}
+		seen[delegator] = struct{}{}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -13,6 +13,8 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr string) *MsgSetWithdrawAddress {
@@ -35,6 +37,24 @@ func NewMsgWithdrawValidatorCommission(valAddr string) *MsgWithdrawValidatorComm
 	}
 }
 
+// NewMsgWithdrawTokenizeShareRecordReward returns a new MsgWithdrawTokenizeShareRecordReward
+// with the owner of the record and its id.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr string, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+		RecordId:     recordID,
+	}
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound opting the delegator
+// in or out of auto-compounding.
+func NewMsgSetAutoCompound(delAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
+
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
 // a funding amount.
 func NewMsgFundCommunityPool(amount sdk.Coins, depositor string) *MsgFundCommunityPool {
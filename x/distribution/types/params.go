@@ -7,19 +7,50 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default auto-compounding parameters. Auto-compounding is disabled until an
+// epoch identifier is set.
+const (
+	DefaultAutoCompoundEpochIdentifier       = ""
+	DefaultAutoCompoundMaxDelegatorsPerEpoch = uint64(1000)
+	DefaultAutoCompoundGasBudgetPerBlock     = uint64(10_000_000)
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:                      math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:                math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:               math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:               true,
+		AutoCompoundEpochIdentifier:       DefaultAutoCompoundEpochIdentifier,
+		AutoCompoundMaxDelegatorsPerEpoch: DefaultAutoCompoundMaxDelegatorsPerEpoch,
+		AutoCompoundGasBudgetPerBlock:     DefaultAutoCompoundGasBudgetPerBlock,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompound(p.AutoCompoundEpochIdentifier, p.AutoCompoundMaxDelegatorsPerEpoch, p.AutoCompoundGasBudgetPerBlock)
+}
+
+// validateAutoCompound checks that the auto-compounding limits are set when
+// auto-compounding is enabled.
+func validateAutoCompound(epochIdentifier string, maxDelegators, gasBudget uint64) error {
+	if epochIdentifier == "" {
+		return nil
+	}
+	if maxDelegators == 0 {
+		return errors.New("auto-compound max delegators per epoch must be positive")
+	}
+	if gasBudget == 0 {
+		return errors.New("auto-compound gas budget per block must be positive")
+	}
+
+	return nil
 }
 
 func validateCommunityTax(i interface{}) error {
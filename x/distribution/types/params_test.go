@@ -45,5 +45,35 @@ func TestParams_ValidateBasic(t *testing.T) {
 }
 
 func TestDefaultParams(t *testing.T) {
-	require.NoError(t, types.DefaultParams().ValidateBasic())
+	params := types.DefaultParams()
+	require.NoError(t, params.ValidateBasic())
+	// auto-compounding is opt-in
+	require.Empty(t, params.AutoCompoundEpochIdentifier)
+}
+
+func TestParams_ValidateBasicAutoCompound(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(p *types.Params)
+		wantErr  bool
+	}{
+		{"enabled", func(p *types.Params) {}, false},
+		{"disabled without limits", func(p *types.Params) {
+			p.AutoCompoundEpochIdentifier = ""
+			p.AutoCompoundMaxDelegatorsPerEpoch = 0
+			p.AutoCompoundGasBudgetPerBlock = 0
+		}, false},
+		{"zero max delegators", func(p *types.Params) { p.AutoCompoundMaxDelegatorsPerEpoch = 0 }, true},
+		{"zero gas budget", func(p *types.Params) { p.AutoCompoundGasBudgetPerBlock = 0 }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			p.AutoCompoundEpochIdentifier = "day"
+			tt.malleate(&p)
+			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
 }
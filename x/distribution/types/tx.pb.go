@@ -285,6 +285,84 @@ func (m *MsgWithdrawValidatorCommissionResponse) GetAmount() github_com_cosmos_c
 	return nil
 }
 
+// MsgSetAutoCompound opts a delegator in or out of auto-compounding. At the end
+// of the configured epoch the rewards of the delegator are withdrawn and the
+// staking denom portion is re-delegated to the same validators.
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{6}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{7}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 // MsgFundCommunityPool allows an account to directly
 // fund the community pool.
 //
@@ -300,7 +378,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{6}
+	return fileDescriptor_ed4f433d965e58ca, []int{8}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +419,7 @@ func (m *MsgFundCommunityPoolResponse) Reset()         { *m = MsgFundCommunityPo
 func (m *MsgFundCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPoolResponse) ProtoMessage()    {}
 func (*MsgFundCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{7}
+	return fileDescriptor_ed4f433d965e58ca, []int{9}
 }
 func (m *MsgFundCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +462,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{8}
+	return fileDescriptor_ed4f433d965e58ca, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +514,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{9}
+	return fileDescriptor_ed4f433d965e58ca, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,7 +557,7 @@ func (m *MsgCommunityPoolSpend) Reset()         { *m = MsgCommunityPoolSpend{} }
 func (m *MsgCommunityPoolSpend) String() string { return proto.CompactTextString(m) }
 func (*MsgCommunityPoolSpend) ProtoMessage()    {}
 func (*MsgCommunityPoolSpend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{10}
+	return fileDescriptor_ed4f433d965e58ca, []int{12}
 }
 func (m *MsgCommunityPoolSpend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +620,7 @@ func (m *MsgCommunityPoolSpendResponse) Reset()         { *m = MsgCommunityPoolS
 func (m *MsgCommunityPoolSpendResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCommunityPoolSpendResponse) ProtoMessage()    {}
 func (*MsgCommunityPoolSpendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{11}
+	return fileDescriptor_ed4f433d965e58ca, []int{13}
 }
 func (m *MsgCommunityPoolSpendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +661,7 @@ func (m *MsgDepositValidatorRewardsPool) Reset()         { *m = MsgDepositValida
 func (m *MsgDepositValidatorRewardsPool) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositValidatorRewardsPool) ProtoMessage()    {}
 func (*MsgDepositValidatorRewardsPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{12}
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
 }
 func (m *MsgDepositValidatorRewardsPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +701,7 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Reset() {
 func (m *MsgDepositValidatorRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositValidatorRewardsPoolResponse) ProtoMessage()    {}
 func (*MsgDepositValidatorRewardsPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{13}
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
 }
 func (m *MsgDepositValidatorRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,6 +730,96 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// of a tokenize share record to its owner.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	RecordId     uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -659,6 +827,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.distribution.v1beta1.MsgUpdateParams")
@@ -667,6 +837,8 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 }
 
 func init() {
@@ -674,71 +846,81 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x22, 0x22, 0xd3, 0x4a, 0x69, 0xb6, 0x29, 0x71, 0x37, 0xed, 0x3a, 0xdd, 0x42,
-	0x88, 0x22, 0xb2, 0x9b, 0x84, 0xa6, 0x15, 0x46, 0xa8, 0x22, 0x29, 0x91, 0x38, 0x6c, 0xa9, 0x1c,
-	0x01, 0x82, 0x4b, 0xb4, 0xf6, 0x2e, 0x9b, 0x51, 0xb3, 0x3b, 0xab, 0x9d, 0x71, 0x5c, 0xdf, 0x10,
-	0x42, 0xa2, 0x42, 0x1c, 0x90, 0x38, 0xd1, 0x0b, 0x3d, 0x56, 0x9c, 0x2c, 0x61, 0x89, 0xfe, 0x01,
-	0x1c, 0xaa, 0x9e, 0x2a, 0x9f, 0x10, 0x07, 0xa8, 0x9c, 0x83, 0x91, 0xf8, 0x07, 0x38, 0x21, 0xb4,
-	0xbb, 0xb3, 0x6b, 0xef, 0x0f, 0xef, 0xda, 0x21, 0x02, 0x2e, 0xb6, 0x35, 0xf3, 0xbe, 0x37, 0xdf,
-	0x7c, 0xf3, 0xcd, 0x7b, 0x63, 0xf8, 0x72, 0x1d, 0x13, 0x13, 0x13, 0x59, 0x43, 0x84, 0x3a, 0xa8,
-	0xd6, 0xa0, 0x08, 0x5b, 0xf2, 0xd1, 0x46, 0x4d, 0xa7, 0xea, 0x86, 0x4c, 0xef, 0x49, 0xb6, 0x83,
-	0x29, 0xe6, 0x16, 0xfd, 0x28, 0x69, 0x38, 0x4a, 0x62, 0x51, 0xfc, 0xbc, 0x81, 0x0d, 0xec, 0xc5,
-	0xc9, 0xee, 0x2f, 0x1f, 0xc2, 0x0b, 0x2c, 0x71, 0x4d, 0x25, 0x7a, 0x98, 0xb0, 0x8e, 0x91, 0xc5,
-	0xe6, 0x2f, 0xfa, 0xf3, 0xfb, 0x3e, 0x90, 0xe5, 0xf7, 0xa7, 0x16, 0x18, 0xd4, 0x24, 0x86, 0x7c,
-	0xb4, 0xe1, 0x7e, 0xb1, 0x89, 0x39, 0xd5, 0x44, 0x16, 0x96, 0xbd, 0x4f, 0x36, 0x24, 0x65, 0xf1,
-	0x8f, 0xd0, 0xf5, 0xe2, 0xc5, 0x3f, 0x00, 0xbc, 0xa0, 0x10, 0x63, 0x4f, 0xa7, 0x1f, 0x22, 0x7a,
-	0xa0, 0x39, 0x6a, 0xf3, 0x6d, 0x4d, 0x73, 0x74, 0x42, 0xb8, 0x77, 0xe0, 0x9c, 0xa6, 0x1f, 0xea,
-	0x86, 0x4a, 0xb1, 0xb3, 0xaf, 0xfa, 0x83, 0x25, 0xb0, 0x04, 0x56, 0x66, 0xb6, 0x4b, 0xdd, 0xce,
-	0xda, 0x3c, 0xa3, 0xc8, 0xc2, 0xf7, 0xa8, 0x83, 0x2c, 0xa3, 0x7a, 0x2e, 0x84, 0x04, 0x69, 0x76,
-	0xe0, 0xb9, 0x26, 0xcb, 0x1c, 0x66, 0x29, 0xe6, 0x64, 0x99, 0x6d, 0x46, 0xb9, 0x54, 0x76, 0xef,
-	0x3f, 0x2c, 0x17, 0x7e, 0x7f, 0x58, 0x2e, 0x7c, 0xd6, 0x6f, 0xaf, 0x26, 0x69, 0x7d, 0xd9, 0x6f,
-	0xaf, 0x5e, 0xf5, 0x33, 0xad, 0x11, 0xed, 0xae, 0xac, 0x10, 0x43, 0xc1, 0x1a, 0xfa, 0xa4, 0x15,
-	0xdb, 0x93, 0x58, 0x86, 0x97, 0x53, 0x37, 0x5b, 0xd5, 0x89, 0x8d, 0x2d, 0xa2, 0x8b, 0x7f, 0x01,
-	0xc8, 0x2b, 0xc4, 0x08, 0xa6, 0x6f, 0x05, 0x2b, 0x55, 0xf5, 0xa6, 0xea, 0x68, 0xa7, 0xa5, 0xc9,
-	0x6d, 0x38, 0x77, 0xa4, 0x1e, 0x22, 0x2d, 0x92, 0xc6, 0x17, 0xe5, 0x4a, 0xb7, 0xb3, 0x76, 0x99,
-	0xa5, 0xf9, 0x20, 0x88, 0x89, 0xe5, 0x3b, 0x8a, 0x8d, 0x57, 0xde, 0xcd, 0x97, 0x67, 0x39, 0x2a,
-	0x4f, 0x6c, 0x83, 0x08, 0x5b, 0xfe, 0x0e, 0xc5, 0x1f, 0x00, 0x14, 0x47, 0x0b, 0x10, 0xe8, 0xc4,
-	0x7d, 0x05, 0xe0, 0xb4, 0x6a, 0xe2, 0x86, 0x45, 0x4b, 0x60, 0x69, 0x6a, 0xe5, 0xcc, 0xe6, 0x45,
-	0x66, 0x3c, 0xc9, 0xf5, 0x77, 0x70, 0x15, 0xa4, 0x1d, 0x8c, 0xac, 0xed, 0x8f, 0x9e, 0xfc, 0x5a,
-	0x2e, 0x7c, 0xff, 0x5b, 0x79, 0xc5, 0x40, 0xf4, 0xa0, 0x51, 0x93, 0xea, 0xd8, 0x64, 0xfe, 0x96,
-	0x87, 0x48, 0xd1, 0x96, 0xad, 0x13, 0x0f, 0x40, 0x7e, 0xe9, 0xac, 0xcd, 0x0e, 0x66, 0x96, 0xd6,
-	0xa5, 0x6b, 0x37, 0x1e, 0xf4, 0xdb, 0xab, 0x67, 0x5d, 0x2a, 0xf5, 0xd6, 0xbe, 0x7b, 0x69, 0xc8,
-	0xa3, 0x7e, 0x7b, 0x15, 0x54, 0x19, 0x07, 0xf1, 0x31, 0x80, 0xc2, 0x10, 0xeb, 0x50, 0xb8, 0x1d,
-	0x6c, 0x9a, 0x88, 0x10, 0x84, 0xad, 0x74, 0xcd, 0xc1, 0xc9, 0x35, 0x8f, 0x5a, 0x32, 0x91, 0x3a,
-	0xc5, 0x92, 0x43, 0xec, 0x06, 0xbc, 0xc4, 0x1f, 0x01, 0x5c, 0xce, 0xa6, 0xfe, 0x7f, 0x15, 0xfd,
-	0x41, 0x11, 0xce, 0x2b, 0xc4, 0xd8, 0x6d, 0x58, 0x9a, 0x4b, 0xb6, 0x61, 0x21, 0xda, 0xba, 0x83,
-	0xf1, 0x21, 0xd7, 0x1a, 0x9f, 0xe6, 0xee, 0xa4, 0x34, 0xb3, 0x39, 0x71, 0xd7, 0xe1, 0x8c, 0xa6,
-	0xdb, 0x98, 0x20, 0x8a, 0x9d, 0xdc, 0x32, 0x33, 0x08, 0xad, 0xbc, 0x17, 0x9c, 0x66, 0x37, 0x2e,
-	0xc3, 0xd6, 0xba, 0x7b, 0xc0, 0x83, 0x50, 0xf7, 0x60, 0xcb, 0xd1, 0x83, 0x4d, 0x28, 0x50, 0x02,
-	0xe2, 0x16, 0xbc, 0x94, 0x36, 0x13, 0x9c, 0x65, 0xe5, 0x42, 0xca, 0x42, 0x25, 0x20, 0x3e, 0x07,
-	0x70, 0x56, 0x21, 0xc6, 0xfb, 0xb6, 0xa6, 0x52, 0xfd, 0x8e, 0xea, 0xa8, 0x26, 0x71, 0xf7, 0xa4,
-	0x36, 0xe8, 0x01, 0x76, 0x10, 0x6d, 0xe5, 0x16, 0x9b, 0x41, 0x28, 0xb7, 0x0b, 0xa7, 0x6d, 0x2f,
-	0x83, 0x27, 0xc4, 0x99, 0xcd, 0xab, 0x52, 0x46, 0xd7, 0x92, 0xfc, 0xc5, 0xb6, 0x67, 0xdc, 0x03,
-	0x61, 0x9a, 0xfa, 0xe8, 0x8a, 0xd2, 0x4d, 0x5a, 0xc3, 0xd3, 0x24, 0x5c, 0xca, 0xd5, 0xe4, 0xd5,
-	0x21, 0x4d, 0x22, 0xcd, 0x27, 0xb6, 0x1d, 0x51, 0x82, 0x0b, 0xb1, 0xa1, 0x50, 0x94, 0xf3, 0x29,
-	0x2b, 0x89, 0x8f, 0x8b, 0x5e, 0x87, 0x8a, 0xc8, 0xb8, 0x67, 0xeb, 0x96, 0x76, 0x62, 0x61, 0x2e,
-	0xc1, 0x19, 0x47, 0xaf, 0x23, 0x1b, 0xe9, 0x16, 0xf5, 0x4d, 0x52, 0x1d, 0x0c, 0x0c, 0xb9, 0x77,
-	0xea, 0x5f, 0x76, 0x6f, 0xe5, 0xf6, 0x28, 0xf7, 0x45, 0x94, 0x5e, 0x8e, 0x2b, 0x2d, 0xa7, 0xca,
-	0x53, 0x02, 0xe2, 0x75, 0xaf, 0xdd, 0x25, 0xa7, 0xf2, 0x5c, 0xf8, 0x67, 0xd1, 0x2b, 0xa7, 0xb7,
-	0x7c, 0xcf, 0x87, 0x25, 0xc9, 0xef, 0x01, 0xc4, 0xbb, 0xe3, 0x91, 0x8b, 0x06, 0xc6, 0xbe, 0x68,
-	0xa7, 0xdd, 0xfa, 0xfe, 0xcb, 0xd3, 0xda, 0x9b, 0xa8, 0x66, 0xbc, 0x92, 0x76, 0x6a, 0x03, 0x85,
-	0x99, 0xb6, 0xe2, 0x5b, 0x5e, 0x37, 0xc8, 0x50, 0x3e, 0xe3, 0xb2, 0x6c, 0xad, 0x6f, 0xfe, 0xf4,
-	0x22, 0x9c, 0x52, 0x88, 0xc1, 0x7d, 0x0e, 0x20, 0x97, 0xf2, 0xa6, 0xdb, 0xcc, 0x2c, 0x01, 0xa9,
-	0x4f, 0x23, 0xbe, 0x32, 0x39, 0x26, 0xec, 0x58, 0xdf, 0x00, 0xb8, 0x30, 0xea, 0x2d, 0x75, 0x23,
-	0x2f, 0xef, 0x08, 0x20, 0x7f, 0xf3, 0x84, 0xc0, 0x90, 0xd5, 0x77, 0x00, 0x2e, 0x66, 0x3d, 0x15,
-	0xde, 0x1c, 0x77, 0x81, 0x14, 0x30, 0xbf, 0xf3, 0x0f, 0xc0, 0x21, 0xc3, 0x6f, 0x01, 0x9c, 0x4b,
-	0xf6, 0xd5, 0x8d, 0xbc, 0xd4, 0x09, 0x08, 0xff, 0xc6, 0xc4, 0x90, 0xf0, 0x29, 0xfc, 0xd2, 0xd3,
-	0xa4, 0xbf, 0xee, 0x17, 0x01, 0xf7, 0x05, 0x80, 0x67, 0x23, 0xfd, 0xe9, 0xb5, 0xbc, 0x35, 0x86,
-	0xa3, 0xf9, 0x6b, 0x93, 0x44, 0x87, 0x64, 0xce, 0x3f, 0x4d, 0x76, 0x06, 0x57, 0x25, 0x2e, 0xa5,
-	0x2d, 0xe4, 0x9a, 0x3c, 0x89, 0xc9, 0x37, 0xf9, 0xe8, 0x22, 0x9a, 0xc2, 0x6d, 0x6b, 0x9d, 0xeb,
-	0x00, 0xb8, 0x98, 0x55, 0x3f, 0x73, 0x3d, 0x96, 0x01, 0xce, 0xf7, 0xd8, 0x18, 0xf5, 0x23, 0x95,
-	0x36, 0xff, 0xc2, 0xa7, 0x6e, 0x89, 0xdb, 0xbe, 0xf9, 0xa8, 0x27, 0x80, 0x27, 0x3d, 0x01, 0x3c,
-	0xeb, 0x09, 0xe0, 0x79, 0x4f, 0x00, 0x5f, 0x1f, 0x0b, 0x85, 0x67, 0xc7, 0x42, 0xe1, 0xe7, 0x63,
-	0xa1, 0xf0, 0xf1, 0x15, 0x1f, 0x45, 0xb4, 0xbb, 0x12, 0xc2, 0xf2, 0xbd, 0x68, 0xbb, 0xf7, 0xea,
-	0x67, 0x6d, 0xda, 0xfb, 0x77, 0xf9, 0xfa, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xd3, 0xe1,
-	0xa5, 0x4f, 0x0f, 0x00, 0x00,
+	// 1176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xdf, 0x49, 0x20, 0x34, 0xd3, 0xa0, 0x34, 0x6e, 0x4a, 0xb6, 0x4e, 0xb2, 0x9b, 0xb8, 0x21,
+	0x44, 0x51, 0x63, 0x27, 0xa1, 0x69, 0x84, 0x51, 0x55, 0x92, 0x2d, 0x91, 0x72, 0xd8, 0x52, 0x6d,
+	0xf8, 0x10, 0x5c, 0x22, 0xef, 0x7a, 0x70, 0x46, 0xc9, 0x7a, 0x56, 0x1e, 0x6f, 0xd2, 0x85, 0x0b,
+	0x42, 0x48, 0x54, 0x88, 0x03, 0x12, 0x27, 0x7a, 0xa1, 0xc7, 0xc2, 0x29, 0x88, 0x48, 0xed, 0x7f,
+	0x40, 0xd5, 0x53, 0x95, 0x13, 0xe2, 0x00, 0x55, 0x72, 0x08, 0x12, 0x67, 0x24, 0x4e, 0x08, 0xd9,
+	0x63, 0x7b, 0xfd, 0xb5, 0xf6, 0x6e, 0x12, 0xd1, 0x5e, 0xf2, 0x31, 0xf3, 0x7e, 0xef, 0xfd, 0xe6,
+	0x37, 0xef, 0xcd, 0x7b, 0xbb, 0x70, 0xa2, 0x42, 0x68, 0x95, 0x50, 0x49, 0xc5, 0xd4, 0x34, 0x70,
+	0xb9, 0x6e, 0x62, 0xa2, 0x4b, 0xdb, 0x73, 0x65, 0x64, 0x2a, 0x73, 0x92, 0x79, 0x5b, 0xac, 0x19,
+	0xc4, 0x24, 0xdc, 0x30, 0xb3, 0x12, 0xfd, 0x56, 0xa2, 0x63, 0xc5, 0x0f, 0x6a, 0x44, 0x23, 0xb6,
+	0x9d, 0x64, 0xfd, 0xc5, 0x20, 0x7c, 0xce, 0x71, 0x5c, 0x56, 0x28, 0xf2, 0x1c, 0x56, 0x08, 0xd6,
+	0x9d, 0xfd, 0x8b, 0x6c, 0x7f, 0x9d, 0x01, 0x1d, 0xff, 0x6c, 0x6b, 0xc8, 0x81, 0x56, 0xa9, 0x26,
+	0x6d, 0xcf, 0x59, 0xbf, 0x9c, 0x8d, 0x01, 0xa5, 0x8a, 0x75, 0x22, 0xd9, 0x3f, 0x9d, 0x25, 0x31,
+	0x89, 0x7f, 0x80, 0xae, 0x6d, 0x2f, 0xfc, 0x05, 0xe0, 0x85, 0x22, 0xd5, 0xd6, 0x90, 0xf9, 0x01,
+	0x36, 0x37, 0x54, 0x43, 0xd9, 0x59, 0x52, 0x55, 0x03, 0x51, 0xca, 0xbd, 0x0d, 0x07, 0x54, 0xb4,
+	0x85, 0x34, 0xc5, 0x24, 0xc6, 0xba, 0xc2, 0x16, 0xb3, 0x60, 0x0c, 0x4c, 0xf5, 0x2e, 0x67, 0xf7,
+	0xf7, 0x66, 0x06, 0x1d, 0x8a, 0x8e, 0xf9, 0x9a, 0x69, 0x60, 0x5d, 0x2b, 0x9d, 0xf3, 0x20, 0xae,
+	0x9b, 0x02, 0x3c, 0xb7, 0xe3, 0x78, 0xf6, 0xbc, 0x74, 0xa5, 0x78, 0xe9, 0xdf, 0x09, 0x72, 0x91,
+	0x57, 0xee, 0xdc, 0xcb, 0x67, 0xfe, 0xbc, 0x97, 0xcf, 0x7c, 0x7e, 0xb4, 0x3b, 0x1d, 0xa5, 0xf5,
+	0xd5, 0xd1, 0xee, 0xf4, 0x25, 0xe6, 0x69, 0x86, 0xaa, 0x9b, 0x52, 0x91, 0x6a, 0x45, 0xa2, 0xe2,
+	0x8f, 0x1b, 0xa1, 0x33, 0x09, 0x79, 0x38, 0x1a, 0x7b, 0xd8, 0x12, 0xa2, 0x35, 0xa2, 0x53, 0x24,
+	0xfc, 0x0b, 0x20, 0x5f, 0xa4, 0x9a, 0xbb, 0x7d, 0xc3, 0x8d, 0x54, 0x42, 0x3b, 0x8a, 0xa1, 0x9e,
+	0x96, 0x26, 0x37, 0xe1, 0xc0, 0xb6, 0xb2, 0x85, 0xd5, 0x80, 0x1b, 0x26, 0xca, 0xf8, 0xfe, 0xde,
+	0xcc, 0xa8, 0xe3, 0xe6, 0x7d, 0xd7, 0x26, 0xe4, 0x6f, 0x3b, 0xb4, 0x2e, 0xaf, 0xa6, 0xcb, 0x33,
+	0x19, 0x94, 0x27, 0x74, 0x40, 0x4c, 0x74, 0x76, 0x42, 0xe1, 0x67, 0x00, 0x85, 0xd6, 0x02, 0xb8,
+	0x3a, 0x71, 0x5f, 0x03, 0xd8, 0xa3, 0x54, 0x49, 0x5d, 0x37, 0xb3, 0x60, 0xac, 0x7b, 0xea, 0xec,
+	0xfc, 0x45, 0x27, 0xf1, 0x44, 0x2b, 0xbf, 0xdd, 0x52, 0x10, 0x0b, 0x04, 0xeb, 0xcb, 0x1f, 0x3e,
+	0xfa, 0x3d, 0x9f, 0xf9, 0xf1, 0x8f, 0xfc, 0x94, 0x86, 0xcd, 0x8d, 0x7a, 0x59, 0xac, 0x90, 0xaa,
+	0x93, 0xdf, 0x92, 0x8f, 0x94, 0xd9, 0xa8, 0x21, 0x6a, 0x03, 0xe8, 0x6f, 0x7b, 0x33, 0xfd, 0xcd,
+	0x9d, 0xb1, 0x59, 0xf1, 0xca, 0xe2, 0xdd, 0xa3, 0xdd, 0xe9, 0x3e, 0x8b, 0x4a, 0xa5, 0xb1, 0x6e,
+	0x15, 0x0d, 0xbd, 0x7f, 0xb4, 0x3b, 0x0d, 0x4a, 0x0e, 0x07, 0xe1, 0x21, 0x80, 0x39, 0x1f, 0x6b,
+	0x4f, 0xb8, 0x02, 0xa9, 0x56, 0x31, 0xa5, 0x98, 0xe8, 0xf1, 0x9a, 0x83, 0xe3, 0x6b, 0x1e, 0x4c,
+	0xc9, 0x88, 0xeb, 0x98, 0x94, 0xf4, 0xb1, 0x6b, 0xf2, 0x12, 0x1e, 0x00, 0x38, 0x99, 0x4c, 0xfd,
+	0x79, 0x15, 0xfd, 0x01, 0x80, 0x1c, 0xab, 0xa6, 0xa5, 0xba, 0x49, 0x0a, 0xa4, 0x5a, 0x23, 0x75,
+	0xfd, 0xd4, 0x6a, 0x24, 0x0b, 0x5f, 0x42, 0xba, 0x52, 0xde, 0x42, 0xaa, 0x5d, 0x19, 0x67, 0x4a,
+	0xee, 0xbf, 0xf2, 0x5b, 0xe9, 0xd9, 0x3e, 0x1a, 0x54, 0x3e, 0x44, 0x51, 0x18, 0x81, 0x7c, 0x74,
+	0xd5, 0x7b, 0x03, 0xee, 0x76, 0xc1, 0xc1, 0x22, 0xd5, 0x56, 0xea, 0xba, 0x6a, 0x5d, 0x42, 0x5d,
+	0xc7, 0x66, 0xe3, 0x16, 0x21, 0x5b, 0x5c, 0xa3, 0x7d, 0xf9, 0x57, 0x3a, 0x95, 0x3f, 0x59, 0x6b,
+	0xee, 0x2a, 0xec, 0x55, 0x51, 0x8d, 0x50, 0x6c, 0x12, 0x23, 0xf5, 0xf9, 0x6c, 0x9a, 0xca, 0xef,
+	0xb8, 0x5a, 0xed, 0x87, 0xaf, 0x77, 0x61, 0xd6, 0x92, 0xaf, 0x69, 0x6a, 0xc9, 0x96, 0x0f, 0xca,
+	0x16, 0x51, 0x20, 0x0b, 0x84, 0x05, 0x38, 0x12, 0xb7, 0xe3, 0x8a, 0x27, 0x5f, 0x88, 0x09, 0x94,
+	0x05, 0xc2, 0x53, 0x00, 0xfb, 0x8b, 0x54, 0x7b, 0xaf, 0xa6, 0x2a, 0x26, 0xba, 0xa5, 0x18, 0x4a,
+	0x95, 0x5a, 0x67, 0x52, 0xea, 0xe6, 0x06, 0x31, 0xb0, 0xd9, 0x48, 0x4d, 0x90, 0xa6, 0x29, 0xb7,
+	0x02, 0x7b, 0x6a, 0xb6, 0x07, 0x5b, 0x88, 0xb3, 0xf3, 0x97, 0xc4, 0x84, 0x6e, 0x2c, 0xb2, 0x60,
+	0xcb, 0xbd, 0xd6, 0x85, 0x38, 0x9a, 0x32, 0xb4, 0x5c, 0xdc, 0x8f, 0xa6, 0xbc, 0xad, 0x89, 0x17,
+	0xca, 0xd2, 0xe4, 0x35, 0x9f, 0x26, 0x81, 0xa6, 0x1a, 0x3a, 0x8e, 0x20, 0xc2, 0xa1, 0xd0, 0x92,
+	0x27, 0xca, 0xf9, 0x98, 0x48, 0xc2, 0xc3, 0x2e, 0xbb, 0xf3, 0x06, 0x64, 0x5c, 0xab, 0x21, 0x5d,
+	0x3d, 0xb6, 0x30, 0x23, 0xb0, 0xd7, 0x40, 0x15, 0x5c, 0xc3, 0x48, 0x37, 0x59, 0x92, 0x94, 0x9a,
+	0x0b, 0xbe, 0xec, 0xed, 0xfe, 0x9f, 0xb3, 0x57, 0xbe, 0xd9, 0x2a, 0xfb, 0x02, 0x4a, 0x4f, 0x86,
+	0x95, 0x96, 0x62, 0xe5, 0xc9, 0x02, 0xe1, 0x2a, 0x1c, 0x8d, 0xdd, 0x4a, 0xcb, 0xc2, 0x7f, 0xba,
+	0xec, 0x36, 0x71, 0x83, 0xe5, 0xbc, 0xf7, 0xd4, 0xb2, 0xde, 0x46, 0xed, 0x1a, 0x0f, 0x14, 0x1a,
+	0x68, 0xbb, 0xd0, 0x4e, 0xbb, 0xa5, 0x3f, 0xcb, 0xdb, 0x5a, 0xeb, 0xe8, 0xcd, 0x78, 0x35, 0xee,
+	0xd6, 0x9a, 0x0a, 0x3b, 0xda, 0x0a, 0xd7, 0xe0, 0x64, 0x60, 0x3d, 0xa2, 0x7c, 0x42, 0xb1, 0x2c,
+	0xcc, 0x0a, 0xbf, 0x00, 0x38, 0xe1, 0xeb, 0x92, 0xef, 0x92, 0x4d, 0xa4, 0xe3, 0x4f, 0xd0, 0xda,
+	0x86, 0x62, 0xa0, 0x12, 0xaa, 0x10, 0x43, 0x65, 0xae, 0xb8, 0x6b, 0xf0, 0x65, 0xb2, 0xa3, 0xa3,
+	0xf6, 0x3b, 0x4f, 0x9f, 0x6d, 0xee, 0xca, 0x3e, 0x6c, 0x97, 0x10, 0x31, 0xd4, 0x75, 0xcc, 0xfa,
+	0xce, 0x0b, 0xa5, 0x33, 0x6c, 0x61, 0x55, 0x95, 0x0b, 0xfe, 0xc6, 0x13, 0x0c, 0x63, 0x29, 0x31,
+	0x11, 0xdf, 0xee, 0x5d, 0xae, 0xce, 0x80, 0xf5, 0x03, 0x80, 0x97, 0xdb, 0x39, 0x89, 0xd7, 0xf5,
+	0x9f, 0x5d, 0xd7, 0x99, 0xff, 0x1b, 0xc2, 0xee, 0x22, 0xd5, 0xb8, 0x2f, 0x00, 0xe4, 0x62, 0x3e,
+	0x21, 0xcc, 0x27, 0x3e, 0xbc, 0xb1, 0x83, 0x36, 0x2f, 0x77, 0x8e, 0xf1, 0x94, 0xf8, 0x16, 0xc0,
+	0xa1, 0x56, 0x93, 0xf9, 0x62, 0x9a, 0xdf, 0x16, 0x40, 0xfe, 0xfa, 0x31, 0x81, 0x1e, 0xab, 0xef,
+	0x01, 0x1c, 0x4e, 0x1a, 0x3c, 0xdf, 0x6c, 0x37, 0x40, 0x0c, 0x98, 0x2f, 0x9c, 0x00, 0xec, 0x31,
+	0xfc, 0x09, 0xc0, 0xf1, 0xf4, 0xca, 0x59, 0x6a, 0x37, 0x54, 0x4b, 0x17, 0xfc, 0xea, 0x89, 0x5d,
+	0x78, 0x9c, 0x3f, 0x85, 0xfd, 0xe1, 0xc1, 0x52, 0x6a, 0x23, 0x75, 0xfc, 0x00, 0x7e, 0xb1, 0x43,
+	0x80, 0x17, 0xfc, 0x3b, 0x00, 0x07, 0xa2, 0xe3, 0xdf, 0x5c, 0x9a, 0xbb, 0x08, 0x84, 0x7f, 0xa3,
+	0x63, 0x88, 0x37, 0x85, 0xbe, 0xf2, 0x38, 0xfa, 0x0c, 0xde, 0xe9, 0x02, 0xdc, 0x97, 0x00, 0xf6,
+	0x05, 0xc6, 0xa8, 0xcb, 0x69, 0x31, 0xfc, 0xd6, 0xfc, 0x95, 0x4e, 0xac, 0x3d, 0x32, 0xe7, 0x1f,
+	0x47, 0x07, 0x18, 0x4b, 0x25, 0x2e, 0x66, 0x7a, 0x49, 0x7d, 0x15, 0xa2, 0x18, 0x5e, 0xee, 0x1c,
+	0x93, 0xc0, 0x6d, 0x61, 0x96, 0xdb, 0x03, 0x70, 0x38, 0xa9, 0xcd, 0xa7, 0x16, 0x65, 0x02, 0x98,
+	0x2f, 0x9c, 0x00, 0x9c, 0x48, 0x9b, 0x7f, 0xf1, 0x33, 0xeb, 0xfd, 0x5d, 0xbe, 0x7e, 0xff, 0x20,
+	0x07, 0x1e, 0x1d, 0xe4, 0xc0, 0x93, 0x83, 0x1c, 0x78, 0x7a, 0x90, 0x03, 0xdf, 0x1c, 0xe6, 0x32,
+	0x4f, 0x0e, 0x73, 0x99, 0x5f, 0x0f, 0x73, 0x99, 0x8f, 0xc6, 0x19, 0x8a, 0xaa, 0x9b, 0x22, 0x26,
+	0xd2, 0xed, 0xe0, 0x54, 0x6a, 0x3f, 0xee, 0xe5, 0x1e, 0xfb, 0xcb, 0x9d, 0xd7, 0xff, 0x1b, 0x00,
+	0xdc, 0x85, 0xc1, 0x74, 0xce, 0x12, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -820,6 +1002,27 @@ func (this *MsgWithdrawValidatorCommissionResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -966,6 +1169,35 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -988,6 +1220,12 @@ type MsgClient interface {
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegation of a tokenize share record to its owner.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of
+	// auto-compounding of their staking rewards.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	//
@@ -1043,6 +1281,24 @@ func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWith
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error) {
 	out := new(MsgFundCommunityPoolResponse)
@@ -1091,6 +1347,12 @@ type MsgServer interface {
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegation of a tokenize share record to its owner.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of
+	// auto-compounding of their staking rewards.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	//
@@ -1124,6 +1386,12 @@ func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req
 func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context, req *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
@@ -1195,6 +1463,42 @@ func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgFundCommunityPool)
 	if err := dec(in); err != nil {
@@ -1284,6 +1588,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawValidatorCommission",
 			Handler:    _Msg_WithdrawValidatorCommission_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 		{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
@@ -1506,6 +1818,69 @@ func (m *MsgWithdrawValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1784,12 +2159,84 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -1881,6 +2328,31 @@ func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgFundCommunityPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1997,6 +2469,37 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2531,6 +3034,158 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3258,6 +3913,191 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
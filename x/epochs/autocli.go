@@ -23,5 +23,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 			},
 		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: epochsv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateEpoch",
+					Use:            "create-epoch <identifier> <duration>",
+					Short:          "Create a new epoch through governance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateEpochDuration",
+					Use:            "update-epoch-duration <identifier> <duration>",
+					Short:          "Update the duration of an epoch through governance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveEpoch",
+					Use:            "remove-epoch <identifier>",
+					Short:          "Remove an epoch through governance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+					GovProposal:    true,
+				},
+			},
+		},
 	}
 }
@@ -40,46 +40,64 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 				epochInfo.CurrentEpochStartTime = epochInfo.StartTime
 				k.Logger.Debug(fmt.Sprintf("Starting new epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 			} else {
-				err := k.EventService.EventManager(ctx).Emit(&types.EventEpochEnd{
-					EpochNumber: epochInfo.CurrentEpoch,
-				})
-				if err != nil {
+				if err := k.endEpoch(ctx, epochInfo); err != nil {
 					return false, nil
 				}
 
-				if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
-					return k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
-				}); err != nil {
-					// purposely ignoring the error here not to halt the chain if the hook fails
-					k.Logger.Error(fmt.Sprintf("Error after epoch end with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
-				}
-
 				epochInfo.CurrentEpoch += 1
 				epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
 				k.Logger.Debug(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 			}
 
 			// emit new epoch start event, set epoch info, and run BeforeEpochStart hook
-			err = k.EventService.EventManager(ctx).Emit(&types.EventEpochStart{
-				EpochNumber:    epochInfo.CurrentEpoch,
-				EpochStartTime: epochInfo.CurrentEpochStartTime.Unix(),
-			})
-			if err != nil {
+			if err := k.startEpoch(ctx, epochInfo); err != nil {
 				return false, err
 			}
-			err = k.EpochInfo.Set(ctx, epochInfo.Identifier, epochInfo)
-			if err != nil {
-				k.Logger.Error(fmt.Sprintf("Error set epoch info with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
-				return false, nil
-			}
-			if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
-				return k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
-			}); err != nil {
-				// purposely ignoring the error here not to halt the chain if the hook fails
-				k.Logger.Error(fmt.Sprintf("Error before epoch start with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
-			}
 			return false, nil
 		},
 	)
 	return err
 }
+
+// endEpoch emits the end event of the current epoch and runs the AfterEpochEnd
+// hook.
+func (k Keeper) endEpoch(ctx context.Context, epochInfo types.EpochInfo) error {
+	err := k.EventService.EventManager(ctx).Emit(&types.EventEpochEnd{
+		EpochNumber: epochInfo.CurrentEpoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		return k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+	}); err != nil {
+		// purposely ignoring the error here not to halt the chain if the hook fails
+		k.Logger.Error(fmt.Sprintf("Error after epoch end with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
+	}
+	return nil
+}
+
+// startEpoch emits the start event of the current epoch, sets the epoch info
+// and runs the BeforeEpochStart hook.
+func (k Keeper) startEpoch(ctx context.Context, epochInfo types.EpochInfo) error {
+	err := k.EventService.EventManager(ctx).Emit(&types.EventEpochStart{
+		EpochNumber:    epochInfo.CurrentEpoch,
+		EpochStartTime: epochInfo.CurrentEpochStartTime.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	err = k.EpochInfo.Set(ctx, epochInfo.Identifier, epochInfo)
+	if err != nil {
+		k.Logger.Error(fmt.Sprintf("Error set epoch info with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
+		return nil
+	}
+	if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		return k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+	}); err != nil {
+		// purposely ignoring the error here not to halt the chain if the hook fails
+		k.Logger.Error(fmt.Sprintf("Error before epoch start with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
+	}
+	return nil
+}
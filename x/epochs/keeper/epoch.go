@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/epochs/types"
 )
 
@@ -59,3 +62,60 @@ func (k Keeper) NumBlocksSinceEpochStart(ctx context.Context, identifier string)
 	}
 	return k.HeaderService.HeaderInfo(ctx).Height - epoch.CurrentEpochStartHeight, nil
 }
+
+// UpdateEpochDuration sets a new duration for an existing epoch.
+// If the epoch is in flight, it is ended at the current block time and the next
+// epoch starts right away with the new duration, so that hooks observe the
+// schedule change. An epoch that already started in the current block only has
+// its duration updated, preserving the invariant of at most one epoch
+// transition per identifier per block.
+func (k Keeper) UpdateEpochDuration(ctx context.Context, identifier string, duration time.Duration) error {
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrEpochNotFound.Wrapf("epoch with identifier %s not found", identifier)
+		}
+		return err
+	}
+
+	epoch.Duration = duration
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	if !epoch.EpochCountingStarted || epoch.CurrentEpochStartHeight == headerInfo.Height {
+		return k.EpochInfo.Set(ctx, identifier, epoch)
+	}
+
+	if err := k.endEpoch(ctx, epoch); err != nil {
+		return err
+	}
+
+	epoch.CurrentEpoch += 1
+	epoch.CurrentEpochStartTime = headerInfo.Time
+	epoch.CurrentEpochStartHeight = headerInfo.Height
+	k.Logger.Debug(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epoch.Identifier, epoch.CurrentEpoch))
+
+	return k.startEpoch(ctx, epoch)
+}
+
+// RemoveEpochInfo removes an existing epoch.
+// If the epoch is in flight, it is ended first so that hooks observe its end.
+func (k Keeper) RemoveEpochInfo(ctx context.Context, identifier string) error {
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrEpochNotFound.Wrapf("epoch with identifier %s not found", identifier)
+		}
+		return err
+	}
+
+	if epoch.EpochCountingStarted {
+		if err := k.endEpoch(ctx, epoch); err != nil {
+			return err
+		}
+	}
+
+	return k.EpochInfo.Remove(ctx, identifier)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -23,20 +24,26 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
 type KeeperTestSuite struct {
 	suite.Suite
 	Ctx          sdk.Context
 	environment  appmodule.Environment
 	EpochsKeeper *epochskeeper.Keeper
 	queryClient  types.QueryClient
+	msgServer    types.MsgServer
+	hooks        *epochHookRecorder
 }
 
 func (s *KeeperTestSuite) SetupTest() {
-	ctx, epochsKeeper, environment := Setup(s.T())
+	s.hooks = &epochHookRecorder{}
+	ctx, epochsKeeper, environment := setupWithHooks(s.T(), types.NewMultiEpochHooks(s.hooks))
 
 	s.Ctx = ctx
 	s.EpochsKeeper = epochsKeeper
 	s.environment = environment
+	s.msgServer = epochskeeper.NewMsgServerImpl(epochsKeeper)
 	queryRouter := baseapp.NewGRPCQueryRouter()
 	cfg := module.NewConfigurator(nil, nil, queryRouter)
 	types.RegisterQueryServer(cfg.QueryServer(), epochskeeper.NewQuerier(*s.EpochsKeeper))
@@ -52,6 +59,12 @@ func (s *KeeperTestSuite) SetupTest() {
 func Setup(t *testing.T) (sdk.Context, *epochskeeper.Keeper, appmodule.Environment) {
 	t.Helper()
 
+	return setupWithHooks(t, types.NewMultiEpochHooks())
+}
+
+func setupWithHooks(t *testing.T, hooks types.EpochHooks) (sdk.Context, *epochskeeper.Keeper, appmodule.Environment) {
+	t.Helper()
+
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
 	environment := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
@@ -62,8 +75,9 @@ func Setup(t *testing.T) (sdk.Context, *epochskeeper.Keeper, appmodule.Environme
 	epochsKeeper := epochskeeper.NewKeeper(
 		environment,
 		encCfg.Codec,
+		govModuleNameStr,
 	)
-	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
+	epochsKeeper = epochsKeeper.SetHooks(hooks)
 	ctx.WithHeaderInfo(header.Info{Height: 1, Time: time.Now().UTC(), ChainID: "epochs"})
 	err := epochsKeeper.InitGenesis(ctx, *types.DefaultGenesis())
 	require.NoError(t, err)
@@ -93,3 +107,27 @@ func SetEpochStartTime(ctx sdk.Context, epochsKeeper epochskeeper.Keeper) {
 		}
 	}
 }
+
+// epochHookCall is a single call to one of the epoch hooks.
+type epochHookCall struct {
+	hook        string
+	identifier  string
+	epochNumber int64
+}
+
+// epochHookRecorder is an epoch hook recording the calls it receives.
+type epochHookRecorder struct {
+	calls []epochHookCall
+}
+
+var _ types.EpochHooks = &epochHookRecorder{}
+
+func (h *epochHookRecorder) AfterEpochEnd(_ context.Context, epochIdentifier string, epochNumber int64) error {
+	h.calls = append(h.calls, epochHookCall{hook: "AfterEpochEnd", identifier: epochIdentifier, epochNumber: epochNumber})
+	return nil
+}
+
+func (h *epochHookRecorder) BeforeEpochStart(_ context.Context, epochIdentifier string, epochNumber int64) error {
+	h.calls = append(h.calls, epochHookCall{hook: "BeforeEpochStart", identifier: epochIdentifier, epochNumber: epochNumber})
+	return nil
+}
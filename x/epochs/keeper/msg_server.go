@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/epochs/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epochs MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateEpoch adds a new epoch.
+func (ms msgServer) CreateEpoch(ctx context.Context, msg *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if msg.Duration <= 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "epoch duration must be positive, got %s", msg.Duration)
+	}
+
+	epoch := types.NewGenesisEpochInfo(msg.Identifier, msg.Duration)
+	epoch.StartTime = msg.StartTime
+	if err := ms.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ms.EventService.EventManager(ctx).Emit(&types.EventEpochCreated{
+		Identifier: msg.Identifier,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// UpdateEpochDuration updates the duration of an existing epoch.
+func (ms msgServer) UpdateEpochDuration(ctx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if msg.Duration <= 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "epoch duration must be positive, got %s", msg.Duration)
+	}
+
+	if err := ms.Keeper.UpdateEpochDuration(ctx, msg.Identifier, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).Emit(&types.EventEpochDurationUpdated{
+		Identifier: msg.Identifier,
+		Duration:   int64(msg.Duration),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// RemoveEpoch removes an existing epoch.
+func (ms msgServer) RemoveEpoch(ctx context.Context, msg *types.MsgRemoveEpoch) (*types.MsgRemoveEpochResponse, error) {
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.RemoveEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).Emit(&types.EventEpochRemoved{
+		Identifier: msg.Identifier,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveEpochResponse{}, nil
+}
+
+func (ms msgServer) validateAuthority(authority string) error {
+	if ms.authority != authority {
+		return errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, authority)
+	}
+	return nil
+}
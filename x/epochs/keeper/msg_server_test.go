@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestMsgCreateEpoch() {
+	now := s.Ctx.HeaderInfo().Time
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCreateEpoch
+		expErr  error
+		errMsg  string
+		expInfo types.EpochInfo
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgCreateEpoch{Authority: "foo", Identifier: "fortnight", Duration: 14 * 24 * time.Hour},
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "zero duration",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "fortnight"},
+			errMsg: "epoch duration must be positive",
+		},
+		{
+			name:   "negative duration",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "fortnight", Duration: -time.Hour},
+			errMsg: "epoch duration must be positive",
+		},
+		{
+			name:   "empty identifier",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Duration: time.Hour},
+			errMsg: "epoch identifier should NOT be empty",
+		},
+		{
+			name:   "existing identifier",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "day", Duration: time.Hour},
+			errMsg: "epoch with identifier day already exists",
+		},
+		{
+			name: "start time defaults to block time",
+			msg:  &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "fortnight", Duration: 14 * 24 * time.Hour},
+			expInfo: types.EpochInfo{
+				Identifier:              "fortnight",
+				StartTime:               now,
+				Duration:                14 * 24 * time.Hour,
+				CurrentEpochStartHeight: s.Ctx.HeaderInfo().Height,
+			},
+		},
+		{
+			name: "start time in the future",
+			msg:  &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "later", Duration: time.Hour, StartTime: now.Add(time.Hour)},
+			expInfo: types.EpochInfo{
+				Identifier:              "later",
+				StartTime:               now.Add(time.Hour),
+				Duration:                time.Hour,
+				CurrentEpochStartHeight: s.Ctx.HeaderInfo().Height,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.CreateEpoch(s.Ctx, tc.msg)
+			switch {
+			case tc.expErr != nil:
+				s.Require().ErrorIs(err, tc.expErr)
+			case tc.errMsg != "":
+				s.Require().ErrorContains(err, tc.errMsg)
+			default:
+				s.Require().NoError(err)
+				info, err := s.EpochsKeeper.EpochInfo.Get(s.Ctx, tc.msg.Identifier)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expInfo, info)
+			}
+		})
+	}
+
+	// counting starts with the next begin blocker
+	s.Require().Empty(s.hooks.calls)
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	s.Require().Contains(s.hooks.calls, epochHookCall{hook: "BeforeEpochStart", identifier: "fortnight", epochNumber: 1})
+	s.Require().NotContains(s.hooks.calls, epochHookCall{hook: "BeforeEpochStart", identifier: "later", epochNumber: 1})
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateEpochDuration() {
+	start := s.Ctx.HeaderInfo().Time
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	s.hooks.calls = nil
+
+	_, err := s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: "foo", Identifier: "day", Duration: time.Hour})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "day"})
+	s.Require().ErrorContains(err, "epoch duration must be positive")
+
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "fortnight", Duration: time.Hour})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	// an epoch started in the current block only has its duration updated
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "day", Duration: 12 * time.Hour})
+	s.Require().NoError(err)
+	info, err := s.EpochsKeeper.EpochInfo.Get(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(12*time.Hour, info.Duration)
+	s.Require().Equal(int64(1), info.CurrentEpoch)
+	s.Require().Equal(start, info.CurrentEpochStartTime)
+	s.Require().Empty(s.hooks.calls)
+
+	// an in-flight epoch is ended and the next one starts with the new duration
+	now := start.Add(time.Hour)
+	s.Ctx = s.Ctx.WithHeaderInfo(header.Info{Height: s.Ctx.HeaderInfo().Height + 1, Time: now})
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "day", Duration: 6 * time.Hour})
+	s.Require().NoError(err)
+	info, err = s.EpochsKeeper.EpochInfo.Get(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(6*time.Hour, info.Duration)
+	s.Require().Equal(int64(2), info.CurrentEpoch)
+	s.Require().Equal(now, info.CurrentEpochStartTime)
+	s.Require().Equal(s.Ctx.HeaderInfo().Height, info.CurrentEpochStartHeight)
+	s.Require().Equal([]epochHookCall{
+		{hook: "AfterEpochEnd", identifier: "day", epochNumber: 1},
+		{hook: "BeforeEpochStart", identifier: "day", epochNumber: 2},
+	}, s.hooks.calls)
+
+	// the begin blocker does not tick again in the same block
+	s.hooks.calls = nil
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	for _, call := range s.hooks.calls {
+		s.Require().NotEqual("day", call.identifier)
+	}
+
+	// the next epoch ends after the new duration
+	s.Ctx = s.Ctx.WithHeaderInfo(header.Info{Height: s.Ctx.HeaderInfo().Height + 1, Time: now.Add(6 * time.Hour).Add(time.Second)})
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	s.Require().Contains(s.hooks.calls, epochHookCall{hook: "AfterEpochEnd", identifier: "day", epochNumber: 2})
+	s.Require().Contains(s.hooks.calls, epochHookCall{hook: "BeforeEpochStart", identifier: "day", epochNumber: 3})
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveEpoch() {
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	s.hooks.calls = nil
+
+	_, err := s.msgServer.RemoveEpoch(s.Ctx, &types.MsgRemoveEpoch{Authority: "foo", Identifier: "day"})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.RemoveEpoch(s.Ctx, &types.MsgRemoveEpoch{Authority: govModuleNameStr, Identifier: "fortnight"})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	// an in-flight epoch is ended before being removed
+	_, err = s.msgServer.RemoveEpoch(s.Ctx, &types.MsgRemoveEpoch{Authority: govModuleNameStr, Identifier: "day"})
+	s.Require().NoError(err)
+	has, err := s.EpochsKeeper.EpochInfo.Has(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().False(has)
+	s.Require().Equal([]epochHookCall{{hook: "AfterEpochEnd", identifier: "day", epochNumber: 1}}, s.hooks.calls)
+
+	// an epoch that has not started is removed without notifying the hooks
+	s.hooks.calls = nil
+	now := s.Ctx.HeaderInfo().Time
+	_, err = s.msgServer.CreateEpoch(s.Ctx, &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "later", Duration: time.Hour, StartTime: now.Add(time.Hour)})
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveEpoch(s.Ctx, &types.MsgRemoveEpoch{Authority: govModuleNameStr, Identifier: "later"})
+	s.Require().NoError(err)
+	s.Require().Empty(s.hooks.calls)
+
+	// the removed epoch no longer ticks
+	s.Ctx = s.Ctx.WithHeaderInfo(header.Info{Height: s.Ctx.HeaderInfo().Height + 1, Time: now.Add(48 * time.Hour)})
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	for _, call := range s.hooks.calls {
+		s.Require().NotEqual("day", call.identifier)
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+	coretransaction "cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
+	legacy.RegisterAminoMsg(registrar, &MsgCreateEpoch{}, "cosmos-sdk/x/epochs/MsgCreateEpoch")
+	legacy.RegisterAminoMsg(registrar, &MsgUpdateEpochDuration{}, "cosmos-sdk/x/epochs/MsgUpdateDuration")
+	legacy.RegisterAminoMsg(registrar, &MsgRemoveEpoch{}, "cosmos-sdk/x/epochs/MsgRemoveEpoch")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations(
+		(*coretransaction.Msg)(nil),
+		&MsgCreateEpoch{},
+		&MsgUpdateEpochDuration{},
+		&MsgRemoveEpoch{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
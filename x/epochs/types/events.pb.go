@@ -121,9 +121,159 @@ func (m *EventEpochStart) GetEpochStartTime() int64 {
 	return 0
 }
 
+// EventEpochCreated is an event emitted when an epoch is created by the
+// authority.
+type EventEpochCreated struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *EventEpochCreated) Reset()         { *m = EventEpochCreated{} }
+func (m *EventEpochCreated) String() string { return proto.CompactTextString(m) }
+func (*EventEpochCreated) ProtoMessage()    {}
+func (*EventEpochCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{2}
+}
+func (m *EventEpochCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochCreated.Merge(m, src)
+}
+func (m *EventEpochCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochCreated proto.InternalMessageInfo
+
+func (m *EventEpochCreated) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// EventEpochDurationUpdated is an event emitted when the duration of an epoch is
+// updated by the authority.
+type EventEpochDurationUpdated struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// duration is the new duration of the epoch, in nanoseconds.
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *EventEpochDurationUpdated) Reset()         { *m = EventEpochDurationUpdated{} }
+func (m *EventEpochDurationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventEpochDurationUpdated) ProtoMessage()    {}
+func (*EventEpochDurationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{3}
+}
+func (m *EventEpochDurationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochDurationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochDurationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochDurationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochDurationUpdated.Merge(m, src)
+}
+func (m *EventEpochDurationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochDurationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochDurationUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochDurationUpdated proto.InternalMessageInfo
+
+func (m *EventEpochDurationUpdated) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EventEpochDurationUpdated) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// EventEpochRemoved is an event emitted when an epoch is removed by the
+// authority.
+type EventEpochRemoved struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *EventEpochRemoved) Reset()         { *m = EventEpochRemoved{} }
+func (m *EventEpochRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventEpochRemoved) ProtoMessage()    {}
+func (*EventEpochRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{4}
+}
+func (m *EventEpochRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochRemoved.Merge(m, src)
+}
+func (m *EventEpochRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochRemoved proto.InternalMessageInfo
+
+func (m *EventEpochRemoved) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventEpochEnd)(nil), "cosmos.epochs.v1beta1.EventEpochEnd")
 	proto.RegisterType((*EventEpochStart)(nil), "cosmos.epochs.v1beta1.EventEpochStart")
+	proto.RegisterType((*EventEpochCreated)(nil), "cosmos.epochs.v1beta1.EventEpochCreated")
+	proto.RegisterType((*EventEpochDurationUpdated)(nil), "cosmos.epochs.v1beta1.EventEpochDurationUpdated")
+	proto.RegisterType((*EventEpochRemoved)(nil), "cosmos.epochs.v1beta1.EventEpochRemoved")
 }
 
 func init() {
@@ -131,22 +281,26 @@ func init() {
 }
 
 var fileDescriptor_691f9b4b0a500cb4 = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x92, 0x84, 0x08, 0xc7, 0x83, 0x15, 0xe9,
-	0x43, 0xd5, 0x80, 0x39, 0x52, 0xca, 0xd8, 0x4d, 0x4d, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x84, 0x2a,
-	0x52, 0x32, 0xe2, 0xe2, 0x75, 0x05, 0x59, 0xe3, 0x0a, 0x52, 0xe4, 0x9a, 0x97, 0x22, 0xa4, 0xc8,
-	0xc5, 0x03, 0xd6, 0x10, 0x9f, 0x57, 0x9a, 0x9b, 0x94, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x1c, 0xc4, 0x0d, 0x16, 0xf3, 0x03, 0x0b, 0x29, 0xc5, 0x71, 0xf1, 0x23, 0xf4, 0x04, 0x97, 0x24,
-	0x16, 0x95, 0x10, 0xa1, 0x4b, 0x48, 0x83, 0x4b, 0x00, 0xa2, 0xa4, 0x18, 0xa4, 0x23, 0xbe, 0x24,
-	0x33, 0x37, 0x55, 0x82, 0x09, 0xac, 0x8c, 0x2f, 0x15, 0x6e, 0x50, 0x48, 0x66, 0x6e, 0xaa, 0x93,
-	0xe9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x49, 0x43, 0xbc, 0x54, 0x9c,
-	0x92, 0xad, 0x97, 0x99, 0xaf, 0x5f, 0x01, 0xf3, 0x5a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0xd8, 0x47, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x88, 0x5e, 0xe4, 0x4e, 0x01, 0x00,
-	0x00,
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0xf4, 0x30,
+	0x14, 0xc5, 0xdb, 0xef, 0x03, 0xd1, 0xf8, 0xbf, 0x20, 0xcc, 0x54, 0x08, 0x5a, 0x37, 0xb3, 0x6a,
+	0x19, 0x07, 0x5f, 0x40, 0xed, 0xd6, 0x45, 0x55, 0x04, 0x17, 0x96, 0x76, 0x72, 0xd5, 0x20, 0x4d,
+	0x4a, 0x73, 0xa7, 0xe8, 0x5b, 0xf8, 0x58, 0x2e, 0x67, 0xe9, 0x52, 0xda, 0x17, 0x91, 0x26, 0x71,
+	0xaa, 0x20, 0x38, 0xcb, 0x1c, 0x7e, 0xbf, 0x93, 0x0b, 0x87, 0x04, 0x53, 0xa9, 0x0a, 0xa9, 0x22,
+	0x28, 0xe5, 0xf4, 0x51, 0x45, 0xf5, 0x38, 0x07, 0xcc, 0xc6, 0x11, 0xd4, 0x20, 0x50, 0x85, 0x65,
+	0x25, 0x51, 0x7a, 0x7b, 0x86, 0x09, 0x0d, 0x13, 0x5a, 0xc6, 0x1f, 0x9a, 0x38, 0xd5, 0x50, 0x64,
+	0x19, 0xfd, 0xf0, 0x8f, 0x7e, 0x6f, 0x7d, 0x00, 0x01, 0x8a, 0x5b, 0x28, 0x38, 0x26, 0x9b, 0x71,
+	0xf7, 0x4d, 0xdc, 0x41, 0xb1, 0x60, 0xde, 0x21, 0xd9, 0xd0, 0x42, 0x2a, 0x66, 0x45, 0x0e, 0xd5,
+	0xc0, 0x3d, 0x70, 0x47, 0xff, 0x93, 0x75, 0x9d, 0x5d, 0xe8, 0x28, 0xb8, 0x23, 0xdb, 0xbd, 0x73,
+	0x89, 0x59, 0x85, 0x4b, 0x58, 0xde, 0x88, 0xec, 0x18, 0x44, 0x75, 0x46, 0x8a, 0xbc, 0x80, 0xc1,
+	0x3f, 0x8d, 0x6d, 0xc1, 0xa2, 0xe8, 0x8a, 0x17, 0x10, 0x4c, 0xc8, 0x6e, 0xdf, 0x7f, 0x56, 0x41,
+	0x86, 0xc0, 0x3c, 0x4a, 0x08, 0x67, 0x20, 0x90, 0xdf, 0x73, 0xdb, 0xbf, 0x96, 0x7c, 0x4b, 0x82,
+	0x1b, 0x32, 0xec, 0xa5, 0xf3, 0x59, 0x95, 0x21, 0x97, 0xe2, 0xba, 0x64, 0xcb, 0xc8, 0x9e, 0x4f,
+	0x56, 0x99, 0x55, 0xec, 0x4d, 0x8b, 0xf7, 0xcf, 0x6b, 0x12, 0x28, 0x64, 0xfd, 0x77, 0xe1, 0xe9,
+	0xc9, 0x5b, 0x43, 0xdd, 0x79, 0x43, 0xdd, 0x8f, 0x86, 0xba, 0xaf, 0x2d, 0x75, 0xe6, 0x2d, 0x75,
+	0xde, 0x5b, 0xea, 0xdc, 0xee, 0x9b, 0x55, 0x14, 0x7b, 0x0a, 0xb9, 0x8c, 0x9e, 0xbf, 0xd6, 0xc1,
+	0x97, 0x12, 0x54, 0xbe, 0xa2, 0x47, 0x99, 0x7c, 0x0e, 0x00, 0xba, 0xe3, 0x77, 0xac, 0x11, 0x02,
+	0x00, 0x00,
 }
 
 func (m *EventEpochEnd) Marshal() (dAtA []byte, err error) {
@@ -210,6 +364,101 @@ func (m *EventEpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEpochCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochDurationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochDurationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochDurationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -248,6 +497,48 @@ func (m *EventEpochStart) Size() (n int) {
 	return n
 }
 
+func (m *EventEpochCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEpochDurationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *EventEpochRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -411,6 +702,271 @@ func (m *EventEpochStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEpochCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochDurationUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochDurationUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochDurationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
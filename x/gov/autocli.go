@@ -89,11 +89,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "LiveTally",
+					Use:       "live-tally <proposal-id>",
+					Short:     "Query the running tally of a proposal in voting period",
+					Example:   fmt.Sprintf("%s query gov live-tally 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "GovernanceDelegation",
+					Use:       "governance-delegation <delegator-addr>",
+					Short:     "Query the governor an account delegated its governance power to",
+					Example:   fmt.Sprintf("%s query gov governance-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "GovernorDelegations",
+					Use:       "governor-delegations <governor-addr>",
+					Short:     "Query the governance delegations made to a governor",
+					Example:   fmt.Sprintf("%s query gov governor-delegations cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -154,6 +181,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "DelegateGovernance",
+					Use:       "delegate-governance <governor-addr>",
+					Short:     "Delegate the governance power of an account to a governor",
+					Long:      "Delegate the governance power of an account to a governor. The governor's votes are tallied with the staked tokens of the account, unless the account votes itself. Any existing governance delegation is replaced.",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-governance cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateGovernance",
+					Use:       "undelegate-governance",
+					Short:     "Revoke the governance delegation of an account",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-governance --from mykey", version.AppName),
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -55,6 +55,23 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, delegation := range data.GovernanceDelegations {
+		delegatorAddr, err := ak.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		governorAddr, err := ak.AddressCodec().StringToBytes(delegation.GovernorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.GovernanceDelegations.Set(ctx, delegatorAddr, *delegation); err != nil {
+			return err
+		}
+		if err := k.GovernorDelegations.Set(ctx, collections.Join(sdk.AccAddress(governorAddr), sdk.AccAddress(delegatorAddr))); err != nil {
+			return err
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -73,6 +90,11 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	// compute the running tallies of the proposals in voting period from the imported votes
+	if err := k.RebuildTallies(ctx); err != nil {
+		return err
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +153,23 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export governance delegations
+	var govDelegations []*v1.GovernanceDelegation
+	err = k.GovernanceDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.GovernanceDelegation) (stop bool, err error) {
+		govDelegations = append(govDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		GovernanceDelegations: govDelegations,
 	}, nil
 }
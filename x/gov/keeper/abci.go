@@ -23,6 +23,11 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
 
+	// halt rather than tally proposals against stale delegations
+	if err := k.ValidateStakingHooks(); err != nil {
+		return err
+	}
+
 	// delete dead proposals from store and returns theirs deposits.
 	// A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](k.HeaderService.HeaderInfo(ctx).Time)
@@ -133,6 +138,10 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 					return err
 				}
 
+				if err = k.deleteTally(ctx, proposal.Id); err != nil {
+					return err
+				}
+
 				continue
 			}
 
@@ -146,6 +155,15 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
+		// the votes and the running tally are cleared once tallied,
+		// a proposal converted to a regular proposal restarts voting
+		if err = k.deleteVotes(ctx, proposal.Id); err != nil {
+			return err
+		}
+		if err = k.deleteTally(ctx, proposal.Id); err != nil {
+			return err
+		}
+
 		// Deposits are always burned if tally said so, regardless of the proposal type.
 		// If a proposal passes, deposits are always refunded, regardless of the proposal type.
 		// If a proposal fails, and isn't spammy, deposits are refunded, unless the proposal is expedited or optimistic.
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegateGovernance assigns the governance power of a delegator to a governor,
+// replacing any existing governance delegation of the delegator.
+func (k Keeper) DelegateGovernance(ctx context.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	if bytes.Equal(delegatorAddr, governorAddr) {
+		return errors.Wrap(types.ErrInvalidGovDelegation, "cannot delegate governance power to self")
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+	governorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	// the delegations of the delegator now follow the votes of the new governor
+	if err := k.updateAccountTallies(ctx, delegatorAddr, func() error {
+		if err := k.removeGovernanceDelegation(ctx, delegatorAddr); err != nil && !stderrors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		delegation := v1.GovernanceDelegation{
+			DelegatorAddress: delegatorStrAddr,
+			GovernorAddress:  governorStrAddr,
+		}
+		if err := k.GovernanceDelegations.Set(ctx, delegatorAddr, delegation); err != nil {
+			return err
+		}
+
+		return k.GovernorDelegations.Set(ctx, collections.Join(governorAddr, delegatorAddr))
+	}); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateGov,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+		event.NewAttribute(types.AttributeKeyGovernor, governorStrAddr),
+	)
+}
+
+// UndelegateGovernance revokes the governance delegation of a delegator.
+// The delegator's governance power is then inherited by its validators again.
+func (k Keeper) UndelegateGovernance(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	// the delegations of the delegator no longer follow the votes of its governor
+	if err := k.updateAccountTallies(ctx, delegatorAddr, func() error {
+		return k.removeGovernanceDelegation(ctx, delegatorAddr)
+	}); err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrGovDelegationNotFound, "delegator %s", delegatorStrAddr)
+		}
+
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateGov,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+	)
+}
+
+// removeGovernanceDelegation removes the governance delegation of a delegator and its governor index entry.
+// It returns collections.ErrNotFound if the delegator has no governance delegation.
+func (k Keeper) removeGovernanceDelegation(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	delegation, err := k.GovernanceDelegations.Get(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegations.Remove(ctx, collections.Join(sdk.AccAddress(governorAddr), delegatorAddr)); err != nil {
+		return err
+	}
+
+	return k.GovernanceDelegations.Remove(ctx, delegatorAddr)
+}
@@ -334,6 +334,102 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// LiveTally queries the running tally of a proposal in voting period
+func (q queryServer) LiveTally(ctx context.Context, req *v1.QueryLiveTallyRequest) (*v1.QueryLiveTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	totalVotingPower, results, err := q.k.calculateVoteResultsAndVotingPower(ctx, proposal.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	totalBonded, err := q.k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tally := v1.NewTallyResultFromMap(results)
+	return &v1.QueryLiveTallyResponse{
+		Tally:             &tally,
+		TotalVotingPower:  totalVotingPower.String(),
+		TotalBondedTokens: totalBonded.String(),
+	}, nil
+}
+
+// GovernanceDelegation queries the governor an account delegated its governance power to
+func (q queryServer) GovernanceDelegation(ctx context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.k.GovernanceDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governance delegation of %s not found", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{GovernorAddress: delegation.GovernorAddress}, nil
+}
+
+// GovernorDelegations queries the governance delegations made to a governor
+func (q queryServer) GovernorDelegations(ctx context.Context, req *v1.QueryGovernorDelegationsRequest) (*v1.QueryGovernorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governor, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.k.GovernorDelegations, req.Pagination, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*v1.GovernanceDelegation, error) {
+		delegator, err := q.k.authKeeper.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return nil, err
+		}
+
+		return &v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: req.GovernorAddress}, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](governor))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -1735,6 +1735,91 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryLiveTally() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	var req *v1.QueryLiveTallyRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QueryLiveTallyRequest{}
+			},
+			false,
+		},
+		{
+			"non existing proposal request",
+			func() {
+				req = &v1.QueryLiveTallyRequest{ProposalId: 2}
+			},
+			false,
+		},
+		{
+			"proposal status deposit",
+			func() {
+				propTime := time.Now()
+				proposal := v1.Proposal{
+					Id:         1,
+					Status:     v1.StatusDepositPeriod,
+					SubmitTime: &propTime,
+					Metadata:   "proposal metadata",
+				}
+				err := suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal)
+				suite.Require().NoError(err)
+
+				req = &v1.QueryLiveTallyRequest{ProposalId: proposal.Id}
+			},
+			false,
+		},
+		{
+			"proposal is in voting period",
+			func() {
+				propTime := time.Now()
+				proposal := v1.Proposal{
+					Id:              1,
+					Status:          v1.StatusVotingPeriod,
+					SubmitTime:      &propTime,
+					VotingStartTime: &propTime,
+					VotingEndTime:   &propTime,
+					Metadata:        "proposal metadata",
+				}
+				err := suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal)
+				suite.Require().NoError(err)
+
+				req = &v1.QueryLiveTallyRequest{ProposalId: proposal.Id}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			res, err := queryClient.LiveTally(gocontext.Background(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				emptyTally := v1.EmptyTallyResult()
+				suite.Require().Equal(emptyTally.String(), res.Tally.String())
+				suite.Require().Equal(math.LegacyZeroDec().String(), res.TotalVotingPower)
+				suite.Require().Equal("10000000", res.TotalBondedTokens)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	queryClient := suite.legacyQueryClient
@@ -2038,3 +2123,51 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernanceDelegations() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+	addr2Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[2])
+	suite.Require().NoError(err)
+
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{})
+	suite.Require().ErrorContains(err, "empty delegator address")
+
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addr0Str})
+	suite.Require().ErrorContains(err, "not found")
+
+	suite.Require().NoError(suite.govKeeper.DelegateGovernance(ctx, suite.addrs[0], suite.addrs[2]))
+	suite.Require().NoError(suite.govKeeper.DelegateGovernance(ctx, suite.addrs[1], suite.addrs[2]))
+
+	res, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addr0Str})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2Str, res.GovernorAddress)
+
+	_, err = queryClient.GovernorDelegations(gocontext.Background(), &v1.QueryGovernorDelegationsRequest{})
+	suite.Require().ErrorContains(err, "empty governor address")
+
+	delegationsRes, err := queryClient.GovernorDelegations(gocontext.Background(), &v1.QueryGovernorDelegationsRequest{GovernorAddress: addr2Str})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]*v1.GovernanceDelegation{
+		{DelegatorAddress: addr0Str, GovernorAddress: addr2Str},
+		{DelegatorAddress: addr1Str, GovernorAddress: addr2Str},
+	}, delegationsRes.Delegations)
+
+	delegationsRes, err = queryClient.GovernorDelegations(gocontext.Background(), &v1.QueryGovernorDelegationsRequest{
+		GovernorAddress: addr2Str,
+		Pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(delegationsRes.Delegations, 1)
+	suite.Require().Equal(uint64(2), delegationsRes.Pagination.Total)
+
+	// accounts without governance delegators have no delegations
+	delegationsRes, err = queryClient.GovernorDelegations(gocontext.Background(), &v1.QueryGovernorDelegationsRequest{GovernorAddress: addr0Str})
+	suite.Require().NoError(err)
+	suite.Require().Empty(delegationsRes.Delegations)
+}
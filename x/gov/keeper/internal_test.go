@@ -8,3 +8,9 @@ import "cosmossdk.io/x/gov/types"
 func UnsafeSetHooks(k *Keeper, h types.GovHooks) {
 	k.hooks = h
 }
+
+// UnsafeSetStakingKeeper updates the gov keeper's staking keeper.
+// WARNING: this function should only be used in tests.
+func UnsafeSetStakingKeeper(k *Keeper, sk types.StakingKeeper) {
+	k.sk = sk
+}
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -65,6 +66,28 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// GovernanceDelegations key: delegatorAddr | value: GovernanceDelegation
+	GovernanceDelegations collections.Map[sdk.AccAddress, v1.GovernanceDelegation]
+	// GovernorDelegations key: governorAddr+delegatorAddr | value: none
+	// This is used to find the delegators of a governor.
+	GovernorDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	// TallyShares key: proposalID+valAddr+voteOption | value: shares
+	// This is the running tally of the delegation shares voted for each option of a proposal in voting period,
+	// per validator. It is updated when votes are cast and when delegations change.
+	TallyShares collections.Map[collections.Triple[uint64, sdk.ValAddress, int32], math.LegacyDec]
+	// TallyDeductions key: proposalID+valAddr | value: shares
+	// This is the running total of the voted delegation shares of each validator of a proposal in voting period,
+	// which are deducted from the shares the validator votes with.
+	TallyDeductions collections.Map[collections.Pair[uint64, sdk.ValAddress], math.LegacyDec]
+	// GovernorShares key: governorAddr+valAddr | value: shares
+	// This is the total of the delegation shares of the accounts that delegated their governance power to a governor,
+	// per validator. It is updated when governance delegations and delegations change, so that a governor's vote
+	// only iterates over validators rather than over its delegators.
+	GovernorShares collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], math.LegacyDec]
+	// GovernorVotedShares key: proposalID+governorAddr+valAddr | value: shares
+	// This is the part of GovernorShares of the delegators that voted themselves on a proposal in voting period,
+	// which does not follow the governor's vote.
+	GovernorVotedShares collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], math.LegacyDec]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -131,6 +154,12 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		GovernanceDelegations:  collections.NewMap(sb, types.GovernanceDelegationsPrefix, "governance_delegations", sdk.AccAddressKey, codec.CollValue[v1.GovernanceDelegation](cdc)),
+		GovernorDelegations:    collections.NewKeySet(sb, types.GovernorDelegationsPrefix, "governor_delegations", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
+		TallyShares:            collections.NewMap(sb, types.TallySharesPrefix, "tally_shares", collections.TripleKeyCodec(collections.Uint64Key, sdk.ValAddressKey, collections.Int32Key), sdk.LegacyDecValue),
+		TallyDeductions:        collections.NewMap(sb, types.TallyDeductionsPrefix, "tally_deductions", collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey), sdk.LegacyDecValue),
+		GovernorShares:         collections.NewMap(sb, types.GovernorSharesPrefix, "governor_shares", collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey), sdk.LegacyDecValue),
+		GovernorVotedShares:    collections.NewMap(sb, types.GovernorVotedSharesPrefix, "governor_voted_shares", collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.ValAddressKey), sdk.LegacyDecValue),
 	}
 	schema, err := sb.Build()
 	if err != nil {
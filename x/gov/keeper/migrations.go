@@ -43,3 +43,9 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 func (m Migrator) Migrate5to6(ctx context.Context) error {
 	return v6.MigrateStore(ctx, m.keeper.KVStoreService, m.keeper.Params, m.keeper.Proposals)
 }
+
+// Migrate6to7 migrates from version 6 to 7.
+// It computes the running tallies of the proposals in voting period from their votes.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return m.keeper.RebuildTallies(ctx)
+}
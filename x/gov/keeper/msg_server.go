@@ -407,6 +407,39 @@ func (k msgServer) SudoExec(ctx context.Context, msg *v1.MsgSudoExec) (*v1.MsgSu
 	}, nil
 }
 
+// DelegateGovernance implements the MsgServer.DelegateGovernance method.
+func (k msgServer) DelegateGovernance(ctx context.Context, msg *v1.MsgDelegateGovernance) (*v1.MsgDelegateGovernanceResponse, error) {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateGovernance(ctx, delegatorAddr, governorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovernanceResponse{}, nil
+}
+
+// UndelegateGovernance implements the MsgServer.UndelegateGovernance method.
+func (k msgServer) UndelegateGovernance(ctx context.Context, msg *v1.MsgUndelegateGovernance) (*v1.MsgUndelegateGovernanceResponse, error) {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateGovernance(ctx, delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovernanceResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
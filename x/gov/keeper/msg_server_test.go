@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -2317,3 +2318,115 @@ func (suite *KeeperTestSuite) TestMsgSudoExec() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgDelegateGovernance() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+	addr2Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[2])
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		input       *v1.MsgDelegateGovernance
+		expGovernor string
+		expErrMsg   string
+	}{
+		{
+			name:      "invalid delegator",
+			input:     &v1.MsgDelegateGovernance{DelegatorAddress: "invalid", GovernorAddress: addr1Str},
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid governor",
+			input:     &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: "invalid"},
+			expErrMsg: "invalid governor address",
+		},
+		{
+			name:      "delegation to self",
+			input:     &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: addr0Str},
+			expErrMsg: "cannot delegate governance power to self",
+		},
+		{
+			name:        "valid",
+			input:       &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: addr1Str},
+			expGovernor: addr1Str,
+		},
+		{
+			name:        "redelegation replaces the existing delegation",
+			input:       &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: addr2Str},
+			expGovernor: addr2Str,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			delegation, err := suite.govKeeper.GovernanceDelegations.Get(suite.ctx, suite.addrs[0])
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGovernor, delegation.GovernorAddress)
+		})
+	}
+
+	// the governor index only references the latest governor
+	has, err := suite.govKeeper.GovernorDelegations.Has(suite.ctx, collections.Join(suite.addrs[1], suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.govKeeper.GovernorDelegations.Has(suite.ctx, collections.Join(suite.addrs[2], suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+}
+
+func (suite *KeeperTestSuite) TestMsgUndelegateGovernance() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.govKeeper.DelegateGovernance(suite.ctx, suite.addrs[0], suite.addrs[1]))
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgUndelegateGovernance
+		expErrMsg string
+	}{
+		{
+			name:      "invalid delegator",
+			input:     &v1.MsgUndelegateGovernance{DelegatorAddress: "invalid"},
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:  "valid",
+			input: &v1.MsgUndelegateGovernance{DelegatorAddress: addr0Str},
+		},
+		{
+			name:      "no governance delegation",
+			input:     &v1.MsgUndelegateGovernance{DelegatorAddress: addr0Str},
+			expErrMsg: "governance delegation not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UndelegateGovernance(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			has, err := suite.govKeeper.GovernanceDelegations.Has(suite.ctx, suite.addrs[0])
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+			has, err = suite.govKeeper.GovernorDelegations.Has(suite.ctx, collections.Join(suite.addrs[1], suite.addrs[0]))
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+		})
+	}
+}
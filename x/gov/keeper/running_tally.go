@@ -0,0 +1,439 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The running tally of a proposal in voting period holds, per validator, the delegation
+// shares voted for each option and their total, which is deducted from the shares the
+// validator votes with. It is kept up to date as votes are cast, as governance delegations
+// change and as delegations change (through the staking hooks), so that tallying a proposal
+// only iterates over the validators.
+//
+// The delegation shares of the governance delegators of each governor are aggregated per
+// validator in the same way, so that a governor's vote only iterates over validators and
+// not over its delegators, whose number the governor does not control.
+
+// validatorShares are the delegation shares of an account to a validator.
+type validatorShares struct {
+	valAddr sdk.ValAddress
+	shares  math.LegacyDec
+}
+
+// accountDelegations returns the delegation shares of an account, per validator.
+func (k Keeper) accountDelegations(ctx context.Context, delegator sdk.AccAddress) ([]validatorShares, error) {
+	var (
+		delegations []validatorShares
+		iterErr     error
+	)
+	err := k.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		var valAddr []byte
+		valAddr, iterErr = k.sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if iterErr != nil {
+			return true
+		}
+
+		delegations = append(delegations, validatorShares{valAddr: valAddr, shares: delegation.GetShares()})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return delegations, iterErr
+}
+
+// governorOf returns the governor an account delegated its governance power to, or nil if none.
+func (k Keeper) governorOf(ctx context.Context, account sdk.AccAddress) (sdk.AccAddress, error) {
+	delegation, err := k.GovernanceDelegations.Get(ctx, account)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+}
+
+// effectiveVoteOptions returns the options the delegations of an account are tallied with on a proposal:
+// its own vote or, if it did not vote, the vote of its governor. It returns nil if neither voted.
+func (k Keeper) effectiveVoteOptions(ctx context.Context, proposalID uint64, voter sdk.AccAddress) (v1.WeightedVoteOptions, error) {
+	vote, err := k.Votes.Get(ctx, collections.Join(proposalID, voter))
+	if err == nil {
+		return vote.Options, nil
+	} else if !stderrors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	governor, err := k.governorOf(ctx, voter)
+	if err != nil || governor == nil {
+		return nil, err
+	}
+
+	vote, err = k.Votes.Get(ctx, collections.Join(proposalID, governor))
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return vote.Options, nil
+}
+
+// addTallyShares adds delegation shares to a validator in the running tally of a proposal,
+// split between the given options. Negative shares remove them.
+func (k Keeper) addTallyShares(ctx context.Context, proposalID uint64, valAddr sdk.ValAddress, shares math.LegacyDec, options v1.WeightedVoteOptions) error {
+	if err := addDec(ctx, k.TallyDeductions, collections.Join(proposalID, valAddr), shares); err != nil {
+		return err
+	}
+
+	for _, option := range options {
+		weight, err := math.LegacyNewDecFromStr(option.Weight)
+		if err != nil {
+			return err
+		}
+
+		if err := addDec(ctx, k.TallyShares, collections.Join3(proposalID, valAddr, int32(option.Option)), shares.Mul(weight)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retallyDelegations moves the given delegations of an account, in the running tally of a proposal,
+// from the options they were tallied with to the new ones. Nil options mean the delegations were
+// not, or are no longer, tallied.
+func (k Keeper) retallyDelegations(ctx context.Context, proposalID uint64, delegations []validatorShares, oldOptions, newOptions v1.WeightedVoteOptions) error {
+	for _, delegation := range delegations {
+		if len(oldOptions) > 0 {
+			if err := k.addTallyShares(ctx, proposalID, delegation.valAddr, delegation.shares.Neg(), oldOptions); err != nil {
+				return err
+			}
+		}
+
+		if len(newOptions) > 0 {
+			if err := k.addTallyShares(ctx, proposalID, delegation.valAddr, delegation.shares, newOptions); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// retallyGovernorShares moves, in the running tally of a proposal, the delegation shares of the
+// accounts that delegated their governance power to governor and did not vote themselves,
+// from the governor's previous vote options to its new ones.
+func (k Keeper) retallyGovernorShares(ctx context.Context, proposalID uint64, governor sdk.AccAddress, oldOptions, newOptions v1.WeightedVoteOptions) error {
+	// the shares are collected first, as the store must not be written while iterating
+	var delegations []validatorShares
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](governor)
+	if err := k.GovernorShares.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], shares math.LegacyDec) (bool, error) {
+		delegations = append(delegations, validatorShares{valAddr: key.K2(), shares: shares})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for i, delegation := range delegations {
+		voted, err := k.GovernorVotedShares.Get(ctx, collections.Join3(proposalID, governor, delegation.valAddr))
+		if err == nil {
+			delegations[i].shares = delegation.shares.Sub(voted)
+		} else if !stderrors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+	}
+
+	return k.retallyDelegations(ctx, proposalID, delegations, oldOptions, newOptions)
+}
+
+// addGovernorShares adds (or removes, when shares are negative) delegation shares of a governance
+// delegator to the shares of its governor, and to the voted shares of the governor on the proposals
+// in voting period the delegator voted on itself.
+func (k Keeper) addGovernorShares(ctx context.Context, proposalIDs []uint64, governor, delegator sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	if err := addDec(ctx, k.GovernorShares, collections.Join(governor, valAddr), shares); err != nil {
+		return err
+	}
+
+	for _, proposalID := range proposalIDs {
+		voted, err := k.Votes.Has(ctx, collections.Join(proposalID, delegator))
+		if err != nil {
+			return err
+		}
+		if !voted {
+			continue
+		}
+
+		if err := addDec(ctx, k.GovernorVotedShares, collections.Join3(proposalID, governor, valAddr), shares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addGovernorVotedShares marks the delegations of an account as voted on a proposal, so that they
+// no longer follow the vote of its governor, if any.
+func (k Keeper) addGovernorVotedShares(ctx context.Context, proposalID uint64, voter sdk.AccAddress, delegations []validatorShares) error {
+	governor, err := k.governorOf(ctx, voter)
+	if err != nil || governor == nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		if err := addDec(ctx, k.GovernorVotedShares, collections.Join3(proposalID, governor, delegation.valAddr), delegation.shares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateAccountTallies runs fn, which changes the governance delegation of an account, and updates
+// the shares of its governors and the running tallies of all the proposals in voting period accordingly.
+func (k Keeper) updateAccountTallies(ctx context.Context, account sdk.AccAddress, fn func() error) error {
+	proposalIDs, err := k.votingPeriodProposalIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	delegations, err := k.accountDelegations(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	oldGovernor, err := k.governorOf(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	oldOptions := make([]v1.WeightedVoteOptions, len(proposalIDs))
+	for i, proposalID := range proposalIDs {
+		if oldOptions[i], err = k.effectiveVoteOptions(ctx, proposalID, account); err != nil {
+			return err
+		}
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	newGovernor, err := k.governorOf(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		if oldGovernor != nil {
+			if err := k.addGovernorShares(ctx, proposalIDs, oldGovernor, account, delegation.valAddr, delegation.shares.Neg()); err != nil {
+				return err
+			}
+		}
+
+		if newGovernor != nil {
+			if err := k.addGovernorShares(ctx, proposalIDs, newGovernor, account, delegation.valAddr, delegation.shares); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, proposalID := range proposalIDs {
+		newOptions, err := k.effectiveVoteOptions(ctx, proposalID, account)
+		if err != nil {
+			return err
+		}
+
+		if err := k.retallyDelegations(ctx, proposalID, delegations, oldOptions[i], newOptions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tallyDelegationShares adds (or removes, when shares are negative) the shares of a delegation
+// to the shares of the delegator's governor and to the running tallies of all the proposals in
+// voting period the delegator takes part in.
+func (k Keeper) tallyDelegationShares(ctx context.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	proposalIDs, err := k.votingPeriodProposalIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	governor, err := k.governorOf(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if governor != nil {
+		if err := k.addGovernorShares(ctx, proposalIDs, governor, delegator, valAddr, shares); err != nil {
+			return err
+		}
+	}
+
+	for _, proposalID := range proposalIDs {
+		options, err := k.effectiveVoteOptions(ctx, proposalID, delegator)
+		if err != nil {
+			return err
+		}
+
+		if len(options) == 0 {
+			continue
+		}
+
+		if err := k.addTallyShares(ctx, proposalID, valAddr, shares, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebuildTally recomputes the running tally of a proposal from its votes.
+func (k Keeper) rebuildTally(ctx context.Context, proposalID uint64) error {
+	if err := k.deleteTally(ctx, proposalID); err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	iter, err := k.Votes.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	votes, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	// the delegations of the voters are tallied first, so that the shares of the governance
+	// delegators that voted themselves are known when tallying the governors' votes
+	for _, vote := range votes {
+		voter := vote.Key.K2()
+		delegations, err := k.accountDelegations(ctx, voter)
+		if err != nil {
+			return err
+		}
+
+		if err := k.retallyDelegations(ctx, proposalID, delegations, nil, vote.Value.Options); err != nil {
+			return err
+		}
+
+		if err := k.addGovernorVotedShares(ctx, proposalID, voter, delegations); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range votes {
+		if err := k.retallyGovernorShares(ctx, proposalID, vote.Key.K2(), nil, vote.Value.Options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RebuildTallies recomputes the shares of the governors from the governance delegations, and the
+// running tallies of all the proposals in voting period from their votes.
+func (k Keeper) RebuildTallies(ctx context.Context) error {
+	if err := k.rebuildGovernorShares(ctx); err != nil {
+		return err
+	}
+
+	proposalIDs, err := k.votingPeriodProposalIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, proposalID := range proposalIDs {
+		if err := k.rebuildTally(ctx, proposalID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebuildGovernorShares recomputes the shares of the governors from the governance delegations.
+func (k Keeper) rebuildGovernorShares(ctx context.Context) error {
+	if err := k.GovernorShares.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	iter, err := k.GovernorDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		delegations, err := k.accountDelegations(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		for _, delegation := range delegations {
+			if err := addDec(ctx, k.GovernorShares, collections.Join(key.K1(), delegation.valAddr), delegation.shares); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteTally deletes the running tally of a proposal.
+func (k Keeper) deleteTally(ctx context.Context, proposalID uint64) error {
+	if err := k.TallyShares.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, int32](proposalID)); err != nil {
+		return err
+	}
+
+	if err := k.GovernorVotedShares.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID)); err != nil {
+		return err
+	}
+
+	return k.TallyDeductions.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID))
+}
+
+// votingPeriodProposalIDs returns the ids of the proposals in voting period.
+func (k Keeper) votingPeriodProposalIDs(ctx context.Context) ([]uint64, error) {
+	var proposalIDs []uint64
+	err := k.ActiveProposalsQueue.Walk(ctx, nil, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (bool, error) {
+		proposalIDs = append(proposalIDs, proposalID)
+		return false, nil
+	})
+
+	return proposalIDs, err
+}
+
+// addDec adds delta to the value stored at key, removing the entry once it reaches zero.
+func addDec[K any](ctx context.Context, m collections.Map[K, math.LegacyDec], key K, delta math.LegacyDec) error {
+	value, err := m.Get(ctx, key)
+	if err != nil {
+		if !stderrors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		value = math.LegacyZeroDec()
+	}
+
+	value = value.Add(delta)
+	if value.IsZero() {
+		return m.Remove(ctx, key)
+	}
+
+	return m.Set(ctx, key, value)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingHooks wrapper struct for the gov keeper, keeping the running tallies
+// of the proposals in voting period up to date with the delegations of the voters.
+type StakingHooks struct {
+	k Keeper
+}
+
+// StakingHooks returns the staking hooks of the gov keeper
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// ValidateStakingHooks returns an error if the staking hooks of the gov keeper are not
+// registered with x/staking, as the running tallies would then miss the delegation changes
+// of the voters. It is a no-op when the staking keeper does not expose its hooks.
+func (k Keeper) ValidateStakingHooks() error {
+	sk, ok := k.sk.(interface{ Hooks() stakingtypes.StakingHooks })
+	if !ok {
+		return nil
+	}
+
+	if !hasStakingHooks(sk.Hooks()) {
+		return errors.New("x/gov staking hooks are not registered with x/staking: add GovKeeper.StakingHooks() to the staking hooks")
+	}
+
+	return nil
+}
+
+// hasStakingHooks reports whether the gov staking hooks are part of the given hooks.
+func hasStakingHooks(hooks stakingtypes.StakingHooks) bool {
+	switch h := hooks.(type) {
+	case StakingHooks:
+		return true
+	case stakingtypes.StakingHooksWrapper:
+		return hasStakingHooks(h.StakingHooks)
+	case stakingtypes.MultiStakingHooks:
+		return slices.ContainsFunc(h, hasStakingHooks)
+	case *stakingtypes.MultiStakingHooks:
+		return h != nil && slices.ContainsFunc(*h, hasStakingHooks)
+	default:
+		return false
+	}
+}
+
+// BeforeDelegationSharesModified removes the current shares of the delegation from the running tallies.
+// The staking module calls it before any change of the delegation shares, including before removing it.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, err := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return h.k.tallyDelegationShares(ctx, delAddr, valAddr, delegation.GetShares().Neg())
+}
+
+// AfterDelegationModified adds the new shares of the delegation to the running tallies.
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, err := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return h.k.tallyDelegationShares(ctx, delAddr, valAddr, delegation.GetShares())
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationRemoved is a no-op: the shares of the delegation were already removed
+// from the running tallies by BeforeDelegationSharesModified.
+func (h StakingHooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ sdkmath.LegacyDec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeTokenizeShareRecordModified(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func (h StakingHooks) AfterConsensusPubKeyUpdate(_ context.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
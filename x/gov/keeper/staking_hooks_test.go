@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	govtestutil "cosmossdk.io/x/gov/testutil"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStakingHooksUpdateRunningTally(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(10000000), nil).AnyTimes()
+
+	addrs := simtestutil.CreateRandomAccounts(2)
+	delAddr, valAddr := addrs[0], sdk.ValAddress(addrs[1])
+	delStrAddr, err := mocks.acctKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+	valStrAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+				fn(0, stakingtypes.Validator{
+					OperatorAddress: valStrAddr,
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				})
+				return nil
+			}).AnyTimes()
+
+	delegation := func(shares int64) stakingtypes.Delegation {
+		return stakingtypes.Delegation{
+			DelegatorAddress: delStrAddr,
+			ValidatorAddress: valStrAddr,
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}
+	}
+
+	proposal, err := govKeeper.SubmitProposal(ctx, nil, "", "title", "summary", delAddr, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	mocks.stakingKeeper.EXPECT().
+		IterateDelegations(ctx, delAddr, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+				fn(0, delegation(42))
+				return nil
+			})
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, delAddr, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	assertYesCount := func(expected string) {
+		t.Helper()
+		_, _, tally, err := govKeeper.Tally(ctx, proposal)
+		require.NoError(t, err)
+		require.Equal(t, expected, tally.YesCount)
+	}
+	assertYesCount("42")
+
+	// the delegator delegates more tokens after voting
+	hooks := govKeeper.StakingHooks()
+	mocks.stakingKeeper.EXPECT().Delegation(ctx, delAddr, valAddr).Return(delegation(42), nil)
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr))
+	mocks.stakingKeeper.EXPECT().Delegation(ctx, delAddr, valAddr).Return(delegation(100), nil)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delAddr, valAddr))
+	assertYesCount("100")
+
+	// the delegator then fully unbonds
+	mocks.stakingKeeper.EXPECT().Delegation(ctx, delAddr, valAddr).Return(delegation(100), nil)
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr))
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr))
+	assertYesCount("0")
+}
+
+func TestStakingHooksUpdateGovernorShares(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(10000000), nil).AnyTimes()
+
+	addrs := simtestutil.CreateRandomAccounts(3)
+	delAddr, govAddr, valAddr := addrs[0], addrs[1], sdk.ValAddress(addrs[2])
+	delStrAddr, err := mocks.acctKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+	valStrAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+				fn(0, stakingtypes.Validator{
+					OperatorAddress: valStrAddr,
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				})
+				return nil
+			}).AnyTimes()
+
+	delegation := func(shares int64) stakingtypes.Delegation {
+		return stakingtypes.Delegation{
+			DelegatorAddress: delStrAddr,
+			ValidatorAddress: valStrAddr,
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}
+	}
+	expectDelegations := func(addr sdk.AccAddress, delegations ...stakingtypes.Delegation) {
+		mocks.stakingKeeper.EXPECT().
+			IterateDelegations(ctx, addr, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
+
+	proposal, err := govKeeper.SubmitProposal(ctx, nil, "", "title", "summary", delAddr, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	assertTally := func(yes, no string) {
+		t.Helper()
+		_, _, tally, err := govKeeper.Tally(ctx, proposal)
+		require.NoError(t, err)
+		require.Equal(t, yes, tally.YesCount)
+		require.Equal(t, no, tally.NoCount)
+	}
+	assertShares := func(m collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], sdkmath.LegacyDec], expected int64) {
+		t.Helper()
+		shares, err := m.Get(ctx, collections.Join(govAddr, valAddr))
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.LegacyNewDec(expected), shares)
+	}
+
+	expectDelegations(delAddr, delegation(42))
+	require.NoError(t, govKeeper.DelegateGovernance(ctx, delAddr, govAddr))
+	assertShares(govKeeper.GovernorShares, 42)
+
+	// the governor's vote is tallied with the shares of its delegators
+	expectDelegations(govAddr)
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, govAddr, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assertTally("42", "0")
+
+	// the delegator delegates more tokens
+	hooks := govKeeper.StakingHooks()
+	mocks.stakingKeeper.EXPECT().Delegation(ctx, delAddr, valAddr).Return(delegation(42), nil)
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr))
+	mocks.stakingKeeper.EXPECT().Delegation(ctx, delAddr, valAddr).Return(delegation(100), nil)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delAddr, valAddr))
+	assertShares(govKeeper.GovernorShares, 100)
+	assertTally("100", "0")
+
+	// the delegator votes itself, and no longer follows its governor
+	expectDelegations(delAddr, delegation(100))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, delAddr, v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	votedShares, err := govKeeper.GovernorVotedShares.Get(ctx, collections.Join3(proposal.Id, govAddr, valAddr))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(100), votedShares)
+	assertTally("0", "100")
+
+	// the governor changing its vote leaves the delegator's shares untouched
+	expectDelegations(govAddr)
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, govAddr, v1.NewNonSplitVoteOption(v1.OptionThree), ""))
+	assertTally("0", "100")
+
+	// undelegating governance removes the delegator's shares from the governor
+	expectDelegations(delAddr, delegation(100))
+	require.NoError(t, govKeeper.UndelegateGovernance(ctx, delAddr))
+	has, err := govKeeper.GovernorShares.Has(ctx, collections.Join(govAddr, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = govKeeper.GovernorVotedShares.Has(ctx, collections.Join3(proposal.Id, govAddr, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	assertTally("0", "100")
+}
+
+type hookedStakingKeeper struct {
+	*govtestutil.MockStakingKeeper
+	hooks stakingtypes.StakingHooks
+}
+
+func (sk *hookedStakingKeeper) Hooks() stakingtypes.StakingHooks {
+	return sk.hooks
+}
+
+func TestValidateStakingHooks(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+
+	// staking keepers not exposing their hooks are not checked
+	require.NoError(t, govKeeper.ValidateStakingHooks())
+
+	sk := &hookedStakingKeeper{MockStakingKeeper: mocks.stakingKeeper, hooks: stakingtypes.MultiStakingHooks{}}
+	keeper.UnsafeSetStakingKeeper(govKeeper, sk)
+	require.ErrorContains(t, govKeeper.ValidateStakingHooks(), "x/gov staking hooks are not registered")
+	require.ErrorContains(t, govKeeper.EndBlocker(ctx), "x/gov staking hooks are not registered")
+
+	sk.hooks = stakingtypes.NewMultiStakingHooks(stakingtypes.StakingHooksWrapper{StakingHooks: govKeeper.StakingHooks()})
+	require.NoError(t, govKeeper.ValidateStakingHooks())
+}
@@ -11,18 +11,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the voters
+// Tally computes the tally of a proposal based on the voting power of the voters
 func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
-	validators, err := k.getCurrentValidators(ctx)
-	if err != nil {
-		return false, false, v1.TallyResult{}, err
-	}
-
-	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
-		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
-	}
-
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	totalVoterPower, results, err := k.calculateVoteResultsAndVotingPower(ctx, proposal.Id)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
@@ -208,6 +199,21 @@ func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded
 	return true, false, tallyResults, nil
 }
 
+// calculateVoteResultsAndVotingPower returns the total voting power and the votes results of a proposal,
+// using the configured CalculateVoteResultsAndVotingPowerFn
+func (k Keeper) calculateVoteResultsAndVotingPower(ctx context.Context, proposalID uint64) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
+		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	}
+
+	return k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposalID, validators)
+}
+
 // getCurrentValidators fetches all the bonded validators, insert them into currValidators
 func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.ValidatorGovInfo, error) {
 	currValidators := make(map[string]v1.ValidatorGovInfo)
@@ -232,8 +238,12 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 	return currValidators, nil
 }
 
-// calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
-// and returns the votes results from voters
+// defaultCalculateVoteResultsAndVotingPower tallies up the voting power of each validator from the
+// running tally of the proposal, and returns the votes results from voters.
+// The voting power of an account that did not vote is tallied with the vote of its governor, if any,
+// and otherwise inherited by the validators it delegates to.
+// As the running tally is kept up to date as votes are cast and delegations change, this only
+// iterates over the validators, not over the votes.
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
@@ -243,77 +253,42 @@ func defaultCalculateVoteResultsAndVotingPower(
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
 
-	// iterate over all votes, tally up the voting power of each validator
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
-	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
-	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
-		if err != nil {
-			return false, err
-		}
-
-		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
-		if err != nil {
-			return false, err
+	for _, val := range validators {
+		// tally the voting power of the delegators who voted, directly or through their governor
+		rng := collections.NewSuperPrefixedTripleRange[uint64, sdk.ValAddress, int32](proposalID, val.Address)
+		if err := k.TallyShares.Walk(ctx, rng, func(key collections.Triple[uint64, sdk.ValAddress, int32], shares math.LegacyDec) (bool, error) {
+			// delegation shares * bonded / total shares
+			votingPower := shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			option := v1.VoteOption(key.K3())
+			results[option] = results[option].Add(votingPower)
+			return false, nil
+		}); err != nil {
+			return math.LegacyDec{}, nil, err
 		}
 
-		if val, ok := validators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			validators[valAddrStr] = val
+		deductions, err := k.TallyDeductions.Get(ctx, collections.Join(proposalID, val.Address))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, nil, err
+		} else if err == nil {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+			val.DelegatorDeductions = deductions
+			totalVP = totalVP.Add(deductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
-
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-
-				totalVP = totalVP.Add(votingPower)
-			}
-
-			return false
-		})
+		// the validator votes with the shares of the delegators who did not vote
+		vote, err := k.Votes.Get(ctx, collections.Join(proposalID, sdk.AccAddress(val.Address)))
 		if err != nil {
-			return false, err
-		}
-
-		votesToRemove = append(votesToRemove, key)
-		return false, nil
-	}); err != nil {
-		return math.LegacyDec{}, nil, err
-	}
-
-	// remove all votes from store
-	for _, key := range votesToRemove {
-		if err := k.Votes.Remove(ctx, key); err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
 			return math.LegacyDec{}, nil, err
 		}
-	}
-
-	// iterate over the validators again to tally their voting power
-	for _, val := range validators {
-		if len(val.Vote) == 0 {
-			continue
-		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-		for _, option := range val.Vote {
+		for _, option := range vote.Options {
 			weight, _ := math.LegacyNewDecFromStr(option.Weight)
 			subPower := votingPower.Mul(weight)
 			results[option.Option] = results[option.Option].Add(subPower)
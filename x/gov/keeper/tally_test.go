@@ -36,8 +36,7 @@ var (
 		s.mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(n), nil)
 	}
 	delegatorVote = func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation, vote v1.VoteOption) {
-		err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
-		require.NoError(s.t, err)
+		// the delegations of the voter are added to the running tally when it votes
 		s.mocks.stakingKeeper.EXPECT().
 			IterateDelegations(s.ctx, voter, gomock.Any()).
 			DoAndReturn(
@@ -47,11 +46,27 @@ var (
 					}
 					return nil
 				})
+		err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
+		require.NoError(s.t, err)
 	}
 	validatorVote = func(s tallyFixture, voter sdk.ValAddress, vote v1.VoteOption) {
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
 	}
+	delegateGovernance = func(s tallyFixture, delegator, governor sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		// the delegations are added to the governor's shares
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+		err := s.keeper.DelegateGovernance(s.ctx, delegator, governor)
+		require.NoError(s.t, err)
+	}
 )
 
 func TestTally_Standard(t *testing.T) {
@@ -170,6 +185,36 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			name: "one delegator changes its vote: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: del0Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				delegatorVote(s, s.delAddrs[0], delegations, v1.VoteOption_VOTE_OPTION_ONE)
+				delegatorVote(s, s.delAddrs[0], delegations, v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "42",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "42",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 		{
 			name: "one delegator votes yes, validator votes also yes: prop fails/burn deposit",
 			setup: func(s tallyFixture) {
@@ -276,6 +321,132 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			// delegator delegates its governance power to an account
+			// governor votes no, validator votes yes
+			name: "delegator without vote follows its governor: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: del0Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				delegateGovernance(s, s.delAddrs[0], s.delAddrs[1], delegations)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "999958",
+				AbstainCount:     "0",
+				NoCount:          "42",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "999958",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "42",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// delegator delegates its governance power to an account
+			// delegator votes yes, governor votes no
+			name: "delegator vote overrides its governor: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: del0Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				delegateGovernance(s, s.delAddrs[0], s.delAddrs[1], delegations)
+				delegatorVote(s, s.delAddrs[0], delegations, v1.VoteOption_VOTE_OPTION_ONE)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "42",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "42",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// two delegators delegate their governance power to an account
+			// governor votes no, then one delegator votes yes, validator votes yes
+			name: "delegator voting after its governor leaves the governor's shares: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				delegation := func(delAddr sdk.AccAddress, shares int64) []stakingtypes.Delegation {
+					delStrAddr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(delAddr)
+					require.NoError(t, err)
+					return []stakingtypes.Delegation{{
+						DelegatorAddress: delStrAddr,
+						ValidatorAddress: val0Addr,
+						Shares:           sdkmath.LegacyNewDec(shares),
+					}}
+				}
+				delegateGovernance(s, s.delAddrs[0], s.delAddrs[1], delegation(s.delAddrs[0], 42))
+				delegateGovernance(s, s.delAddrs[2], s.delAddrs[1], delegation(s.delAddrs[2], 8))
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[2], delegation(s.delAddrs[2], 8), v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "999958",
+				AbstainCount:     "0",
+				NoCount:          "42",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "999958",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "42",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// delegator delegates its governance power to an account that does not vote
+			// validator votes yes and inherits the delegator power
+			name: "governor does not vote, validator inherits delegator power: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateGovernance(s, s.delAddrs[0], s.delAddrs[1], nil)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "1000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 		{
 			name: "quorum reached with only abstain: prop fails",
 			setup: func(s tallyFixture) {
@@ -77,12 +77,44 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	if err != nil {
 		return err
 	}
+
+	// the options the voter and its governance delegators were tallied with before this vote
+	oldOptions, err := k.effectiveVoteOptions(ctx, proposalID, voterAddr)
+	if err != nil {
+		return err
+	}
+	var oldDelegatorsOptions v1.WeightedVoteOptions
+	oldVote, err := k.Votes.Get(ctx, collections.Join(proposalID, voterAddr))
+	if err == nil {
+		oldDelegatorsOptions = oldVote.Options
+	} else if !stderrors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
 	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
 	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
 	if err != nil {
 		return err
 	}
 
+	// update the running tally of the proposal
+	delegations, err := k.accountDelegations(ctx, voterAddr)
+	if err != nil {
+		return err
+	}
+	if err := k.retallyDelegations(ctx, proposalID, delegations, oldOptions, options); err != nil {
+		return err
+	}
+	if oldDelegatorsOptions == nil {
+		// the delegations of the voter no longer follow its governor on this proposal
+		if err := k.addGovernorVotedShares(ctx, proposalID, voterAddr, delegations); err != nil {
+			return err
+		}
+	}
+	if err := k.retallyGovernorShares(ctx, proposalID, voterAddr, oldDelegatorsOptions, options); err != nil {
+		return err
+	}
+
 	// called after a vote on a proposal is cast
 	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
 		return err
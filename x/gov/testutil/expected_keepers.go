@@ -60,6 +60,7 @@ type StakingKeeper interface {
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
+	Delegation(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.DelegationI, error)
 
 	BondDenom(ctx context.Context) (string, error)
 	TokensFromConsensusPower(ctx context.Context, power int64) math.Int
@@ -28,4 +28,6 @@ var (
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
 	ErrTooManyVoteOptions      = errors.Register(ModuleName, 26, "too many weighted vote options")
+	ErrInvalidGovDelegation    = errors.Register(ModuleName, 27, "invalid governance delegation")
+	ErrGovDelegationNotFound   = errors.Register(ModuleName, 28, "governance delegation not found")
 )
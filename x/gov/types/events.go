@@ -8,6 +8,8 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeDelegateGov      = "delegate_governance"
+	EventTypeUndelegateGov    = "undelegate_governance"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +21,8 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyDelegator            = "delegator"
+	AttributeKeyGovernor             = "governor"
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -22,6 +22,7 @@ type StakingKeeper interface {
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
+	Delegation(ctx context.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.DelegationI, error)
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
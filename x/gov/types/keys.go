@@ -26,6 +26,12 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	GovernanceDelegationsPrefix  = collections.NewPrefix(52) // GovernanceDelegationsPrefix stores the governance delegation of each delegator.
+	GovernorDelegationsPrefix    = collections.NewPrefix(53) // GovernorDelegationsPrefix indexes the governance delegations by governor.
+	TallySharesPrefix            = collections.NewPrefix(54) // TallySharesPrefix stores the running tally of the voted delegation shares of proposals.
+	TallyDeductionsPrefix        = collections.NewPrefix(55) // TallyDeductionsPrefix stores the voted delegation shares deducted from the validators of proposals.
+	GovernorSharesPrefix         = collections.NewPrefix(56) // GovernorSharesPrefix stores the delegation shares of the governance delegators of each governor.
+	GovernorVotedSharesPrefix    = collections.NewPrefix(57) // GovernorVotedSharesPrefix stores the delegation shares of the governance delegators that voted on proposals.
 )
 
 // Reserved kvstore keys
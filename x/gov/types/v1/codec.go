@@ -20,6 +20,8 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(registrar, &MsgDelegateGovernance{}, "cosmos-sdk/v1/MsgDelegateGovernance")
+	legacy.RegisterAminoMsg(registrar, &MsgUndelegateGovernance{}, "cosmos-sdk/v1/MsgUndelegateGovernance")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -34,6 +36,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgDelegateGovernance{},
+		&MsgUndelegateGovernance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
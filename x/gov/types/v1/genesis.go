@@ -34,7 +34,8 @@ func (data GenesisState) Empty() bool {
 // ValidateGenesis checks if gov genesis state is valid ranges
 // It checks if params are in valid ranges
 // It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals.
+// It also makes sure that each account has at most one governance delegation, which is not to itself
 func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -98,6 +99,30 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate and self governance delegations
+	errGroup.Go(func() error {
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, err := ac.StringToBytes(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid governance delegation %v: %w", d, err)
+			}
+			if _, err := ac.StringToBytes(d.GovernorAddress); err != nil {
+				return fmt.Errorf("invalid governance delegation %v: %w", d, err)
+			}
+			if d.DelegatorAddress == d.GovernorAddress {
+				return fmt.Errorf("governance delegation to self: %v", d)
+			}
+
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -23,6 +23,10 @@ func TestEmptyGenesis(t *testing.T) {
 func TestValidateGenesis(t *testing.T) {
 	codec := address.NewBech32Codec("cosmos")
 	params := v1.DefaultParams()
+	delegator, err := codec.BytesToString([]byte("delegator"))
+	require.NoError(t, err)
+	governor, err := codec.BytesToString([]byte("governor"))
+	require.NoError(t, err)
 
 	testCases := []struct {
 		name         string
@@ -172,6 +176,55 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor},
+					&v1.GovernanceDelegation{DelegatorAddress: governor, GovernorAddress: delegator},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "invalid governance delegation address",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: "governor"},
+				)
+
+				return state
+			},
+			expErrMsg: "invalid governance delegation",
+		},
+		{
+			name: "governance delegation to self",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: delegator},
+				)
+
+				return state
+			},
+			expErrMsg: "governance delegation to self",
+		},
+		{
+			name: "duplicate governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor},
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor},
+				)
+
+				return state
+			},
+			expErrMsg: "duplicate governance delegation",
+		},
 	}
 
 	for _, tc := range testCases {
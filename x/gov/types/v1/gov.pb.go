@@ -956,15 +956,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +975,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1279,6 +1279,64 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+// GovernanceDelegation defines the assignment of the governance power of a
+// delegator to a governor. When the governor votes on a proposal, the staked
+// tokens of the delegator are tallied with the governor's vote, unless the
+// delegator votes on the proposal.
+type GovernanceDelegation struct {
+	// delegator_address is the address of the account delegating its governance power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the address of the account voting on behalf of the delegator.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1294,138 +1352,142 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0xf9, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0xfc,
+	0xb0, 0xf0, 0x2f, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41,
+	0x6c, 0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e,
+	0xfc, 0xd1, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0x45, 0x2f, 0xdd, 0x63, 0x0f, 0x41, 0xef, 0x3d, 0x75,
+	0xd1, 0x43, 0xb1, 0xc8, 0xa9, 0x58, 0xa0, 0x69, 0x91, 0x1c, 0x0a, 0xec, 0x9f, 0x50, 0xf4, 0x50,
+	0xcc, 0x70, 0x28, 0x52, 0x5f, 0xb1, 0xbc, 0xe8, 0x25, 0xb1, 0x66, 0x9e, 0xe7, 0x99, 0x77, 0xde,
+	0xaf, 0x79, 0x25, 0x58, 0x3a, 0x21, 0x81, 0x4b, 0x82, 0x52, 0x93, 0x9c, 0x95, 0xce, 0x76, 0xd9,
+	0x7f, 0xc5, 0xb6, 0x4f, 0x28, 0x41, 0xf9, 0x70, 0xa3, 0xc8, 0x56, 0xce, 0x76, 0x97, 0xd7, 0x04,
+	0xee, 0xd8, 0x0a, 0x70, 0xe9, 0x6c, 0xf7, 0x18, 0x53, 0x6b, 0xb7, 0x74, 0x42, 0x1c, 0x2f, 0x84,
+	0x2f, 0x2f, 0x34, 0x49, 0x93, 0xf0, 0x3f, 0x4b, 0xec, 0x2f, 0xb1, 0xba, 0xde, 0x24, 0xa4, 0xd9,
+	0xc2, 0x25, 0xfe, 0xe9, 0xb8, 0xf3, 0xb2, 0x44, 0x1d, 0x17, 0x07, 0xd4, 0x72, 0xdb, 0x02, 0x70,
+	0xab, 0x1f, 0x60, 0x79, 0x97, 0x62, 0x6b, 0xad, 0x7f, 0xcb, 0xee, 0xf8, 0x16, 0x75, 0x48, 0x74,
+	0xe2, 0xad, 0xd0, 0x22, 0x33, 0x3c, 0x54, 0x58, 0x1b, 0x6e, 0xcd, 0x59, 0xae, 0xe3, 0x91, 0x12,
+	0xff, 0x37, 0x5c, 0xda, 0x24, 0x80, 0x9e, 0x61, 0xa7, 0x79, 0x4a, 0xb1, 0x7d, 0x44, 0x28, 0xae,
+	0xb5, 0x99, 0x12, 0xda, 0x85, 0x49, 0xc2, 0xff, 0x52, 0xa4, 0x0d, 0x69, 0xab, 0x70, 0xf7, 0x56,
+	0xb1, 0xe7, 0xd6, 0xc5, 0x18, 0xaa, 0x0b, 0x20, 0xfa, 0x10, 0x26, 0xcf, 0xb9, 0x90, 0x92, 0xda,
+	0x90, 0xb6, 0xb2, 0x95, 0xc2, 0xeb, 0x57, 0xdb, 0x20, 0x58, 0x55, 0x7c, 0xa2, 0x8b, 0xdd, 0xcd,
+	0xdf, 0x4a, 0x30, 0x55, 0xc5, 0x6d, 0x12, 0x38, 0x14, 0xad, 0xc3, 0x4c, 0xdb, 0x27, 0x6d, 0x12,
+	0x58, 0x2d, 0xd3, 0xb1, 0xf9, 0x59, 0x19, 0x1d, 0xa2, 0x25, 0xcd, 0x46, 0x3f, 0x84, 0xac, 0x1d,
+	0x62, 0x89, 0x2f, 0x74, 0x95, 0xd7, 0xaf, 0xb6, 0x17, 0x84, 0x6e, 0xd9, 0xb6, 0x7d, 0x1c, 0x04,
+	0x0d, 0xea, 0x3b, 0x5e, 0x53, 0x8f, 0xa1, 0xe8, 0x33, 0x98, 0xb4, 0x5c, 0xd2, 0xf1, 0xa8, 0x92,
+	0xde, 0x48, 0x6f, 0xcd, 0xc4, 0xf6, 0xb3, 0x30, 0x15, 0x45, 0x98, 0x8a, 0xfb, 0xc4, 0xf1, 0x2a,
+	0xd9, 0xaf, 0xdf, 0xac, 0xdf, 0xf8, 0xea, 0x5f, 0x7f, 0xb8, 0x23, 0xe9, 0x82, 0xb3, 0xf9, 0xa7,
+	0x29, 0x98, 0xae, 0x0b, 0x23, 0x50, 0x01, 0x52, 0x5d, 0xd3, 0x52, 0x8e, 0x8d, 0x76, 0x60, 0xda,
+	0xc5, 0x41, 0x60, 0x35, 0x71, 0xa0, 0xa4, 0xb8, 0xf8, 0x42, 0x31, 0x8c, 0x48, 0x31, 0x8a, 0x48,
+	0xb1, 0xec, 0x5d, 0xea, 0x5d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee,
+	0xcc, 0xd5, 0x3e, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7,
+	0xb3, 0x5a, 0x26, 0xb5, 0x5a, 0xad, 0x4b, 0xd3, 0xc7, 0x41, 0xa7, 0x45, 0x95, 0xcc, 0x86, 0xb4,
+	0x35, 0x73, 0x77, 0xb9, 0x4f, 0xc2, 0x60, 0x10, 0x9d, 0x23, 0x74, 0x99, 0xb3, 0x12, 0x2b, 0xa8,
+	0x0c, 0x33, 0x41, 0xe7, 0xd8, 0x75, 0xa8, 0xc9, 0xd2, 0x4c, 0x99, 0x10, 0x12, 0xfd, 0x56, 0x1b,
+	0x51, 0x0e, 0x56, 0x32, 0x5f, 0xfe, 0x63, 0x5d, 0xd2, 0x21, 0x24, 0xb1, 0x65, 0xf4, 0x18, 0x64,
+	0xe1, 0x5d, 0x13, 0x7b, 0x76, 0xa8, 0x33, 0x39, 0xa6, 0x4e, 0x41, 0x30, 0x55, 0xcf, 0xe6, 0x5a,
+	0x1a, 0xe4, 0x29, 0xa1, 0x56, 0xcb, 0x14, 0xeb, 0xca, 0xd4, 0x35, 0x62, 0x94, 0xe3, 0xd4, 0x28,
+	0x81, 0x9e, 0xc0, 0xdc, 0x19, 0xa1, 0x8e, 0xd7, 0x34, 0x03, 0x6a, 0xf9, 0xe2, 0x7e, 0xd3, 0x63,
+	0xda, 0x35, 0x1b, 0x52, 0x1b, 0x8c, 0xc9, 0x0d, 0x7b, 0x04, 0x62, 0x29, 0xbe, 0x63, 0x76, 0x4c,
+	0xad, 0x7c, 0x48, 0x8c, 0xae, 0xb8, 0xcc, 0x92, 0x84, 0x5a, 0xb6, 0x45, 0x2d, 0x05, 0x58, 0xda,
+	0xea, 0xdd, 0xcf, 0xe8, 0xff, 0x61, 0x82, 0x3a, 0xb4, 0x85, 0x95, 0x19, 0x9e, 0xcf, 0xf3, 0xdf,
+	0xbe, 0xda, 0x9e, 0x0d, 0x6f, 0xbe, 0x1d, 0xd8, 0x5f, 0x6c, 0xec, 0x14, 0x7f, 0xf0, 0x23, 0x3d,
+	0x44, 0xa0, 0x6d, 0x98, 0x0a, 0x3a, 0xae, 0x6b, 0xf9, 0x97, 0x4a, 0x6e, 0x34, 0x38, 0xc2, 0xa0,
+	0x87, 0x30, 0x1d, 0xd6, 0x0e, 0xf6, 0x95, 0x3c, 0xc7, 0x7f, 0x34, 0xaa, 0x58, 0x86, 0xe9, 0x74,
+	0xc9, 0xe8, 0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d,
+	0x57, 0x16, 0x07, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e,
+	0x0b, 0xdb, 0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x08, 0x93, 0xf7, 0x76, 0xf4, 0x5c, 0x88,
+	0xd4, 0x39, 0x10, 0xe9, 0x90, 0xef, 0xb6, 0x01, 0x7a, 0xd9, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0x65,
+	0x44, 0x9d, 0x18, 0x97, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3,
+	0x6c, 0xa7, 0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x48, 0x30, 0x1f, 0x11,
+	0xe2, 0x6e, 0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36,
+	0x5c, 0xa9, 0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x89, 0x92, 0x4a, 0x6e, 0x1b, 0xe7, 0x04, 0xdd, 0x86,
+	0x5c, 0xb4, 0x7d, 0xea, 0x63, 0xcc, 0x0b, 0x3a, 0xab, 0xcf, 0x08, 0x00, 0x5b, 0x62, 0x3d, 0x4d,
+	0x40, 0x5e, 0x92, 0x8e, 0xcf, 0xeb, 0x35, 0xab, 0x0b, 0xd1, 0x07, 0xa4, 0xe3, 0x27, 0x00, 0x41,
+	0xdb, 0x72, 0x95, 0x89, 0x24, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0xbe, 0xab, 0x6d, 0xfe,
+	0x27, 0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0xec, 0x25, 0x0e, 0xcc, 0x13, 0xde, 0xe1, 0xf8, 0x1d,
+	0x2a, 0x72, 0xa2, 0xdd, 0x6a, 0x6c, 0x55, 0x9f, 0xbe, 0xc4, 0xc1, 0x3e, 0x43, 0xa0, 0x3d, 0xc8,
+	0x5b, 0xc7, 0x01, 0xb5, 0x1c, 0x4f, 0x50, 0x52, 0x23, 0x28, 0x39, 0x01, 0x0b, 0x69, 0x1f, 0xc1,
+	0xb4, 0x47, 0x04, 0x23, 0x3d, 0x82, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73,
+	0x87, 0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x08, 0xda, 0xac, 0x47, 0x9e, 0x39, 0xf4, 0xf4,
+	0x08, 0x53, 0x41, 0xff, 0x14, 0xe4, 0x38, 0x2c, 0x82, 0x3c, 0x31, 0xf0, 0x8e, 0x68, 0x1e, 0xd5,
+	0x0b, 0xdd, 0x60, 0xf5, 0x33, 0xe9, 0x79, 0x74, 0xec, 0xe4, 0xfb, 0x98, 0xc6, 0xb9, 0x38, 0xf3,
+	0x33, 0x40, 0xc9, 0x60, 0x0a, 0xee, 0xd4, 0x50, 0xae, 0x9c, 0x08, 0x71, 0xc8, 0xbe, 0x07, 0x73,
+	0x89, 0x38, 0x0b, 0xf2, 0xf4, 0x50, 0xf2, 0x6c, 0x1c, 0xfd, 0x90, 0xbb, 0x0d, 0xc0, 0x62, 0x2f,
+	0x48, 0xd9, 0xa1, 0xa4, 0x2c, 0x43, 0x70, 0xf8, 0xe6, 0x1f, 0x25, 0xc8, 0xb0, 0x1c, 0xbe, 0xfa,
+	0xbd, 0x2c, 0xc2, 0xc4, 0x19, 0xa1, 0xf8, 0xea, 0xb7, 0x32, 0x84, 0xa1, 0x9f, 0xc0, 0x54, 0x68,
+	0x5b, 0xa0, 0x64, 0x78, 0x13, 0xbe, 0xdd, 0x57, 0x73, 0x83, 0xb3, 0x81, 0x1e, 0x31, 0x7a, 0x9a,
+	0xdc, 0x44, 0x6f, 0x93, 0x7b, 0x9c, 0x99, 0x4e, 0xcb, 0x99, 0xcd, 0xbf, 0x4b, 0x90, 0x17, 0xad,
+	0xba, 0x6e, 0xf9, 0x96, 0x1b, 0xa0, 0x17, 0x30, 0xe3, 0x3a, 0x5e, 0xb7, 0xf3, 0x4b, 0x57, 0x75,
+	0xfe, 0x55, 0xd6, 0xf9, 0xbf, 0x7b, 0xb3, 0xbe, 0x98, 0x60, 0x7d, 0x4c, 0x5c, 0x87, 0x62, 0xb7,
+	0x4d, 0x2f, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36,
+	0xf6, 0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0xfa, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0xff, 0xfb,
+	0xee, 0xcd, 0xfa, 0x07, 0x83, 0xc4, 0xf8, 0x90, 0x5f, 0xb3, 0xfe, 0x2e, 0xbb, 0xd6, 0x45, 0x74,
+	0x13, 0xbe, 0x7f, 0x2f, 0xa5, 0x48, 0x9b, 0xcf, 0x21, 0x77, 0xc4, 0xfb, 0xbe, 0xb8, 0x5d, 0x15,
+	0xc4, 0x3b, 0x10, 0x9d, 0x2e, 0x5d, 0x75, 0x7a, 0x86, 0xab, 0xe7, 0x42, 0x56, 0x42, 0xf9, 0x37,
+	0x92, 0xa8, 0x78, 0xa1, 0xfc, 0x21, 0x4c, 0xfe, 0xb2, 0x43, 0xfc, 0x8e, 0xab, 0x48, 0x03, 0xd9,
+	0xc2, 0xa7, 0xab, 0x70, 0x17, 0x7d, 0x0c, 0x59, 0x96, 0xcc, 0xc1, 0x29, 0x69, 0xd9, 0x23, 0x06,
+	0xb1, 0x18, 0x80, 0xf6, 0xa0, 0xc0, 0x8b, 0x35, 0xa6, 0xa4, 0x87, 0x52, 0xf2, 0x0c, 0x65, 0x44,
+	0x20, 0x6e, 0xe0, 0x9f, 0xf3, 0x30, 0x29, 0x6c, 0x53, 0xaf, 0x19, 0xd3, 0xc4, 0x6b, 0x9e, 0x8c,
+	0xdf, 0xc1, 0xf7, 0x8b, 0x5f, 0x66, 0x78, 0x7c, 0x06, 0x63, 0x91, 0xfe, 0x1e, 0xb1, 0x48, 0xf8,
+	0x3d, 0x33, 0xbe, 0xdf, 0x27, 0xae, 0xef, 0xf7, 0xc9, 0x31, 0xfc, 0x8e, 0x34, 0xb8, 0xc5, 0x1c,
+	0xed, 0x78, 0x0e, 0x75, 0xe2, 0xf1, 0xc9, 0xe4, 0xe6, 0x2b, 0x53, 0x43, 0x15, 0x6e, 0xba, 0x8e,
+	0xa7, 0x85, 0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x76, 0x92, 0x13, 0xcb, 0x3b,
+	0xc1, 0x2d, 0x21, 0x13, 0x76, 0xb0, 0xdb, 0xbd, 0x32, 0xc3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf,
+	0xe9, 0xa1, 0xec, 0x2f, 0x60, 0xa1, 0x5f, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6,
+	0x76, 0x74, 0xd4, 0xab, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x77, 0xde, 0x30, 0x7b, 0xa3,
+	0x0b, 0x57, 0x45, 0x77, 0x89, 0x45, 0x77, 0xd8, 0x41, 0x8b, 0x5d, 0xc9, 0xa3, 0x64, 0xe4, 0x75,
+	0x98, 0x8f, 0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x97, 0x1d, 0x07, 0xf0, 0x39, 0xc4,
+	0x87, 0x99, 0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2,
+	0x71, 0xc7, 0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0xa1, 0x23, 0x63, 0x81,
+	0x81, 0x59, 0x4f, 0xff, 0x69, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xdd, 0xe0, 0x75, 0xab, 0xd0,
+	0xc7, 0x4c, 0x52, 0x29, 0x8c, 0xd6, 0x5a, 0x66, 0xcc, 0x68, 0xd4, 0x8a, 0x6a, 0x30, 0xa4, 0xa1,
+	0x1f, 0x43, 0x21, 0x36, 0x8b, 0x25, 0xb3, 0x32, 0x3b, 0x5a, 0x28, 0x17, 0x19, 0xc5, 0xc6, 0x02,
+	0x74, 0x00, 0x73, 0x09, 0x0f, 0x89, 0xec, 0x94, 0xc7, 0xf5, 0xfe, 0x6c, 0xdc, 0x58, 0xc2, 0xcc,
+	0xfc, 0x39, 0x2c, 0xf7, 0x67, 0x26, 0xeb, 0x36, 0x22, 0x7b, 0xe6, 0xb8, 0xee, 0xda, 0x80, 0x6e,
+	0xef, 0x84, 0xb9, 0xd4, 0x9b, 0x92, 0x07, 0xd6, 0x85, 0xc8, 0x95, 0x36, 0xac, 0xb3, 0x47, 0xd1,
+	0x75, 0x02, 0xea, 0x9c, 0x98, 0x56, 0x87, 0x9e, 0x12, 0xdf, 0xf9, 0x15, 0xb6, 0x4d, 0x2b, 0xcc,
+	0x72, 0x1c, 0x28, 0x68, 0x23, 0xbd, 0x95, 0xad, 0x6c, 0xbd, 0xa7, 0x02, 0x7a, 0xcf, 0x5a, 0x8d,
+	0x05, 0xcb, 0x5d, 0xbd, 0x72, 0x24, 0x87, 0x8e, 0x21, 0x01, 0x30, 0x7d, 0xfc, 0x39, 0x3e, 0xe9,
+	0xcd, 0xd3, 0xf9, 0xb1, 0x6e, 0xb4, 0x12, 0x8b, 0xe8, 0x42, 0x23, 0xce, 0xd6, 0xfb, 0x00, 0x6c,
+	0xca, 0x14, 0xd9, 0xb4, 0x30, 0x96, 0x20, 0x9b, 0x4b, 0x45, 0x4e, 0x69, 0x20, 0xc7, 0xc9, 0x2e,
+	0x44, 0x16, 0xaf, 0x10, 0xd9, 0x2d, 0xee, 0x14, 0x77, 0xf4, 0xd9, 0x2e, 0x4f, 0x48, 0x3d, 0x80,
+	0x9b, 0xdd, 0xe0, 0xe1, 0x0b, 0x7c, 0xd2, 0xe1, 0x73, 0x57, 0xd3, 0x0a, 0x94, 0x9b, 0x6c, 0x04,
+	0x1a, 0xf2, 0x65, 0xa0, 0xdb, 0x86, 0xd4, 0x08, 0xfe, 0xd0, 0x0a, 0xee, 0xcd, 0xbf, 0x1e, 0x4c,
+	0xbb, 0xcd, 0xaf, 0x52, 0x80, 0x0e, 0xc2, 0xef, 0xea, 0x15, 0x2b, 0xc0, 0xf6, 0xff, 0xf2, 0x2d,
+	0x4f, 0xbc, 0x1f, 0xa9, 0xf7, 0xbe, 0x1f, 0xdb, 0x43, 0x7c, 0x3d, 0xf0, 0x80, 0xc4, 0xbe, 0xed,
+	0x79, 0x6e, 0xd2, 0xd7, 0x7f, 0x6e, 0x32, 0xe3, 0x3c, 0xf3, 0x83, 0xdf, 0x43, 0x7e, 0x27, 0xc1,
+	0xc2, 0x43, 0x72, 0x86, 0x7d, 0x8f, 0x15, 0x40, 0x15, 0xb7, 0x70, 0x93, 0x5f, 0x1d, 0xa9, 0x30,
+	0x67, 0x87, 0x9f, 0x88, 0x1f, 0x25, 0xbd, 0x22, 0x5d, 0x31, 0x83, 0xca, 0x5d, 0x8a, 0x58, 0x47,
+	0xfb, 0x20, 0x37, 0xb9, 0x7c, 0x42, 0xe5, 0xaa, 0x49, 0x76, 0x36, 0x62, 0x88, 0xe5, 0x3b, 0xbf,
+	0x97, 0x20, 0x97, 0xfc, 0xaa, 0x88, 0x56, 0xe1, 0x56, 0x5d, 0xaf, 0xd5, 0x6b, 0x8d, 0xf2, 0x13,
+	0xd3, 0x78, 0x51, 0x57, 0xcd, 0xa7, 0x87, 0x8d, 0xba, 0xba, 0xaf, 0x3d, 0xd0, 0xd4, 0xaa, 0x7c,
+	0x03, 0x2d, 0xc3, 0xcd, 0xde, 0xed, 0x86, 0x51, 0x3e, 0xac, 0x96, 0xf5, 0xaa, 0x2c, 0xa1, 0xdb,
+	0xb0, 0xda, 0xbb, 0x77, 0xf0, 0xf4, 0x89, 0xa1, 0xd5, 0x9f, 0xa8, 0xe6, 0xfe, 0xa3, 0x9a, 0xb6,
+	0xaf, 0xca, 0x29, 0xf4, 0x01, 0x28, 0xbd, 0x90, 0x5a, 0xdd, 0xd0, 0x0e, 0xb4, 0x86, 0xa1, 0xed,
+	0xcb, 0x69, 0xb4, 0x02, 0x4b, 0xbd, 0xbb, 0xea, 0xf3, 0xba, 0x5a, 0xd5, 0x0c, 0xb5, 0x2a, 0x67,
+	0xee, 0xfc, 0x5b, 0x02, 0x48, 0xfc, 0xe8, 0xb6, 0x02, 0x4b, 0x47, 0x35, 0x23, 0x14, 0xa8, 0x1d,
+	0xf6, 0x59, 0x39, 0x0f, 0xb3, 0xc9, 0xcd, 0x17, 0x6a, 0x43, 0x96, 0xfa, 0x17, 0x6b, 0x87, 0xaa,
+	0x2c, 0xa1, 0x25, 0x98, 0x4f, 0x2e, 0x96, 0x2b, 0x0d, 0xa3, 0xac, 0x1d, 0xca, 0xa9, 0x7e, 0xb4,
+	0xf1, 0xac, 0x26, 0xa7, 0x10, 0x82, 0x42, 0x72, 0xf1, 0xb0, 0x26, 0xa7, 0xd1, 0x22, 0xcc, 0xf5,
+	0x00, 0x1f, 0xe9, 0xaa, 0x2a, 0xa7, 0xd9, 0x4d, 0x7b, 0xa1, 0xe6, 0x33, 0xcd, 0x78, 0x64, 0x1e,
+	0xa9, 0x46, 0x4d, 0xce, 0xa0, 0x05, 0x90, 0x93, 0xbb, 0x0f, 0x6a, 0x4f, 0xf5, 0xc1, 0xd5, 0x46,
+	0xbd, 0x7c, 0x20, 0x4f, 0x2c, 0xa7, 0x64, 0xe9, 0xce, 0x5f, 0x25, 0x28, 0xf4, 0xfe, 0xf2, 0x85,
+	0xd6, 0x61, 0xa5, 0xeb, 0xac, 0x86, 0x51, 0x36, 0x9e, 0x36, 0xfa, 0x9c, 0xb0, 0x09, 0x6b, 0xfd,
+	0x80, 0xaa, 0x5a, 0xaf, 0x35, 0x34, 0xc3, 0xac, 0xab, 0xba, 0x56, 0xeb, 0x0f, 0x99, 0xc0, 0x1c,
+	0xd5, 0x0c, 0xed, 0xf0, 0x61, 0x04, 0x49, 0xf5, 0x44, 0x5c, 0x40, 0xea, 0xe5, 0x46, 0x43, 0xad,
+	0x86, 0x97, 0xec, 0xdf, 0xd3, 0xd5, 0xc7, 0xea, 0x3e, 0x8f, 0xd8, 0x30, 0xe6, 0x83, 0xb2, 0xf6,
+	0x44, 0xad, 0xca, 0x13, 0x95, 0xbd, 0xaf, 0xdf, 0xae, 0x49, 0xdf, 0xbc, 0x5d, 0x93, 0xfe, 0xf9,
+	0x76, 0x4d, 0xfa, 0xf2, 0xdd, 0xda, 0x8d, 0x6f, 0xde, 0xad, 0xdd, 0xf8, 0xdb, 0xbb, 0xb5, 0x1b,
+	0x3f, 0x5b, 0x09, 0x13, 0x37, 0xb0, 0xbf, 0x28, 0x3a, 0xa4, 0xc4, 0x2b, 0xaa, 0xc4, 0x7e, 0xe7,
+	0x08, 0xd8, 0x0f, 0xc6, 0x93, 0xbc, 0x91, 0x7c, 0xf2, 0xdf, 0x01, 0x00, 0x69, 0xc8, 0x14, 0x64,
+	0x71, 0x16, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -2287,6 +2349,43 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -2690,6 +2789,23 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5469,6 +5585,120 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1169,6 +1169,182 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgDelegateGovernance defines a message to assign the governance power of an
+// account to a governor. It replaces any existing governance delegation.
+type MsgDelegateGovernance struct {
+	// delegator_address is the address of the account delegating its governance power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the address of the account voting on behalf of the delegator.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *MsgDelegateGovernance) Reset()         { *m = MsgDelegateGovernance{} }
+func (m *MsgDelegateGovernance) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernance) ProtoMessage()    {}
+func (*MsgDelegateGovernance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgDelegateGovernance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernance.Merge(m, src)
+}
+func (m *MsgDelegateGovernance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernance proto.InternalMessageInfo
+
+func (m *MsgDelegateGovernance) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgDelegateGovernance) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// MsgDelegateGovernanceResponse defines the Msg/DelegateGovernance response type.
+type MsgDelegateGovernanceResponse struct {
+}
+
+func (m *MsgDelegateGovernanceResponse) Reset()         { *m = MsgDelegateGovernanceResponse{} }
+func (m *MsgDelegateGovernanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernanceResponse) ProtoMessage()    {}
+func (*MsgDelegateGovernanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernanceResponse.Merge(m, src)
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernanceResponse proto.InternalMessageInfo
+
+// MsgUndelegateGovernance defines a message to revoke the governance delegation of an account.
+type MsgUndelegateGovernance struct {
+	// delegator_address is the address of the account revoking its governance delegation.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *MsgUndelegateGovernance) Reset()         { *m = MsgUndelegateGovernance{} }
+func (m *MsgUndelegateGovernance) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernance) ProtoMessage()    {}
+func (*MsgUndelegateGovernance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{22}
+}
+func (m *MsgUndelegateGovernance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernance.Merge(m, src)
+}
+func (m *MsgUndelegateGovernance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernance proto.InternalMessageInfo
+
+func (m *MsgUndelegateGovernance) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// MsgUndelegateGovernanceResponse defines the Msg/UndelegateGovernance response type.
+type MsgUndelegateGovernanceResponse struct {
+}
+
+func (m *MsgUndelegateGovernanceResponse) Reset()         { *m = MsgUndelegateGovernanceResponse{} }
+func (m *MsgUndelegateGovernanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernanceResponse) ProtoMessage()    {}
+func (*MsgUndelegateGovernanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{23}
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernanceResponse.Merge(m, src)
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1366,117 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgDelegateGovernance)(nil), "cosmos.gov.v1.MsgDelegateGovernance")
+	proto.RegisterType((*MsgDelegateGovernanceResponse)(nil), "cosmos.gov.v1.MsgDelegateGovernanceResponse")
+	proto.RegisterType((*MsgUndelegateGovernance)(nil), "cosmos.gov.v1.MsgUndelegateGovernance")
+	proto.RegisterType((*MsgUndelegateGovernanceResponse)(nil), "cosmos.gov.v1.MsgUndelegateGovernanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xae, 0xbd, 0xbb, 0x76, 0xd2, 0x22, 0x21, 0x2e, 0xad, 0xf7, 0x7d, 0xcd, 0x7b, 0xbf, 0x79, 0xf3,
-	0x7b, 0x2f, 0x60, 0xa9, 0x81, 0xb0, 0x89, 0x70, 0x51, 0x47, 0xdd, 0x62, 0xb7, 0x5c, 0xb4, 0x77,
-	0x64, 0xab, 0x8d, 0x6c, 0xc4, 0x1f, 0x73, 0xe4, 0xb2, 0x8e, 0xba, 0x72, 0xb7, 0x2c, 0x66, 0x98,
-	0xd9, 0x96, 0x8a, 0x61, 0xb1, 0x5b, 0xde, 0x82, 0xb6, 0x5a, 0x2e, 0x36, 0x90, 0xd1, 0x72, 0xcc,
-	0xc5, 0xe5, 0xe1, 0x30, 0xc4, 0xcb, 0x51, 0xa4, 0x75, 0xa4, 0x23, 0xfa, 0xb3, 0x48, 0x7e, 0x31,
-	0xe9, 0x8a, 0x63, 0x5e, 0x77, 0x14, 0xec, 0x28, 0xa6, 0xd2, 0x11, 0xd2, 0x9b, 0xb0, 0x48, 0xbf,
-	0xb6, 0x3a, 0x9f, 0x16, 0xd5, 0x56, 0x2f, 0x70, 0x88, 0x89, 0x75, 0x72, 0x88, 0x89, 0x75, 0xa6,
-	0x58, 0x50, 0x4d, 0xa3, 0x85, 0x8a, 0xf4, 0x5f, 0x26, 0xca, 0x06, 0xc3, 0xd8, 0x86, 0x09, 0xb1,
-	0xad, 0x9a, 0x96, 0x63, 0x20, 0xfd, 0x38, 0x05, 0x16, 0x6a, 0x58, 0xbf, 0xd1, 0xd9, 0x32, 0x0d,
-	0xfb, 0x7a, 0x1b, 0x59, 0x08, 0xab, 0x4d, 0xbe, 0x04, 0x92, 0x26, 0xc4, 0x58, 0xd5, 0x21, 0x16,
-	0xb8, 0x5c, 0x2c, 0x3f, 0xbb, 0x9e, 0x96, 0x9d, 0x48, 0xb2, 0x1b, 0x49, 0xbe, 0xd2, 0xea, 0x29,
-	0x9e, 0x15, 0x7f, 0x8f, 0x03, 0xf3, 0x46, 0xcb, 0xb0, 0x0d, 0xb5, 0x59, 0xd7, 0xa0, 0x85, 0xb0,
-	0x61, 0x0b, 0x93, 0xd4, 0x73, 0x45, 0x66, 0x85, 0x11, 0xd0, 0x64, 0x06, 0x9a, 0xbc, 0x89, 0x8c,
-	0x56, 0xf5, 0x9d, 0x07, 0x8f, 0xb3, 0x13, 0x3f, 0x3c, 0xc9, 0xe6, 0x75, 0xc3, 0xde, 0xee, 0x6c,
-	0xc9, 0x0d, 0x64, 0x32, 0x14, 0xd8, 0x7f, 0x05, 0xac, 0xdd, 0x2d, 0xda, 0x3d, 0x0b, 0x62, 0xea,
-	0x80, 0xbf, 0x39, 0xd8, 0x5d, 0x4b, 0x35, 0xa1, 0xae, 0x36, 0x7a, 0x75, 0x02, 0x3b, 0xfe, 0xee,
-	0x60, 0x77, 0x8d, 0x53, 0xe6, 0xd8, 0xc9, 0x6f, 0x3b, 0x07, 0xf3, 0x17, 0x40, 0xd2, 0xa2, 0xa5,
-	0xc0, 0xb6, 0x10, 0xcb, 0x71, 0xf9, 0x99, 0xaa, 0xb0, 0xdf, 0x2f, 0xa4, 0x59, 0x1e, 0x57, 0x34,
-	0xad, 0x0d, 0x31, 0xbe, 0x61, 0xb7, 0x8d, 0x96, 0xae, 0x78, 0x96, 0xbc, 0x48, 0x8a, 0xb6, 0x55,
-	0x4d, 0xb5, 0x55, 0x61, 0x8a, 0x78, 0x29, 0xde, 0x37, 0xff, 0x2a, 0x98, 0xb6, 0x0d, 0xbb, 0x09,
-	0x85, 0x69, 0x1a, 0x6e, 0xf1, 0x51, 0xbf, 0x30, 0xef, 0xa7, 0x98, 0x2b, 0xc9, 0x17, 0x5e, 0x57,
-	0x1c, 0x0b, 0xbe, 0x00, 0x12, 0xb8, 0x63, 0x9a, 0x6a, 0xbb, 0x27, 0xc4, 0x47, 0x1b, 0xbb, 0x36,
-	0xfc, 0x79, 0x30, 0x03, 0x77, 0x2c, 0xa8, 0x19, 0x36, 0xd4, 0x84, 0x44, 0x8e, 0xcb, 0x27, 0xab,
-	0x27, 0x42, 0x0e, 0x1b, 0x25, 0x81, 0x53, 0x7c, 0x3b, 0x5e, 0x01, 0xc7, 0x2c, 0x76, 0x57, 0x75,
-	0x02, 0x8f, 0x90, 0xcc, 0x71, 0xf9, 0xb9, 0xf5, 0x93, 0xf2, 0x50, 0xbb, 0xca, 0xee, 0x7d, 0xde,
-	0xec, 0x59, 0xb0, 0x7a, 0xfc, 0x51, 0xbf, 0x90, 0xda, 0x21, 0x3d, 0x99, 0xeb, 0x96, 0xe4, 0x75,
-	0xb9, 0xa4, 0xa4, 0xac, 0x01, 0x7d, 0xa5, 0xfc, 0xe5, 0xc1, 0xee, 0x9a, 0x87, 0xc6, 0xbd, 0x83,
-	0xdd, 0xb5, 0xec, 0xc0, 0x25, 0x74, 0xcb, 0xc5, 0x50, 0x9b, 0x48, 0x97, 0xc0, 0x4a, 0x48, 0xa8,
-	0x40, 0x6c, 0xa1, 0x16, 0x86, 0x7c, 0x16, 0xcc, 0x7a, 0x39, 0x1a, 0x9a, 0xc0, 0xe5, 0xb8, 0xfc,
-	0x94, 0x02, 0x5c, 0xd1, 0x35, 0x4d, 0xfa, 0x89, 0x03, 0xe9, 0x1a, 0xd6, 0xaf, 0xee, 0xc0, 0xc6,
-	0xfb, 0xf4, 0x4a, 0x37, 0x51, 0xcb, 0x86, 0x2d, 0x9b, 0xff, 0x00, 0x24, 0x1a, 0xce, 0x4f, 0xea,
-	0x35, 0xa2, 0xf9, 0xaa, 0x99, 0xbd, 0x7e, 0x41, 0x1c, 0x2a, 0xd8, 0x6d, 0x2d, 0xea, 0xab, 0xb8,
-	0x41, 0xf8, 0x55, 0x30, 0xa3, 0x76, 0xec, 0x6d, 0xd4, 0x36, 0xec, 0x9e, 0x30, 0x49, 0x6f, 0xd6,
-	0x17, 0x54, 0x36, 0x48, 0xdd, 0xfe, 0x37, 0x29, 0x5c, 0x0a, 0x15, 0x1e, 0x4a, 0x52, 0xca, 0x80,
-	0xd5, 0x28, 0xb9, 0x5b, 0xbe, 0xf4, 0x07, 0x07, 0x12, 0x35, 0xac, 0xdf, 0x46, 0x36, 0xe4, 0x37,
-	0x22, 0xa0, 0xa8, 0xa6, 0xff, 0x7a, 0x9c, 0x1d, 0x14, 0x3b, 0xad, 0x3c, 0x00, 0x10, 0x2f, 0x83,
-	0xe9, 0x2e, 0xb2, 0x61, 0xdb, 0xc9, 0x79, 0x4c, 0x0f, 0x3b, 0x66, 0x7c, 0x19, 0xc4, 0x91, 0x65,
-	0x1b, 0xa8, 0x45, 0x9b, 0x7e, 0xce, 0x7f, 0x79, 0xac, 0x1d, 0x48, 0x2e, 0x1f, 0x52, 0x03, 0x85,
-	0x19, 0x8e, 0xeb, 0xf9, 0xca, 0x4b, 0x04, 0x18, 0x27, 0x34, 0x01, 0xe5, 0x44, 0x08, 0x14, 0x12,
-	0x4f, 0x5a, 0x00, 0xf3, 0xec, 0xa7, 0x57, 0xfa, 0xdf, 0x9c, 0x27, 0xfb, 0x08, 0x1a, 0xfa, 0x36,
-	0xe9, 0xd8, 0xff, 0x08, 0x82, 0xb7, 0x40, 0xc2, 0xa9, 0x0c, 0x0b, 0x31, 0xca, 0x3e, 0xa7, 0x03,
-	0x18, 0xb8, 0x09, 0x0d, 0x60, 0xe1, 0x7a, 0x8c, 0x05, 0xe3, 0xb5, 0x61, 0x30, 0x4e, 0x45, 0x82,
-	0xe1, 0x06, 0x97, 0x56, 0xc0, 0x72, 0x40, 0xe4, 0x81, 0xf3, 0x27, 0x07, 0x40, 0x0d, 0xeb, 0x2e,
-	0x55, 0xbd, 0x20, 0x2e, 0x17, 0xc1, 0x0c, 0x63, 0x59, 0x74, 0x38, 0x36, 0xbe, 0x29, 0x7f, 0x09,
-	0xc4, 0x55, 0x13, 0x75, 0x5a, 0x36, 0x83, 0x67, 0x0c, 0x39, 0xcf, 0x10, 0x72, 0x76, 0x4e, 0x66,
-	0x3e, 0x95, 0x73, 0xf4, 0xa9, 0x78, 0xd1, 0x08, 0x10, 0x42, 0x08, 0x08, 0x56, 0x99, 0x94, 0x06,
-	0xbc, 0xff, 0xe5, 0x95, 0xff, 0x8b, 0xd3, 0x1b, 0xb7, 0x2c, 0x4d, 0xb5, 0xe1, 0x75, 0xb5, 0xad,
-	0x9a, 0x98, 0x14, 0xe3, 0xbf, 0x4f, 0xee, 0xb0, 0x62, 0x3c, 0x53, 0xfe, 0x0d, 0x10, 0xb7, 0x68,
-	0x04, 0x8a, 0xc0, 0xec, 0xfa, 0x89, 0x20, 0xfd, 0x51, 0xe5, 0x50, 0x21, 0x8e, 0x7d, 0xe5, 0xda,
-	0x7e, 0x98, 0x92, 0xc3, 0x34, 0x70, 0x66, 0xa0, 0xb6, 0x1d, 0x77, 0xa6, 0x07, 0x92, 0x97, 0x64,
-	0x7a, 0xd5, 0x83, 0x22, 0xb7, 0xd6, 0xca, 0x62, 0xc4, 0x29, 0xd2, 0xb7, 0x1c, 0x1d, 0xb8, 0x9b,
-	0x6a, 0xab, 0x01, 0x9b, 0x03, 0x03, 0x37, 0xa2, 0x0d, 0xe6, 0x03, 0x6d, 0x30, 0xd4, 0x01, 0x83,
-	0x33, 0x6e, 0xf2, 0xa8, 0x33, 0xae, 0x92, 0xdb, 0x0f, 0x8f, 0x96, 0x21, 0xde, 0x97, 0x7e, 0xe5,
-	0x28, 0xa9, 0x0f, 0xe7, 0xe7, 0x91, 0xfa, 0xf3, 0xe7, 0x79, 0x0d, 0x1c, 0x6b, 0xd0, 0x58, 0x50,
-	0xab, 0x93, 0xe5, 0x83, 0xdd, 0x95, 0x18, 0xa2, 0xf4, 0x9b, 0xee, 0x66, 0x52, 0x4d, 0x92, 0x0b,
-	0xbb, 0xff, 0x24, 0xcb, 0x29, 0x29, 0xd7, 0x95, 0x28, 0xf9, 0xb3, 0x60, 0xde, 0x0b, 0xb5, 0x4d,
-	0xdf, 0x15, 0x25, 0xba, 0x29, 0x65, 0xce, 0x15, 0xbf, 0x47, 0xa5, 0x11, 0xc0, 0x6f, 0x94, 0xa4,
-	0xaf, 0x62, 0x20, 0xeb, 0x4d, 0xab, 0x5a, 0xa7, 0x69, 0x1b, 0x56, 0x13, 0x6e, 0x6e, 0x23, 0xa3,
-	0x01, 0xbd, 0x6b, 0x88, 0xda, 0x62, 0xb8, 0xff, 0xc3, 0x16, 0x33, 0xf9, 0x42, 0x5b, 0x4c, 0x2c,
-	0xb0, 0xc5, 0xa4, 0xdd, 0x2d, 0xc6, 0x61, 0x37, 0xb6, 0xb0, 0x08, 0xfe, 0xc2, 0x42, 0xb7, 0x1b,
-	0x7f, 0x37, 0xb9, 0x0a, 0x52, 0x84, 0xf1, 0xea, 0x2e, 0xa5, 0xc6, 0xe9, 0xd5, 0x49, 0x23, 0xb6,
-	0x0c, 0x9f, 0x52, 0xb1, 0x32, 0xdb, 0xf5, 0x3f, 0x2a, 0xab, 0xfb, 0xfd, 0xc2, 0xac, 0xb3, 0x79,
-	0x94, 0xe5, 0x92, 0x1c, 0x68, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65,
-	0x3e, 0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a,
-	0xcb, 0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd,
-	0xe4, 0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63,
-	0x4c, 0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0xfc, 0x23, 0xa5, 0x32, 0xc8, 0x44, 0x17, 0xe1, 0x51,
-	0x4d, 0xa8, 0xf0, 0xef, 0x39, 0x30, 0x4b, 0x61, 0xd5, 0x10, 0xd9, 0x51, 0x5e, 0xb8, 0xda, 0x4d,
-	0x10, 0x33, 0xb1, 0xce, 0x1e, 0x6d, 0xf4, 0x1e, 0x76, 0x72, 0xaf, 0x5f, 0x58, 0x8e, 0x7a, 0x1d,
-	0x35, 0xac, 0x2b, 0xc4, 0xfb, 0xb0, 0xf2, 0x2e, 0x83, 0xc5, 0x81, 0x54, 0xbd, 0xdb, 0x5e, 0x02,
-	0xf1, 0x36, 0xc4, 0x9d, 0xa6, 0xb3, 0x05, 0xa6, 0x14, 0xf6, 0x15, 0xaa, 0x75, 0xfd, 0x5e, 0x12,
-	0xc4, 0x6a, 0x58, 0xe7, 0xef, 0x80, 0xb9, 0xc0, 0xdf, 0x31, 0xb9, 0xe0, 0x15, 0x04, 0xb7, 0x55,
-	0x31, 0x7f, 0x98, 0x85, 0x97, 0x0e, 0x04, 0x0b, 0xe1, 0x55, 0xf5, 0x4c, 0xd8, 0x3d, 0x64, 0x24,
-	0x9e, 0x3b, 0x82, 0x91, 0x77, 0xcc, 0x65, 0x30, 0x45, 0x77, 0xc6, 0xa5, 0xb0, 0x13, 0x91, 0x8b,
-	0x99, 0x68, 0xb9, 0xe7, 0x7f, 0x1b, 0xa4, 0x86, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65,
-	0xbc, 0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48,
-	0x95, 0x17, 0xe8, 0x2e, 0x48, 0x0d, 0x4d, 0xff, 0x88, 0x04, 0x07, 0xf5, 0x51, 0x09, 0x46, 0x4d,
-	0x5b, 0x69, 0x71, 0x2f, 0x3c, 0x6d, 0xf9, 0xcf, 0xc0, 0x5c, 0x60, 0xd2, 0x46, 0xb4, 0xc4, 0xb0,
-	0x45, 0x54, 0x4b, 0x44, 0x4f, 0xc3, 0x88, 0x23, 0x37, 0x4a, 0xfc, 0xd7, 0x1c, 0x58, 0x1d, 0x3b,
-	0x64, 0xe4, 0x51, 0x2d, 0x17, 0x6d, 0x2f, 0x5e, 0x7c, 0x3e, 0x7b, 0x2f, 0xbb, 0xe3, 0x7b, 0xfd,
-	0x42, 0x2a, 0x37, 0xf0, 0x50, 0xf8, 0xcf, 0xc1, 0x62, 0x14, 0x13, 0xbe, 0x3c, 0x0a, 0xe1, 0x21,
-	0x33, 0xb1, 0x70, 0x24, 0xb3, 0x31, 0xc7, 0xdf, 0x01, 0x49, 0x8f, 0x8f, 0xc4, 0xa8, 0xa2, 0x1c,
-	0x9d, 0x28, 0x8d, 0xd6, 0x8d, 0x8e, 0x2e, 0x4e, 0x7f, 0x41, 0xa6, 0x68, 0x75, 0xe3, 0xc1, 0xd3,
-	0x0c, 0xf7, 0xf0, 0x69, 0x86, 0xfb, 0xfd, 0x69, 0x86, 0xbb, 0xff, 0x2c, 0x33, 0xf1, 0xf0, 0x59,
-	0x66, 0xe2, 0xb7, 0x67, 0x99, 0x89, 0x8f, 0x4f, 0x3a, 0x51, 0xb1, 0x76, 0x57, 0x36, 0x10, 0x5b,
-	0xe8, 0xe8, 0x54, 0x2e, 0x76, 0xcb, 0x5b, 0x71, 0x4a, 0x69, 0xe7, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0xac, 0x54, 0xba, 0x09, 0x12, 0x00, 0x00,
+	// 1613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x2d, 0x5b, 0xb6, 0xd7, 0x8a, 0x3f, 0x68, 0xd9, 0xa6, 0x19, 0x47, 0x52, 0x98, 0x2f,
+	0x3d, 0x27, 0xa2, 0x24, 0x27, 0xce, 0x7b, 0x4f, 0x2f, 0x08, 0x5e, 0xe4, 0xa6, 0x69, 0x80, 0xaa,
+	0x0d, 0x98, 0x8f, 0x02, 0x6d, 0x00, 0x81, 0x16, 0xb7, 0x34, 0x11, 0x91, 0xcb, 0x6a, 0x29, 0xc1,
+	0x3e, 0x14, 0x28, 0x7a, 0xcc, 0x29, 0x97, 0x02, 0x45, 0x81, 0x5e, 0x7a, 0x6a, 0x7b, 0xf2, 0xc1,
+	0xa7, 0xf6, 0xd8, 0x4b, 0xe0, 0x43, 0x11, 0xf8, 0x50, 0x14, 0x39, 0x24, 0x45, 0xdc, 0xd6, 0x40,
+	0xff, 0x89, 0x16, 0xbb, 0x24, 0x57, 0x12, 0x49, 0x49, 0x4e, 0x8a, 0x16, 0xbd, 0x24, 0xda, 0x99,
+	0xdf, 0xcc, 0xee, 0x6f, 0x76, 0x76, 0x66, 0x68, 0xb0, 0x50, 0x43, 0xd8, 0x44, 0x38, 0xaf, 0xa3,
+	0x56, 0xbe, 0x55, 0xcc, 0x3b, 0x5b, 0xb2, 0xdd, 0x40, 0x0e, 0xe2, 0x8f, 0xb9, 0x72, 0x59, 0x47,
+	0x2d, 0xb9, 0x55, 0x14, 0x53, 0x1e, 0x6c, 0x43, 0xc5, 0x30, 0xdf, 0x2a, 0x6e, 0x40, 0x47, 0x2d,
+	0xe6, 0x6b, 0xc8, 0xb0, 0x5c, 0xb8, 0xb8, 0xd8, 0xed, 0x86, 0x58, 0xb9, 0x8a, 0xa4, 0x8e, 0x74,
+	0x44, 0x7f, 0xe6, 0xc9, 0x2f, 0x4f, 0xba, 0xe4, 0xc2, 0xab, 0xae, 0xc2, 0xdb, 0xca, 0x53, 0xe9,
+	0x08, 0xe9, 0x75, 0x98, 0xa7, 0xab, 0x8d, 0xe6, 0xfb, 0x79, 0xd5, 0xda, 0x0e, 0x6c, 0x62, 0x62,
+	0x9d, 0x6c, 0x62, 0x62, 0xdd, 0x53, 0xcc, 0xaa, 0xa6, 0x61, 0xa1, 0x3c, 0xfd, 0xd7, 0x13, 0xa5,
+	0x83, 0x6e, 0x1c, 0xc3, 0x84, 0xd8, 0x51, 0x4d, 0xdb, 0x05, 0x48, 0xdf, 0x8c, 0x80, 0xd9, 0x0a,
+	0xd6, 0x6f, 0x37, 0x37, 0x4c, 0xc3, 0xb9, 0xd5, 0x40, 0x36, 0xc2, 0x6a, 0x9d, 0x2f, 0x80, 0x71,
+	0x13, 0x62, 0xac, 0xea, 0x10, 0x0b, 0x5c, 0x26, 0x96, 0x9d, 0x5c, 0x4d, 0xca, 0xae, 0x27, 0xd9,
+	0xf7, 0x24, 0x5f, 0xb3, 0xb6, 0x15, 0x86, 0xe2, 0x1f, 0x72, 0x60, 0xda, 0xb0, 0x0c, 0xc7, 0x50,
+	0xeb, 0x55, 0x0d, 0xda, 0x08, 0x1b, 0x8e, 0x30, 0x4c, 0x2d, 0x97, 0x64, 0x8f, 0x18, 0x09, 0x9a,
+	0xec, 0x05, 0x4d, 0x5e, 0x47, 0x86, 0x55, 0x7e, 0xfd, 0xf1, 0xb3, 0xf4, 0xd0, 0xd7, 0xcf, 0xd3,
+	0x59, 0xdd, 0x70, 0x36, 0x9b, 0x1b, 0x72, 0x0d, 0x99, 0x5e, 0x14, 0xbc, 0xff, 0x72, 0x58, 0x7b,
+	0x90, 0x77, 0xb6, 0x6d, 0x88, 0xa9, 0x01, 0xfe, 0xec, 0x70, 0x67, 0x25, 0x51, 0x87, 0xba, 0x5a,
+	0xdb, 0xae, 0x92, 0xb0, 0xe3, 0x2f, 0x0f, 0x77, 0x56, 0x38, 0x65, 0xca, 0xdb, 0xf9, 0x35, 0x77,
+	0x63, 0xfe, 0x12, 0x18, 0xb7, 0x29, 0x15, 0xd8, 0x10, 0x62, 0x19, 0x2e, 0x3b, 0x51, 0x16, 0xf6,
+	0x77, 0x73, 0x49, 0xef, 0x1c, 0xd7, 0x34, 0xad, 0x01, 0x31, 0xbe, 0xed, 0x34, 0x0c, 0x4b, 0x57,
+	0x18, 0x92, 0x17, 0x09, 0x69, 0x47, 0xd5, 0x54, 0x47, 0x15, 0x46, 0x88, 0x95, 0xc2, 0xd6, 0xfc,
+	0xbf, 0xc0, 0xa8, 0x63, 0x38, 0x75, 0x28, 0x8c, 0x52, 0x77, 0x73, 0x4f, 0x77, 0x73, 0xd3, 0xed,
+	0x23, 0x66, 0x0a, 0xf2, 0xa5, 0x7f, 0x2b, 0x2e, 0x82, 0xcf, 0x81, 0x31, 0xdc, 0x34, 0x4d, 0xb5,
+	0xb1, 0x2d, 0xc4, 0x7b, 0x83, 0x7d, 0x0c, 0x7f, 0x11, 0x4c, 0xc0, 0x2d, 0x1b, 0x6a, 0x86, 0x03,
+	0x35, 0x61, 0x2c, 0xc3, 0x65, 0xc7, 0xcb, 0xf3, 0x21, 0x83, 0xb5, 0x82, 0xc0, 0x29, 0x6d, 0x1c,
+	0xaf, 0x80, 0x63, 0xb6, 0x77, 0x57, 0x55, 0x12, 0x1e, 0x61, 0x3c, 0xc3, 0x65, 0xa7, 0x56, 0x8f,
+	0xcb, 0x5d, 0xe9, 0x2a, 0xfb, 0xf7, 0x79, 0x67, 0xdb, 0x86, 0xe5, 0x99, 0xa7, 0xbb, 0xb9, 0xc4,
+	0x16, 0xc9, 0xc9, 0x4c, 0xab, 0x20, 0xaf, 0xca, 0x05, 0x25, 0x61, 0x77, 0xe8, 0x4b, 0xc5, 0x8f,
+	0x0f, 0x77, 0x56, 0x58, 0x34, 0x1e, 0x1e, 0xee, 0xac, 0xa4, 0x3b, 0x2e, 0xa1, 0x55, 0xcc, 0x87,
+	0xd2, 0x44, 0xba, 0x02, 0x96, 0x42, 0x42, 0x05, 0x62, 0x1b, 0x59, 0x18, 0xf2, 0x69, 0x30, 0xc9,
+	0xce, 0x68, 0x68, 0x02, 0x97, 0xe1, 0xb2, 0x23, 0x0a, 0xf0, 0x45, 0x37, 0x35, 0xe9, 0x5b, 0x0e,
+	0x24, 0x2b, 0x58, 0xbf, 0xbe, 0x05, 0x6b, 0x6f, 0xd2, 0x2b, 0x5d, 0x47, 0x96, 0x03, 0x2d, 0x87,
+	0x7f, 0x0b, 0x8c, 0xd5, 0xdc, 0x9f, 0xd4, 0xaa, 0x47, 0xf2, 0x95, 0x53, 0x7b, 0xbb, 0x39, 0xb1,
+	0x8b, 0xb0, 0x9f, 0x5a, 0xd4, 0x56, 0xf1, 0x9d, 0xf0, 0xcb, 0x60, 0x42, 0x6d, 0x3a, 0x9b, 0xa8,
+	0x61, 0x38, 0xdb, 0xc2, 0x30, 0xbd, 0xd9, 0xb6, 0xa0, 0xb4, 0x46, 0x78, 0xb7, 0xd7, 0x84, 0xb8,
+	0x14, 0x22, 0x1e, 0x3a, 0xa4, 0x94, 0x02, 0xcb, 0x51, 0x72, 0x9f, 0xbe, 0xf4, 0x0b, 0x07, 0xc6,
+	0x2a, 0x58, 0xbf, 0x87, 0x1c, 0xc8, 0xaf, 0x45, 0x84, 0xa2, 0x9c, 0xfc, 0xed, 0x59, 0xba, 0x53,
+	0xec, 0xa6, 0x72, 0x47, 0x80, 0x78, 0x19, 0x8c, 0xb6, 0x90, 0x03, 0x1b, 0xc2, 0xf0, 0x80, 0x1c,
+	0x76, 0x61, 0x7c, 0x11, 0xc4, 0x91, 0xed, 0x18, 0xc8, 0xa2, 0x49, 0x3f, 0xd5, 0x7e, 0x79, 0x5e,
+	0x3a, 0x90, 0xb3, 0xbc, 0x4d, 0x01, 0x8a, 0x07, 0xec, 0x97, 0xf3, 0xa5, 0xd3, 0x24, 0x30, 0xae,
+	0x6b, 0x12, 0x94, 0xf9, 0x50, 0x50, 0x88, 0x3f, 0x69, 0x16, 0x4c, 0x7b, 0x3f, 0x19, 0xf5, 0xdf,
+	0x39, 0x26, 0x7b, 0x07, 0x1a, 0xfa, 0x26, 0xc9, 0xd8, 0xbf, 0x29, 0x04, 0xff, 0x03, 0x63, 0x2e,
+	0x33, 0x2c, 0xc4, 0x68, 0xf5, 0x39, 0x19, 0x88, 0x81, 0x7f, 0xa0, 0x8e, 0x58, 0xf8, 0x16, 0x7d,
+	0x83, 0x71, 0xa1, 0x3b, 0x18, 0x27, 0x22, 0x83, 0xe1, 0x3b, 0x97, 0x96, 0xc0, 0x62, 0x40, 0xc4,
+	0x82, 0xf3, 0x2b, 0x07, 0x40, 0x05, 0xeb, 0x7e, 0xa9, 0x7a, 0xc5, 0xb8, 0x5c, 0x06, 0x13, 0x5e,
+	0x95, 0x45, 0x83, 0x63, 0xd3, 0x86, 0xf2, 0x57, 0x40, 0x5c, 0x35, 0x51, 0xd3, 0x72, 0xbc, 0xf0,
+	0xf4, 0x29, 0xce, 0x13, 0xa4, 0x38, 0xbb, 0x3b, 0x7b, 0x36, 0xa5, 0xf3, 0xf4, 0xa9, 0x30, 0x6f,
+	0x24, 0x10, 0x42, 0x28, 0x10, 0x1e, 0x33, 0x29, 0x09, 0xf8, 0xf6, 0x8a, 0xd1, 0xff, 0xde, 0xcd,
+	0x8d, 0xbb, 0xb6, 0xa6, 0x3a, 0xf0, 0x96, 0xda, 0x50, 0x4d, 0x4c, 0xc8, 0xb4, 0xdf, 0x27, 0x37,
+	0x88, 0x0c, 0x83, 0xf2, 0xff, 0x01, 0x71, 0x9b, 0x7a, 0xa0, 0x11, 0x98, 0x5c, 0x9d, 0x0f, 0x96,
+	0x3f, 0xaa, 0xec, 0x22, 0xe2, 0xe2, 0x4b, 0x37, 0xf7, 0xc3, 0x25, 0x39, 0x5c, 0x06, 0x4e, 0x75,
+	0x70, 0xdb, 0xf2, 0x7b, 0x7a, 0xe0, 0xf0, 0x92, 0x0c, 0x16, 0x03, 0x22, 0x9f, 0x6b, 0x69, 0x2e,
+	0x62, 0x17, 0xe9, 0x73, 0x8e, 0x36, 0xdc, 0x75, 0xd5, 0xaa, 0xc1, 0x7a, 0x47, 0xc3, 0x8d, 0x48,
+	0x83, 0xe9, 0x40, 0x1a, 0x74, 0x65, 0x40, 0x67, 0x8f, 0x1b, 0x3e, 0x6a, 0x8f, 0x2b, 0x65, 0xf6,
+	0xc3, 0xad, 0xa5, 0xab, 0xee, 0x4b, 0x3f, 0x70, 0x60, 0x29, 0x74, 0x3e, 0x56, 0xd4, 0x5f, 0xfe,
+	0x9c, 0x37, 0xc1, 0xb1, 0x1a, 0xf5, 0x05, 0xb5, 0x2a, 0x19, 0x3e, 0xbc, 0xbb, 0x12, 0x43, 0x25,
+	0xfd, 0x8e, 0x3f, 0x99, 0x94, 0xc7, 0xc9, 0x85, 0x3d, 0x7a, 0x9e, 0xe6, 0x94, 0x84, 0x6f, 0x4a,
+	0x94, 0xfc, 0x39, 0x30, 0xcd, 0x5c, 0x6d, 0xd2, 0x77, 0x45, 0x0b, 0xdd, 0x88, 0x32, 0xe5, 0x8b,
+	0xdf, 0xa0, 0xd2, 0x88, 0xc0, 0xaf, 0x15, 0xa4, 0x4f, 0x62, 0x20, 0xcd, 0xba, 0x55, 0xa5, 0x59,
+	0x77, 0x0c, 0xbb, 0x0e, 0xd7, 0x37, 0x91, 0x51, 0x83, 0xec, 0x1a, 0xa2, 0xa6, 0x18, 0xee, 0x9f,
+	0x30, 0xc5, 0x0c, 0xbf, 0xd2, 0x14, 0x13, 0x0b, 0x4c, 0x31, 0x49, 0x7f, 0x8a, 0x71, 0xab, 0x9b,
+	0xbb, 0xe0, 0x85, 0xf6, 0xc0, 0x42, 0xa7, 0x9b, 0xf6, 0x6c, 0x72, 0x1d, 0x24, 0x48, 0xc5, 0xab,
+	0xfa, 0x25, 0x35, 0x4e, 0xaf, 0x4e, 0xea, 0x31, 0x65, 0xb4, 0x4b, 0x2a, 0x56, 0x26, 0x5b, 0xed,
+	0x45, 0x69, 0x79, 0x7f, 0x37, 0x37, 0xe9, 0x4e, 0x1e, 0x45, 0xb9, 0x20, 0x07, 0x12, 0xee, 0x3d,
+	0x70, 0x6e, 0xc0, 0xb5, 0x1c, 0x79, 0xa4, 0x28, 0x4d, 0x07, 0x76, 0x92, 0xbe, 0xe3, 0xc0, 0x02,
+	0x7b, 0x9e, 0x15, 0x77, 0x58, 0xfd, 0x93, 0x55, 0x67, 0x11, 0x8c, 0x99, 0x58, 0xaf, 0x36, 0x1b,
+	0x75, 0x6f, 0x96, 0x88, 0x9b, 0x58, 0xbf, 0xdb, 0xa8, 0xf3, 0xff, 0x65, 0xe5, 0x28, 0x96, 0xe1,
+	0x22, 0x5a, 0x8f, 0xb7, 0x7d, 0x59, 0xc5, 0x50, 0xf3, 0x2a, 0x85, 0x5f, 0x8f, 0x4e, 0x44, 0x44,
+	0xa8, 0xbd, 0xa5, 0x54, 0x04, 0xa9, 0x68, 0x12, 0xac, 0xd4, 0x84, 0x88, 0x7f, 0xc5, 0x81, 0x49,
+	0x1a, 0x56, 0x0d, 0x91, 0x19, 0xe5, 0x95, 0xd9, 0xae, 0x83, 0x98, 0x89, 0x75, 0x61, 0xb8, 0xcf,
+	0x1c, 0x76, 0x7c, 0x6f, 0x37, 0xb7, 0x18, 0xf5, 0x3a, 0x2a, 0x58, 0x57, 0x88, 0xf5, 0x20, 0x7a,
+	0x57, 0xc1, 0x5c, 0xc7, 0x51, 0xd9, 0x6d, 0x2f, 0x80, 0x78, 0x03, 0xe2, 0x66, 0xdd, 0x9d, 0x02,
+	0x13, 0x8a, 0xb7, 0x0a, 0x73, 0x3d, 0xe0, 0xc0, 0x3c, 0x6d, 0x35, 0xe4, 0x4d, 0x39, 0xf0, 0x06,
+	0x6a, 0xc1, 0x86, 0x45, 0x2a, 0x02, 0x7f, 0x1d, 0xcc, 0x6a, 0xae, 0x14, 0x35, 0xaa, 0xaa, 0xcb,
+	0x71, 0x20, 0xfb, 0x19, 0x66, 0xe2, 0xc9, 0xf9, 0x75, 0x30, 0xa3, 0x53, 0xa7, 0x1d, 0x5e, 0x06,
+	0xbd, 0xc8, 0x69, 0xdf, 0xc2, 0x13, 0x97, 0xae, 0x12, 0xd6, 0xe1, 0xe3, 0x04, 0x1b, 0x8d, 0xdf,
+	0x44, 0x83, 0x5c, 0xa4, 0x34, 0x38, 0x11, 0xa9, 0x60, 0xad, 0xf5, 0x0b, 0xce, 0x6d, 0x45, 0x96,
+	0xf6, 0x57, 0x05, 0xa2, 0xf4, 0xff, 0xde, 0x1c, 0xce, 0x84, 0x38, 0x44, 0x1d, 0x44, 0x3a, 0x09,
+	0xd2, 0x3d, 0x54, 0x3e, 0x8f, 0xd5, 0x9f, 0x27, 0x40, 0xac, 0x82, 0x75, 0xfe, 0x3e, 0x98, 0x0a,
+	0x7c, 0x96, 0x66, 0x82, 0x2f, 0x2a, 0xf8, 0xf1, 0x21, 0x66, 0x07, 0x21, 0x58, 0x76, 0x41, 0x30,
+	0x1b, 0xfe, 0xf2, 0x38, 0x15, 0x36, 0x0f, 0x81, 0xc4, 0xf3, 0x47, 0x00, 0xb1, 0x6d, 0xae, 0x82,
+	0x11, 0xfa, 0x09, 0xb0, 0x10, 0x36, 0x22, 0x72, 0x31, 0x15, 0x2d, 0x67, 0xf6, 0xf7, 0x40, 0xa2,
+	0x6b, 0x8e, 0xee, 0x81, 0xf7, 0xf5, 0xe2, 0xd9, 0xfe, 0x7a, 0xe6, 0xf7, 0x06, 0x18, 0xf3, 0xfb,
+	0xcc, 0x52, 0xd8, 0xc4, 0x53, 0x89, 0x27, 0x7b, 0xaa, 0x98, 0xa3, 0x07, 0x20, 0xd1, 0x35, 0xcc,
+	0x45, 0x1c, 0xb0, 0x53, 0x2f, 0x9e, 0xed, 0xaf, 0x67, 0xd9, 0x3c, 0xb7, 0x17, 0x1e, 0x9e, 0xf8,
+	0x0f, 0xc0, 0x54, 0x60, 0x70, 0x8a, 0x48, 0x89, 0x6e, 0x84, 0x98, 0x1d, 0x84, 0xe8, 0xb3, 0xe5,
+	0x5a, 0x81, 0xff, 0x94, 0x03, 0xcb, 0x7d, 0x67, 0x06, 0xb9, 0x57, 0xca, 0x45, 0xe3, 0xc5, 0xcb,
+	0x2f, 0x87, 0x67, 0xa7, 0x9b, 0xd9, 0xdb, 0xcd, 0x25, 0x32, 0x1d, 0x75, 0x8f, 0xff, 0x10, 0xcc,
+	0x45, 0x35, 0xb6, 0x33, 0xbd, 0x22, 0xdc, 0x05, 0x13, 0x73, 0x47, 0x82, 0xf5, 0xd9, 0xfe, 0x3e,
+	0x18, 0x67, 0xed, 0x45, 0x8c, 0x22, 0xe5, 0xea, 0x44, 0xa9, 0xb7, 0xae, 0x8f, 0xf7, 0x4d, 0xc0,
+	0x47, 0x14, 0xf4, 0xd3, 0x51, 0x09, 0x19, 0x44, 0x89, 0x17, 0x8e, 0x82, 0x62, 0x19, 0x6c, 0x81,
+	0x64, 0x64, 0xcd, 0x8c, 0xca, 0xd4, 0x08, 0x9c, 0x28, 0x1f, 0x0d, 0xe7, 0xef, 0x27, 0x8e, 0x7e,
+	0x44, 0xc6, 0xbd, 0xf2, 0xda, 0xe3, 0x17, 0x29, 0xee, 0xc9, 0x8b, 0x14, 0xf7, 0xd3, 0x8b, 0x14,
+	0xf7, 0xe8, 0x20, 0x35, 0xf4, 0xe4, 0x20, 0x35, 0xf4, 0xe3, 0x41, 0x6a, 0xe8, 0xdd, 0xe3, 0xae,
+	0x3f, 0xac, 0x3d, 0x90, 0x0d, 0xe4, 0x7d, 0x79, 0xd0, 0xf1, 0x91, 0xfc, 0xc9, 0x31, 0x4e, 0x7b,
+	0xef, 0xc5, 0x3f, 0x06, 0x00, 0x0b, 0xe2, 0x32, 0x64, 0xb2, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1514,10 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// DelegateGovernance defines a method to assign the governance power of an account to a governor.
+	DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to revoke the governance delegation of an account.
+	UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1618,24 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error) {
+	out := new(MsgDelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/DelegateGovernance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error) {
+	out := new(MsgUndelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UndelegateGovernance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1661,10 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// DelegateGovernance defines a method to assign the governance power of an account to a governor.
+	DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to revoke the governance delegation of an account.
+	UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1701,12 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) DelegateGovernance(ctx context.Context, req *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernance not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateGovernance(ctx context.Context, req *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1892,42 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/DelegateGovernance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernance(ctx, req.(*MsgDelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UndelegateGovernance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernance(ctx, req.(*MsgUndelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
@@ -1717,6 +1973,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "DelegateGovernance",
+			Handler:    _Msg_DelegateGovernance_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernance",
+			Handler:    _Msg_UndelegateGovernance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -2518,6 +2782,119 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2872,16 +3249,64 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgDelegateGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgDelegateGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *MsgUndelegateGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -5110,6 +5535,302 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
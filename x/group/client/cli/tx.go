@@ -20,6 +20,8 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagWeightSource       = "weight-source"
+	FlagWeightSourceDenom  = "weight-source-denom"
 )
 
 var errZeroGroupID = errors.New("group id cannot be 0")
@@ -103,16 +105,23 @@ Where members.json contains:
 				return err
 			}
 
+			weightSource, err := parseWeightSourceFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			msg := &group.MsgCreateGroup{
-				Admin:    admin,
-				Members:  members,
-				Metadata: args[1],
+				Admin:        admin,
+				Members:      members,
+				Metadata:     args[1],
+				WeightSource: weightSource,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addWeightSourceFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -295,10 +304,16 @@ and policy.json contains:
 				return err
 			}
 
+			msg.WeightSource, err = parseWeightSourceFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().Bool(FlagGroupPolicyAsAdmin, false, "Sets admin of the newly created group and group policy with group policy address itself when true")
+	addWeightSourceFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
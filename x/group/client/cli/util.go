@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
 	"cosmossdk.io/x/group"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -53,6 +56,39 @@ func parseMembers(membersFile string) ([]group.MemberRequest, error) {
 	return members.Members, nil
 }
 
+// addWeightSourceFlags adds the flags creating a token-weighted group.
+func addWeightSourceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagWeightSource, "", "Creates a token-weighted group whose member weights are read from the given weight source (e.g. staking, bank)")
+	cmd.Flags().String(FlagWeightSourceDenom, "", "Denom read by the weight source of a token-weighted group")
+}
+
+// parseWeightSourceFlags reads the weight source of a token-weighted group
+// from the flags, returning nil for regular groups.
+func parseWeightSourceFlags(fs *pflag.FlagSet) (*group.WeightSource, error) {
+	sourceType, err := fs.GetString(FlagWeightSource)
+	if err != nil {
+		return nil, err
+	}
+	denom, err := fs.GetString(FlagWeightSourceDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceType == "" {
+		if denom != "" {
+			return nil, fmt.Errorf("--%s requires --%s", FlagWeightSourceDenom, FlagWeightSource)
+		}
+		return nil, nil
+	}
+
+	source := &group.WeightSource{Type: sourceType, Denom: denom}
+	if err := source.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
 func execFromString(execStr string) group.Exec {
 	exec := group.Exec_EXEC_UNSPECIFIED
 	if execStr == ExecTry {
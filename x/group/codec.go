@@ -15,6 +15,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterInterface((*DecisionPolicy)(nil), nil)
 	registrar.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy")
 	registrar.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy")
+	registrar.RegisterConcrete(&QuorumDecisionPolicy{}, "cosmos-sdk/QuorumDecisionPolicy")
 
 	legacy.RegisterAminoMsg(registrar, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -30,6 +31,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgVote{}, "cosmos-sdk/group/MsgVote")
 	legacy.RegisterAminoMsg(registrar, &MsgExec{}, "cosmos-sdk/group/MsgExec")
 	legacy.RegisterAminoMsg(registrar, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(registrar, &MsgVetoProposal{}, "cosmos-sdk/group/MsgVetoProposal")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -49,6 +51,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgVote{},
 		&MsgExec{},
 		&MsgLeaveGroup{},
+		&MsgVetoProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -58,5 +61,6 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
 		&PercentageDecisionPolicy{},
+		&QuorumDecisionPolicy{},
 	)
 }
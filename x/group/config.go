@@ -20,14 +20,24 @@ type Config struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64
+
+	// MaxQueuedProposalExecutionGas is the gas limit for executing a queued
+	// proposal at the end of the block once its timelock ends.
+	// Defaults to 10_000_000 if not explicitly set.
+	MaxQueuedProposalExecutionGas uint64
+
+	// WeightProviders are the providers available to token-weighted groups,
+	// keyed by the weight source type they handle.
+	WeightProviders map[string]WeightProvider
 }
 
 // DefaultConfig returns the default config for group.
 func DefaultConfig() Config {
 	return Config{
-		MaxExecutionPeriod:    2 * time.Hour * 24 * 7, // Two weeks.
-		MaxMetadataLen:        255,
-		MaxProposalTitleLen:   255,
-		MaxProposalSummaryLen: 10200,
+		MaxExecutionPeriod:            2 * time.Hour * 24 * 7, // Two weeks.
+		MaxMetadataLen:                255,
+		MaxProposalTitleLen:           255,
+		MaxProposalSummaryLen:         10200,
+		MaxQueuedProposalExecutionGas: 10_000_000, // ten million
 	}
 }
@@ -6,16 +6,21 @@ package group
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -468,7 +473,7 @@ func (m *EventLeaveGroup) GetAddress() string {
 type EventProposalPruned struct {
 	// proposal_id is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN).
+	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN, VETOED).
 	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
 	// tally_result is the proposal tally result (when applicable).
 	TallyResult *TallyResult `protobuf:"bytes,3,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
@@ -528,6 +533,117 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventProposalQueued is an event emitted when an accepted proposal is queued
+// behind the timelock of its decision policy.
+type EventProposalQueued struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// timelock_end is the time after which the proposal can be executed.
+	TimelockEnd time.Time `protobuf:"bytes,2,opt,name=timelock_end,json=timelockEnd,proto3,stdtime" json:"timelock_end"`
+}
+
+func (m *EventProposalQueued) Reset()         { *m = EventProposalQueued{} }
+func (m *EventProposalQueued) String() string { return proto.CompactTextString(m) }
+func (*EventProposalQueued) ProtoMessage()    {}
+func (*EventProposalQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{10}
+}
+func (m *EventProposalQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalQueued.Merge(m, src)
+}
+func (m *EventProposalQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalQueued proto.InternalMessageInfo
+
+func (m *EventProposalQueued) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalQueued) GetTimelockEnd() time.Time {
+	if m != nil {
+		return m.TimelockEnd
+	}
+	return time.Time{}
+}
+
+// EventVetoProposal is an event emitted when a queued proposal is vetoed.
+type EventVetoProposal struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is the account address of the timelock guardian.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *EventVetoProposal) Reset()         { *m = EventVetoProposal{} }
+func (m *EventVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*EventVetoProposal) ProtoMessage()    {}
+func (*EventVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVetoProposal.Merge(m, src)
+}
+func (m *EventVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVetoProposal proto.InternalMessageInfo
+
+func (m *EventVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventVetoProposal) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -539,40 +655,48 @@ func init() {
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventProposalQueued)(nil), "cosmos.group.v1.EventProposalQueued")
+	proto.RegisterType((*EventVetoProposal)(nil), "cosmos.group.v1.EventVetoProposal")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x8b, 0xda, 0x40,
-	0x18, 0xc6, 0x1d, 0x2b, 0x5a, 0xc7, 0x52, 0xcb, 0xf4, 0x0f, 0xd1, 0x4a, 0x14, 0x2f, 0xf5, 0x50,
-	0x13, 0xb4, 0xd0, 0xf6, 0x26, 0xb5, 0x48, 0x11, 0x3c, 0x48, 0xec, 0x1f, 0xe8, 0xc5, 0x46, 0x67,
-	0xb0, 0xa1, 0xd1, 0x09, 0x33, 0x93, 0x54, 0x8f, 0xfb, 0x0d, 0xf6, 0xa3, 0xec, 0x61, 0x3f, 0xc4,
-	0x1e, 0x65, 0x4f, 0x7b, 0x5c, 0xf4, 0x8b, 0x2c, 0x99, 0x4c, 0x54, 0x5c, 0x96, 0x08, 0x7b, 0x9b,
-	0x99, 0xf7, 0xf7, 0x3c, 0x79, 0x9f, 0x79, 0x33, 0xb0, 0x32, 0xa5, 0x7c, 0x4e, 0xb9, 0x39, 0x63,
-	0xd4, 0xf7, 0xcc, 0xa0, 0x65, 0x92, 0x80, 0x2c, 0x04, 0x37, 0x3c, 0x46, 0x05, 0x45, 0xc5, 0xa8,
-	0x6a, 0xc8, 0xaa, 0x11, 0xb4, 0xca, 0xa5, 0xe8, 0x60, 0x2c, 0xcb, 0xa6, 0xaa, 0xca, 0x4d, 0xf9,
-	0xed, 0xb1, 0x93, 0x58, 0x79, 0x44, 0x15, 0xeb, 0x4d, 0xf8, 0xa2, 0x17, 0x1a, 0x7f, 0x65, 0xc4,
-	0x16, 0xe4, 0x5b, 0x88, 0xa0, 0x12, 0x7c, 0x2a, 0xd9, 0xb1, 0x83, 0x35, 0x50, 0x03, 0x8d, 0x8c,
-	0x95, 0x93, 0xfb, 0x3e, 0xde, 0xe1, 0x3f, 0x3c, 0x7c, 0x0a, 0x3e, 0x80, 0x6f, 0x8e, 0xdd, 0x87,
-	0xd4, 0x75, 0xa6, 0x2b, 0xd4, 0x86, 0x39, 0x1b, 0x63, 0x46, 0x38, 0x97, 0x9a, 0x7c, 0x57, 0xbb,
-	0xbe, 0x6c, 0xbe, 0x52, 0x7d, 0x7f, 0x89, 0x2a, 0x23, 0xc1, 0x9c, 0xc5, 0xcc, 0x8a, 0xc1, 0x9d,
-	0xdb, 0xc1, 0xc7, 0x1f, 0xe1, 0xf6, 0x11, 0xbe, 0x94, 0x6e, 0x23, 0x7f, 0x32, 0x77, 0xc4, 0x90,
-	0x51, 0x8f, 0x72, 0xdb, 0x45, 0x55, 0x58, 0xf0, 0xd4, 0x7a, 0x1f, 0x08, 0xc6, 0x47, 0x7d, 0x5c,
-	0xff, 0x0c, 0x5f, 0x4b, 0xdd, 0x2f, 0x47, 0xfc, 0xc5, 0xcc, 0xfe, 0x7f, 0xba, 0xf2, 0x3d, 0xcc,
-	0x4b, 0xe5, 0x4f, 0x2a, 0x48, 0x32, 0x7d, 0x06, 0x14, 0xde, 0x5b, 0x92, 0x69, 0x22, 0x8e, 0x3a,
-	0x30, 0xcb, 0x08, 0xf7, 0x5d, 0xa1, 0xa5, 0x6b, 0xa0, 0xf1, 0xbc, 0xfd, 0xce, 0x38, 0xfa, 0x45,
-	0x8c, 0xb8, 0xd1, 0xd0, 0xcf, 0x17, 0x94, 0x59, 0x12, 0xb7, 0x94, 0x0c, 0x21, 0x98, 0x71, 0xe9,
-	0x8c, 0x6b, 0x4f, 0xc2, 0x0b, 0xb4, 0xe4, 0xba, 0xfe, 0x07, 0x16, 0x65, 0x0b, 0x03, 0x62, 0x07,
-	0x89, 0xd3, 0x3e, 0x9c, 0x42, 0xfa, 0xd4, 0x29, 0x5c, 0x00, 0x35, 0x86, 0xb8, 0xbb, 0x21, 0xf3,
-	0x17, 0x04, 0x27, 0xe7, 0xfd, 0x04, 0xb3, 0x5c, 0xd8, 0xc2, 0xe7, 0x2a, 0x6f, 0xf5, 0xc1, 0xbc,
-	0x23, 0x89, 0x59, 0x0a, 0x47, 0x1d, 0xf8, 0x4c, 0xd8, 0xae, 0xbb, 0x1a, 0xab, 0xeb, 0x0a, 0xf3,
-	0x16, 0xda, 0x95, 0x7b, 0xf2, 0xef, 0x21, 0xa4, 0xee, 0xa8, 0x20, 0xf6, 0x9b, 0xae, 0x71, 0xb5,
-	0xd1, 0xc1, 0x7a, 0xa3, 0x83, 0xdb, 0x8d, 0x0e, 0xce, 0xb7, 0x7a, 0x6a, 0xbd, 0xd5, 0x53, 0x37,
-	0x5b, 0x3d, 0xf5, 0x5b, 0x65, 0xe5, 0xf8, 0x9f, 0xe1, 0x50, 0x73, 0x19, 0xbd, 0xb8, 0x49, 0x56,
-	0xbe, 0xb4, 0x0f, 0x77, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x96, 0x2a, 0x36, 0xd2, 0x03, 0x00,
-	0x00,
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0xc6, 0xb4, 0x75, 0xce, 0xc4, 0xc0, 0x0c, 0xd4, 0x95, 0x29, 0x99, 0x72, 0x61, 0x07,
+	0xe6, 0x68, 0x05, 0x01, 0xb7, 0x89, 0xa2, 0x6a, 0x9a, 0xb4, 0x43, 0x49, 0x07, 0x48, 0x5c, 0x8a,
+	0x5b, 0x9b, 0x10, 0x96, 0xc6, 0x91, 0xff, 0x94, 0xf5, 0x84, 0xf8, 0x06, 0xfb, 0x28, 0x1c, 0xf8,
+	0x10, 0x3b, 0x4e, 0x9c, 0x38, 0x01, 0x6a, 0xbf, 0x08, 0x8a, 0xe3, 0x74, 0x53, 0x11, 0x4a, 0x24,
+	0x6e, 0xb6, 0x7f, 0xef, 0xbd, 0xbc, 0xe7, 0x9f, 0x7f, 0x01, 0xdb, 0x43, 0x26, 0x46, 0x4c, 0xf8,
+	0x21, 0x67, 0x2a, 0xf5, 0xc7, 0xfb, 0x3e, 0x1d, 0xd3, 0x44, 0x0a, 0x94, 0x72, 0x26, 0x19, 0xdc,
+	0xc8, 0xab, 0x48, 0x57, 0xd1, 0x78, 0xbf, 0xb9, 0x95, 0x1f, 0xf4, 0x75, 0xd9, 0x37, 0x55, 0xbd,
+	0x69, 0xde, 0x5f, 0x54, 0x92, 0x93, 0x94, 0x16, 0xc5, 0xcd, 0x90, 0x85, 0x2c, 0x27, 0x65, 0x2b,
+	0x73, 0xea, 0x86, 0x8c, 0x85, 0x31, 0xf5, 0xf5, 0x6e, 0xa0, 0xde, 0xfb, 0x32, 0x1a, 0x51, 0x21,
+	0xf1, 0x28, 0xcd, 0x01, 0xde, 0x1e, 0xb8, 0xd5, 0xc9, 0xfc, 0xbc, 0xe0, 0x14, 0x4b, 0x7a, 0x98,
+	0x29, 0xc3, 0x2d, 0x50, 0xd7, 0x9f, 0xe8, 0x47, 0xa4, 0x61, 0xed, 0x58, 0xbb, 0xcb, 0xc1, 0xaa,
+	0xde, 0x1f, 0x91, 0x39, 0xfc, 0x55, 0x4a, 0xaa, 0xc0, 0x8f, 0xc1, 0xbd, 0x45, 0xf5, 0x2e, 0x8b,
+	0xa3, 0xe1, 0x04, 0xb6, 0xc0, 0x2a, 0x26, 0x84, 0x53, 0x21, 0x34, 0x67, 0xad, 0xdd, 0xf8, 0xfe,
+	0x6d, 0x6f, 0xd3, 0xc4, 0x7d, 0x9e, 0x57, 0x7a, 0x92, 0x47, 0x49, 0x18, 0x14, 0xc0, 0xb9, 0xda,
+	0xb5, 0x8f, 0xff, 0x87, 0xda, 0x13, 0x70, 0x47, 0xab, 0xf5, 0xd4, 0x60, 0x14, 0xc9, 0x2e, 0x67,
+	0x29, 0x13, 0x38, 0x86, 0x2e, 0xb0, 0x53, 0xb3, 0xbe, 0x0a, 0x04, 0x8a, 0xa3, 0x23, 0xe2, 0x3d,
+	0x03, 0x77, 0x35, 0xef, 0x4d, 0x24, 0x3f, 0x10, 0x8e, 0x3f, 0x55, 0x67, 0x3e, 0x04, 0x6b, 0x9a,
+	0xf9, 0x9a, 0x49, 0x5a, 0x8e, 0xfe, 0x62, 0x19, 0x78, 0xe7, 0x8c, 0x0e, 0x4b, 0xe1, 0xf0, 0x00,
+	0xac, 0x70, 0x2a, 0x54, 0x2c, 0x1b, 0x4b, 0x3b, 0xd6, 0xee, 0xcd, 0xd6, 0x03, 0xb4, 0xf0, 0xb2,
+	0x50, 0x61, 0x34, 0xd3, 0x53, 0x92, 0xf1, 0x40, 0xc3, 0x03, 0x43, 0x83, 0x10, 0x2c, 0xc7, 0x2c,
+	0x14, 0x8d, 0x1b, 0xd9, 0x05, 0x06, 0x7a, 0xed, 0xbd, 0x03, 0x1b, 0xda, 0xc2, 0x31, 0xc5, 0xe3,
+	0xd2, 0x6e, 0x5f, 0xef, 0xc2, 0x52, 0xd5, 0x2e, 0x7c, 0xb5, 0x4c, 0x1b, 0x0a, 0x77, 0x5d, 0xae,
+	0x12, 0x4a, 0xca, 0xf3, 0x3e, 0x05, 0x2b, 0x42, 0x62, 0xa9, 0x84, 0xc9, 0xeb, 0xfe, 0x33, 0x6f,
+	0x4f, 0xc3, 0x02, 0x03, 0x87, 0x07, 0x60, 0x5d, 0xe2, 0x38, 0x9e, 0xf4, 0xcd, 0x75, 0x65, 0x79,
+	0xed, 0xd6, 0xf6, 0x5f, 0xf4, 0x93, 0x0c, 0x64, 0xee, 0xc8, 0x96, 0x57, 0x1b, 0xef, 0xf3, 0x82,
+	0xe3, 0x97, 0x8a, 0xaa, 0x2a, 0x8e, 0x0f, 0xc1, 0x7a, 0x36, 0x7d, 0x31, 0x1b, 0x9e, 0xf6, 0x69,
+	0x42, 0xb4, 0x6f, 0xbb, 0xd5, 0x44, 0xf9, 0x88, 0xa2, 0x62, 0x44, 0xd1, 0x49, 0x31, 0xa2, 0xed,
+	0xfa, 0xc5, 0x4f, 0xb7, 0x76, 0xfe, 0xcb, 0xb5, 0x02, 0xbb, 0x60, 0x76, 0x12, 0xe2, 0x7d, 0x04,
+	0xb7, 0xf3, 0x77, 0x44, 0x25, 0xab, 0xfc, 0xfa, 0xe0, 0x63, 0x50, 0x0f, 0x15, 0xe6, 0x24, 0xc2,
+	0x49, 0x69, 0x7b, 0xe6, 0xc8, 0x36, 0xba, 0x98, 0x3a, 0xd6, 0xe5, 0xd4, 0xb1, 0x7e, 0x4f, 0x1d,
+	0xeb, 0x7c, 0xe6, 0xd4, 0x2e, 0x67, 0x4e, 0xed, 0xc7, 0xcc, 0xa9, 0xbd, 0x35, 0x4c, 0x41, 0x4e,
+	0x51, 0xc4, 0xfc, 0xb3, 0xfc, 0xaf, 0x34, 0x58, 0xd1, 0x31, 0x1e, 0xfd, 0x19, 0x00, 0x2b, 0x98,
+	0x54, 0x74, 0xf6, 0x04, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -895,6 +1019,77 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventProposalQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TimelockEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimelockEnd):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1046,6 +1241,36 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventProposalQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimelockEnd)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1906,6 +2131,209 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventProposalQueued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalQueued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TimelockEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -80,6 +80,18 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, w := range s.VoterWeights {
+
+		if err := w.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "VoterWeight validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[w.ProposalId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", w.ProposalId))
+		}
+	}
 	return nil
 }
 
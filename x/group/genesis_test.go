@@ -741,6 +741,93 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid voter weight",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:           1,
+						Admin:        accStrAddr,
+						Metadata:     "1",
+						Version:      1,
+						TotalWeight:  "1",
+						WeightSource: &WeightSource{Type: WeightSourceBank, Denom: "gov"},
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				VoterWeights: []*VoterWeight{
+					{
+						ProposalId: proposal.Id,
+						Voter:      memberStrAdrr,
+						Weight:     "10",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"voter weight on proposal that doesn't exist",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:           1,
+						Admin:        accStrAddr,
+						Metadata:     "1",
+						Version:      1,
+						TotalWeight:  "1",
+						WeightSource: &WeightSource{Type: WeightSourceBank, Denom: "gov"},
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				VoterWeights: []*VoterWeight{
+					{
+						ProposalId: 2,
+						Voter:      memberStrAdrr,
+						Weight:     "10",
+					},
+				},
+			},
+			true,
+		},
+		{
+			"negative voter weight",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:           1,
+						Admin:        accStrAddr,
+						Metadata:     "1",
+						Version:      1,
+						TotalWeight:  "1",
+						WeightSource: &WeightSource{Type: WeightSourceBank, Denom: "gov"},
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				VoterWeights: []*VoterWeight{
+					{
+						ProposalId: proposal.Id,
+						Voter:      memberStrAdrr,
+						Weight:     "-1",
+					},
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -8,8 +8,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
-// EndBlocker called at every block, updates proposal's `FinalTallyResult` and
-// prunes expired proposals.
+// EndBlocker called at every block, updates proposal's `FinalTallyResult`,
+// executes queued proposals whose timelock has ended and prunes expired
+// proposals.
 func (k Keeper) EndBlocker(ctx context.Context) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
@@ -18,5 +19,9 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 		return err
 	}
 
+	if err := k.ExecuteQueuedProposals(ctx); err != nil {
+		return err
+	}
+
 	return k.PruneProposals(ctx)
 }
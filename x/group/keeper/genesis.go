@@ -41,6 +41,10 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		return errors.Wrap(err, "votes")
 	}
 
+	if err := k.voterWeightTable.Import(store, genesisState.VoterWeights, 0); err != nil {
+		return errors.Wrap(err, "voter weights")
+	}
+
 	return nil
 }
 
@@ -89,5 +93,12 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) (*group.Ge
 	}
 	genesisState.Votes = votes
 
+	var voterWeights []*group.VoterWeight
+	_, err = k.voterWeightTable.Export(store, &voterWeights)
+	if err != nil {
+		return nil, errors.Wrap(err, "voter weights")
+	}
+	genesisState.VoterWeights = voterWeights
+
 	return genesisState, nil
 }
@@ -314,6 +314,46 @@ func (k Keeper) getVote(ctx context.Context, proposalID uint64, voter string) (g
 	return v, k.voteTable.GetOne(k.KVStoreService.OpenKVStore(ctx), orm.PrimaryKey(&group.Vote{ProposalId: proposalID, Voter: voter}, k.accKeeper.AddressCodec()), &v)
 }
 
+// VoterWeightsByProposal queries the voter weights snapshotted for a proposal
+// of a token-weighted group.
+func (k Keeper) VoterWeightsByProposal(ctx context.Context, request *group.QueryVoterWeightsByProposalRequest) (*group.QueryVoterWeightsByProposalResponse, error) {
+	it, err := k.voterWeightByProposalIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), request.ProposalId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var weights []*group.VoterWeight
+	pageRes, err := orm.Paginate(it, request.Pagination, &weights)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVoterWeightsByProposalResponse{
+		VoterWeights: weights,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// QueuedProposals queries the proposals queued behind the timelock of their
+// decision policy, ordered by timelock end.
+func (k Keeper) QueuedProposals(ctx context.Context, request *group.QueryQueuedProposalsRequest) (*group.QueryQueuedProposalsResponse, error) {
+	it, err := k.proposalsByTimelockEnd.PrefixScan(k.KVStoreService.OpenKVStore(ctx), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryQueuedProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
 // getVotesByProposal returns an iterator for the given proposal id and page request.
 func (k Keeper) getVotesByProposal(ctx context.Context, proposalID uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
 	return k.voteByProposalIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), proposalID, pageRequest)
@@ -37,11 +37,16 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalsByTimelockEndPrefix     byte = 0x34
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Voter Weight Table
+	VoterWeightTablePrefix           byte = 0x50
+	VoterWeightByProposalIndexPrefix byte = 0x51
 )
 
 type Keeper struct {
@@ -67,12 +72,17 @@ type Keeper struct {
 	proposalTable              orm.AutoUInt64Table
 	proposalByGroupPolicyIndex orm.Index
 	proposalsByVotingPeriodEnd orm.Index
+	proposalsByTimelockEnd     orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Voter Weight Table
+	voterWeightTable           orm.PrimaryKeyTable
+	voterWeightByProposalIndex orm.Index
+
 	config group.Config
 
 	cdc codec.Codec
@@ -111,6 +121,10 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if config.MaxProposalSummaryLen <= 0 {
 		config.MaxProposalSummaryLen = defaultConfig.MaxProposalSummaryLen
 	}
+	// If MaxQueuedProposalExecutionGas not set by app developer, set to default value.
+	if config.MaxQueuedProposalExecutionGas == 0 {
+		config.MaxQueuedProposalExecutionGas = defaultConfig.MaxQueuedProposalExecutionGas
+	}
 	k.config = config
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc, k.accKeeper.AddressCodec())
@@ -202,6 +216,18 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if err != nil {
 		panic(err.Error())
 	}
+	// Only queued proposals, i.e. accepted and not executed yet, are indexed
+	// by their timelock end.
+	k.proposalsByTimelockEnd, err = orm.NewIndex(proposalTable, ProposalsByTimelockEndPrefix, func(value interface{}) ([]interface{}, error) {
+		proposal := value.(*group.Proposal)
+		if !isQueued(*proposal) {
+			return nil, nil
+		}
+		return []interface{}{sdk.FormatTimeBytes(*proposal.TimelockEnd)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	k.proposalTable = *proposalTable
 
 	// Vote Table
@@ -227,6 +253,19 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	}
 	k.voteTable = *voteTable
 
+	// Voter Weight Table
+	voterWeightTable, err := orm.NewPrimaryKeyTable([2]byte{VoterWeightTablePrefix}, &group.VoterWeight{}, cdc, k.accKeeper.AddressCodec())
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voterWeightByProposalIndex, err = orm.NewIndex(voterWeightTable, VoterWeightByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.VoterWeight).ProposalId}, nil
+	}, group.VoterWeight{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voterWeightTable = *voterWeightTable
+
 	return k
 }
 
@@ -273,6 +312,32 @@ func (k Keeper) proposalsByVPEnd(ctx context.Context, endTime time.Time) (propos
 	return proposals, nil
 }
 
+// queuedProposals returns all queued proposals whose timelock_end is before the
+// `endTime` time argument. As the end of the scan is exclusive, proposals whose
+// timelock ends at `endTime` can still be vetoed and are not returned.
+func (k Keeper) queuedProposals(ctx context.Context, endTime time.Time) (proposals []group.Proposal, err error) {
+	timeBytes := sdk.FormatTimeBytes(endTime)
+	it, err := k.proposalsByTimelockEnd.PrefixScan(k.KVStoreService.OpenKVStore(ctx), nil, timeBytes)
+	if err != nil {
+		return proposals, err
+	}
+	defer it.Close()
+
+	for {
+		var proposal group.Proposal
+		_, err := it.LoadNext(&proposal)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return proposals, err
+		}
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx context.Context, proposalID uint64) error {
 	err := k.proposalTable.Delete(k.KVStoreService.OpenKVStore(ctx), proposalID)
@@ -346,6 +411,48 @@ func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
 	return nil
 }
 
+// pruneVoterWeights prunes all the voter weights snapshotted for a proposal
+// from state.
+func (k Keeper) pruneVoterWeights(ctx context.Context, proposalID uint64) error {
+	weights, err := k.voterWeightsByProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range weights {
+		err = k.voterWeightTable.Delete(k.KVStoreService.OpenKVStore(ctx), &w)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// voterWeightsByProposal returns all the voter weights snapshotted for a
+// given proposal.
+func (k Keeper) voterWeightsByProposal(ctx context.Context, proposalID uint64) ([]group.VoterWeight, error) {
+	it, err := k.voterWeightByProposalIndex.Get(k.KVStoreService.OpenKVStore(ctx), proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var weights []group.VoterWeight
+	for {
+		var weight group.VoterWeight
+		_, err = it.LoadNext(&weight)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return weights, err
+		}
+		weights = append(weights, weight)
+	}
+	return weights, nil
+}
+
 // votesByProposal returns all votes for a given proposal.
 func (k Keeper) votesByProposal(ctx context.Context, proposalID uint64) ([]group.Vote, error) {
 	it, err := k.voteByProposalIndex.Get(k.KVStoreService.OpenKVStore(ctx), proposalID)
@@ -398,6 +505,47 @@ func (k Keeper) PruneProposals(ctx context.Context) error {
 	return nil
 }
 
+// ExecuteQueuedProposals executes all queued proposals whose timelock has
+// ended. The execution result is stored in the proposal, and failed proposals
+// can still be executed with MsgExec until they are pruned.
+// Each proposal is executed with at most MaxQueuedProposalExecutionGas, and a
+// proposal failing to execute is marked as failed without halting the chain.
+func (k Keeper) ExecuteQueuedProposals(ctx context.Context) error {
+	proposals, err := k.queuedProposals(ctx, k.HeaderService.HeaderInfo(ctx).Time)
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		_, err := k.BranchService.ExecuteWithGasLimit(ctx, k.config.MaxQueuedProposalExecutionGas, func(ctx context.Context) error {
+			_, err := k.Exec(ctx, &group.MsgExec{
+				ProposalId: proposal.Id,
+				Executor:   proposal.GroupPolicyAddress,
+			})
+			return err
+		})
+		if err == nil {
+			continue
+		}
+
+		// The branch is discarded, mark the proposal as failed so that it
+		// leaves the queue.
+		k.Logger.Error("queued proposal execution failed", "cause", err, "proposalID", proposal.Id)
+		proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+		if err := k.proposalTable.Update(k.KVStoreService.OpenKVStore(ctx), proposal.Id, &proposal); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).Emit(&group.EventExec{
+			ProposalId: proposal.Id,
+			Logs:       fmt.Sprintf("queued proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error()),
+			Result:     proposal.ExecutorResult,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TallyProposalsAtVPEnd iterates over all proposals whose voting period
 // has ended, tallies their votes, prunes them, and updates the proposal's
 // `FinalTallyResult` field.
@@ -425,6 +573,9 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx context.Context) error {
 			if err := k.pruneVotes(ctx, proposalID); err != nil {
 				return err
 			}
+			if err := k.pruneVoterWeights(ctx, proposalID); err != nil {
+				return err
+			}
 			// Emit event for proposal finalized with its result
 			if err := k.EventService.EventManager(ctx).Emit(
 				&group.EventProposalPruned{
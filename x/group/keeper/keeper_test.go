@@ -47,6 +47,7 @@ type TestSuite struct {
 	groupKeeper        keeper.Keeper
 	blockTime          time.Time
 	bankKeeper         *grouptestutil.MockBankKeeper
+	stakingKeeper      *grouptestutil.MockStakingKeeper
 	accountKeeper      *grouptestutil.MockAccountKeeper
 }
 
@@ -72,6 +73,7 @@ func (s *TestSuite) SetupTest() {
 	s.accountKeeper.EXPECT().AddressCodec().Return(addressCodec).AnyTimes()
 
 	s.bankKeeper = grouptestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = grouptestutil.NewMockStakingKeeper(ctrl)
 
 	bApp := baseapp.NewBaseApp(
 		"group",
@@ -84,6 +86,10 @@ func (s *TestSuite) SetupTest() {
 
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger(), runtime.EnvWithQueryRouterService(bApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(bApp.MsgServiceRouter()))
 	config := group.DefaultConfig()
+	config.WeightProviders = map[string]group.WeightProvider{
+		group.WeightSourceStaking: group.NewStakingWeightProvider(s.stakingKeeper),
+		group.WeightSourceBank:    group.NewBankWeightProvider(s.bankKeeper),
+	}
 	s.groupKeeper = keeper.NewKeeper(env, encCfg.Codec, s.accountKeeper, config)
 	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.blockTime})
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
@@ -39,6 +39,10 @@ func (k Keeper) CreateGroup(ctx context.Context, msg *group.MsgCreateGroup) (*gr
 		return nil, err
 	}
 
+	if err := k.validateWeightSource(msg.WeightSource); err != nil {
+		return nil, errorsmod.Wrap(err, "weight source")
+	}
+
 	totalWeight := math.NewDecFromInt64(0)
 	for _, m := range msg.Members {
 		if err := k.assertMetadataLength(m.Metadata, "member metadata"); err != nil {
@@ -63,12 +67,13 @@ func (k Keeper) CreateGroup(ctx context.Context, msg *group.MsgCreateGroup) (*gr
 	// Create a new group in the groupTable.
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	groupInfo := &group.GroupInfo{
-		Id:          k.groupTable.Sequence().PeekNextVal(kvStore),
-		Admin:       msg.Admin,
-		Metadata:    msg.Metadata,
-		Version:     1,
-		TotalWeight: totalWeight.String(),
-		CreatedAt:   k.HeaderService.HeaderInfo(ctx).Time,
+		Id:           k.groupTable.Sequence().PeekNextVal(kvStore),
+		Admin:        msg.Admin,
+		Metadata:     msg.Metadata,
+		Version:      1,
+		TotalWeight:  totalWeight.String(),
+		CreatedAt:    k.HeaderService.HeaderInfo(ctx).Time,
+		WeightSource: msg.WeightSource,
 	}
 	groupID, err := k.groupTable.Create(kvStore, groupInfo)
 	if err != nil {
@@ -280,9 +285,10 @@ func (k Keeper) UpdateGroupMetadata(ctx context.Context, msg *group.MsgUpdateGro
 func (k Keeper) CreateGroupWithPolicy(ctx context.Context, msg *group.MsgCreateGroupWithPolicy) (*group.MsgCreateGroupWithPolicyResponse, error) {
 	// NOTE: admin, and group message validation is performed in the CreateGroup method
 	groupRes, err := k.CreateGroup(ctx, &group.MsgCreateGroup{
-		Admin:    msg.Admin,
-		Members:  msg.Members,
-		Metadata: msg.GroupMetadata,
+		Admin:        msg.Admin,
+		Members:      msg.Members,
+		Metadata:     msg.GroupMetadata,
+		WeightSource: msg.WeightSource,
 	})
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "group response")
@@ -598,8 +604,20 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, err
 	}
 
+	proposalID := k.proposalTable.Sequence().PeekNextVal(kvStore)
+
+	// Snapshot the voting weights of the members of token-weighted groups, the
+	// proposal is then tallied against these weights only.
+	var totalWeight string
+	if groupInfo.WeightSource != nil {
+		totalWeight, err = k.snapshotVoterWeights(ctx, proposalID, groupInfo)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "snapshot voter weights")
+		}
+	}
+
 	m := &group.Proposal{
-		Id:                 k.proposalTable.Sequence().PeekNextVal(kvStore),
+		Id:                 proposalID,
 		GroupPolicyAddress: msg.GroupPolicyAddress,
 		Metadata:           msg.Metadata,
 		Proposers:          msg.Proposers,
@@ -612,6 +630,7 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		FinalTallyResult:   group.DefaultTallyResult(),
 		Title:              msg.Title,
 		Summary:            msg.Summary,
+		TotalWeight:        totalWeight,
 	}
 
 	if err := m.SetMsgs(msgs); err != nil {
@@ -701,6 +720,60 @@ func (k Keeper) WithdrawProposal(ctx context.Context, msg *group.MsgWithdrawProp
 	return &group.MsgWithdrawProposalResponse{}, nil
 }
 
+// VetoProposal implements the MsgServer/VetoProposal method.
+func (k Keeper) VetoProposal(ctx context.Context, msg *group.MsgVetoProposal) (*group.MsgVetoProposalResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address: %s", msg.Guardian)
+	}
+
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the proposal is queued and its timelock has not ended yet.
+	if !isQueued(proposal) {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "proposal %d is not queued", proposal.Id)
+	}
+	if !isTimelocked(proposal, k.HeaderService.HeaderInfo(ctx).Time) {
+		return nil, errorsmod.Wrapf(errors.ErrExpired, "timelock of proposal %d ended on %s", proposal.Id, proposal.TimelockEnd)
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the guardian of the current decision policy can veto the proposal.
+	timelockPolicy, ok := policy.(group.TimelockDecisionPolicy)
+	if !ok || timelockPolicy.GetTimelock() == nil || timelockPolicy.GetTimelock().Guardian != msg.Guardian {
+		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "%s is not the timelock guardian of group policy %s", msg.Guardian, policyInfo.Address)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_VETOED
+	if err := k.proposalTable.Update(k.KVStoreService.OpenKVStore(ctx), proposal.Id, &proposal); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventVetoProposal{
+		ProposalId: proposal.Id,
+		Guardian:   msg.Guardian,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgVetoProposalResponse{}, nil
+}
+
 func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteResponse, error) {
 	if msg.ProposalId == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
@@ -748,10 +821,17 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 		return nil, err
 	}
 
-	// Count and store votes.
-	voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
-	if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
-		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+	// Count and store votes. Proposals of token-weighted groups can only be
+	// voted on by the members whose weight was snapshotted at submission.
+	if proposal.TotalWeight != "" {
+		if _, err := k.getVoterWeight(ctx, proposal.Id, msg.Voter); err != nil {
+			return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+		}
+	} else {
+		voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
+		if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
+			return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+		}
 	}
 	newVote := group.Vote{
 		ProposalId: msg.ProposalId,
@@ -784,7 +864,7 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
-// - prune all the votes.
+// - prune all the votes and snapshotted voter weights.
 func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupInfo group.GroupInfo, policyInfo group.GroupPolicyInfo) error {
 	policy, err := policyInfo.GetDecisionPolicy()
 	if err != nil {
@@ -796,7 +876,14 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	// Proposals of token-weighted groups use the total weight snapshotted at
+	// submission.
+	totalWeight := groupInfo.TotalWeight
+	if p.TotalWeight != "" {
+		totalWeight = p.TotalWeight
+	}
+
+	result, err := policy.Allow(tallyResult, totalWeight)
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
@@ -807,9 +894,15 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
+		if err := k.pruneVoterWeights(ctx, p.Id); err != nil {
+			return err
+		}
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+			if err := k.queueProposal(ctx, p, policy); err != nil {
+				return err
+			}
 		} else {
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
@@ -853,9 +946,13 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 		}
 	}
 
-	// Execute proposal payload.
+	// Execute proposal payload, unless the proposal is queued behind the
+	// timelock of its decision policy. Timelocked proposals are executed
+	// automatically at the end of the block once their timelock ends.
 	var logs string
-	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+	if now := k.HeaderService.HeaderInfo(ctx).Time; isTimelocked(proposal, now) {
+		logs = fmt.Sprintf("proposal %d is timelocked until %s", proposal.Id, proposal.TimelockEnd)
+	} else if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		addr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
 		if err != nil {
 			return nil, err
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/internal/orm"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,11 +16,12 @@ import (
 // and returns the tally result without modifying the proposal or any state.
 func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
-	// accepted/rejected, in which case we just return the previously stored result.
+	// accepted/rejected/vetoed, in which case we just return the previously
+	// stored result.
 	//
 	// In all other cases (including withdrawn, aborted...) we do the tally
 	// again.
-	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED {
+	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED || p.Status == group.PROPOSAL_STATUS_VETOED {
 		return p.FinalTallyResult, nil
 	}
 
@@ -43,12 +45,7 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 			return group.TallyResult{}, err
 		}
 
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupID,
-			Member:  &group.Member{Address: vote.Voter},
-		}, k.accKeeper.AddressCodec()), &member)
-
+		weight, err := k.voteWeight(ctx, p, groupID, vote.Voter)
 		switch {
 		case sdkerrors.ErrNotFound.Is(err):
 			// If the member left the group after voting, then we simply skip the
@@ -59,10 +56,43 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		// Members of token-weighted groups can have no weight at all, in which
+		// case their vote doesn't count.
+		weightDec, err := math.NewNonNegativeDecFromString(weight)
+		if err != nil {
+			return group.TallyResult{}, err
+		}
+		if weightDec.IsZero() {
+			continue
+		}
+
+		if err := tallyResult.Add(vote, weight); err != nil {
 			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
 		}
 	}
 
 	return tallyResult, nil
 }
+
+// voteWeight returns the weight of a voter on a proposal: the weight
+// snapshotted at submission for proposals of token-weighted groups, and the
+// current member weight otherwise.
+func (k Keeper) voteWeight(ctx context.Context, p group.Proposal, groupID uint64, voter string) (string, error) {
+	if p.TotalWeight != "" {
+		w, err := k.getVoterWeight(ctx, p.Id, voter)
+		if err != nil {
+			return "", err
+		}
+		return w.Weight, nil
+	}
+
+	var member group.GroupMember
+	err := k.groupMemberTable.GetOne(k.KVStoreService.OpenKVStore(ctx), orm.PrimaryKey(&group.GroupMember{
+		GroupId: groupID,
+		Member:  &group.Member{Address: voter},
+	}, k.accKeeper.AddressCodec()), &member)
+	if err != nil {
+		return "", err
+	}
+	return member.Member.Weight, nil
+}
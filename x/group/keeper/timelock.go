@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/x/group"
+)
+
+// isQueued returns true if the proposal has been accepted by a decision policy
+// with a timelock and has not been executed yet.
+func isQueued(p group.Proposal) bool {
+	return p.Status == group.PROPOSAL_STATUS_ACCEPTED &&
+		p.TimelockEnd != nil &&
+		p.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN
+}
+
+// isTimelocked returns true if the proposal is queued and its timelock has not
+// ended yet at the given time.
+func isTimelocked(p group.Proposal, now time.Time) bool {
+	return isQueued(p) && !now.After(*p.TimelockEnd)
+}
+
+// queueProposal queues an accepted proposal behind the timelock of its
+// decision policy, if any. The timelock ends `delay` after acceptance, and
+// never before the policy's min execution period has elapsed, so that queued
+// proposals are executable as soon as their timelock ends.
+func (k Keeper) queueProposal(ctx context.Context, p *group.Proposal, policy group.DecisionPolicy) error {
+	timelockPolicy, ok := policy.(group.TimelockDecisionPolicy)
+	if !ok || timelockPolicy.GetTimelock() == nil {
+		return nil
+	}
+
+	timelockEnd := k.HeaderService.HeaderInfo(ctx).Time.Add(timelockPolicy.GetTimelock().Delay)
+	if minExecutionTime := p.SubmitTime.Add(policy.GetMinExecutionPeriod()); minExecutionTime.After(timelockEnd) {
+		timelockEnd = minExecutionTime
+	}
+	p.TimelockEnd = &timelockEnd
+
+	return k.EventService.EventManager(ctx).Emit(&group.EventProposalQueued{
+		ProposalId:  p.Id,
+		TimelockEnd: timelockEnd,
+	})
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/group"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestTimelockedProposal() {
+	delay := time.Hour
+	guardian := s.addrsStr[5]
+
+	policy := group.NewThresholdDecisionPolicy("1", time.Minute, 0).(*group.ThresholdDecisionPolicy)
+	policy.Windows.Timelock = &group.Timelock{Delay: delay, Guardian: guardian}
+	policyAddr, _ := s.createGroupAndGroupPolicy(s.addrs[0], []group.MemberRequest{{Address: s.addrsStr[1], Weight: "1"}}, policy)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   s.addrsStr[2],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	submitAccepted := func() uint64 {
+		req := &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{s.addrsStr[1]},
+			Exec:               group.Exec_EXEC_TRY,
+		}
+		s.Require().NoError(req.SetMsgs([]sdk.Msg{msgSend}))
+		res, err := s.groupKeeper.SubmitProposal(s.ctx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	getProposal := func(ctx sdk.Context, id uint64) (*group.Proposal, error) {
+		res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		if err != nil {
+			return nil, err
+		}
+		return res.Proposal, nil
+	}
+
+	// the proposal is accepted at submission, but queued instead of executed.
+	executedID := submitAccepted()
+	vetoedID := submitAccepted()
+
+	proposal, err := getProposal(s.sdkCtx, executedID)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+	s.Require().NotNil(proposal.TimelockEnd)
+	s.Require().Equal(s.blockTime.Add(delay), *proposal.TimelockEnd)
+
+	queuedRes, err := s.groupKeeper.QueuedProposals(s.ctx, &group.QueryQueuedProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queuedRes.Proposals, 2)
+
+	// executing a timelocked proposal is a no-op.
+	execRes, err := s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: executedID, Executor: s.addrsStr[1]})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, execRes.Result)
+
+	// only the guardian can veto.
+	_, err = s.groupKeeper.VetoProposal(s.ctx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: s.addrsStr[1]})
+	s.Require().ErrorContains(err, "is not the timelock guardian")
+
+	_, err = s.groupKeeper.VetoProposal(s.ctx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: guardian})
+	s.Require().NoError(err)
+
+	proposal, err = getProposal(s.sdkCtx, vetoedID)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_VETOED, proposal.Status)
+
+	_, err = s.groupKeeper.VetoProposal(s.ctx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: guardian})
+	s.Require().ErrorContains(err, "is not queued")
+	_, err = s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: vetoedID, Executor: s.addrsStr[1]})
+	s.Require().ErrorContains(err, "not possible to exec with proposal status PROPOSAL_STATUS_VETOED")
+
+	queuedRes, err = s.groupKeeper.QueuedProposals(s.ctx, &group.QueryQueuedProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queuedRes.Proposals, 1)
+	s.Require().Equal(executedID, queuedRes.Proposals[0].Id)
+
+	// the proposal can still be vetoed when its timelock ends, and is not
+	// executed by the end blocker yet.
+	ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(delay)})
+	s.Require().NoError(s.groupKeeper.EndBlocker(ctx))
+	proposal, err = getProposal(ctx, executedID)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+
+	// once the timelock has ended, the proposal is executed at the end of the
+	// block and cannot be vetoed anymore.
+	ctx = s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(delay + time.Second)})
+	_, err = s.groupKeeper.VetoProposal(ctx, &group.MsgVetoProposal{ProposalId: executedID, Guardian: guardian})
+	s.Require().ErrorContains(err, "timelock of proposal")
+
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil).Times(1)
+	s.Require().NoError(s.groupKeeper.EndBlocker(ctx))
+
+	_, err = getProposal(ctx, executedID)
+	s.Require().ErrorContains(err, "not found")
+
+	queuedRes, err = s.groupKeeper.QueuedProposals(ctx, &group.QueryQueuedProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(queuedRes.Proposals)
+}
+
+func (s *TestSuite) TestTimelockDelayValidation() {
+	policy := group.NewPercentageDecisionPolicy("0.5", time.Minute, 0).(*group.PercentageDecisionPolicy)
+	policy.Windows.Timelock = &group.Timelock{Delay: group.DefaultConfig().MaxExecutionPeriod + time.Second}
+
+	req := &group.MsgCreateGroupPolicy{Admin: s.addrsStr[0], GroupId: s.groupID}
+	s.Require().NoError(req.SetDecisionPolicy(policy))
+	s.setNextAccount()
+	_, err := s.groupKeeper.CreateGroupPolicy(s.ctx, req)
+	s.Require().ErrorContains(err, "timelock delay should be smaller than max_execution_period")
+}
+
+func (s *TestSuite) TestQueuedProposalExecutionFailure() {
+	delay := time.Hour
+
+	policy := group.NewThresholdDecisionPolicy("1", time.Minute, 0).(*group.ThresholdDecisionPolicy)
+	policy.Windows.Timelock = &group.Timelock{Delay: delay, Guardian: s.addrsStr[5]}
+	policyAddr, _ := s.createGroupAndGroupPolicy(s.addrs[0], []group.MemberRequest{{Address: s.addrsStr[1], Weight: "1"}}, policy)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   s.addrsStr[2],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	req := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{s.addrsStr[1]},
+		Exec:               group.Exec_EXEC_TRY,
+	}
+	s.Require().NoError(req.SetMsgs([]sdk.Msg{msgSend}))
+	res, err := s.groupKeeper.SubmitProposal(s.ctx, req)
+	s.Require().NoError(err)
+
+	// the payload runs out of gas, which fails the proposal without halting
+	// the chain.
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).DoAndReturn(func(ctx context.Context, _ *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(group.DefaultConfig().MaxQueuedProposalExecutionGas+1, "test")
+		return &banktypes.MsgSendResponse{}, nil
+	}).Times(1)
+
+	ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(delay + time.Second)})
+	s.Require().NoError(s.groupKeeper.EndBlocker(ctx))
+
+	proposalRes, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: res.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposalRes.Proposal.ExecutorResult)
+
+	queuedRes, err := s.groupKeeper.QueuedProposals(ctx, &group.QueryQueuedProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(queuedRes.Proposals)
+}
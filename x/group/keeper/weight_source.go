@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/math"
+	"cosmossdk.io/x/group/internal/orm"
+)
+
+// validateWeightSource checks that the weight source of a token-weighted group
+// is handled by one of the weight providers registered in the keeper config.
+func (k Keeper) validateWeightSource(source *group.WeightSource) error {
+	if source == nil {
+		return nil
+	}
+
+	if err := source.ValidateBasic(); err != nil {
+		return err
+	}
+
+	provider, ok := k.config.WeightProviders[source.Type]
+	if !ok {
+		return errorsmod.Wrapf(errors.ErrInvalid, "unknown weight source type %s", source.Type)
+	}
+
+	return provider.ValidateSource(*source)
+}
+
+// snapshotVoterWeights reads the voting weight of all the members of a
+// token-weighted group from its weight source, stores them for the given
+// proposal and returns the group's total weight. It fails if the members
+// have no voting weight at all.
+func (k Keeper) snapshotVoterWeights(ctx context.Context, proposalID uint64, groupInfo group.GroupInfo) (string, error) {
+	provider, ok := k.config.WeightProviders[groupInfo.WeightSource.Type]
+	if !ok {
+		return "", errorsmod.Wrapf(errors.ErrInvalid, "unknown weight source type %s", groupInfo.WeightSource.Type)
+	}
+
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	it, err := k.groupMemberByGroupIndex.Get(kvStore, groupInfo.Id)
+	if err != nil {
+		return "", err
+	}
+	defer it.Close()
+
+	var weights []group.VoterWeight
+	totalWeight := math.NewDecFromInt64(0)
+	for {
+		var member group.GroupMember
+		_, err := it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if err := k.GasService.GasMeter(ctx).Consume(gasCostPerIteration, "snapshot voter weight"); err != nil {
+			return "", err
+		}
+
+		addr, err := k.accKeeper.AddressCodec().StringToBytes(member.Member.Address)
+		if err != nil {
+			return "", err
+		}
+		amount, err := provider.MemberWeight(ctx, *groupInfo.WeightSource, addr)
+		if err != nil {
+			return "", errorsmod.Wrapf(err, "weight of %s", member.Member.Address)
+		}
+		weight, err := math.NewNonNegativeDecFromString(amount.String())
+		if err != nil {
+			return "", errorsmod.Wrapf(err, "weight of %s", member.Member.Address)
+		}
+
+		weights = append(weights, group.VoterWeight{
+			ProposalId: proposalID,
+			Voter:      member.Member.Address,
+			Weight:     weight.String(),
+		})
+		totalWeight, err = totalWeight.Add(weight)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if totalWeight.IsZero() {
+		return "", errorsmod.Wrap(errors.ErrInvalid, "group members have no voting weight")
+	}
+
+	for i := range weights {
+		if err := k.voterWeightTable.Create(kvStore, &weights[i]); err != nil {
+			return "", errorsmod.Wrap(err, "store voter weight")
+		}
+	}
+
+	return totalWeight.String(), nil
+}
+
+// getVoterWeight returns the voting weight of a voter snapshotted at the
+// submission of a proposal of a token-weighted group.
+func (k Keeper) getVoterWeight(ctx context.Context, proposalID uint64, voter string) (group.VoterWeight, error) {
+	var w group.VoterWeight
+	return w, k.voterWeightTable.GetOne(k.KVStoreService.OpenKVStore(ctx), orm.PrimaryKey(&group.VoterWeight{ProposalId: proposalID, Voter: voter}, k.accKeeper.AddressCodec()), &w)
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/group"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestCreateTokenWeightedGroup() {
+	members := []group.MemberRequest{{Address: s.addrsStr[1], Weight: "1"}}
+
+	testCases := []struct {
+		name         string
+		weightSource *group.WeightSource
+		expErr       string
+	}{
+		{
+			name:         "bank weight source",
+			weightSource: &group.WeightSource{Type: group.WeightSourceBank, Denom: "gov"},
+		},
+		{
+			name:         "staking weight source",
+			weightSource: &group.WeightSource{Type: group.WeightSourceStaking},
+		},
+		{
+			name:         "unknown weight source",
+			weightSource: &group.WeightSource{Type: "unknown"},
+			expErr:       "unknown weight source type unknown",
+		},
+		{
+			name:         "bank weight source without denom",
+			weightSource: &group.WeightSource{Type: group.WeightSourceBank},
+			expErr:       "invalid denom",
+		},
+		{
+			name:         "staking weight source with denom",
+			weightSource: &group.WeightSource{Type: group.WeightSourceStaking, Denom: "gov"},
+			expErr:       "staking weight source does not accept a denom",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.groupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+				Admin:        s.addrsStr[0],
+				Members:      members,
+				WeightSource: tc.weightSource,
+			})
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			groupRes, err := s.groupKeeper.GroupInfo(s.ctx, &group.QueryGroupInfoRequest{GroupId: res.GroupId})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.weightSource, groupRes.Info.WeightSource)
+		})
+	}
+}
+
+func (s *TestSuite) TestTokenWeightedProposal() {
+	// addrs[1] and addrs[2] hold governance tokens, addrs[3] holds none.
+	balances := map[string]int64{s.addrsStr[1]: 60, s.addrsStr[2]: 30, s.addrsStr[3]: 0}
+
+	groupRes, err := s.groupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: s.addrsStr[1], Weight: "1"},
+			{Address: s.addrsStr[2], Weight: "1"},
+			{Address: s.addrsStr[3], Weight: "1"},
+		},
+		WeightSource: &group.WeightSource{Type: group.WeightSourceBank, Denom: "gov"},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	policyReq := &group.MsgCreateGroupPolicy{Admin: s.addrsStr[0], GroupId: groupID}
+	s.Require().NoError(policyReq.SetDecisionPolicy(group.NewQuorumDecisionPolicy("0.3", "0.5", time.Minute, 0)))
+	s.setNextAccount()
+	policyRes, err := s.groupKeeper.CreateGroupPolicy(s.ctx, policyReq)
+	s.Require().NoError(err)
+
+	submitProposal := func() (uint64, error) {
+		res, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyRes.Address,
+			Proposers:          []string{s.addrsStr[1]},
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+
+	// every member has a zero balance: the proposal cannot be submitted.
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), "gov").Return(sdk.NewInt64Coin("gov", 0)).Times(3)
+	_, err = submitProposal()
+	s.Require().ErrorContains(err, "group members have no voting weight")
+
+	for i := 1; i <= 3; i++ {
+		s.bankKeeper.EXPECT().GetBalance(gomock.Any(), s.addrs[i], "gov").Return(sdk.NewInt64Coin("gov", balances[s.addrsStr[i]])).Times(1)
+	}
+	proposalID, err := submitProposal()
+	s.Require().NoError(err)
+
+	proposalRes, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("90", proposalRes.Proposal.TotalWeight)
+
+	weightsRes, err := s.groupKeeper.VoterWeightsByProposal(s.ctx, &group.QueryVoterWeightsByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(weightsRes.VoterWeights, 3)
+	for _, w := range weightsRes.VoterWeights {
+		s.Require().Equal(math.NewInt(balances[w.Voter]).String(), w.Weight)
+	}
+
+	// membership changes after submission don't affect the snapshot.
+	_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:   s.addrsStr[0],
+		GroupId: groupID,
+		MemberUpdates: []group.MemberRequest{
+			{Address: s.addrsStr[4], Weight: "1"},
+			{Address: s.addrsStr[2], Weight: "0"},
+		},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addrsStr[4], Option: group.VOTE_OPTION_YES})
+	s.Require().ErrorContains(err, "not found")
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addrsStr[2], Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addrsStr[3], Option: group.VOTE_OPTION_NO})
+	s.Require().NoError(err)
+
+	tallyRes, err := s.groupKeeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("30", tallyRes.Tally.YesCount)
+	s.Require().Equal("0", tallyRes.Tally.NoCount)
+
+	// the vote of addrs[3] has no weight. A third of the total weight voted,
+	// which reaches the quorum, and yes is the majority of the votes cast: the
+	// proposal passes at the end of the voting period.
+	ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(time.Minute + time.Second)})
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+	proposalRes, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+	s.Require().Equal("30", proposalRes.Proposal.FinalTallyResult.YesCount)
+
+	// snapshots are pruned with the votes once the proposal is final.
+	weightsRes, err = s.groupKeeper.VoterWeightsByProposal(ctx, &group.QueryVoterWeightsByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Empty(weightsRes.VoterWeights)
+}
+
+func (s *TestSuite) TestStakingWeightedProposal() {
+	groupRes, err := s.groupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: s.addrsStr[1], Weight: "1"},
+			{Address: s.addrsStr[2], Weight: "1"},
+		},
+		WeightSource: &group.WeightSource{Type: group.WeightSourceStaking},
+	})
+	s.Require().NoError(err)
+
+	policyReq := &group.MsgCreateGroupPolicy{Admin: s.addrsStr[0], GroupId: groupRes.GroupId}
+	s.Require().NoError(policyReq.SetDecisionPolicy(group.NewPercentageDecisionPolicy("0.5", time.Minute, 0)))
+	s.setNextAccount()
+	policyRes, err := s.groupKeeper.CreateGroupPolicy(s.ctx, policyReq)
+	s.Require().NoError(err)
+
+	s.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), s.addrs[1]).Return(math.NewInt(25), nil).Times(1)
+	s.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), s.addrs[2]).Return(math.NewInt(75), nil).Times(1)
+
+	// the proposer holds a minority of the bonded tokens, trying to execute
+	// the proposal at submission doesn't accept it.
+	res, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyRes.Address,
+		Proposers:          []string{s.addrsStr[1]},
+		Exec:               group.Exec_EXEC_TRY,
+	})
+	s.Require().NoError(err)
+
+	proposalRes, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: res.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal("100", proposalRes.Proposal.TotalWeight)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposalRes.Proposal.Status)
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: res.ProposalId, Voter: s.addrsStr[2], Option: group.VOTE_OPTION_YES, Exec: group.Exec_EXEC_TRY})
+	s.Require().NoError(err)
+
+	proposalRes, err = s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: res.ProposalId})
+	s.Require().ErrorContains(err, "not found")
+	s.Require().Nil(proposalRes)
+}
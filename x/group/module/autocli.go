@@ -121,6 +121,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "groups",
 					Short:     "Query for all groups on chain",
 				},
+				{
+					RpcMethod: "VoterWeightsByProposal",
+					Use:       "voter-weights-by-proposal <proposal-id>",
+					Short:     "Query for the voter weights snapshotted for a proposal of a token-weighted group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "QueuedProposals",
+					Use:       "queued-proposals",
+					Short:     "Query for the accepted proposals queued behind a timelock",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -167,6 +180,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"}, {ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "VetoProposal",
+					Use:       "veto-proposal <proposal-id> <guardian>",
+					Short:     "Veto a queued proposal as the timelock guardian of its group policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"}, {ProtoField: "guardian"},
+					},
+				},
 				{
 					RpcMethod: "Vote",
 					Use:       "vote <proposal-id> <voter> <vote-option> <metadata>",
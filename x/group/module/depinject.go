@@ -32,6 +32,7 @@ type GroupInputs struct {
 	Cdc           codec.Codec
 	AccountKeeper group.AccountKeeper
 	BankKeeper    group.BankKeeper
+	StakingKeeper group.StakingKeeper `optional:"true"`
 	Registry      cdctypes.InterfaceRegistry
 }
 
@@ -43,6 +44,13 @@ type GroupOutputs struct {
 }
 
 func ProvideModule(in GroupInputs) GroupOutputs {
+	weightProviders := map[string]group.WeightProvider{
+		group.WeightSourceBank: group.NewBankWeightProvider(in.BankKeeper),
+	}
+	if in.StakingKeeper != nil {
+		weightProviders[group.WeightSourceStaking] = group.NewStakingWeightProvider(in.StakingKeeper)
+	}
+
 	k := keeper.NewKeeper(in.Environment,
 		in.Cdc,
 		in.AccountKeeper,
@@ -51,6 +59,7 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 			MaxMetadataLen:        in.Config.MaxMetadataLen,
 			MaxProposalTitleLen:   in.Config.MaxProposalTitleLen,
 			MaxProposalSummaryLen: in.Config.MaxProposalSummaryLen,
+			WeightProviders:       weightProviders,
 		},
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
@@ -1069,6 +1069,217 @@ func (m *QueryVotesByProposalResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryVoterWeightsByProposalRequest is the Query/VoterWeightsByProposal request type.
+type QueryVoterWeightsByProposalRequest struct {
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoterWeightsByProposalRequest) Reset()         { *m = QueryVoterWeightsByProposalRequest{} }
+func (m *QueryVoterWeightsByProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterWeightsByProposalRequest) ProtoMessage()    {}
+func (*QueryVoterWeightsByProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{20}
+}
+func (m *QueryVoterWeightsByProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterWeightsByProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterWeightsByProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterWeightsByProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterWeightsByProposalRequest.Merge(m, src)
+}
+func (m *QueryVoterWeightsByProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterWeightsByProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterWeightsByProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterWeightsByProposalRequest proto.InternalMessageInfo
+
+func (m *QueryVoterWeightsByProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryVoterWeightsByProposalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoterWeightsByProposalResponse is the Query/VoterWeightsByProposal response type.
+type QueryVoterWeightsByProposalResponse struct {
+	// voter_weights are the snapshotted voter weights of the given proposal_id.
+	VoterWeights []*VoterWeight `protobuf:"bytes,1,rep,name=voter_weights,json=voterWeights,proto3" json:"voter_weights,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoterWeightsByProposalResponse) Reset()         { *m = QueryVoterWeightsByProposalResponse{} }
+func (m *QueryVoterWeightsByProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterWeightsByProposalResponse) ProtoMessage()    {}
+func (*QueryVoterWeightsByProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{21}
+}
+func (m *QueryVoterWeightsByProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterWeightsByProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterWeightsByProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterWeightsByProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterWeightsByProposalResponse.Merge(m, src)
+}
+func (m *QueryVoterWeightsByProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterWeightsByProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterWeightsByProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterWeightsByProposalResponse proto.InternalMessageInfo
+
+func (m *QueryVoterWeightsByProposalResponse) GetVoterWeights() []*VoterWeight {
+	if m != nil {
+		return m.VoterWeights
+	}
+	return nil
+}
+
+func (m *QueryVoterWeightsByProposalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedProposalsRequest is the Query/QueuedProposals request type.
+type QueryQueuedProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedProposalsRequest) Reset()         { *m = QueryQueuedProposalsRequest{} }
+func (m *QueryQueuedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsRequest) ProtoMessage()    {}
+func (*QueryQueuedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{22}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsRequest.Merge(m, src)
+}
+func (m *QueryQueuedProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedProposalsResponse is the Query/QueuedProposals response type.
+type QueryQueuedProposalsResponse struct {
+	// proposals are the queued proposals.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedProposalsResponse) Reset()         { *m = QueryQueuedProposalsResponse{} }
+func (m *QueryQueuedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedProposalsResponse) ProtoMessage()    {}
+func (*QueryQueuedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{23}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedProposalsResponse.Merge(m, src)
+}
+func (m *QueryQueuedProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedProposalsResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryQueuedProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryVotesByVoterRequest is the Query/VotesByVoter request type.
 type QueryVotesByVoterRequest struct {
 	// voter is a proposal voter account address.
@@ -1081,7 +1292,7 @@ func (m *QueryVotesByVoterRequest) Reset()         { *m = QueryVotesByVoterReque
 func (m *QueryVotesByVoterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByVoterRequest) ProtoMessage()    {}
 func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{20}
+	return fileDescriptor_0fcf9f1d74302290, []int{24}
 }
 func (m *QueryVotesByVoterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1347,7 @@ func (m *QueryVotesByVoterResponse) Reset()         { *m = QueryVotesByVoterResp
 func (m *QueryVotesByVoterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByVoterResponse) ProtoMessage()    {}
 func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{21}
+	return fileDescriptor_0fcf9f1d74302290, []int{25}
 }
 func (m *QueryVotesByVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1402,7 @@ func (m *QueryGroupsByMemberRequest) Reset()         { *m = QueryGroupsByMemberR
 func (m *QueryGroupsByMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsByMemberRequest) ProtoMessage()    {}
 func (*QueryGroupsByMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{22}
+	return fileDescriptor_0fcf9f1d74302290, []int{26}
 }
 func (m *QueryGroupsByMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1457,7 @@ func (m *QueryGroupsByMemberResponse) Reset()         { *m = QueryGroupsByMember
 func (m *QueryGroupsByMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsByMemberResponse) ProtoMessage()    {}
 func (*QueryGroupsByMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{23}
+	return fileDescriptor_0fcf9f1d74302290, []int{27}
 }
 func (m *QueryGroupsByMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1510,7 @@ func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest
 func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultRequest) ProtoMessage()    {}
 func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{24}
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
 }
 func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1345,7 +1556,7 @@ func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultRespon
 func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultResponse) ProtoMessage()    {}
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{25}
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
 }
 func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,7 +1602,7 @@ func (m *QueryGroupsRequest) Reset()         { *m = QueryGroupsRequest{} }
 func (m *QueryGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsRequest) ProtoMessage()    {}
 func (*QueryGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{26}
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
 }
 func (m *QueryGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1650,7 @@ func (m *QueryGroupsResponse) Reset()         { *m = QueryGroupsResponse{} }
 func (m *QueryGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsResponse) ProtoMessage()    {}
 func (*QueryGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{27}
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
 }
 func (m *QueryGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1503,6 +1714,10 @@ func init() {
 	proto.RegisterType((*QueryVoteByProposalVoterResponse)(nil), "cosmos.group.v1.QueryVoteByProposalVoterResponse")
 	proto.RegisterType((*QueryVotesByProposalRequest)(nil), "cosmos.group.v1.QueryVotesByProposalRequest")
 	proto.RegisterType((*QueryVotesByProposalResponse)(nil), "cosmos.group.v1.QueryVotesByProposalResponse")
+	proto.RegisterType((*QueryVoterWeightsByProposalRequest)(nil), "cosmos.group.v1.QueryVoterWeightsByProposalRequest")
+	proto.RegisterType((*QueryVoterWeightsByProposalResponse)(nil), "cosmos.group.v1.QueryVoterWeightsByProposalResponse")
+	proto.RegisterType((*QueryQueuedProposalsRequest)(nil), "cosmos.group.v1.QueryQueuedProposalsRequest")
+	proto.RegisterType((*QueryQueuedProposalsResponse)(nil), "cosmos.group.v1.QueryQueuedProposalsResponse")
 	proto.RegisterType((*QueryVotesByVoterRequest)(nil), "cosmos.group.v1.QueryVotesByVoterRequest")
 	proto.RegisterType((*QueryVotesByVoterResponse)(nil), "cosmos.group.v1.QueryVotesByVoterResponse")
 	proto.RegisterType((*QueryGroupsByMemberRequest)(nil), "cosmos.group.v1.QueryGroupsByMemberRequest")
@@ -1516,90 +1731,99 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0x26, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x85,
-	0xfc, 0xce, 0x6e, 0xec, 0xa4, 0x09, 0x2a, 0x54, 0xa8, 0x96, 0x20, 0xe4, 0x50, 0x94, 0x9a, 0x0a,
-	0x09, 0x2e, 0xd1, 0xa6, 0xde, 0x58, 0xab, 0xda, 0x3b, 0xae, 0xd7, 0x89, 0xb0, 0x22, 0x5f, 0x90,
-	0x40, 0x02, 0x71, 0xa0, 0x14, 0xa1, 0x12, 0x71, 0xe8, 0x01, 0x89, 0x72, 0xe3, 0x50, 0x84, 0xc4,
-	0xad, 0xb7, 0x8a, 0x53, 0x55, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x37, 0xd0, 0xce, 0xbc, 0xb5,
-	0xf7, 0xc7, 0xec, 0xda, 0x11, 0x16, 0xcd, 0xa5, 0xaa, 0x77, 0xde, 0x9b, 0xf9, 0xcc, 0xf7, 0xbd,
-	0x9d, 0xfd, 0x4e, 0xe0, 0xd2, 0x6d, 0xe6, 0x94, 0x98, 0xa3, 0x17, 0x2a, 0x6c, 0xb7, 0xac, 0xef,
-	0xa5, 0xf5, 0xbb, 0xbb, 0x66, 0xa5, 0xa6, 0x95, 0x2b, 0xac, 0xca, 0xe8, 0x90, 0x18, 0xd4, 0xf8,
-	0xa0, 0xb6, 0x97, 0x56, 0x86, 0x0b, 0xac, 0xc0, 0xf8, 0x98, 0xee, 0xfe, 0x4f, 0x84, 0x29, 0xe3,
-	0x05, 0xc6, 0x0a, 0x45, 0x53, 0x37, 0xca, 0x96, 0x6e, 0xd8, 0x36, 0xab, 0x1a, 0x55, 0x8b, 0xd9,
-	0x0e, 0x8e, 0x46, 0x56, 0xa8, 0xd6, 0xca, 0xa6, 0x37, 0x38, 0x87, 0x83, 0xdb, 0x86, 0x63, 0x8a,
-	0xa5, 0xf5, 0xbd, 0xf4, 0xb6, 0x59, 0x35, 0xd2, 0x7a, 0xd9, 0x28, 0x58, 0x36, 0x9f, 0x09, 0x63,
-	0xc7, 0x44, 0xec, 0x96, 0x58, 0x1f, 0xd1, 0xc4, 0xd0, 0x39, 0xa3, 0x64, 0xd9, 0x4c, 0xe7, 0xff,
-	0x8a, 0x47, 0x6a, 0x06, 0x46, 0x6e, 0xba, 0xf3, 0xad, 0xbb, 0xcb, 0x6e, 0xd8, 0x3b, 0x2c, 0x67,
-	0xde, 0xdd, 0x35, 0x9d, 0x2a, 0x1d, 0x83, 0x7e, 0x8e, 0xb2, 0x65, 0xe5, 0x47, 0xc9, 0x24, 0x99,
-	0xe9, 0xce, 0xf5, 0xf1, 0xdf, 0x1b, 0x79, 0xf5, 0x1d, 0xb8, 0x10, 0xce, 0x71, 0xca, 0xcc, 0x76,
-	0x4c, 0xaa, 0x41, 0xb7, 0x65, 0xef, 0x30, 0x9e, 0x30, 0x98, 0x51, 0xb4, 0x90, 0x30, 0x5a, 0x33,
-	0x83, 0xc7, 0xa9, 0x37, 0xe1, 0x52, 0x73, 0xa6, 0x4d, 0x56, 0xb4, 0x6e, 0xd7, 0xfc, 0x0c, 0x19,
-	0xe8, 0x33, 0xf2, 0xf9, 0x8a, 0xe9, 0x38, 0x7c, 0xc6, 0x81, 0xec, 0xe8, 0xf3, 0xc7, 0x8b, 0xc3,
-	0x38, 0xe9, 0x75, 0x31, 0xf2, 0x5e, 0xb5, 0x62, 0xd9, 0x85, 0x9c, 0x17, 0xa8, 0xde, 0x82, 0x71,
-	0xf9, 0x94, 0x88, 0xb8, 0x12, 0x40, 0x9c, 0x94, 0x23, 0xfa, 0xf2, 0x04, 0x68, 0x1d, 0x46, 0x9b,
-	0xb3, 0xde, 0x30, 0x4b, 0xdb, 0x66, 0xc5, 0x69, 0xad, 0x14, 0x7d, 0x1b, 0xa0, 0x59, 0x9f, 0xd1,
-	0x53, 0x7c, 0xc9, 0x29, 0x6f, 0x49, 0xb7, 0x98, 0x9a, 0xe8, 0x23, 0x2c, 0xa6, 0xb6, 0x69, 0x14,
-	0x4c, 0x9c, 0x36, 0xe7, 0xcb, 0x54, 0xbf, 0x23, 0x30, 0x26, 0x59, 0x1f, 0xb7, 0xb4, 0x0a, 0x7d,
-	0x25, 0xf1, 0x68, 0x94, 0x4c, 0xbe, 0x34, 0x33, 0x98, 0x19, 0x97, 0xef, 0x4a, 0xe4, 0xe5, 0xbc,
-	0x60, 0xba, 0x2e, 0xa1, 0x9b, 0x6e, 0x49, 0x27, 0x16, 0x0d, 0xe0, 0xdd, 0x0f, 0xe0, 0x39, 0xd9,
-	0xda, 0xf5, 0x7c, 0xc9, 0xb2, 0x3d, 0x7d, 0x34, 0xe8, 0x31, 0xdc, 0xdf, 0x2d, 0x6b, 0x28, 0xc2,
-	0x3a, 0x26, 0xda, 0xb7, 0x04, 0x14, 0x19, 0x15, 0xaa, 0x96, 0x81, 0x5e, 0x2e, 0x8f, 0x27, 0x5a,
-	0x52, 0xb7, 0x62, 0x64, 0xe7, 0x14, 0xfb, 0x84, 0xc0, 0x64, 0xa8, 0x4d, 0x2d, 0xd3, 0xc9, 0x8a,
-	0x9f, 0xff, 0x63, 0x63, 0xfd, 0x4c, 0xe0, 0xe5, 0x04, 0x0e, 0x94, 0x6a, 0x1d, 0xce, 0x0a, 0x90,
-	0x32, 0x06, 0xa0, 0x64, 0xad, 0xdf, 0x9e, 0x33, 0x05, 0xff, 0xbc, 0x9d, 0xd3, 0xef, 0x20, 0x46,
-	0xbf, 0x13, 0xd1, 0x78, 0x71, 0xa2, 0x06, 0xfb, 0xef, 0xe4, 0x89, 0xba, 0x06, 0xc3, 0x1c, 0x7b,
-	0xb3, 0xc2, 0xca, 0xcc, 0x31, 0x8a, 0x9e, 0x8e, 0x13, 0x30, 0x58, 0xc6, 0x47, 0xcd, 0x56, 0x04,
-	0xef, 0xd1, 0x46, 0x5e, 0x7d, 0x17, 0x3f, 0x22, 0xcd, 0x44, 0xdc, 0xe3, 0x15, 0xe8, 0xf7, 0xc2,
-	0xf0, 0xc0, 0x1d, 0x8b, 0xec, 0xae, 0x91, 0xd4, 0x08, 0x55, 0x1f, 0x12, 0x50, 0x03, 0x13, 0x7a,
-	0x1d, 0x29, 0x44, 0xf8, 0x0f, 0x9f, 0x87, 0x8e, 0xd5, 0xf8, 0x07, 0x02, 0x97, 0x13, 0x11, 0x51,
-	0x81, 0x35, 0x18, 0xf0, 0xb6, 0xe5, 0x15, 0x38, 0x41, 0x82, 0x66, 0x6c, 0xe7, 0xaa, 0x5a, 0x81,
-	0x09, 0x0e, 0xfa, 0x3e, 0xab, 0x9a, 0xd9, 0x06, 0xae, 0xfb, 0xab, 0xd2, 0x6e, 0x81, 0xdd, 0x37,
-	0x69, 0xcf, 0x4d, 0xe0, 0x1c, 0x89, 0x6f, 0x12, 0x0f, 0x53, 0x6f, 0xe0, 0xdb, 0x29, 0x5d, 0x13,
-	0x95, 0x99, 0x85, 0x6e, 0x37, 0x18, 0xfb, 0x62, 0x24, 0x22, 0x8a, 0x1b, 0x9d, 0xe3, 0x21, 0xea,
-	0xa7, 0x04, 0x7d, 0x82, 0xfb, 0xcc, 0xc9, 0x1e, 0xbb, 0x41, 0x3b, 0x56, 0xf5, 0xaf, 0x09, 0xba,
-	0x8b, 0x08, 0x08, 0x6e, 0x6a, 0x5e, 0x08, 0xe5, 0x95, 0x3a, 0x66, 0x57, 0x22, 0xa6, 0x73, 0x25,
-	0xfe, 0x8a, 0xa0, 0x3d, 0x41, 0xac, 0x40, 0x71, 0x1b, 0xb5, 0x23, 0x6d, 0xd5, 0xae, 0x63, 0x5a,
-	0xdd, 0xf3, 0x4c, 0x41, 0x10, 0xea, 0x85, 0x0a, 0xf5, 0x20, 0x6c, 0x09, 0xd0, 0x12, 0x9d, 0x80,
-	0x03, 0xe5, 0x80, 0xf8, 0xbd, 0xb0, 0x0f, 0xed, 0x24, 0xd8, 0x95, 0xab, 0x70, 0x91, 0xb3, 0xdd,
-	0x32, 0x8a, 0x45, 0xf7, 0x6c, 0xdb, 0x2d, 0x56, 0xdb, 0xfe, 0x38, 0x7c, 0x80, 0xbd, 0x19, 0xc8,
-	0xc5, 0x4d, 0x5d, 0x83, 0x9e, 0xaa, 0xfb, 0x18, 0x0f, 0x81, 0xa8, 0x6f, 0xf5, 0x25, 0x65, 0x07,
-	0x9e, 0xfe, 0x39, 0xd1, 0xf5, 0xe8, 0x9f, 0x9f, 0xe6, 0x48, 0x4e, 0x64, 0xa9, 0x0e, 0x50, 0x9f,
-	0x64, 0x1e, 0x51, 0x87, 0x2a, 0x72, 0x75, 0xe4, 0xf9, 0xe3, 0xc5, 0x73, 0x22, 0x6d, 0xd1, 0xc9,
-	0xdf, 0x99, 0x5c, 0xd2, 0x56, 0xd6, 0xb4, 0xb4, 0xfa, 0x23, 0x81, 0xf3, 0x81, 0x55, 0x4f, 0x40,
-	0x81, 0x62, 0x58, 0x33, 0xf7, 0x28, 0xf4, 0x70, 0x56, 0xfa, 0x05, 0x81, 0x81, 0xc6, 0xfa, 0x74,
-	0x2a, 0xc2, 0x26, 0xbd, 0x04, 0x2a, 0xd3, 0x2d, 0xe3, 0x04, 0x8b, 0xaa, 0x7d, 0xfc, 0xfb, 0xdf,
-	0xf7, 0x4f, 0xcd, 0xd0, 0x29, 0x3d, 0x7c, 0x8d, 0x45, 0x07, 0x6b, 0xef, 0x30, 0x7d, 0xdf, 0x73,
-	0xb3, 0x75, 0xfa, 0x3d, 0x81, 0xa1, 0x90, 0xad, 0xa1, 0x0b, 0x09, 0x8b, 0x45, 0xee, 0x86, 0xca,
-	0x62, 0x9b, 0xd1, 0x08, 0xb8, 0xc2, 0x01, 0x35, 0xba, 0x10, 0x03, 0xc8, 0x4d, 0x58, 0x0d, 0x39,
-	0xf1, 0xdd, 0xae, 0xd3, 0x07, 0x04, 0x4e, 0xfb, 0xaf, 0x5c, 0x74, 0x36, 0x61, 0xd5, 0xe0, 0xb5,
-	0x50, 0x99, 0x6b, 0x27, 0x14, 0xe9, 0xd2, 0x9c, 0x6e, 0x9e, 0xce, 0xc6, 0xd0, 0xe1, 0x8d, 0xcd,
-	0xaf, 0xe0, 0x01, 0x81, 0x33, 0x81, 0x8b, 0x0d, 0x4d, 0x5a, 0x30, 0x64, 0x8d, 0x95, 0xf9, 0xb6,
-	0x62, 0x91, 0x6e, 0x89, 0xd3, 0xcd, 0xd1, 0x19, 0x39, 0x9d, 0xb3, 0xb5, 0x5d, 0xdb, 0xe2, 0x0e,
-	0xda, 0x55, 0xae, 0x64, 0xd9, 0x75, 0xfa, 0x2b, 0x81, 0x61, 0xd9, 0x8d, 0x82, 0xa6, 0x5b, 0x55,
-	0x2d, 0x72, 0x0b, 0x52, 0x32, 0xc7, 0x49, 0x41, 0xe2, 0xd7, 0x39, 0xf1, 0x15, 0xba, 0x9c, 0x54,
-	0x6d, 0xcb, 0xe4, 0xe4, 0x62, 0xc8, 0xa7, 0xec, 0x2f, 0x51, 0x78, 0x21, 0x70, 0x7b, 0xf0, 0x01,
-	0x9d, 0x33, 0xc7, 0x49, 0x41, 0xf8, 0xd7, 0x38, 0x7c, 0x86, 0x2e, 0xb5, 0x01, 0x1f, 0x94, 0xfd,
-	0x73, 0x02, 0xfd, 0x9e, 0x25, 0xa1, 0xaf, 0xca, 0x97, 0x0e, 0x79, 0x27, 0x65, 0xaa, 0x55, 0x18,
-	0x52, 0xe9, 0x9c, 0x6a, 0x96, 0x4e, 0x47, 0xa8, 0xbc, 0xb3, 0x5e, 0xdf, 0xf7, 0x7d, 0x08, 0xea,
-	0xf4, 0x09, 0x81, 0x0b, 0x72, 0x73, 0x4c, 0x97, 0x93, 0xd7, 0x94, 0xba, 0x7d, 0x65, 0xe5, 0x78,
-	0x49, 0x88, 0xfd, 0x06, 0xc7, 0x5e, 0xa5, 0x2b, 0xb1, 0xd8, 0xcd, 0x26, 0xc0, 0x43, 0xc0, 0xf7,
-	0xfe, 0x3f, 0x21, 0x70, 0x5e, 0xe2, 0x61, 0xe9, 0x92, 0x9c, 0x25, 0xde, 0x62, 0x2b, 0xe9, 0x63,
-	0x64, 0x20, 0xfa, 0x5b, 0x1c, 0xfd, 0x4d, 0x7a, 0x2d, 0x82, 0xee, 0xba, 0x22, 0x97, 0xba, 0xa1,
-	0x37, 0x77, 0x6e, 0x41, 0xfd, 0xf5, 0x7d, 0xfe, 0xb0, 0x4e, 0x1f, 0x11, 0x18, 0x0a, 0xd9, 0xd5,
-	0xb8, 0xa3, 0x56, 0x6e, 0xaf, 0xe3, 0x8e, 0xda, 0x18, 0x0f, 0x9c, 0xd0, 0xbf, 0xdc, 0xcd, 0xf9,
-	0xc1, 0x43, 0x2d, 0xf3, 0x0d, 0x81, 0xd3, 0x7e, 0xb7, 0x18, 0x77, 0xdc, 0x4a, 0x6c, 0x6e, 0xdc,
-	0x71, 0x2b, 0x33, 0x9f, 0x09, 0xbd, 0xdc, 0x20, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0x06,
-	0x7d, 0x19, 0x6d, 0x71, 0x82, 0x06, 0x8c, 0xa5, 0xb2, 0xd0, 0x5e, 0x30, 0xe2, 0x2d, 0x73, 0xbc,
-	0x45, 0x3a, 0x9f, 0x70, 0xde, 0x8a, 0x2f, 0x82, 0xaf, 0x55, 0x0f, 0x08, 0x0c, 0xfa, 0xdc, 0x12,
-	0x9d, 0x91, 0x2f, 0x19, 0x75, 0x70, 0xca, 0x6c, 0x1b, 0x91, 0x48, 0xb6, 0xca, 0xc9, 0x96, 0xa8,
-	0x16, 0xff, 0x36, 0x85, 0xba, 0x90, 0x1b, 0x35, 0xfa, 0x19, 0x81, 0x5e, 0xb1, 0x59, 0x7a, 0x39,
-	0x49, 0x0a, 0x0f, 0xe9, 0x95, 0xe4, 0x20, 0xef, 0x9b, 0xfe, 0x9b, 0xcc, 0xf4, 0x70, 0xc4, 0x31,
-	0x7a, 0x31, 0x46, 0xbc, 0xac, 0xf6, 0xf4, 0x30, 0x45, 0x9e, 0x1d, 0xa6, 0xc8, 0x5f, 0x87, 0x29,
-	0xf2, 0xe5, 0x51, 0xaa, 0xeb, 0xd9, 0x51, 0xaa, 0xeb, 0x8f, 0xa3, 0x54, 0xd7, 0x87, 0xe8, 0xf4,
-	0x9d, 0xfc, 0x1d, 0xcd, 0x62, 0xfa, 0x47, 0x22, 0x61, 0xbb, 0x97, 0xff, 0xa1, 0x7c, 0xf9, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x6a, 0xbb, 0x90, 0x03, 0x18, 0x00, 0x00,
+	// 1462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdf, 0x8f, 0x13, 0x55,
+	0x14, 0xc7, 0xf7, 0x22, 0xbf, 0xf6, 0x2c, 0xb0, 0xe1, 0xb2, 0x0b, 0xdd, 0x61, 0x53, 0x96, 0x41,
+	0x97, 0xed, 0x2e, 0x9d, 0xd9, 0x76, 0xcb, 0x42, 0x50, 0x34, 0x34, 0x51, 0xe4, 0x01, 0x03, 0x95,
+	0x68, 0xf4, 0x65, 0x33, 0x4b, 0x87, 0x3a, 0xa1, 0x9d, 0x29, 0x9d, 0x76, 0xb5, 0x21, 0x7d, 0x31,
+	0xd1, 0x44, 0xa3, 0x89, 0x8a, 0x31, 0xb0, 0xf1, 0x81, 0x07, 0x13, 0xf1, 0x4d, 0x13, 0x8c, 0x89,
+	0x6f, 0xc4, 0x17, 0xe2, 0x13, 0xc1, 0x17, 0x9f, 0x8c, 0x01, 0x13, 0xff, 0x00, 0xff, 0x01, 0x33,
+	0xf7, 0x9e, 0x69, 0xe7, 0xc7, 0x9d, 0xe9, 0x14, 0x1b, 0xe9, 0x0b, 0x61, 0xe6, 0x9e, 0x73, 0xcf,
+	0xe7, 0x7e, 0xef, 0xb9, 0x77, 0xce, 0xe9, 0xc2, 0xc1, 0xcb, 0x96, 0x5d, 0xb3, 0x6c, 0xb5, 0xd2,
+	0xb0, 0x5a, 0x75, 0x75, 0x23, 0xa7, 0x5e, 0x6b, 0xe9, 0x8d, 0xb6, 0x52, 0x6f, 0x58, 0x4d, 0x8b,
+	0x4e, 0xf2, 0x41, 0x85, 0x0d, 0x2a, 0x1b, 0x39, 0x69, 0xaa, 0x62, 0x55, 0x2c, 0x36, 0xa6, 0x3a,
+	0xff, 0xe3, 0x66, 0xd2, 0x6c, 0xc5, 0xb2, 0x2a, 0x55, 0x5d, 0xd5, 0xea, 0x86, 0xaa, 0x99, 0xa6,
+	0xd5, 0xd4, 0x9a, 0x86, 0x65, 0xda, 0x38, 0x1a, 0x8a, 0xd0, 0x6c, 0xd7, 0x75, 0x77, 0x70, 0x11,
+	0x07, 0xd7, 0x35, 0x5b, 0xe7, 0xa1, 0xd5, 0x8d, 0xdc, 0xba, 0xde, 0xd4, 0x72, 0x6a, 0x5d, 0xab,
+	0x18, 0x26, 0x9b, 0x09, 0x6d, 0x67, 0xb8, 0xed, 0x1a, 0x8f, 0xcf, 0x1f, 0x70, 0x68, 0xaf, 0x56,
+	0x33, 0x4c, 0x4b, 0x65, 0xff, 0xf2, 0x57, 0x72, 0x1e, 0xa6, 0x2f, 0x3a, 0xf3, 0x9d, 0x75, 0xc2,
+	0x9e, 0x33, 0xaf, 0x58, 0x25, 0xfd, 0x5a, 0x4b, 0xb7, 0x9b, 0x74, 0x06, 0x76, 0x32, 0x94, 0x35,
+	0xa3, 0x9c, 0x22, 0x73, 0x64, 0x61, 0x6b, 0x69, 0x07, 0x7b, 0x3e, 0x57, 0x96, 0x5f, 0x85, 0xfd,
+	0x41, 0x1f, 0xbb, 0x6e, 0x99, 0xb6, 0x4e, 0x15, 0xd8, 0x6a, 0x98, 0x57, 0x2c, 0xe6, 0x30, 0x91,
+	0x97, 0x94, 0x80, 0x30, 0x4a, 0xcf, 0x83, 0xd9, 0xc9, 0x17, 0xe1, 0x60, 0x6f, 0xa6, 0x0b, 0x56,
+	0xd5, 0xb8, 0xdc, 0xf6, 0x32, 0xe4, 0x61, 0x87, 0x56, 0x2e, 0x37, 0x74, 0xdb, 0x66, 0x33, 0x8e,
+	0x17, 0x53, 0x0f, 0xef, 0x66, 0xa7, 0x70, 0xd2, 0x33, 0x7c, 0xe4, 0xf5, 0x66, 0xc3, 0x30, 0x2b,
+	0x25, 0xd7, 0x50, 0xbe, 0x04, 0xb3, 0xe2, 0x29, 0x11, 0xb1, 0xe0, 0x43, 0x9c, 0x13, 0x23, 0x7a,
+	0xfc, 0x38, 0x68, 0x07, 0x52, 0xbd, 0x59, 0xcf, 0xeb, 0xb5, 0x75, 0xbd, 0x61, 0xf7, 0x57, 0x8a,
+	0xbe, 0x02, 0xd0, 0xdb, 0x9f, 0xd4, 0x16, 0x16, 0x72, 0xde, 0x0d, 0xe9, 0x6c, 0xa6, 0xc2, 0xf3,
+	0x08, 0x37, 0x53, 0xb9, 0xa0, 0x55, 0x74, 0x9c, 0xb6, 0xe4, 0xf1, 0x94, 0xbf, 0x26, 0x30, 0x23,
+	0x88, 0x8f, 0x4b, 0x5a, 0x85, 0x1d, 0x35, 0xfe, 0x2a, 0x45, 0xe6, 0x9e, 0x59, 0x98, 0xc8, 0xcf,
+	0x8a, 0x57, 0xc5, 0xfd, 0x4a, 0xae, 0x31, 0x3d, 0x2b, 0xa0, 0x3b, 0xda, 0x97, 0x8e, 0x07, 0xf5,
+	0xe1, 0xdd, 0xf0, 0xe1, 0xd9, 0xc5, 0xf6, 0x99, 0x72, 0xcd, 0x30, 0x5d, 0x7d, 0x14, 0xd8, 0xa6,
+	0x39, 0xcf, 0x7d, 0xf7, 0x90, 0x9b, 0x0d, 0x4d, 0xb4, 0x5b, 0x04, 0x24, 0x11, 0x15, 0xaa, 0x96,
+	0x87, 0xed, 0x4c, 0x1e, 0x57, 0xb4, 0xb8, 0x6c, 0x45, 0xcb, 0xe1, 0x29, 0xf6, 0x01, 0x81, 0xb9,
+	0x40, 0x9a, 0x1a, 0xba, 0x5d, 0xe4, 0x8f, 0xff, 0x63, 0x62, 0xfd, 0x48, 0xe0, 0x70, 0x0c, 0x07,
+	0x4a, 0x75, 0x16, 0xf6, 0x70, 0x90, 0x3a, 0x1a, 0xa0, 0x64, 0xfd, 0x4f, 0xcf, 0xee, 0x8a, 0x77,
+	0xde, 0xe1, 0xe9, 0xb7, 0x19, 0xa1, 0xdf, 0x48, 0x24, 0x5e, 0x94, 0xa8, 0xfe, 0xfc, 0x1b, 0x3d,
+	0x51, 0x4f, 0xc0, 0x14, 0xc3, 0xbe, 0xd0, 0xb0, 0xea, 0x96, 0xad, 0x55, 0x5d, 0x1d, 0x0f, 0xc1,
+	0x44, 0x1d, 0x5f, 0xf5, 0x52, 0x11, 0xdc, 0x57, 0xe7, 0xca, 0xf2, 0x6b, 0x30, 0x1d, 0x70, 0xc4,
+	0x35, 0x1e, 0x87, 0x9d, 0xae, 0x19, 0x5e, 0xb8, 0x33, 0xa1, 0xd5, 0x75, 0x9d, 0xba, 0xa6, 0xf2,
+	0x6d, 0x02, 0xb2, 0x6f, 0x42, 0x37, 0x23, 0xb9, 0x08, 0xff, 0xe1, 0xf3, 0x30, 0xb4, 0x3d, 0xfe,
+	0x96, 0xc0, 0x91, 0x58, 0x44, 0x54, 0xe0, 0x04, 0x8c, 0xbb, 0xcb, 0x72, 0x37, 0x38, 0x46, 0x82,
+	0x9e, 0xed, 0xf0, 0x76, 0xb5, 0x01, 0x87, 0x18, 0xe8, 0x1b, 0x56, 0x53, 0x2f, 0x76, 0x71, 0x9d,
+	0xa7, 0x46, 0xd2, 0x0d, 0x76, 0x4e, 0xd2, 0x86, 0xe3, 0x90, 0xda, 0xd2, 0x47, 0x67, 0x6e, 0x26,
+	0x9f, 0xc7, 0xd3, 0x29, 0x8c, 0x89, 0xca, 0x64, 0x60, 0xab, 0x63, 0x8c, 0x79, 0x31, 0x1d, 0x12,
+	0xc5, 0xb1, 0x2e, 0x31, 0x13, 0xf9, 0x43, 0x82, 0x75, 0x82, 0xf3, 0xce, 0x2e, 0x0e, 0x9c, 0xa0,
+	0x43, 0xdb, 0xf5, 0x2f, 0x09, 0xcc, 0x8a, 0x41, 0x70, 0x51, 0x4b, 0x5c, 0x28, 0x77, 0xab, 0x23,
+	0x56, 0xc5, 0x6d, 0x86, 0xb7, 0xc5, 0x9f, 0xba, 0xe7, 0x85, 0x29, 0xfc, 0xa6, 0x6e, 0x54, 0xde,
+	0x69, 0x3e, 0x4d, 0x99, 0x7e, 0x70, 0x0f, 0x47, 0x14, 0x0f, 0xaa, 0x75, 0x06, 0x76, 0xb3, 0x7c,
+	0x59, 0x7b, 0x97, 0x9b, 0x44, 0x96, 0x2f, 0x9e, 0x79, 0x4a, 0xbb, 0x36, 0x7a, 0x0f, 0x43, 0xd4,
+	0x50, 0xc7, 0x14, 0xbb, 0xd8, 0xd2, 0x5b, 0x7a, 0xb9, 0x7b, 0xaa, 0x5d, 0xed, 0xfc, 0xd2, 0x90,
+	0x27, 0x96, 0xe6, 0xb6, 0x9b, 0x41, 0xa1, 0x38, 0x23, 0x73, 0x61, 0x7c, 0x41, 0xb0, 0xd8, 0xc5,
+	0x24, 0xf7, 0x5d, 0x15, 0xdd, 0x9b, 0x80, 0x24, 0xba, 0x09, 0x86, 0x96, 0x52, 0x9f, 0xbb, 0x25,
+	0xa6, 0x1f, 0xea, 0xa9, 0x1e, 0xbb, 0x9b, 0xc1, 0x02, 0x13, 0x0b, 0xec, 0x11, 0xf8, 0x3c, 0x6d,
+	0x12, 0x38, 0x28, 0x44, 0x1b, 0x85, 0xe2, 0xf7, 0x14, 0x1c, 0x60, 0x6c, 0x97, 0xb4, 0x6a, 0xd5,
+	0xf9, 0x52, 0xb6, 0xaa, 0xcd, 0xc4, 0xa5, 0xc6, 0x5b, 0x90, 0x0a, 0xfb, 0xe2, 0xa2, 0x4e, 0xc3,
+	0xb6, 0xa6, 0xf3, 0x1a, 0x8f, 0x67, 0xf8, 0x1a, 0xf1, 0x38, 0x15, 0xc7, 0xef, 0xff, 0x71, 0x68,
+	0xec, 0xce, 0xdf, 0xdf, 0x2f, 0x92, 0x12, 0xf7, 0x92, 0x6d, 0xa0, 0x1e, 0xc9, 0xc4, 0x07, 0xff,
+	0x89, 0x77, 0xe4, 0xd4, 0xf4, 0xc3, 0xbb, 0xd9, 0xbd, 0xdc, 0x2d, 0x6b, 0x97, 0xaf, 0xce, 0x2d,
+	0x2b, 0x85, 0x13, 0x4a, 0x4e, 0xfe, 0x8e, 0xc0, 0x3e, 0x5f, 0xd4, 0x11, 0xd8, 0xa0, 0x08, 0xd6,
+	0xfc, 0x3f, 0x53, 0xb0, 0x8d, 0xb1, 0xd2, 0x4f, 0x08, 0x8c, 0x77, 0xe3, 0xd3, 0xf9, 0x10, 0x9b,
+	0xf0, 0x27, 0x05, 0xe9, 0x68, 0x5f, 0x3b, 0xce, 0x22, 0x2b, 0xef, 0xff, 0xf6, 0xd7, 0x8d, 0x2d,
+	0x0b, 0x74, 0x5e, 0x0d, 0xfe, 0x28, 0x82, 0xfd, 0x90, 0x79, 0xc5, 0x52, 0xaf, 0xe3, 0xff, 0xcb,
+	0x1d, 0xfa, 0x0d, 0x81, 0xc9, 0x40, 0x91, 0x4c, 0x8f, 0xc5, 0x04, 0x0b, 0xfd, 0xd2, 0x20, 0x65,
+	0x13, 0x5a, 0x23, 0x60, 0x81, 0x01, 0x2a, 0xf4, 0x58, 0x04, 0x20, 0x2b, 0xe9, 0xdb, 0xc8, 0x89,
+	0x67, 0xbb, 0x43, 0x6f, 0x12, 0xd8, 0xe5, 0x6d, 0xe0, 0x69, 0x26, 0x26, 0xaa, 0xff, 0x47, 0x06,
+	0x69, 0x31, 0x89, 0x29, 0xd2, 0xe5, 0x18, 0xdd, 0x12, 0xcd, 0x44, 0xd0, 0x61, 0xff, 0xef, 0x55,
+	0x70, 0x93, 0xc0, 0x6e, 0x5f, 0x9b, 0x4c, 0xe3, 0x02, 0x06, 0x1a, 0x2d, 0x69, 0x29, 0x91, 0x2d,
+	0xd2, 0x2d, 0x33, 0xba, 0x45, 0xba, 0x20, 0xa6, 0xb3, 0xd7, 0xd6, 0xdb, 0x6b, 0xac, 0x1f, 0x73,
+	0x94, 0xab, 0x19, 0x66, 0x87, 0xfe, 0x4c, 0x60, 0x4a, 0xd4, 0x9f, 0xd2, 0x5c, 0xbf, 0x5d, 0x0b,
+	0xf5, 0xd4, 0x52, 0x7e, 0x10, 0x17, 0x24, 0x7e, 0x9e, 0x11, 0x1f, 0xa7, 0x2b, 0x71, 0xbb, 0x6d,
+	0xe8, 0x8c, 0x9c, 0x0f, 0x79, 0x94, 0xfd, 0x29, 0x0c, 0xcf, 0x05, 0x4e, 0x06, 0xef, 0xd3, 0x39,
+	0x3f, 0x88, 0x0b, 0xc2, 0x9f, 0x64, 0xf0, 0x79, 0xba, 0x9c, 0x00, 0xde, 0x2f, 0xfb, 0xc7, 0x04,
+	0x76, 0xba, 0x85, 0x06, 0x7d, 0x4e, 0x1c, 0x3a, 0x50, 0x62, 0x4a, 0xf3, 0xfd, 0xcc, 0x90, 0x4a,
+	0x65, 0x54, 0x19, 0x7a, 0x34, 0x44, 0xe5, 0xde, 0xf5, 0xea, 0x75, 0xcf, 0x87, 0xa0, 0x43, 0xef,
+	0x11, 0xd8, 0x2f, 0x6e, 0xb5, 0xe8, 0x4a, 0x7c, 0x4c, 0x61, 0xef, 0x28, 0x15, 0x06, 0x73, 0x42,
+	0xec, 0x17, 0x18, 0xf6, 0x2a, 0x2d, 0x44, 0x62, 0xf7, 0x92, 0x00, 0x2f, 0x01, 0xcf, 0xf9, 0xbf,
+	0x47, 0x60, 0x9f, 0xa0, 0x23, 0xa2, 0xcb, 0x62, 0x96, 0xe8, 0x86, 0x4d, 0xca, 0x0d, 0xe0, 0x81,
+	0xe8, 0x2f, 0x33, 0xf4, 0x97, 0xe8, 0xe9, 0x10, 0xba, 0x53, 0x15, 0x39, 0xd4, 0x5d, 0xbd, 0x9d,
+	0x17, 0x0d, 0xbf, 0xfe, 0xea, 0x75, 0xf6, 0xb2, 0x43, 0xef, 0x10, 0x98, 0x0c, 0x34, 0x3f, 0x51,
+	0x57, 0xad, 0xb8, 0x59, 0x93, 0xb2, 0x09, 0xad, 0xfb, 0xe6, 0xaf, 0x43, 0x64, 0x7b, 0xc1, 0x03,
+	0x29, 0xf3, 0x15, 0x81, 0x5d, 0xde, 0x6a, 0x31, 0xea, 0xba, 0x15, 0x94, 0xb9, 0x51, 0xd7, 0xad,
+	0xa8, 0xf8, 0x8c, 0xc9, 0xe5, 0x2e, 0x21, 0x2a, 0x8a, 0x1a, 0xde, 0x26, 0xb0, 0xc7, 0x5f, 0x97,
+	0xd1, 0x3e, 0x37, 0xa8, 0xaf, 0xb0, 0x94, 0x8e, 0x25, 0x33, 0x46, 0xbc, 0x15, 0x86, 0x97, 0xa5,
+	0x4b, 0x31, 0xf7, 0x2d, 0xff, 0x22, 0x78, 0x52, 0x75, 0x93, 0xc0, 0x84, 0xa7, 0x5a, 0xa2, 0x0b,
+	0xe2, 0x90, 0xe1, 0x0a, 0x4e, 0xca, 0x24, 0xb0, 0x44, 0xb2, 0x55, 0x46, 0xb6, 0x4c, 0x95, 0xe8,
+	0xd3, 0x14, 0xc8, 0x42, 0x56, 0xa8, 0xd1, 0x8f, 0x08, 0x6c, 0xe7, 0x8b, 0xa5, 0x47, 0xe2, 0xa4,
+	0x70, 0x91, 0x9e, 0x8d, 0x37, 0x72, 0xbf, 0xe9, 0xbf, 0x8a, 0x8a, 0x1e, 0x86, 0x38, 0x43, 0x0f,
+	0x44, 0x88, 0x47, 0x7f, 0x21, 0xb0, 0x5f, 0xdc, 0xe5, 0x46, 0xdd, 0x4b, 0xb1, 0x3d, 0xba, 0x54,
+	0x18, 0xcc, 0x09, 0xd9, 0x5f, 0x64, 0x98, 0x27, 0xe9, 0x6a, 0x62, 0x25, 0x7d, 0x7d, 0x37, 0xbd,
+	0x45, 0x60, 0x32, 0xd0, 0x90, 0x46, 0x9d, 0x6a, 0x71, 0x7f, 0x2c, 0x65, 0x13, 0x5a, 0x23, 0x70,
+	0x86, 0x01, 0x1f, 0xa1, 0x87, 0x55, 0xc1, 0x1f, 0xd6, 0x5a, 0x7a, 0xb9, 0x7b, 0xa6, 0xed, 0xa2,
+	0x72, 0xff, 0x51, 0x9a, 0x3c, 0x78, 0x94, 0x26, 0x7f, 0x3e, 0x4a, 0x93, 0xcf, 0x1e, 0xa7, 0xc7,
+	0x1e, 0x3c, 0x4e, 0x8f, 0xfd, 0xfe, 0x38, 0x3d, 0xf6, 0x36, 0xf6, 0x52, 0x76, 0xf9, 0xaa, 0x62,
+	0x58, 0xea, 0x7b, 0x7c, 0x8e, 0xf5, 0xed, 0xec, 0x0f, 0x5b, 0x2b, 0xff, 0x0e, 0x00, 0x17, 0xcb,
+	0xb5, 0xa8, 0xb3, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1646,6 +1870,13 @@ type QueryClient interface {
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// VoterWeightsByProposal queries the voter weights snapshotted at the
+	// submission of a proposal of a token-weighted group. Snapshots are pruned
+	// together with the votes once the proposal is final.
+	VoterWeightsByProposal(ctx context.Context, in *QueryVoterWeightsByProposalRequest, opts ...grpc.CallOption) (*QueryVoterWeightsByProposalResponse, error)
+	// QueuedProposals queries the accepted proposals queued behind the timelock
+	// of their decision policy and not executed yet, ordered by timelock end.
+	QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error)
 }
 
 type queryClient struct {
@@ -1782,6 +2013,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VoterWeightsByProposal(ctx context.Context, in *QueryVoterWeightsByProposalRequest, opts ...grpc.CallOption) (*QueryVoterWeightsByProposalResponse, error) {
+	out := new(QueryVoterWeightsByProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/VoterWeightsByProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error) {
+	out := new(QueryQueuedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/QueuedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1816,6 +2065,13 @@ type QueryServer interface {
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// VoterWeightsByProposal queries the voter weights snapshotted at the
+	// submission of a proposal of a token-weighted group. Snapshots are pruned
+	// together with the votes once the proposal is final.
+	VoterWeightsByProposal(context.Context, *QueryVoterWeightsByProposalRequest) (*QueryVoterWeightsByProposalResponse, error)
+	// QueuedProposals queries the accepted proposals queued behind the timelock
+	// of their decision policy and not executed yet, ordered by timelock end.
+	QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1864,6 +2120,12 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) VoterWeightsByProposal(ctx context.Context, req *QueryVoterWeightsByProposalRequest) (*QueryVoterWeightsByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoterWeightsByProposal not implemented")
+}
+func (*UnimplementedQueryServer) QueuedProposals(ctx context.Context, req *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedProposals not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2121,6 +2383,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoterWeightsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoterWeightsByProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoterWeightsByProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/VoterWeightsByProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoterWeightsByProposal(ctx, req.(*QueryVoterWeightsByProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/QueuedProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedProposals(ctx, req.(*QueryQueuedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
@@ -2182,6 +2480,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "VoterWeightsByProposal",
+			Handler:    _Query_VoterWeightsByProposal_Handler,
+		},
+		{
+			MethodName: "QueuedProposals",
+			Handler:    _Query_QueuedProposals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -2988,7 +3294,7 @@ func (m *QueryVotesByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVoterWeightsByProposalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2998,12 +3304,12 @@ func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVotesByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVoterWeightsByProposalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVoterWeightsByProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3020,17 +3326,15 @@ func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVoterWeightsByProposalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3040,12 +3344,12 @@ func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVotesByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVoterWeightsByProposalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVoterWeightsByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3062,10 +3366,10 @@ func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.VoterWeights) > 0 {
+		for iNdEx := len(m.VoterWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.VoterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3079,7 +3383,7 @@ func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsByMemberRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryQueuedProposalsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3089,12 +3393,12 @@ func (m *QueryGroupsByMemberRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3109,19 +3413,12 @@ func (m *QueryGroupsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsByMemberResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryQueuedProposalsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3131,12 +3428,12 @@ func (m *QueryGroupsByMemberResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQueuedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3153,10 +3450,10 @@ func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3170,7 +3467,7 @@ func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3180,27 +3477,209 @@ func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsByMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsByMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -3644,6 +4123,73 @@ func (m *QueryVotesByProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVoterWeightsByProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoterWeightsByProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoterWeights) > 0 {
+		for _, e := range m.VoterWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryVotesByVoterRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5831,6 +6377,437 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoterWeightsByProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoterWeightsByProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoterWeightsByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoterWeightsByProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoterWeightsByProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoterWeightsByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterWeights = append(m.VoterWeights, &VoterWeight{})
+			if err := m.VoterWeights[len(m.VoterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -72,6 +72,9 @@ type MsgCreateGroup struct {
 	Members []MemberRequest `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
 	// metadata is any arbitrary metadata to attached to the group.
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// weight_source, if set, makes the group token-weighted: the voting weight
+	// of its members is read from the weight source at proposal submission.
+	WeightSource *WeightSource `protobuf:"bytes,4,opt,name=weight_source,json=weightSource,proto3" json:"weight_source,omitempty"`
 }
 
 func (m *MsgCreateGroup) Reset()         { *m = MsgCreateGroup{} }
@@ -128,6 +131,13 @@ func (m *MsgCreateGroup) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgCreateGroup) GetWeightSource() *WeightSource {
+	if m != nil {
+		return m.WeightSource
+	}
+	return nil
+}
+
 // MsgCreateGroupResponse is the Msg/CreateGroup response type.
 type MsgCreateGroupResponse struct {
 	// group_id is the unique ID of the newly created group.
@@ -685,6 +695,9 @@ type MsgCreateGroupWithPolicy struct {
 	GroupPolicyAsAdmin bool `protobuf:"varint,5,opt,name=group_policy_as_admin,json=groupPolicyAsAdmin,proto3" json:"group_policy_as_admin,omitempty"`
 	// decision_policy specifies the group policy's decision policy.
 	DecisionPolicy *any.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// weight_source, if set, makes the group token-weighted: the voting weight
+	// of its members is read from the weight source at proposal submission.
+	WeightSource *WeightSource `protobuf:"bytes,7,opt,name=weight_source,json=weightSource,proto3" json:"weight_source,omitempty"`
 }
 
 func (m *MsgCreateGroupWithPolicy) Reset()         { *m = MsgCreateGroupWithPolicy{} }
@@ -1152,6 +1165,98 @@ func (m *MsgWithdrawProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawProposalResponse proto.InternalMessageInfo
 
+// MsgVetoProposal is the Msg/VetoProposal request type.
+type MsgVetoProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is the timelock guardian of the group policy's decision policy.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{22}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVetoProposal) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// MsgVetoProposalResponse is the Msg/VetoProposal response type.
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{23}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 // MsgVote is the Msg/Vote request type.
 type MsgVote struct {
 	// proposal is the unique ID of the proposal.
@@ -1171,7 +1276,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{22}
+	return fileDescriptor_6b8d3d629f136420, []int{24}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1348,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{23}
+	return fileDescriptor_6b8d3d629f136420, []int{25}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1284,7 +1389,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+	return fileDescriptor_6b8d3d629f136420, []int{26}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1442,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+	return fileDescriptor_6b8d3d629f136420, []int{27}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1385,7 +1490,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1541,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,6 +1594,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.group.v1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos.group.v1.MsgWithdrawProposal")
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos.group.v1.MsgWithdrawProposalResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.group.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.group.v1.MsgVetoProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.group.v1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
@@ -1500,98 +1607,104 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xda, 0xce, 0xdb, 0x93, 0x7f, 0x9d, 0x64, 0x93, 0xb4, 0xce, 0xb6, 0xb5, 0xdd, 0xe9,
-	0x4b, 0x52, 0xab, 0x5e, 0x37, 0x4e, 0xdb, 0xbf, 0x30, 0x48, 0xa8, 0x49, 0x03, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xbb, 0x5d, 0x56, 0xb5, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x2f, 0x17, 0xde, 0x24, 0x90, 0xe0, 0x03, 0xc0, 0x8d, 0x63, 0x91, 0x7a, 0xe0, 0xc6, 0x0d, 0x55,
-	0xe5, 0x52, 0x71, 0x42, 0x1c, 0x10, 0x6a, 0x85, 0x7a, 0xe3, 0x2b, 0x80, 0x76, 0x66, 0x77, 0xec,
-	0xf1, 0xee, 0x7a, 0xb7, 0x96, 0x05, 0x97, 0xc8, 0x3b, 0xcf, 0xef, 0x79, 0xfb, 0x3d, 0xcf, 0x3c,
-	0x33, 0x13, 0xc8, 0xec, 0x59, 0xb8, 0x61, 0xe1, 0x92, 0xd1, 0xb6, 0x3a, 0xad, 0xd2, 0xfe, 0x5a,
-	0xc9, 0x3e, 0x90, 0x5b, 0x6d, 0xcb, 0xb6, 0xc4, 0x59, 0x2a, 0x91, 0x89, 0x44, 0xde, 0x5f, 0x93,
-	0x16, 0x0d, 0xcb, 0xb0, 0x88, 0xac, 0xe4, 0xfc, 0xa2, 0x30, 0x69, 0x99, 0xc2, 0x6a, 0x54, 0xe0,
-	0xea, 0xb8, 0x22, 0xc3, 0xb2, 0x8c, 0xba, 0x5e, 0x22, 0x5f, 0xbb, 0x9d, 0xdb, 0x25, 0xb5, 0x79,
-	0xe8, 0x8a, 0x8e, 0xfb, 0xdc, 0x1e, 0xb6, 0x74, 0x4f, 0xef, 0x98, 0x2b, 0x6c, 0x60, 0xc3, 0x11,
-	0x35, 0xb0, 0xe1, 0x0a, 0xe6, 0xd5, 0x86, 0xd9, 0xb4, 0x4a, 0xe4, 0x2f, 0x5d, 0x42, 0x3f, 0x0b,
-	0x90, 0xae, 0x62, 0x63, 0xb3, 0xad, 0xab, 0xb6, 0xfe, 0xb2, 0x63, 0x4d, 0x94, 0x61, 0x5c, 0xd5,
-	0x1a, 0x66, 0x33, 0x23, 0xe4, 0x85, 0xd5, 0xe9, 0x8d, 0xcc, 0x2f, 0xf7, 0x8b, 0x8b, 0x6e, 0x5c,
-	0x57, 0x35, 0xad, 0xad, 0x63, 0x7c, 0xdd, 0x6e, 0x9b, 0x4d, 0x43, 0xa1, 0x30, 0x71, 0x13, 0x26,
-	0x1b, 0x7a, 0x63, 0x57, 0x6f, 0xe3, 0x4c, 0x22, 0x9f, 0x5c, 0x9d, 0x29, 0x67, 0xe5, 0xbe, 0xd4,
-	0xe5, 0x2a, 0x91, 0x2b, 0xfa, 0x7b, 0x1d, 0x1d, 0xdb, 0x1b, 0xd3, 0x0f, 0x7e, 0xcf, 0x8d, 0x7d,
-	0xf7, 0xf4, 0x5e, 0x41, 0x50, 0x3c, 0x4d, 0x51, 0x82, 0xa9, 0x86, 0x6e, 0xab, 0x9a, 0x6a, 0xab,
-	0x99, 0xa4, 0xe3, 0x57, 0x61, 0xdf, 0x95, 0xd5, 0x0f, 0x9f, 0xde, 0x2b, 0x50, 0x67, 0x9f, 0x3e,
-	0xbd, 0x57, 0x70, 0x19, 0x2b, 0x62, 0xed, 0x4e, 0x89, 0x0f, 0x1d, 0xad, 0xc3, 0x51, 0x7e, 0x45,
-	0xd1, 0x71, 0xcb, 0x6a, 0x62, 0x5d, 0x5c, 0x86, 0x29, 0x12, 0x4d, 0xcd, 0xd4, 0x48, 0x5e, 0x29,
-	0x65, 0x92, 0x7c, 0x6f, 0x6b, 0xe8, 0x4f, 0x01, 0x96, 0xaa, 0xd8, 0xb8, 0xd9, 0xd2, 0x3c, 0xad,
-	0xaa, 0x1b, 0xd4, 0xb3, 0x32, 0xd1, 0xeb, 0x24, 0xc1, 0x39, 0x11, 0x77, 0x20, 0x4d, 0x53, 0xad,
-	0x75, 0x88, 0x1f, 0x9c, 0x49, 0x3e, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x94, 0x78,
-	0x56, 0xf2, 0x3c, 0x2b, 0xfe, 0x6c, 0x50, 0x0e, 0x4e, 0x06, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x2c, 0xf0, 0x88, 0xab, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x19, 0xa6, 0x9b, 0xfa, 0xdd, 0x1a, 0x35,
-	0x97, 0x8c, 0x30, 0x37, 0xd5, 0xd4, 0xef, 0x92, 0x08, 0x2a, 0x45, 0x3e, 0xd7, 0x6c, 0x68, 0xae,
-	0x04, 0x8e, 0x4e, 0xc2, 0xf1, 0x80, 0x65, 0x96, 0xe7, 0xf7, 0x02, 0x69, 0x13, 0x8e, 0x09, 0xda,
-	0x6a, 0xa3, 0x4c, 0x75, 0x50, 0x47, 0x5f, 0xe4, 0xf3, 0x39, 0x35, 0xa0, 0x76, 0x54, 0x03, 0xe5,
-	0x21, 0x1b, 0x2c, 0x61, 0x59, 0x7d, 0x9d, 0x80, 0x45, 0xbe, 0xf9, 0x77, 0xac, 0xba, 0xb9, 0x77,
-	0xf8, 0x2f, 0xe5, 0x24, 0xaa, 0x30, 0xab, 0xe9, 0x7b, 0x26, 0x36, 0xad, 0x66, 0xad, 0x45, 0x3c,
-	0x67, 0x52, 0x79, 0x61, 0x75, 0xa6, 0xbc, 0x28, 0xd3, 0x39, 0x26, 0x7b, 0x73, 0x4c, 0xbe, 0xda,
-	0x3c, 0xdc, 0x40, 0x0f, 0xef, 0x17, 0xb3, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57, 0xd2,
-	0x1a, 0xf7, 0x5d, 0x29, 0x7f, 0xfc, 0x4d, 0x6e, 0x8c, 0xa7, 0x2e, 0x17, 0x3a, 0x0c, 0xa8, 0x0e,
-	0x52, 0xe0, 0x44, 0xd0, 0x3a, 0x1b, 0x0c, 0x65, 0x98, 0x54, 0x29, 0x0b, 0x91, 0xfc, 0x78, 0x40,
-	0xf4, 0x51, 0x02, 0x96, 0xf9, 0x6a, 0x50, 0xa3, 0xc3, 0x6d, 0x97, 0x57, 0x60, 0x91, 0xf2, 0x4d,
-	0x59, 0xab, 0x79, 0xe1, 0x24, 0x22, 0xd4, 0x45, 0xa3, 0xd7, 0x33, 0x91, 0x0c, 0xbb, 0xbf, 0xd6,
-	0x79, 0x52, 0xcf, 0x84, 0xf6, 0x63, 0x4f, 0x9e, 0xe8, 0x34, 0x9c, 0x0a, 0x15, 0xb2, 0xae, 0xfc,
-	0x21, 0x09, 0x19, 0x9e, 0xff, 0x5b, 0xa6, 0xfd, 0xee, 0x90, 0x9d, 0x39, 0x92, 0x93, 0xe6, 0x2c,
-	0xa4, 0x29, 0xdd, 0x7d, 0x9d, 0x7c, 0xc4, 0xe0, 0x26, 0x41, 0x19, 0x96, 0xb8, 0xaa, 0x30, 0x74,
-	0x8a, 0xa0, 0x17, 0x7a, 0xc8, 0x67, 0x3a, 0x6b, 0x7d, 0x3a, 0x2a, 0x76, 0x2b, 0x31, 0x9e, 0x17,
-	0x56, 0xa7, 0xf8, 0x82, 0x61, 0xda, 0x2c, 0x01, 0xbb, 0x66, 0x62, 0xc4, 0xbb, 0xe6, 0x8a, 0x7f,
-	0xd7, 0x9c, 0x0e, 0xdd, 0x35, 0xdd, 0xea, 0xa0, 0x4f, 0x04, 0xc8, 0x87, 0x09, 0x63, 0x9c, 0xab,
-	0xa3, 0xec, 0x6b, 0xf4, 0x63, 0x02, 0x50, 0x50, 0xb3, 0xf1, 0xa9, 0xff, 0xa7, 0x5b, 0x2f, 0xa0,
-	0x92, 0xc9, 0x11, 0x57, 0xb2, 0xe2, 0xaf, 0xe4, 0x4a, 0xe8, 0x56, 0xe5, 0x6d, 0xa1, 0x0b, 0x50,
-	0x88, 0x26, 0x90, 0x6d, 0xdb, 0xbf, 0x04, 0x32, 0x36, 0x7d, 0xf0, 0xa1, 0x0f, 0xca, 0x51, 0x32,
-	0x3d, 0xe8, 0x64, 0xbd, 0x12, 0x97, 0x1e, 0x3e, 0x1f, 0x74, 0x0e, 0xce, 0x0c, 0x92, 0x33, 0x62,
-	0x3e, 0x4b, 0xc2, 0x7c, 0x15, 0x1b, 0xd7, 0x3b, 0xbb, 0x0d, 0xd3, 0xde, 0x69, 0x5b, 0x2d, 0x0b,
-	0xab, 0xf5, 0xd0, 0xec, 0x84, 0x21, 0xb2, 0x3b, 0x01, 0xd3, 0x2d, 0x62, 0xd7, 0x1b, 0x73, 0xd3,
-	0x4a, 0x77, 0x61, 0xe0, 0x09, 0x7c, 0xd1, 0x91, 0x61, 0xac, 0x1a, 0x3a, 0xce, 0xa4, 0xc8, 0x7c,
-	0x0c, 0x6c, 0x3d, 0x85, 0xa1, 0xc4, 0xf3, 0x90, 0xd2, 0x0f, 0xf4, 0x3d, 0x32, 0x9f, 0xd2, 0xe5,
-	0x25, 0xdf, 0x34, 0xdd, 0x3a, 0xd0, 0xf7, 0x14, 0x02, 0x11, 0xcf, 0xc3, 0xb8, 0x6d, 0xda, 0x75,
-	0x9d, 0x8c, 0xa7, 0xe9, 0x8d, 0x85, 0xdf, 0xee, 0x17, 0x67, 0xbb, 0xe4, 0xe6, 0x2f, 0xca, 0x97,
-	0xfe, 0xaf, 0x50, 0x84, 0x58, 0x84, 0x49, 0xdc, 0x69, 0x34, 0xd4, 0xf6, 0x61, 0x66, 0x32, 0x1c,
-	0xec, 0x61, 0x2a, 0xcf, 0x79, 0x5d, 0xdd, 0x4d, 0xd3, 0x29, 0x1d, 0xea, 0x29, 0x1d, 0x7d, 0xe6,
-	0xf8, 0x78, 0x47, 0x2f, 0x90, 0x73, 0x98, 0x5f, 0x64, 0xa3, 0x29, 0x07, 0x33, 0x2d, 0x77, 0xad,
-	0x3b, 0x9d, 0xc0, 0x5b, 0xda, 0xd6, 0xd0, 0xb7, 0xf4, 0xbe, 0xeb, 0x4c, 0x35, 0xad, 0xad, 0xde,
-	0x65, 0xd5, 0x8c, 0x52, 0xec, 0xbd, 0x33, 0x24, 0x62, 0xde, 0x19, 0x2a, 0x97, 0x9d, 0x0c, 0xbd,
-	0xaf, 0xfe, 0x43, 0x96, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x95, 0xed, 0x5f, 0x66, 0xed, 0xf8, 0xb7,
-	0x00, 0x93, 0x55, 0x6c, 0xbc, 0x61, 0xd9, 0xd1, 0xf9, 0x3a, 0x7b, 0x76, 0xdf, 0xb2, 0xf5, 0x76,
-	0x64, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb2, 0x4d, 0x8b, 0xde, 0x24, 0xd2, 0xe5, 0xe3,
-	0xbe, 0xfe, 0x70, 0xfc, 0xbe, 0x4e, 0x20, 0x8a, 0x0b, 0xe5, 0x1a, 0x34, 0xd5, 0xd7, 0xa0, 0xf1,
-	0xdb, 0xad, 0xb2, 0x42, 0xf6, 0x31, 0x89, 0xc3, 0x21, 0x2b, 0x13, 0x44, 0x96, 0xe3, 0x1d, 0xcd,
-	0xc3, 0xac, 0xfb, 0x93, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4d, 0xca, 0x25, 0x98, 0x72,
-	0x1c, 0x76, 0x6c, 0x2b, 0x9a, 0x17, 0x86, 0xac, 0x14, 0x9c, 0xf0, 0xd8, 0x67, 0x68, 0x84, 0x4e,
-	0x08, 0x48, 0x21, 0x11, 0x92, 0xdc, 0xbc, 0xd6, 0x7c, 0x11, 0x26, 0xda, 0x3a, 0xee, 0xd4, 0x6d,
-	0xe2, 0x32, 0x5d, 0x5e, 0xf1, 0x51, 0xe1, 0x55, 0x7a, 0xcb, 0x75, 0xa1, 0x10, 0xb8, 0xe2, 0xaa,
-	0xa1, 0x2f, 0x04, 0x38, 0x52, 0xc5, 0xc6, 0xab, 0xba, 0xba, 0xef, 0xbe, 0xda, 0x87, 0xb8, 0xc7,
-	0x0e, 0xb8, 0xe9, 0xd3, 0xd7, 0x65, 0x6f, 0xbb, 0x66, 0x83, 0xf2, 0xeb, 0xfa, 0x47, 0xc7, 0xc8,
-	0x23, 0xba, 0xbb, 0xe0, 0xe5, 0x5a, 0x28, 0x40, 0x8a, 0x54, 0x62, 0x11, 0xe6, 0xb6, 0xde, 0xdc,
-	0xda, 0xac, 0xdd, 0x7c, 0xed, 0xfa, 0xce, 0xd6, 0xe6, 0xf6, 0x4b, 0xdb, 0x5b, 0xd7, 0xe6, 0xc6,
-	0xc4, 0xff, 0xc1, 0x14, 0x59, 0xbd, 0xa1, 0xbc, 0x35, 0x27, 0x94, 0x1f, 0xce, 0x40, 0xb2, 0x8a,
-	0x0d, 0xf1, 0x16, 0xcc, 0xf4, 0xfe, 0x47, 0x22, 0xe7, 0xbf, 0xe6, 0x71, 0xf7, 0x12, 0x69, 0x25,
-	0x02, 0xc0, 0x88, 0xaf, 0x83, 0x18, 0xf0, 0xce, 0x3f, 0x17, 0xa4, 0xee, 0xc7, 0x49, 0x72, 0x3c,
-	0x1c, 0xf3, 0x76, 0x1b, 0xe6, 0x7c, 0x8f, 0xe9, 0x33, 0x11, 0x36, 0x08, 0x4a, 0xba, 0x10, 0x07,
-	0xc5, 0xfc, 0x58, 0xb0, 0x10, 0xf4, 0x98, 0x5d, 0x89, 0x0c, 0x97, 0x02, 0xa5, 0x52, 0x4c, 0x20,
-	0x73, 0x68, 0xc2, 0xbc, 0xff, 0x9d, 0x79, 0x36, 0xa2, 0x08, 0x14, 0x26, 0x15, 0x63, 0xc1, 0x98,
-	0xab, 0x0e, 0x2c, 0x05, 0x3f, 0x1e, 0xce, 0x47, 0xd8, 0xe9, 0x42, 0xa5, 0xb5, 0xd8, 0x50, 0xe6,
-	0xf6, 0x00, 0x8e, 0x86, 0x3c, 0xef, 0x0a, 0x11, 0x64, 0xf5, 0x60, 0xa5, 0x72, 0x7c, 0x2c, 0xf3,
-	0xfc, 0x95, 0x00, 0xb9, 0xa8, 0x7b, 0xee, 0x7a, 0x2c, 0xbb, 0xbc, 0x92, 0xf4, 0xfc, 0x10, 0x4a,
-	0x2c, 0xaa, 0x0f, 0x04, 0x58, 0x0e, 0xbf, 0x0d, 0x16, 0x63, 0x99, 0x66, 0xfd, 0x76, 0xf9, 0x99,
-	0xe0, 0x2c, 0x86, 0x77, 0x20, 0xdd, 0x77, 0xef, 0x42, 0x41, 0x86, 0x78, 0x8c, 0x54, 0x88, 0xc6,
-	0xf4, 0x6e, 0x58, 0xdf, 0x6d, 0x20, 0x70, 0xc3, 0xf6, 0xa3, 0x82, 0x37, 0x6c, 0xd8, 0xb1, 0x2d,
-	0x6e, 0x40, 0x8a, 0x1c, 0xd9, 0x99, 0x20, 0x2d, 0x47, 0x22, 0xe5, 0xc3, 0x24, 0xbd, 0x36, 0xc8,
-	0x5c, 0x0d, 0xb4, 0xe1, 0x48, 0x82, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x80, 0x9e, 0x23, 0x24, 0x1b,
-	0x84, 0xef, 0xca, 0xa5, 0x73, 0x83, 0xe5, 0x9e, 0x55, 0x69, 0xfc, 0x7d, 0xe7, 0xc5, 0xbd, 0x21,
-	0x3f, 0x78, 0x9c, 0x15, 0x1e, 0x3d, 0xce, 0x0a, 0x7f, 0x3c, 0xce, 0x0a, 0x5f, 0x3e, 0xc9, 0x8e,
-	0x3d, 0x7a, 0x92, 0x1d, 0xfb, 0xf5, 0x49, 0x76, 0xec, 0x6d, 0xf7, 0x58, 0xc2, 0xda, 0x1d, 0xd9,
-	0xb4, 0x4a, 0x07, 0xf4, 0x3c, 0xd9, 0x9d, 0x20, 0x97, 0xd4, 0xf5, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x59, 0x7d, 0x05, 0x2f, 0x53, 0x17, 0x00, 0x00,
+	// 1541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0x25, 0xd9, 0xb2, 0x8f, 0x6d, 0xd9, 0xa6, 0xed, 0x44, 0x66, 0x12, 0x49, 0x61, 0x1e,
+	0x76, 0x84, 0x48, 0x8a, 0xe5, 0x24, 0x17, 0x57, 0xf7, 0x02, 0x17, 0x91, 0xe3, 0x5b, 0xb8, 0xa8,
+	0x5a, 0x83, 0x4e, 0x9a, 0x36, 0x1b, 0x95, 0x36, 0x27, 0x0c, 0x11, 0x4b, 0x54, 0x35, 0x94, 0x1f,
+	0xbb, 0x3e, 0x36, 0x7d, 0x01, 0x29, 0xd0, 0x2e, 0xbb, 0x68, 0x37, 0x45, 0x97, 0x29, 0x90, 0x7d,
+	0x77, 0x45, 0xd0, 0x55, 0xd0, 0x55, 0xd1, 0x45, 0x51, 0x24, 0x28, 0xb2, 0xeb, 0x2f, 0x28, 0xd0,
+	0x82, 0x33, 0xe4, 0x88, 0x14, 0x49, 0x91, 0x16, 0x84, 0x76, 0x23, 0x68, 0xe6, 0x7c, 0x73, 0x1e,
+	0xdf, 0x99, 0x73, 0x66, 0x86, 0x90, 0xde, 0xd5, 0x71, 0x43, 0xc7, 0x25, 0xb5, 0xad, 0x77, 0x5a,
+	0xa5, 0xfd, 0xd5, 0x92, 0x71, 0x58, 0x6c, 0xb5, 0x75, 0x43, 0xe7, 0x67, 0xa8, 0xa4, 0x48, 0x24,
+	0xc5, 0xfd, 0x55, 0x61, 0x41, 0xd5, 0x55, 0x9d, 0xc8, 0x4a, 0xe6, 0x3f, 0x0a, 0x13, 0x96, 0x28,
+	0xac, 0x4e, 0x05, 0xd6, 0x1a, 0x4b, 0xa4, 0xea, 0xba, 0xba, 0x87, 0x4a, 0x64, 0xb4, 0xd3, 0xb9,
+	0x57, 0x92, 0x9b, 0x47, 0x96, 0xe8, 0x94, 0xc7, 0xec, 0x51, 0x0b, 0xd9, 0xeb, 0x4e, 0x5a, 0xc2,
+	0x06, 0x56, 0x4d, 0x51, 0x03, 0xab, 0x96, 0x60, 0x4e, 0x6e, 0x68, 0x4d, 0xbd, 0x44, 0x7e, 0xe9,
+	0x94, 0xf8, 0x30, 0x06, 0xa9, 0x1a, 0x56, 0xd7, 0xdb, 0x48, 0x36, 0xd0, 0x4b, 0xa6, 0x36, 0xbe,
+	0x08, 0xa3, 0xb2, 0xd2, 0xd0, 0x9a, 0x69, 0x2e, 0xc7, 0xad, 0x4c, 0x54, 0xd3, 0x3f, 0x3e, 0x2e,
+	0x2c, 0x58, 0x7e, 0xdd, 0x50, 0x94, 0x36, 0xc2, 0x78, 0xdb, 0x68, 0x6b, 0x4d, 0x55, 0xa2, 0x30,
+	0x7e, 0x1d, 0x92, 0x0d, 0xd4, 0xd8, 0x41, 0x6d, 0x9c, 0x8e, 0xe5, 0xe2, 0x2b, 0x93, 0xe5, 0x4c,
+	0xb1, 0x27, 0xf4, 0x62, 0x8d, 0xc8, 0x25, 0xf4, 0x76, 0x07, 0x61, 0xa3, 0x3a, 0xf1, 0xe4, 0x97,
+	0xec, 0xc8, 0x37, 0x2f, 0x1e, 0xe5, 0x39, 0xc9, 0x5e, 0xc9, 0x0b, 0x30, 0xde, 0x40, 0x86, 0xac,
+	0xc8, 0x86, 0x9c, 0x8e, 0x9b, 0x76, 0x25, 0x36, 0xe6, 0xab, 0x30, 0x7d, 0x80, 0x34, 0xf5, 0xbe,
+	0x51, 0xc7, 0x7a, 0xa7, 0xbd, 0x8b, 0xd2, 0x89, 0x1c, 0xb7, 0x32, 0x59, 0x3e, 0xe3, 0x31, 0x73,
+	0x87, 0xa0, 0xb6, 0x09, 0x48, 0x9a, 0x3a, 0x70, 0x8c, 0x2a, 0x2b, 0xef, 0xbd, 0x78, 0x94, 0xa7,
+	0x0e, 0x7f, 0xf4, 0xe2, 0x51, 0xde, 0x62, 0xbd, 0x80, 0x95, 0x07, 0x25, 0x77, 0xf8, 0xe2, 0x1a,
+	0x9c, 0x70, 0xcf, 0x48, 0x08, 0xb7, 0xf4, 0x26, 0x46, 0xfc, 0x12, 0x8c, 0x13, 0x53, 0x75, 0x4d,
+	0x21, 0xdc, 0x24, 0xa4, 0x24, 0x19, 0x6f, 0x2a, 0xe2, 0x6f, 0x1c, 0x2c, 0xd6, 0xb0, 0x7a, 0xbb,
+	0xa5, 0xd8, 0xab, 0x6a, 0x56, 0x60, 0xc7, 0x65, 0xd3, 0x69, 0x24, 0xe6, 0x32, 0xc2, 0x6f, 0x41,
+	0x8a, 0xd2, 0x55, 0xef, 0x10, 0x3b, 0x38, 0x1d, 0x3f, 0x2e, 0xdf, 0xd3, 0x54, 0x01, 0xf5, 0x13,
+	0x57, 0x4a, 0x6e, 0x56, 0x72, 0x6e, 0x56, 0xbc, 0xd1, 0x88, 0x59, 0x38, 0xe3, 0x2b, 0xb0, 0x39,
+	0x12, 0xbf, 0xe7, 0x60, 0xde, 0x8d, 0xb8, 0x41, 0xc2, 0x1a, 0x22, 0x0d, 0xd7, 0x60, 0xa2, 0x89,
+	0x0e, 0xea, 0x54, 0x5d, 0x3c, 0x44, 0xdd, 0x78, 0x13, 0x1d, 0x10, 0x0f, 0x2a, 0x05, 0x77, 0xac,
+	0x99, 0xc0, 0x58, 0x09, 0x5c, 0x3c, 0x03, 0xa7, 0x7c, 0xa6, 0x59, 0x9c, 0xdf, 0x72, 0x70, 0xc2,
+	0x2d, 0xaf, 0xd9, 0xdb, 0x75, 0x88, 0xa1, 0xf6, 0xa9, 0x8a, 0xca, 0x15, 0x77, 0x3c, 0x67, 0xfb,
+	0xe4, 0x8e, 0xae, 0x10, 0x73, 0x90, 0xf1, 0x97, 0xb0, 0xa8, 0x3e, 0x8f, 0xc1, 0x82, 0x7b, 0xf3,
+	0x6f, 0xe9, 0x7b, 0xda, 0xee, 0xd1, 0xdf, 0x14, 0x13, 0x2f, 0xc3, 0x8c, 0x82, 0x76, 0x35, 0xac,
+	0xe9, 0xcd, 0x7a, 0x8b, 0x58, 0xb6, 0x6a, 0x7d, 0xa1, 0x48, 0x7b, 0x61, 0xd1, 0xee, 0x85, 0xc5,
+	0x1b, 0xcd, 0xa3, 0xaa, 0xf8, 0xc3, 0xe3, 0x42, 0xa6, 0x77, 0xef, 0xdf, 0xb4, 0x14, 0x50, 0xcf,
+	0xa5, 0x94, 0xe2, 0x1a, 0x57, 0xca, 0x1f, 0x7c, 0x99, 0x1d, 0x71, 0x53, 0x97, 0x0d, 0x6c, 0x06,
+	0x74, 0x8d, 0x28, 0xc1, 0x69, 0xbf, 0x79, 0xd6, 0x18, 0xca, 0x90, 0x94, 0x29, 0x0b, 0xa1, 0xfc,
+	0xd8, 0x40, 0xf1, 0xfd, 0x18, 0x2c, 0xb9, 0xb3, 0x41, 0x95, 0x0e, 0x56, 0x2e, 0x2f, 0xc3, 0x02,
+	0xe5, 0x9b, 0xb2, 0x56, 0xb7, 0xdd, 0x89, 0x85, 0x2c, 0xe7, 0x55, 0xa7, 0x65, 0x22, 0x19, 0xb4,
+	0xbe, 0xd6, 0xdc, 0xa4, 0x9e, 0x0f, 0xdc, 0x8f, 0x8e, 0x38, 0xc5, 0x73, 0x70, 0x36, 0x50, 0xc8,
+	0x76, 0xe5, 0x1f, 0x71, 0x48, 0xbb, 0xf9, 0xbf, 0xa3, 0x19, 0xf7, 0x07, 0xdc, 0x99, 0x43, 0x39,
+	0xad, 0x2e, 0x40, 0x8a, 0xd2, 0xdd, 0xb3, 0x93, 0xa7, 0x55, 0x57, 0x27, 0x28, 0xc3, 0xa2, 0x2b,
+	0x2b, 0x0c, 0x9d, 0x20, 0xe8, 0x79, 0x07, 0xf9, 0x6c, 0xcd, 0x6a, 0xcf, 0x1a, 0x19, 0x5b, 0x99,
+	0x18, 0xcd, 0x71, 0x2b, 0xe3, 0xee, 0x84, 0x61, 0xba, 0x59, 0x7c, 0xaa, 0x66, 0x6c, 0xb8, 0x55,
+	0xe3, 0x3d, 0x82, 0x93, 0xc7, 0x3f, 0x82, 0xaf, 0x7b, 0x2b, 0xef, 0x5c, 0x60, 0xe5, 0x75, 0x33,
+	0x2c, 0x7e, 0xc8, 0x41, 0x2e, 0x48, 0x18, 0xe1, 0x6c, 0x1e, 0x66, 0x6d, 0x88, 0xdf, 0xc5, 0x40,
+	0xf4, 0xdb, 0xb0, 0x6e, 0xfa, 0xfe, 0xd1, 0xf2, 0xf5, 0xd9, 0x0d, 0xf1, 0x21, 0xf7, 0xd0, 0x8a,
+	0x37, 0x93, 0xcb, 0x81, 0xe5, 0xee, 0xd6, 0x25, 0x5e, 0x86, 0x7c, 0x38, 0x81, 0xac, 0xf4, 0x7f,
+	0xe7, 0xe0, 0xb4, 0x1f, 0x7c, 0xe0, 0xc3, 0x76, 0x98, 0x4c, 0xf7, 0x3b, 0x9d, 0xaf, 0x47, 0xa5,
+	0xc7, 0x1d, 0x8f, 0x78, 0x11, 0xce, 0xf7, 0x93, 0x33, 0x62, 0x3e, 0x8e, 0xc3, 0x5c, 0x0d, 0xab,
+	0xdb, 0x9d, 0x9d, 0x86, 0x66, 0x6c, 0xb5, 0xf5, 0x96, 0x8e, 0xe5, 0xbd, 0xc0, 0xe8, 0xb8, 0x01,
+	0xa2, 0x3b, 0x0d, 0x13, 0x2d, 0xa2, 0xd7, 0x6e, 0x95, 0x13, 0x52, 0x77, 0xa2, 0xef, 0x29, 0x7e,
+	0xc5, 0x94, 0x61, 0x2c, 0xab, 0x08, 0xa7, 0x13, 0xb9, 0x78, 0xd0, 0xd6, 0x93, 0x18, 0x8a, 0xbf,
+	0x04, 0x09, 0x74, 0x88, 0x76, 0x49, 0x8f, 0x4b, 0x95, 0x17, 0x3d, 0x5d, 0x65, 0xe3, 0x10, 0xed,
+	0x4a, 0x04, 0xc2, 0x5f, 0x82, 0x51, 0x43, 0x33, 0xf6, 0x10, 0x69, 0x71, 0x13, 0xd5, 0xf9, 0x9f,
+	0x1f, 0x17, 0x66, 0xba, 0xe4, 0xe6, 0xae, 0x14, 0xaf, 0xfe, 0x4b, 0xa2, 0x08, 0xbe, 0x00, 0x49,
+	0xdc, 0x69, 0x34, 0xe4, 0xf6, 0x51, 0x3a, 0x19, 0x0c, 0xb6, 0x31, 0x95, 0x7f, 0xdb, 0xbb, 0xba,
+	0x1b, 0xa6, 0x99, 0x3a, 0xd1, 0x91, 0x3a, 0xfa, 0xdc, 0xf2, 0xf0, 0x2e, 0xfe, 0x17, 0x96, 0x3c,
+	0x93, 0xac, 0x35, 0x65, 0x61, 0xb2, 0x65, 0xcd, 0x75, 0xbb, 0x13, 0xd8, 0x53, 0x9b, 0x8a, 0xf8,
+	0x15, 0xbd, 0x33, 0x9b, 0x5d, 0x4d, 0x69, 0xcb, 0x07, 0x2c, 0x9b, 0x61, 0x0b, 0x9d, 0xf7, 0x8e,
+	0x58, 0xc4, 0x7b, 0x47, 0xe5, 0x9a, 0x19, 0xa1, 0x3d, 0xea, 0x3d, 0xa8, 0x59, 0x7c, 0xbd, 0xbe,
+	0x58, 0xd7, 0xe1, 0xde, 0x69, 0xb6, 0x1d, 0xbf, 0xe0, 0x60, 0xa6, 0x86, 0xd5, 0xd7, 0x91, 0xa1,
+	0x47, 0x77, 0xff, 0x2a, 0x8c, 0xab, 0x1d, 0xb9, 0xad, 0x68, 0x72, 0x33, 0xd4, 0x7f, 0x86, 0xac,
+	0x94, 0xcd, 0x00, 0xd8, 0xb0, 0xf7, 0xd9, 0xc2, 0x22, 0x70, 0xba, 0x22, 0x2e, 0xc1, 0xc9, 0x9e,
+	0x29, 0xe6, 0xf9, 0x9f, 0x1c, 0x24, 0x4d, 0x99, 0x6e, 0x84, 0x67, 0xca, 0xec, 0x36, 0xfb, 0xba,
+	0x81, 0xda, 0xa1, 0xee, 0x52, 0x18, 0xbf, 0x06, 0x63, 0x7a, 0xcb, 0xd0, 0x74, 0x7a, 0x8f, 0x4a,
+	0x95, 0x4f, 0x79, 0x76, 0xb6, 0x69, 0xf7, 0x35, 0x02, 0x91, 0x2c, 0xa8, 0xab, 0xb4, 0x12, 0x3d,
+	0xa5, 0x15, 0xbd, 0x50, 0x2a, 0xcb, 0xa4, 0x03, 0x11, 0x3f, 0x4c, 0x92, 0xd2, 0xbe, 0x24, 0xe9,
+	0x06, 0x12, 0xe7, 0x68, 0xea, 0x74, 0x03, 0x31, 0x52, 0x3e, 0xa1, 0xa4, 0x98, 0xda, 0x22, 0xa5,
+	0xd1, 0x34, 0xd8, 0x31, 0xf4, 0x70, 0x5e, 0x18, 0xb2, 0x92, 0x27, 0x69, 0xb4, 0x87, 0x81, 0x1e,
+	0x9a, 0x2e, 0x88, 0x12, 0xcc, 0x58, 0x7f, 0x59, 0x51, 0xfd, 0x0f, 0xc6, 0xda, 0x08, 0x77, 0xf6,
+	0x0c, 0x62, 0x32, 0x55, 0x5e, 0xf6, 0x50, 0x61, 0x67, 0x7a, 0xc3, 0x32, 0x21, 0x11, 0xb8, 0x64,
+	0x2d, 0x13, 0x1f, 0x72, 0x30, 0x5d, 0xc3, 0xea, 0x2b, 0x48, 0xde, 0xb7, 0xbe, 0x7b, 0x0c, 0x70,
+	0x8b, 0xef, 0xf3, 0xce, 0xa1, 0x6f, 0x6b, 0x67, 0xa1, 0x65, 0xfc, 0xe2, 0xeb, 0xda, 0x17, 0x4f,
+	0xc2, 0xa2, 0x6b, 0xc2, 0x8e, 0x35, 0x9f, 0x87, 0x04, 0xc9, 0xc4, 0x02, 0xcc, 0x6e, 0xbc, 0xb1,
+	0xb1, 0x5e, 0xbf, 0xfd, 0xea, 0xf6, 0xd6, 0xc6, 0xfa, 0xe6, 0xff, 0x37, 0x37, 0x6e, 0xce, 0x8e,
+	0xf0, 0x53, 0x30, 0x4e, 0x66, 0x6f, 0x49, 0x6f, 0xce, 0x72, 0xe5, 0xaf, 0xa7, 0x20, 0x5e, 0xc3,
+	0x2a, 0x7f, 0x07, 0x26, 0x9d, 0xdf, 0x74, 0xb2, 0xde, 0x4b, 0xae, 0xeb, 0x46, 0x25, 0x2c, 0x87,
+	0x00, 0x18, 0xf1, 0x7b, 0xc0, 0xfb, 0x7c, 0xe5, 0xb8, 0xe8, 0xb7, 0xdc, 0x8b, 0x13, 0x8a, 0xd1,
+	0x70, 0xcc, 0xda, 0x3d, 0x98, 0xf5, 0x7c, 0x4a, 0x38, 0x1f, 0xa2, 0x83, 0xa0, 0x84, 0xcb, 0x51,
+	0x50, 0xcc, 0x8e, 0x0e, 0xf3, 0x7e, 0x4f, 0xf9, 0xe5, 0x50, 0x77, 0x29, 0x50, 0x28, 0x45, 0x04,
+	0x32, 0x83, 0x1a, 0xcc, 0x79, 0x5f, 0xd9, 0x17, 0x42, 0x92, 0x40, 0x61, 0x42, 0x21, 0x12, 0x8c,
+	0x99, 0xea, 0xc0, 0xa2, 0xff, 0xd3, 0xe9, 0x52, 0x88, 0x9e, 0x2e, 0x54, 0x58, 0x8d, 0x0c, 0x65,
+	0x66, 0x0f, 0xe1, 0x44, 0xc0, 0xe3, 0x36, 0x1f, 0x42, 0x96, 0x03, 0x2b, 0x94, 0xa3, 0x63, 0x99,
+	0xe5, 0xcf, 0x38, 0xc8, 0x86, 0xdd, 0xd0, 0xd7, 0x22, 0xe9, 0x75, 0x2f, 0x12, 0xfe, 0x33, 0xc0,
+	0x22, 0xe6, 0xd5, 0xbb, 0x1c, 0x2c, 0x05, 0xdf, 0x63, 0x0b, 0x91, 0x54, 0xb3, 0xfd, 0x76, 0xed,
+	0x58, 0x70, 0xe6, 0xc3, 0x5b, 0x90, 0xea, 0xb9, 0x31, 0x8a, 0x7e, 0x8a, 0xdc, 0x18, 0x21, 0x1f,
+	0x8e, 0x71, 0x16, 0xac, 0xe7, 0x1e, 0xe3, 0x5b, 0xb0, 0xbd, 0x28, 0xe1, 0x72, 0x14, 0x14, 0xb3,
+	0x53, 0x85, 0x04, 0x39, 0xb2, 0xd3, 0x7e, 0xab, 0x4c, 0x89, 0x90, 0x0b, 0x92, 0x38, 0x75, 0x90,
+	0xbe, 0xea, 0xab, 0xc3, 0x94, 0x08, 0xb9, 0x20, 0x09, 0xd3, 0x71, 0x0b, 0xc0, 0x71, 0x84, 0x64,
+	0xfc, 0xf0, 0x5d, 0xb9, 0x70, 0xb1, 0xbf, 0x9c, 0x69, 0xbd, 0x0b, 0x53, 0xae, 0xab, 0x94, 0x7f,
+	0x2c, 0x0e, 0x84, 0xb0, 0x12, 0x86, 0xb0, 0x75, 0x0b, 0xa3, 0xef, 0x98, 0xdf, 0x32, 0xaa, 0xc5,
+	0x27, 0xcf, 0x32, 0xdc, 0xd3, 0x67, 0x19, 0xee, 0xd7, 0x67, 0x19, 0xee, 0xd3, 0xe7, 0x99, 0x91,
+	0xa7, 0xcf, 0x33, 0x23, 0x3f, 0x3d, 0xcf, 0x8c, 0xdc, 0xb5, 0x8e, 0x3c, 0xac, 0x3c, 0x28, 0x6a,
+	0x7a, 0xe9, 0x90, 0x9e, 0x55, 0x3b, 0x63, 0xe4, 0xea, 0xbe, 0xf6, 0xd7, 0x00, 0x14, 0x00, 0x97,
+	0xd4, 0xf1, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1634,6 +1747,9 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// VetoProposal allows the timelock guardian of a group policy to veto a
+	// queued proposal.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1770,6 +1886,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1800,6 +1925,9 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// VetoProposal allows the timelock guardian of a group policy to veto a
+	// queued proposal.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1848,6 +1976,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2105,6 +2236,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
@@ -2166,6 +2315,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -2191,6 +2344,18 @@ func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WeightSource != nil {
+		{
+			size, err := m.WeightSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -2623,6 +2788,18 @@ func (m *MsgCreateGroupWithPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.WeightSource != nil {
+		{
+			size, err := m.WeightSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.DecisionPolicy != nil {
 		{
 			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -3022,6 +3199,64 @@ func (m *MsgWithdrawProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3249,6 +3484,10 @@ func (m *MsgCreateGroup) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.WeightSource != nil {
+		l = m.WeightSource.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3451,6 +3690,10 @@ func (m *MsgCreateGroupWithPolicy) Size() (n int) {
 		l = m.DecisionPolicy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.WeightSource != nil {
+		l = m.WeightSource.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3607,6 +3850,31 @@ func (m *MsgWithdrawProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgVote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3828,6 +4096,42 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightSource == nil {
+				m.WeightSource = &WeightSource{}
+			}
+			if err := m.WeightSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5131,6 +5435,42 @@ func (m *MsgCreateGroupWithPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightSource == nil {
+				m.WeightSource = &WeightSource{}
+			}
+			if err := m.WeightSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6132,6 +6472,157 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -49,12 +49,49 @@ type DecisionPolicy interface {
 	Validate(g GroupInfo, config Config) error
 }
 
+// TimelockDecisionPolicy is an optional extension of DecisionPolicy for
+// policies that queue accepted proposals behind a timelock before they can be
+// executed.
+type TimelockDecisionPolicy interface {
+	DecisionPolicy
+
+	// GetTimelock returns the timelock of the policy, or nil if accepted
+	// proposals can be executed right away.
+	GetTimelock() *Timelock
+}
+
+// ValidateBasic does basic validation on Timelock. A nil timelock is valid.
+func (t *Timelock) ValidateBasic() error {
+	if t == nil {
+		return nil
+	}
+	if t.Delay <= 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "timelock delay must be positive")
+	}
+	if t.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(t.Guardian); err != nil {
+			return errorsmod.Wrap(err, "timelock guardian")
+		}
+	}
+	return nil
+}
+
+// validateTimelock checks the timelock of a decision policy's windows against
+// the app config.
+func validateTimelock(windows *DecisionPolicyWindows, config Config) error {
+	timelock := windows.GetTimelock()
+	if timelock != nil && timelock.Delay > config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "timelock delay should be smaller than max_execution_period")
+	}
+	return nil
+}
+
 // Implements DecisionPolicy Interface
-var _ DecisionPolicy = &ThresholdDecisionPolicy{}
+var _ TimelockDecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -67,6 +104,11 @@ func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetTimelock returns the timelock of ThresholdDecisionPolicy, if any
+func (p ThresholdDecisionPolicy) GetTimelock() *Timelock {
+	return p.Windows.GetTimelock()
+}
+
 // ValidateBasic does basic validation on ThresholdDecisionPolicy
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
@@ -77,7 +119,7 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
 
-	return nil
+	return p.Windows.Timelock.ValidateBasic()
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
@@ -151,15 +193,15 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
-	return nil
+	return validateTimelock(p.Windows, config)
 }
 
 // Implements DecisionPolicy Interface
-var _ DecisionPolicy = &PercentageDecisionPolicy{}
+var _ TimelockDecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of PercentageDecisionPolicy
@@ -172,6 +214,11 @@ func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetTimelock returns the timelock of PercentageDecisionPolicy, if any
+func (p PercentageDecisionPolicy) GetTimelock() *Timelock {
+	return p.Windows.GetTimelock()
+}
+
 // ValidateBasic does basic validation on PercentageDecisionPolicy
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	percentage, err := math.NewPositiveDecFromString(p.Percentage)
@@ -186,7 +233,7 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be 0")
 	}
 
-	return nil
+	return p.Windows.Timelock.ValidateBasic()
 }
 
 // Validate validates the policy against the group.
@@ -194,7 +241,7 @@ func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
-	return nil
+	return validateTimelock(p.Windows, config)
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage threshold before the timeout.
@@ -243,6 +290,159 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ TimelockDecisionPolicy = &QuorumDecisionPolicy{}
+
+// NewQuorumDecisionPolicy creates a new quorum DecisionPolicy
+func NewQuorumDecisionPolicy(quorum, threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &QuorumDecisionPolicy{quorum, threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of QuorumDecisionPolicy
+func (p QuorumDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of QuorumDecisionPolicy
+func (p QuorumDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// GetTimelock returns the timelock of QuorumDecisionPolicy, if any
+func (p QuorumDecisionPolicy) GetTimelock() *Timelock {
+	return p.Windows.GetTimelock()
+}
+
+// ValidateBasic does basic validation on QuorumDecisionPolicy
+func (p QuorumDecisionPolicy) ValidateBasic() error {
+	if _, err := newPercentageFromString(p.Quorum); err != nil {
+		return errorsmod.Wrap(err, "quorum")
+	}
+	if _, err := newPercentageFromString(p.Threshold); err != nil {
+		return errorsmod.Wrap(err, "threshold")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	return p.Windows.Timelock.ValidateBasic()
+}
+
+// Validate validates the policy against the group.
+func (p *QuorumDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return validateTimelock(p.Windows, config)
+}
+
+// Allow allows a proposal to pass when the votes cast reach the quorum and the
+// percentage of yes votes out of the non-abstaining votes equals or exceeds the
+// threshold. The result is final as soon as the remaining undecided weight
+// cannot change it anymore, otherwise it is evaluated at the voting period end.
+func (p QuorumDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	quorum, err := newPercentageFromString(p.Quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "quorum")
+	}
+	threshold, err := newPercentageFromString(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "threshold")
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "yes count")
+	}
+	abstainCount, err := tally.GetAbstainCount()
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "abstain count")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "total power")
+	}
+
+	// Nothing can ever be voted if the group has no weight at all.
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	// decided is the weighted sum of all the votes which are not abstaining.
+	decided, err := math.SubNonNegative(totalCounts, abstainCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	turnout, err := totalCounts.Quo(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	quorumReached := turnout.Cmp(quorum) >= 0
+
+	// The proposal is rejected if, even with all undecided voters voting yes,
+	// the threshold cannot be reached.
+	maxDecided, err := decided.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if maxDecided.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	maxYesCount, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	maxYesPercentage, err := maxYesCount.Quo(maxDecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if maxYesPercentage.Cmp(threshold) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	// The proposal is accepted if the quorum is reached and, even with all
+	// undecided voters voting no, the threshold is still met.
+	minYesPercentage, err := yesCount.Quo(maxDecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if quorumReached && minYesPercentage.Cmp(threshold) >= 0 {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	// Otherwise the outcome is not final yet, and is decided by the votes
+	// cast so far once the voting period ends.
+	if !quorumReached || decided.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	yesPercentage, err := yesCount.Quo(decided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	return DecisionPolicyResult{Allow: yesPercentage.Cmp(threshold) >= 0, Final: false}, nil
+}
+
+// newPercentageFromString parses a percentage which must be > 0 and <= 1.
+func newPercentageFromString(s string) (math.Dec, error) {
+	percentage, err := math.NewPositiveDecFromString(s)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return math.Dec{}, errorsmod.Wrap(errors.ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+	return percentage, nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -313,6 +513,11 @@ func (g GroupInfo) ValidateBasic() error {
 	if g.Version == 0 {
 		return errorsmod.Wrap(errors.ErrEmpty, "version")
 	}
+	if g.WeightSource != nil {
+		if err := g.WeightSource.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "weight source")
+		}
+	}
 	return nil
 }
 
@@ -426,6 +631,14 @@ func (g Proposal) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(err, "proposal FinalTallyResult veto count")
 	}
+	if g.TotalWeight != "" {
+		if _, err := math.NewNonNegativeDecFromString(g.TotalWeight); err != nil {
+			return errorsmod.Wrap(err, "proposal total weight")
+		}
+	}
+	if g.TimelockEnd != nil && g.Status != PROPOSAL_STATUS_ACCEPTED && g.Status != PROPOSAL_STATUS_VETOED {
+		return errorsmod.Wrap(errors.ErrInvalid, "only accepted proposals can be queued")
+	}
 	return nil
 }
 
@@ -92,6 +92,9 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of an accepted proposal vetoed by the timelock guardian
+	// while it was queued.
+	PROPOSAL_STATUS_VETOED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -101,6 +104,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_REJECTED",
 	4: "PROPOSAL_STATUS_ABORTED",
 	5: "PROPOSAL_STATUS_WITHDRAWN",
+	6: "PROPOSAL_STATUS_VETOED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -110,6 +114,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_REJECTED":    3,
 	"PROPOSAL_STATUS_ABORTED":     4,
 	"PROPOSAL_STATUS_WITHDRAWN":   5,
+	"PROPOSAL_STATUS_VETOED":      6,
 }
 
 func (x ProposalStatus) String() string {
@@ -418,6 +423,84 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// QuorumDecisionPolicy is a decision policy where a proposal passes when it
+// satisfies the three following conditions:
+//  1. The weighted sum of all votes out of the total group weight is greater or
+//     equal than the given `quorum`.
+//  2. The percentage of `YES` voters' weights out of the non-abstaining voters'
+//     weights is greater or equal than the given `threshold`.
+//  3. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// Contrary to the other decision policies, the outcome only depends on the
+// ratio of the votes cast, which makes it suitable for token-weighted groups
+// whose total weight changes between proposals.
+type QuorumDecisionPolicy struct {
+	// quorum is the minimum percentage of the total group weight that must vote
+	// for a proposal to succeed.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum percentage of the weighted sum of `YES` votes out
+	// of the weighted sum of `YES`, `NO` and `NO_WITH_VETO` votes for a proposal
+	// to succeed.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,3,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *QuorumDecisionPolicy) Reset()         { *m = QuorumDecisionPolicy{} }
+func (m *QuorumDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*QuorumDecisionPolicy) ProtoMessage()    {}
+func (*QuorumDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *QuorumDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumDecisionPolicy.Merge(m, src)
+}
+func (m *QuorumDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumDecisionPolicy proto.InternalMessageInfo
+
+func (m *QuorumDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -435,13 +518,16 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// timelock, if set, queues accepted proposals for a delay before they can be
+	// executed, giving a guardian the opportunity to veto them.
+	Timelock *Timelock `protobuf:"bytes,3,opt,name=timelock,proto3" json:"timelock,omitempty"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,6 +570,135 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetTimelock() *Timelock {
+	if m != nil {
+		return m.Timelock
+	}
+	return nil
+}
+
+// Timelock defines the delay accepted proposals are queued for before being
+// executed, and the guardian allowed to veto them during that delay.
+type Timelock struct {
+	// delay is the duration after a proposal is accepted during which it cannot
+	// be executed. Once the delay has passed, the proposal is executed
+	// automatically at the end of the block, or can be executed with MsgExec.
+	//
+	// The delay must be smaller than the app-specific max_execution_period, so
+	// that queued proposals are executed before they are pruned.
+	Delay time.Duration `protobuf:"bytes,1,opt,name=delay,proto3,stdduration" json:"delay"`
+	// guardian is the account address allowed to veto queued proposals, e.g. the
+	// group policy account of a veto group. If empty, queued proposals cannot be
+	// vetoed.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *Timelock) Reset()         { *m = Timelock{} }
+func (m *Timelock) String() string { return proto.CompactTextString(m) }
+func (*Timelock) ProtoMessage()    {}
+func (*Timelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+}
+func (m *Timelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Timelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Timelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Timelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timelock.Merge(m, src)
+}
+func (m *Timelock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Timelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timelock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timelock proto.InternalMessageInfo
+
+func (m *Timelock) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *Timelock) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// WeightSource defines where the voting weights of the members of a
+// token-weighted group are read from. The weights are snapshotted for every
+// proposal at submission time.
+type WeightSource struct {
+	// type is the name of the weight provider, as registered in the group keeper
+	// config. The built-in providers are "staking", using the member's bonded
+	// tokens, and "bank", using the member's balance of `denom`.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// denom is the denomination read by the weight provider, if any.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *WeightSource) Reset()         { *m = WeightSource{} }
+func (m *WeightSource) String() string { return proto.CompactTextString(m) }
+func (*WeightSource) ProtoMessage()    {}
+func (*WeightSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+}
+func (m *WeightSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightSource.Merge(m, src)
+}
+func (m *WeightSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightSource proto.InternalMessageInfo
+
+func (m *WeightSource) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WeightSource) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// id is the unique ID of the group.
@@ -502,13 +717,17 @@ type GroupInfo struct {
 	TotalWeight string `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
 	// created_at is a timestamp specifying when a group was created.
 	CreatedAt time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// weight_source is set for token-weighted groups. The voting weight of their
+	// members is read from the weight source when a proposal is submitted, and
+	// the weights of the group members only determine membership.
+	WeightSource *WeightSource `protobuf:"bytes,7,opt,name=weight_source,json=weightSource,proto3" json:"weight_source,omitempty"`
 }
 
 func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,6 +798,13 @@ func (m *GroupInfo) GetCreatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *GroupInfo) GetWeightSource() *WeightSource {
+	if m != nil {
+		return m.WeightSource
+	}
+	return nil
+}
+
 // GroupMember represents the relationship between a group and a member.
 type GroupMember struct {
 	// group_id is the unique ID of the group.
@@ -591,7 +817,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +885,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,13 +960,21 @@ type Proposal struct {
 	Title string `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
 	// summary is a short summary of the proposal
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// total_weight is the total weight of the group snapshotted at proposal
+	// submission. It is only set for proposals of token-weighted groups, whose
+	// votes are tallied against the snapshotted voter weights.
+	TotalWeight string `protobuf:"bytes,15,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// timelock_end is set when a proposal accepted by a decision policy with a
+	// timelock is queued. The proposal can only be executed after this time, and
+	// can be vetoed by the timelock guardian until then.
+	TimelockEnd *time.Time `protobuf:"bytes,16,opt,name=timelock_end,json=timelockEnd,proto3,stdtime" json:"timelock_end,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +1019,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1067,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,6 +1131,71 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// VoterWeight is the voting weight of a group member on a proposal of a
+// token-weighted group, snapshotted at proposal submission.
+type VoterWeight struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the account address of the group member.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// weight is the voting weight of the group member.
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *VoterWeight) Reset()         { *m = VoterWeight{} }
+func (m *VoterWeight) String() string { return proto.CompactTextString(m) }
+func (*VoterWeight) ProtoMessage()    {}
+func (*VoterWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{14}
+}
+func (m *VoterWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoterWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterWeight.Merge(m, src)
+}
+func (m *VoterWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoterWeight proto.InternalMessageInfo
+
+func (m *VoterWeight) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoterWeight) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoterWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -905,105 +1204,122 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*QuorumDecisionPolicy)(nil), "cosmos.group.v1.QuorumDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
+	proto.RegisterType((*Timelock)(nil), "cosmos.group.v1.Timelock")
+	proto.RegisterType((*WeightSource)(nil), "cosmos.group.v1.WeightSource")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*VoterWeight)(nil), "cosmos.group.v1.VoterWeight")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0x3a, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x04, 0xc5, 0x6e, 0x53, 0x44, 0xa5, 0x9e, 0xb0, 0x9d, 0x2d, 0x75, 0xd4, 0xda, 0xd6,
-	0x7a, 0x9d, 0xd0, 0x5e, 0x56, 0x1b, 0xef, 0xd4, 0x59, 0xd5, 0xde, 0x31, 0xbb, 0xe3, 0xa4, 0xfe,
-	0x0f, 0x2a, 0x2e, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0x97, 0x1e, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0x84, 0x38, 0x00, 0x6a, 0x0f, 0xe5, 0xc4, 0x89, 0x2b, 0x12, 0xda, 0x99,
-	0x59, 0xc7, 0x1f, 0xb1, 0x4b, 0xaa, 0x8a, 0x4b, 0x94, 0x99, 0xe7, 0x79, 0xdf, 0x79, 0x9f, 0xf7,
-	0x6b, 0x13, 0x58, 0x6d, 0x10, 0xb7, 0x4d, 0xdc, 0x5c, 0xd3, 0x21, 0xdd, 0x4e, 0xee, 0xf0, 0x72,
-	0x8e, 0xf6, 0x3a, 0xd8, 0xcd, 0x76, 0x1c, 0x42, 0x09, 0x4a, 0x70, 0x30, 0xcb, 0xc0, 0xec, 0xe1,
-	0xe5, 0x95, 0xc5, 0x26, 0x69, 0x12, 0x86, 0xe5, 0xbc, 0xdf, 0x38, 0x6d, 0x25, 0xd5, 0x24, 0xa4,
-	0xd9, 0xc2, 0x39, 0x76, 0xda, 0xef, 0xde, 0xcd, 0x99, 0x5d, 0xc7, 0xa0, 0x16, 0xb1, 0x05, 0x9e,
-	0x1e, 0xc5, 0xa9, 0xd5, 0xc6, 0x2e, 0x35, 0xda, 0x1d, 0x41, 0x58, 0xe6, 0xef, 0xe8, 0xdc, 0xb3,
-	0x78, 0x54, 0x40, 0xa3, 0xb6, 0x86, 0xdd, 0x13, 0xd0, 0x19, 0xa3, 0x6d, 0xd9, 0x24, 0xc7, 0x7e,
-	0xf2, 0xab, 0xcc, 0x37, 0x12, 0x84, 0x6e, 0xe1, 0xf6, 0x3e, 0x76, 0xd0, 0x16, 0x84, 0x0d, 0xd3,
-	0x74, 0xb0, 0xeb, 0xca, 0xd2, 0x9a, 0xb4, 0x1e, 0x2d, 0xc8, 0x3f, 0x3d, 0xdd, 0x5c, 0x14, 0xbe,
-	0xf3, 0x1c, 0xa9, 0x51, 0xc7, 0xb2, 0x9b, 0xaa, 0x4f, 0x44, 0xe7, 0x20, 0x74, 0x84, 0xad, 0xe6,
-	0x01, 0x95, 0x03, 0x9e, 0x89, 0x2a, 0x4e, 0x68, 0x05, 0x22, 0x6d, 0x4c, 0x0d, 0xd3, 0xa0, 0x86,
-	0x3c, 0xcb, 0x90, 0xfe, 0x19, 0x6d, 0x43, 0xc4, 0x30, 0x4d, 0x6c, 0xea, 0x06, 0x95, 0x83, 0x6b,
-	0xd2, 0x7a, 0x6c, 0x6b, 0x25, 0xcb, 0x63, 0xce, 0xfa, 0x31, 0x67, 0x35, 0x5f, 0x6f, 0x61, 0xe1,
-	0xd9, 0xaf, 0xe9, 0x99, 0x87, 0xbf, 0xa5, 0xa5, 0xc7, 0xaf, 0x9e, 0x6c, 0x48, 0xec, 0x65, 0x6c,
-	0xe6, 0x69, 0xe6, 0x08, 0x16, 0x78, 0xdc, 0x2a, 0xfe, 0xac, 0x8b, 0x5d, 0xfa, 0x5f, 0x85, 0x9f,
-	0xf9, 0x5e, 0x82, 0x25, 0xed, 0xc0, 0xc1, 0xee, 0x01, 0x69, 0x99, 0xdb, 0xb8, 0x61, 0xb9, 0x16,
-	0xb1, 0xab, 0xa4, 0x65, 0x35, 0x7a, 0xe8, 0x3c, 0x44, 0xa9, 0x0f, 0xf1, 0x28, 0xd4, 0xe3, 0x0b,
-	0xf4, 0x31, 0x84, 0x8f, 0x2c, 0xdb, 0x24, 0x47, 0x2e, 0x7b, 0x2e, 0xb6, 0xf5, 0x6e, 0x76, 0xa4,
-	0x5d, 0xb2, 0xc3, 0xfe, 0xf6, 0x38, 0x5b, 0xf5, 0xcd, 0xae, 0x95, 0x7e, 0x78, 0xba, 0x99, 0x9a,
-	0x6e, 0xf3, 0xf9, 0xab, 0x27, 0x1b, 0x19, 0x4e, 0xd9, 0x74, 0xcd, 0x7b, 0xb9, 0x09, 0xa1, 0x66,
-	0x9e, 0x49, 0x20, 0x57, 0xb1, 0xd3, 0xc0, 0x36, 0x35, 0x9a, 0x78, 0x44, 0x47, 0x0a, 0xa0, 0xd3,
-	0xc7, 0x84, 0x90, 0x81, 0x9b, 0xb7, 0xa0, 0x64, 0xe7, 0xdf, 0x29, 0xb9, 0x30, 0xa0, 0x64, 0x52,
-	0xb4, 0x99, 0xef, 0x24, 0xf8, 0xdf, 0x89, 0xcf, 0xa1, 0x5b, 0xb0, 0x70, 0x48, 0xa8, 0x65, 0x37,
-	0xf5, 0x0e, 0x76, 0x2c, 0xc2, 0x6b, 0x12, 0xdb, 0x5a, 0x1e, 0xeb, 0xb7, 0x6d, 0x31, 0x7f, 0xbc,
-	0xdd, 0xbe, 0xec, 0xb7, 0xdb, 0x3c, 0x37, 0xaf, 0x32, 0x6b, 0x74, 0x07, 0x16, 0xdb, 0x96, 0xad,
-	0xe3, 0xfb, 0xb8, 0xd1, 0xf5, 0xd8, 0xbe, 0xd7, 0xc0, 0x29, 0xbd, 0xa2, 0xb6, 0x65, 0x2b, 0xbe,
-	0x13, 0xee, 0x3b, 0xf3, 0xa7, 0x04, 0xd1, 0x4f, 0xbc, 0x44, 0x94, 0xec, 0xbb, 0x04, 0xc5, 0x21,
-	0x60, 0xf1, 0x68, 0x83, 0x6a, 0xc0, 0x32, 0x51, 0x16, 0xe6, 0x0c, 0xb3, 0x6d, 0xd9, 0xbc, 0x4f,
-	0xa7, 0xb4, 0x36, 0xa7, 0x4d, 0x9d, 0x3f, 0x19, 0xc2, 0x87, 0xd8, 0xf1, 0x92, 0xc5, 0xc6, 0x2f,
-	0xa8, 0xfa, 0x47, 0xf4, 0x0e, 0xcc, 0x53, 0x42, 0x8d, 0x96, 0x2e, 0x86, 0x62, 0x8e, 0x59, 0xc6,
-	0xd8, 0xdd, 0x1e, 0x9f, 0x8c, 0x1b, 0x00, 0x0d, 0x07, 0x1b, 0x94, 0x8f, 0x6f, 0xe8, 0xb4, 0xe3,
-	0x1b, 0x15, 0xc6, 0x79, 0x9a, 0xb9, 0x0d, 0x31, 0xa6, 0x57, 0x6c, 0x9f, 0x65, 0x88, 0xb0, 0x3e,
-	0xd0, 0xfb, 0xba, 0xc3, 0xec, 0x5c, 0x32, 0x51, 0x0e, 0x42, 0x6d, 0x46, 0x12, 0x89, 0x5e, 0x1a,
-	0x6b, 0x36, 0xb1, 0x09, 0x04, 0x2d, 0xf3, 0x77, 0x00, 0x12, 0xcc, 0x37, 0xef, 0x06, 0x96, 0xd1,
-	0x37, 0x59, 0x0f, 0x83, 0x31, 0x05, 0x86, 0x63, 0xea, 0x17, 0x64, 0xf6, 0xf4, 0x05, 0x09, 0x4e,
-	0x2e, 0xc8, 0xdc, 0x70, 0x41, 0x0c, 0x48, 0x98, 0xa2, 0xb1, 0xf5, 0x0e, 0xd3, 0x22, 0x52, 0xbe,
-	0x38, 0x96, 0xf2, 0xbc, 0xdd, 0x2b, 0x64, 0x5e, 0x3f, 0x54, 0x6a, 0xdc, 0x1c, 0x1e, 0xf5, 0xe1,
-	0x82, 0x86, 0xdf, 0xbc, 0xa0, 0xd7, 0x22, 0x0f, 0x1e, 0xa5, 0x67, 0xfe, 0x78, 0x94, 0x96, 0x32,
-	0x8f, 0x43, 0x10, 0xa9, 0x3a, 0xa4, 0x43, 0x5c, 0xa3, 0x35, 0xd6, 0xca, 0x3b, 0xb0, 0xc8, 0x93,
-	0xca, 0x05, 0xe9, 0x7e, 0x55, 0x5e, 0xd7, 0xd9, 0xa8, 0x79, 0x5c, 0x51, 0x81, 0x4c, 0x6d, 0xf3,
-	0x8f, 0x20, 0xda, 0x61, 0x31, 0x60, 0xc7, 0x95, 0x83, 0x6b, 0xb3, 0x53, 0x9d, 0x1f, 0x53, 0xd1,
-	0x0e, 0xc4, 0xdc, 0xee, 0x7e, 0xdb, 0xa2, 0xba, 0xf7, 0xd1, 0x65, 0x15, 0x39, 0x55, 0x46, 0x80,
-	0x5b, 0x7b, 0x38, 0xba, 0x00, 0x0b, 0x5c, 0xab, 0x5f, 0xdf, 0x10, 0x4b, 0xc3, 0x3c, 0xbb, 0xdc,
-	0x15, 0x45, 0xbe, 0x34, 0x92, 0x10, 0x9f, 0x1b, 0x66, 0xdc, 0x41, 0xd9, 0xbe, 0xc5, 0x55, 0x08,
-	0xb9, 0xd4, 0xa0, 0x5d, 0x57, 0x8e, 0xac, 0x49, 0xeb, 0xf1, 0xad, 0xf4, 0xd8, 0x40, 0xf8, 0xd9,
-	0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0e, 0xe8, 0xae, 0x65, 0x1b, 0x2d, 0x9d, 0x1a, 0xad, 0x56,
-	0x4f, 0x77, 0xb0, 0xdb, 0x6d, 0x51, 0x39, 0xca, 0x24, 0x9e, 0x1f, 0x73, 0xa2, 0x79, 0x24, 0x95,
-	0x71, 0x0a, 0x51, 0x4f, 0x24, 0x17, 0x98, 0x64, 0x2e, 0x06, 0x40, 0x54, 0x87, 0x33, 0x43, 0x6b,
-	0x56, 0xc7, 0xb6, 0x29, 0xc3, 0x69, 0x13, 0x97, 0x18, 0xdc, 0xb5, 0x8a, 0x6d, 0xa2, 0x2a, 0x24,
-	0xf8, 0xaa, 0x25, 0x8e, 0x1f, 0x6a, 0x8c, 0xe9, 0x7d, 0x6f, 0xa2, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x1c, 0x0f, 0x9d, 0xd1, 0x25, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x62, 0x57, 0x9e, 0x5f, 0x9b,
-	0x9d, 0x34, 0x48, 0x6a, 0x9f, 0x85, 0xde, 0x87, 0x39, 0x6a, 0xd1, 0x16, 0x96, 0x17, 0x58, 0x7b,
-	0x9e, 0xfd, 0xe5, 0xe9, 0x66, 0xe2, 0xf8, 0x9b, 0xb4, 0x76, 0x29, 0xfb, 0xe1, 0x55, 0x95, 0x33,
-	0xd0, 0x26, 0x84, 0xdd, 0x6e, 0xbb, 0x6d, 0x38, 0x3d, 0x39, 0x3e, 0x99, 0xec, 0x73, 0xae, 0x05,
-	0xbd, 0x71, 0xc9, 0x7c, 0x25, 0x41, 0x6c, 0x30, 0x95, 0xab, 0x10, 0xed, 0x61, 0x57, 0x6f, 0x90,
-	0xae, 0x4d, 0xc5, 0x87, 0x37, 0xd2, 0xc3, 0x6e, 0xd1, 0x3b, 0x7b, 0xed, 0x64, 0xec, 0xbb, 0xd4,
-	0xb0, 0x6c, 0x41, 0xe0, 0x7f, 0xb5, 0xcc, 0x8b, 0x4b, 0x4e, 0x5a, 0x86, 0x88, 0x4d, 0x04, 0xce,
-	0x67, 0x22, 0x6c, 0x13, 0x0e, 0x7d, 0x00, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c,
-	0x7d, 0x12, 0x5f, 0x47, 0x09, 0x9b, 0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xf1, 0xfd,
-	0x25, 0x41, 0x70, 0x97, 0x50, 0x8c, 0xd2, 0x10, 0xeb, 0x88, 0x24, 0x1f, 0xaf, 0x68, 0xf0, 0xaf,
-	0xf8, 0x46, 0x3c, 0x24, 0x54, 0x2c, 0xe9, 0xa9, 0x1b, 0x91, 0xd1, 0xd0, 0x15, 0x08, 0x91, 0x8e,
-	0xf7, 0x01, 0x64, 0x51, 0xc6, 0xb7, 0x56, 0xc7, 0x8a, 0xea, 0xbd, 0x5b, 0x61, 0x14, 0x55, 0x50,
-	0xa7, 0xae, 0xd1, 0xb7, 0x38, 0xb8, 0x1b, 0x5f, 0x48, 0x00, 0xc7, 0xcf, 0xa3, 0x55, 0x58, 0xda,
-	0xad, 0x68, 0x8a, 0x5e, 0xa9, 0x6a, 0xa5, 0x4a, 0x59, 0xaf, 0x97, 0x6b, 0x55, 0xa5, 0x58, 0xba,
-	0x5e, 0x52, 0xb6, 0x93, 0x33, 0xe8, 0x2c, 0x24, 0x06, 0xc1, 0xdb, 0x4a, 0x2d, 0x29, 0xa1, 0x25,
-	0x38, 0x3b, 0x78, 0x99, 0x2f, 0xd4, 0xb4, 0x7c, 0xa9, 0x9c, 0x0c, 0x20, 0x04, 0xf1, 0x41, 0xa0,
-	0x5c, 0x49, 0xce, 0xa2, 0xf3, 0x20, 0x0f, 0xdf, 0xe9, 0x7b, 0x25, 0xed, 0x86, 0xbe, 0xab, 0x68,
-	0x95, 0x64, 0x70, 0x25, 0xf8, 0xe0, 0xeb, 0xd4, 0xcc, 0xc6, 0x8f, 0x12, 0xc4, 0x87, 0xa7, 0x1a,
-	0xa5, 0x61, 0xb5, 0xaa, 0x56, 0xaa, 0x95, 0x5a, 0xfe, 0xa6, 0x5e, 0xd3, 0xf2, 0x5a, 0xbd, 0x36,
-	0x12, 0xd9, 0xff, 0x61, 0x79, 0x94, 0x50, 0xab, 0x17, 0x6e, 0x95, 0x34, 0x4d, 0xd9, 0x4e, 0x4a,
-	0xde, 0xb3, 0xa3, 0x70, 0xbe, 0x58, 0x54, 0xaa, 0x1e, 0x1a, 0x38, 0x09, 0x55, 0x95, 0x1d, 0xa5,
-	0xe8, 0xa1, 0xb3, 0x5e, 0x46, 0xc6, 0x6c, 0x0b, 0x15, 0xd5, 0x03, 0x83, 0x27, 0xbd, 0xeb, 0x09,
-	0xda, 0x56, 0xf3, 0x7b, 0xe5, 0xe4, 0x9c, 0x10, 0xf4, 0xad, 0x04, 0xe7, 0x4e, 0x1e, 0x5b, 0xb4,
-	0x0e, 0x17, 0xfb, 0xf6, 0xca, 0xa7, 0x4a, 0xb1, 0xae, 0x55, 0x54, 0x5d, 0x55, 0x6a, 0xf5, 0x9b,
-	0xda, 0x88, 0xc2, 0x8b, 0xb0, 0x36, 0x91, 0x59, 0xae, 0x68, 0xba, 0x5a, 0x2f, 0x27, 0xa5, 0xa9,
-	0xac, 0x5a, 0xbd, 0x58, 0x54, 0x6a, 0xb5, 0x64, 0x60, 0x2a, 0xeb, 0x7a, 0xbe, 0x74, 0xb3, 0xae,
-	0x2a, 0xc9, 0x59, 0x1e, 0x7c, 0x21, 0xfb, 0xec, 0x45, 0x4a, 0x7a, 0xfe, 0x22, 0x25, 0xfd, 0xfe,
-	0x22, 0x25, 0x3d, 0x7c, 0x99, 0x9a, 0x79, 0xfe, 0x32, 0x35, 0xf3, 0xf3, 0xcb, 0xd4, 0xcc, 0x1d,
-	0xd1, 0xf3, 0xae, 0x79, 0x2f, 0x6b, 0x91, 0xdc, 0x7d, 0xfe, 0x6f, 0xe2, 0x7e, 0x88, 0xb5, 0xdf,
-	0x95, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x6e, 0xa9, 0xde, 0x3d, 0x0e, 0x00, 0x00,
+	// 1573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0xd6, 0x92, 0x14, 0x7f, 0x1e, 0x29, 0x92, 0x1e, 0x2b, 0xd2, 0x4a, 0xb2, 0x49, 0x65, 0x6d,
+	0x24, 0x8a, 0x02, 0x91, 0xb6, 0xec, 0xc4, 0x81, 0x8b, 0x20, 0x24, 0xb5, 0x8e, 0x29, 0xd8, 0x22,
+	0xb3, 0x24, 0xa5, 0xd8, 0xcd, 0x62, 0xc5, 0x1d, 0x53, 0x0b, 0x93, 0x3b, 0xf4, 0xee, 0x50, 0x32,
+	0xbb, 0x94, 0x46, 0x9a, 0xb8, 0x4c, 0x13, 0xc0, 0x40, 0x9a, 0x94, 0x46, 0x60, 0xa4, 0x48, 0x9d,
+	0xc2, 0x48, 0x65, 0xa4, 0x08, 0x0e, 0x57, 0xdc, 0x1d, 0xec, 0xc2, 0x87, 0xab, 0xaf, 0xb9, 0xe2,
+	0x80, 0xc3, 0xce, 0xcc, 0x52, 0xfc, 0x91, 0x28, 0xcb, 0x30, 0xae, 0x11, 0x38, 0xf3, 0x7d, 0x6f,
+	0xe6, 0x7d, 0xef, 0xbd, 0x79, 0x4f, 0x24, 0xac, 0x34, 0x89, 0xdb, 0x21, 0x6e, 0xbe, 0xe5, 0x90,
+	0x5e, 0x37, 0x7f, 0x78, 0x3d, 0x4f, 0xfb, 0x5d, 0xec, 0xe6, 0xba, 0x0e, 0xa1, 0x04, 0xa5, 0x38,
+	0x98, 0x63, 0x60, 0xee, 0xf0, 0xfa, 0xf2, 0x7c, 0x8b, 0xb4, 0x08, 0xc3, 0xf2, 0xde, 0x27, 0x4e,
+	0x5b, 0xce, 0xb4, 0x08, 0x69, 0xb5, 0x71, 0x9e, 0xad, 0xf6, 0x7b, 0x8f, 0xf2, 0x66, 0xcf, 0x31,
+	0xa8, 0x45, 0x6c, 0x81, 0x67, 0xc7, 0x71, 0x6a, 0x75, 0xb0, 0x4b, 0x8d, 0x4e, 0x57, 0x10, 0x96,
+	0xf8, 0x3d, 0x3a, 0x3f, 0x59, 0x5c, 0x2a, 0xa0, 0x71, 0x5b, 0xc3, 0xee, 0x0b, 0xe8, 0x82, 0xd1,
+	0xb1, 0x6c, 0x92, 0x67, 0x7f, 0xf9, 0x96, 0xf2, 0x2f, 0x09, 0xc2, 0xf7, 0x71, 0x67, 0x1f, 0x3b,
+	0x68, 0x13, 0x22, 0x86, 0x69, 0x3a, 0xd8, 0x75, 0x65, 0x69, 0x55, 0x5a, 0x8b, 0x15, 0xe5, 0xff,
+	0xbd, 0xda, 0x98, 0x17, 0x67, 0x17, 0x38, 0x52, 0xa3, 0x8e, 0x65, 0xb7, 0x34, 0x9f, 0x88, 0x16,
+	0x20, 0x7c, 0x84, 0xad, 0xd6, 0x01, 0x95, 0x03, 0x9e, 0x89, 0x26, 0x56, 0x68, 0x19, 0xa2, 0x1d,
+	0x4c, 0x0d, 0xd3, 0xa0, 0x86, 0x1c, 0x64, 0xc8, 0x60, 0x8d, 0xb6, 0x20, 0x6a, 0x98, 0x26, 0x36,
+	0x75, 0x83, 0xca, 0xa1, 0x55, 0x69, 0x2d, 0xbe, 0xb9, 0x9c, 0xe3, 0x3e, 0xe7, 0x7c, 0x9f, 0x73,
+	0x75, 0x5f, 0x6f, 0x71, 0xee, 0xf5, 0x17, 0xd9, 0x99, 0xe7, 0x5f, 0x66, 0xa5, 0x7f, 0xbc, 0x7f,
+	0xb9, 0x2e, 0xb1, 0x9b, 0xb1, 0x59, 0xa0, 0xca, 0x11, 0xcc, 0x71, 0xbf, 0x35, 0xfc, 0xa4, 0x87,
+	0x5d, 0xfa, 0x63, 0xb9, 0xaf, 0xfc, 0x47, 0x82, 0xc5, 0xfa, 0x81, 0x83, 0xdd, 0x03, 0xd2, 0x36,
+	0xb7, 0x70, 0xd3, 0x72, 0x2d, 0x62, 0x57, 0x49, 0xdb, 0x6a, 0xf6, 0xd1, 0x25, 0x88, 0x51, 0x1f,
+	0xe2, 0x5e, 0x68, 0xc7, 0x1b, 0xe8, 0x77, 0x10, 0x39, 0xb2, 0x6c, 0x93, 0x1c, 0xb9, 0xec, 0xba,
+	0xf8, 0xe6, 0xcf, 0x72, 0x63, 0xe5, 0x92, 0x1b, 0x3d, 0x6f, 0x8f, 0xb3, 0x35, 0xdf, 0xec, 0x76,
+	0xf9, 0xbf, 0xaf, 0x36, 0x32, 0xd3, 0x6d, 0xfe, 0xfc, 0xfe, 0xe5, 0xba, 0xc2, 0x29, 0x1b, 0xae,
+	0xf9, 0x38, 0x7f, 0x8a, 0xab, 0xca, 0x6b, 0x09, 0xe4, 0x2a, 0x76, 0x9a, 0xd8, 0xa6, 0x46, 0x0b,
+	0x8f, 0xe9, 0xc8, 0x00, 0x74, 0x07, 0x98, 0x10, 0x32, 0xb4, 0xf3, 0x09, 0x94, 0x6c, 0x7f, 0x98,
+	0x92, 0x2b, 0x43, 0x4a, 0x4e, 0xf3, 0x56, 0xf9, 0xbf, 0x04, 0xf3, 0x7f, 0xe8, 0x11, 0xa7, 0xd7,
+	0x19, 0x93, 0xb1, 0x00, 0xe1, 0x27, 0x6c, 0x5f, 0x48, 0x10, 0xab, 0xd1, 0x34, 0x05, 0xa6, 0xa4,
+	0x29, 0xf8, 0x71, 0xe2, 0xee, 0x7c, 0x98, 0xb8, 0xec, 0x90, 0xb8, 0x93, 0xfc, 0x57, 0xbe, 0x93,
+	0xe0, 0x27, 0x27, 0x5e, 0x85, 0xee, 0xc3, 0xdc, 0x21, 0xa1, 0x96, 0xdd, 0xd2, 0xbb, 0xd8, 0xb1,
+	0x08, 0x2f, 0xb6, 0xf8, 0xe6, 0xd2, 0xc4, 0x43, 0xda, 0x12, 0x8d, 0x85, 0xbf, 0xa3, 0xbf, 0x0e,
+	0xde, 0x51, 0x82, 0x9b, 0x57, 0x99, 0x35, 0x7a, 0x08, 0xf3, 0x1d, 0xcb, 0xd6, 0xf1, 0x53, 0xdc,
+	0xec, 0x79, 0x6c, 0xff, 0xd4, 0xc0, 0x39, 0x4f, 0x45, 0x1d, 0xcb, 0x56, 0xfd, 0x43, 0xc4, 0xd9,
+	0xbf, 0x82, 0xa8, 0xd7, 0xbd, 0xda, 0xa4, 0xf9, 0x58, 0xc4, 0x73, 0x69, 0x22, 0x9e, 0x75, 0x41,
+	0xd0, 0x06, 0x54, 0xe5, 0x4f, 0x12, 0x44, 0xfd, 0x6d, 0xf4, 0x5b, 0x98, 0x35, 0x71, 0xdb, 0xe8,
+	0x9f, 0x5b, 0x26, 0x37, 0x43, 0x37, 0x21, 0xda, 0xea, 0x19, 0x8e, 0x69, 0x19, 0xb6, 0x1c, 0x38,
+	0xa3, 0x39, 0x0c, 0x98, 0xca, 0x6f, 0x20, 0xb1, 0xc7, 0xfa, 0x41, 0x8d, 0xf4, 0x9c, 0x26, 0x46,
+	0x08, 0x42, 0x5e, 0xaf, 0x17, 0xc5, 0xc4, 0x3e, 0xa3, 0x79, 0xcf, 0x33, 0x9b, 0x74, 0x44, 0x19,
+	0xf1, 0x85, 0xf2, 0xcf, 0x00, 0xc4, 0x7e, 0xef, 0x89, 0x2b, 0xdb, 0x8f, 0x08, 0x4a, 0x42, 0xc0,
+	0xe2, 0x19, 0x0a, 0x69, 0x01, 0xcb, 0x44, 0x39, 0x98, 0x35, 0xcc, 0x8e, 0x75, 0xb6, 0x2b, 0x9c,
+	0x36, 0xb5, 0x99, 0xca, 0x10, 0x39, 0xc4, 0x8e, 0x57, 0x20, 0xac, 0x97, 0x86, 0x34, 0x7f, 0x89,
+	0x7e, 0x0a, 0x09, 0x4a, 0xa8, 0xd1, 0xd6, 0x45, 0x87, 0x9b, 0x65, 0x96, 0x71, 0xb6, 0xc7, 0x65,
+	0xa1, 0xbb, 0x00, 0x4d, 0x07, 0x1b, 0x94, 0xf7, 0xe2, 0xf0, 0x79, 0x7b, 0x71, 0x4c, 0x18, 0x17,
+	0x28, 0x2a, 0xc2, 0x1c, 0xbf, 0x46, 0x77, 0x59, 0xac, 0xe4, 0x08, 0x3b, 0xec, 0xf2, 0x44, 0xa6,
+	0x87, 0x03, 0xaa, 0x25, 0x8e, 0x86, 0x56, 0xca, 0x03, 0x88, 0xb3, 0x98, 0x89, 0x71, 0xb4, 0x04,
+	0x51, 0x66, 0xa5, 0x0f, 0x62, 0x17, 0x61, 0xeb, 0xb2, 0x89, 0xf2, 0x10, 0xee, 0x30, 0x92, 0x28,
+	0xd0, 0xc5, 0x89, 0x6b, 0xc4, 0x68, 0x10, 0x34, 0xe5, 0xfb, 0x00, 0xa4, 0xd8, 0xd9, 0xfc, 0x15,
+	0xb1, 0xac, 0x7c, 0xcc, 0xbc, 0x18, 0xf6, 0x29, 0x30, 0xea, 0xd3, 0x20, 0xa9, 0xc1, 0xf3, 0x27,
+	0x35, 0x74, 0x7a, 0x52, 0x67, 0x47, 0x93, 0x6a, 0x40, 0xca, 0x14, 0x0d, 0x41, 0xef, 0x32, 0x2d,
+	0x22, 0x6d, 0xf3, 0x13, 0x69, 0x2b, 0xd8, 0xfd, 0xa2, 0x72, 0x76, 0x23, 0xd2, 0x92, 0xe6, 0xc8,
+	0x7a, 0xac, 0x28, 0x22, 0x1f, 0x5f, 0x14, 0xb7, 0xa3, 0xcf, 0x5e, 0x64, 0x67, 0xbe, 0x7e, 0x91,
+	0x95, 0x94, 0xe7, 0x11, 0x88, 0x56, 0x1d, 0xd2, 0x25, 0xae, 0xd1, 0x9e, 0x78, 0x0e, 0xdb, 0x30,
+	0xcf, 0x83, 0xca, 0x05, 0xe9, 0x7e, 0x56, 0xce, 0x7a, 0x1d, 0xa8, 0x75, 0x9c, 0x51, 0x81, 0x4c,
+	0x7d, 0x2a, 0xbf, 0x86, 0x58, 0x97, 0xf9, 0x80, 0x1d, 0x57, 0x0e, 0xad, 0x06, 0xa7, 0x1e, 0x7e,
+	0x4c, 0x45, 0xdb, 0x10, 0x77, 0x7b, 0xfb, 0x1d, 0x8b, 0xea, 0x5e, 0x73, 0x92, 0x67, 0xcf, 0x1b,
+	0x11, 0xe0, 0xd6, 0x1e, 0x8e, 0xae, 0xc0, 0x1c, 0xd7, 0xea, 0xe7, 0x37, 0xcc, 0xc2, 0x90, 0x60,
+	0x9b, 0xbb, 0x22, 0xc9, 0xd7, 0xc6, 0x02, 0xe2, 0x73, 0x23, 0x8c, 0x3b, 0x2c, 0xdb, 0xb7, 0xb8,
+	0x05, 0x61, 0x97, 0x1a, 0xb4, 0xe7, 0xca, 0xd1, 0x55, 0x69, 0x2d, 0xb9, 0x99, 0x9d, 0x78, 0x10,
+	0x7e, 0xf4, 0x6b, 0x8c, 0xa6, 0x09, 0x3a, 0x6a, 0x00, 0x7a, 0x64, 0xd9, 0x46, 0x5b, 0xa7, 0x46,
+	0xbb, 0xdd, 0xd7, 0x1d, 0xec, 0xf6, 0xda, 0x54, 0x8e, 0x31, 0x89, 0x97, 0x26, 0xdb, 0xb4, 0x47,
+	0xd2, 0x18, 0xa7, 0x18, 0xf3, 0x44, 0x72, 0x81, 0x69, 0x76, 0xc4, 0x10, 0x88, 0x1a, 0x70, 0x61,
+	0x64, 0x3c, 0xe9, 0xd8, 0x36, 0x65, 0x38, 0x6f, 0xe0, 0x52, 0xc3, 0x33, 0x4a, 0xb5, 0x4d, 0x54,
+	0x85, 0x14, 0x1f, 0x51, 0xc4, 0xf1, 0x5d, 0x8d, 0x33, 0xbd, 0x3f, 0x3f, 0x55, 0xaf, 0x2a, 0xf8,
+	0xdc, 0x31, 0x2d, 0x89, 0x47, 0xd6, 0xe8, 0x9a, 0x57, 0x2f, 0xae, 0x6b, 0xb4, 0xb0, 0x2b, 0x27,
+	0x56, 0x83, 0xa7, 0x3d, 0x24, 0x6d, 0xc0, 0x42, 0xbf, 0x80, 0x59, 0x6a, 0xd1, 0x36, 0x96, 0xe7,
+	0x58, 0x79, 0x5e, 0xfc, 0xfc, 0xd5, 0x46, 0xea, 0x78, 0x8e, 0xaf, 0x5e, 0xcb, 0xdd, 0xbc, 0xa5,
+	0x71, 0x06, 0xda, 0x80, 0x88, 0xdb, 0xeb, 0x74, 0x0c, 0xa7, 0x2f, 0x27, 0x4f, 0x27, 0xfb, 0x9c,
+	0x89, 0x86, 0x9d, 0x9a, 0x6c, 0xd8, 0x25, 0x48, 0xf8, 0x03, 0x92, 0x85, 0x34, 0x7d, 0x66, 0x48,
+	0x43, 0x5e, 0x38, 0xb5, 0xb8, 0x6f, 0xa5, 0xda, 0xe6, 0xed, 0x90, 0xf7, 0x2c, 0x95, 0xbf, 0x49,
+	0x10, 0x1f, 0x4e, 0xd9, 0x0a, 0xc4, 0xfa, 0xd8, 0xd5, 0x9b, 0xa4, 0x67, 0x53, 0x31, 0xe1, 0xa2,
+	0x7d, 0xec, 0x96, 0xbc, 0xb5, 0x57, 0xb6, 0xc6, 0xbe, 0x4b, 0x0d, 0xcb, 0x16, 0x04, 0x3e, 0xed,
+	0x12, 0x62, 0x93, 0x93, 0x96, 0x20, 0x6a, 0x13, 0x81, 0xf3, 0xb7, 0x17, 0xb1, 0x09, 0x87, 0x7e,
+	0x09, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c, 0x7d, 0x12, 0x6f, 0x7b, 0x29, 0x9b,
+	0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xfe, 0x7d, 0x2b, 0x41, 0x68, 0x97, 0x50, 0x8c,
+	0xb2, 0x10, 0xef, 0x8a, 0x64, 0x1e, 0x8f, 0x02, 0xf0, 0xb7, 0x78, 0xe7, 0x3d, 0x24, 0x54, 0x0c,
+	0x83, 0xa9, 0x9d, 0x97, 0xd1, 0xd0, 0x0d, 0x08, 0x93, 0xae, 0xf7, 0xcf, 0x02, 0xf3, 0x32, 0xb9,
+	0xb9, 0x32, 0x51, 0x3c, 0xde, 0xbd, 0x15, 0x46, 0xd1, 0x04, 0x75, 0x6a, 0xbb, 0xfe, 0x84, 0x0d,
+	0x42, 0x39, 0x84, 0xb8, 0x77, 0xbb, 0x23, 0x12, 0xfe, 0xc9, 0xc5, 0x1f, 0x7f, 0xe3, 0x09, 0x0e,
+	0x7f, 0xe3, 0x59, 0xff, 0x8b, 0x04, 0x70, 0x2c, 0x1b, 0xad, 0xc0, 0xe2, 0x6e, 0xa5, 0xae, 0xea,
+	0x95, 0x6a, 0xbd, 0x5c, 0xd9, 0xd1, 0x1b, 0x3b, 0xb5, 0xaa, 0x5a, 0x2a, 0xdf, 0x29, 0xab, 0x5b,
+	0xe9, 0x19, 0x74, 0x11, 0x52, 0xc3, 0xe0, 0x03, 0xb5, 0x96, 0x96, 0xd0, 0x22, 0x5c, 0x1c, 0xde,
+	0x2c, 0x14, 0x6b, 0xf5, 0x42, 0x79, 0x27, 0x1d, 0x40, 0x08, 0x92, 0xc3, 0xc0, 0x4e, 0x25, 0x1d,
+	0x44, 0x97, 0x40, 0x1e, 0xdd, 0xd3, 0xf7, 0xca, 0xf5, 0xbb, 0xfa, 0xae, 0x5a, 0xaf, 0xa4, 0x43,
+	0xcb, 0xa1, 0x67, 0x7f, 0xcf, 0xcc, 0xac, 0x7f, 0x23, 0x41, 0x72, 0xb4, 0x6b, 0xa1, 0x2c, 0xac,
+	0x54, 0xb5, 0x4a, 0xb5, 0x52, 0x2b, 0xdc, 0xd3, 0x6b, 0xf5, 0x42, 0xbd, 0x51, 0x1b, 0xf3, 0xec,
+	0x32, 0x2c, 0x8d, 0x13, 0x6a, 0x8d, 0xe2, 0xfd, 0x72, 0xbd, 0xae, 0x6e, 0xa5, 0x25, 0xef, 0xda,
+	0x71, 0xb8, 0x50, 0x2a, 0xa9, 0x55, 0x0f, 0x0d, 0x9c, 0x84, 0x6a, 0xea, 0xb6, 0x5a, 0xf2, 0xd0,
+	0xa0, 0x17, 0x91, 0x09, 0xdb, 0x62, 0x45, 0xf3, 0xc0, 0xd0, 0x49, 0xf7, 0x7a, 0x82, 0xb6, 0xb4,
+	0xc2, 0xde, 0x4e, 0x7a, 0x16, 0x2d, 0xc3, 0xc2, 0x38, 0xec, 0x49, 0x55, 0xb7, 0xd2, 0x61, 0x21,
+	0xf6, 0xdf, 0x12, 0x2c, 0x9c, 0xdc, 0xb2, 0xd0, 0x1a, 0x5c, 0x1d, 0x18, 0xab, 0x7f, 0x54, 0x4b,
+	0x8d, 0x7a, 0x45, 0xd3, 0x35, 0xb5, 0xd6, 0xb8, 0x57, 0x1f, 0x53, 0x7f, 0x15, 0x56, 0x4f, 0x65,
+	0xee, 0x54, 0xea, 0xba, 0xd6, 0xd8, 0x49, 0x4b, 0x53, 0x59, 0xb5, 0x46, 0xa9, 0xa4, 0xd6, 0x6a,
+	0xe9, 0xc0, 0x54, 0xd6, 0x9d, 0x42, 0xf9, 0x5e, 0x43, 0x53, 0xd3, 0x41, 0xee, 0x7c, 0x31, 0xf7,
+	0xfa, 0x6d, 0x46, 0x7a, 0xf3, 0x36, 0x23, 0x7d, 0xf5, 0x36, 0x23, 0x3d, 0x7f, 0x97, 0x99, 0x79,
+	0xf3, 0x2e, 0x33, 0xf3, 0xd9, 0xbb, 0xcc, 0xcc, 0x43, 0x51, 0x8a, 0xae, 0xf9, 0x38, 0x67, 0x91,
+	0xfc, 0x53, 0xfe, 0x9b, 0xc9, 0x7e, 0x98, 0x3d, 0x89, 0x1b, 0x3f, 0x0c, 0x00, 0xf2, 0x43, 0x6c,
+	0x7a, 0x4a, 0x11, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1228,7 +1544,7 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
+func (m *QuorumDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1238,36 +1554,46 @@ func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DecisionPolicyWindows) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuorumDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuorumDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1277,59 +1603,48 @@ func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecisionPolicyWindows) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if m.Timelock != nil {
+		{
+			size, err := m.Timelock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err6 != nil {
 		return 0, err6
 	}
 	i -= n6
 	i = encodeVarintTypes(dAtA, i, uint64(n6))
 	i--
-	dAtA[i] = 0x32
-	if len(m.TotalWeight) > 0 {
-		i -= len(m.TotalWeight)
-		copy(dAtA[i:], m.TotalWeight)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	dAtA[i] = 0x12
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupMember) Marshal() (dAtA []byte, err error) {
+func (m *Timelock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1339,29 +1654,178 @@ func (m *GroupMember) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
+func (m *Timelock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Timelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Member != nil {
-		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Guardian)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.GroupId != 0 {
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightSource != nil {
+		{
+			size, err := m.WeightSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x32
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
 		i--
 		dAtA[i] = 0x8
@@ -1389,12 +1853,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1463,6 +1927,25 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimelockEnd != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimelockEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTypes(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1496,12 +1979,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1529,12 +2012,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1639,12 +2122,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n18
+	i = encodeVarintTypes(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1674,6 +2157,48 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoterWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoterWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1763,6 +2288,27 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *QuorumDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1773,6 +2319,42 @@ func (m *DecisionPolicyWindows) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Timelock != nil {
+		l = m.Timelock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Timelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WeightSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1802,6 +2384,10 @@ func (m *GroupInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.WeightSource != nil {
+		l = m.WeightSource.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1909,9 +2495,17 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	return n
-}
-
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TimelockEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *TallyResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1962,6 +2556,26 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoterWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2529,7 +3143,424 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+func (m *QuorumDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timelock == nil {
+				m.Timelock = &Timelock{}
+			}
+			if err := m.Timelock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2552,17 +3583,17 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2572,30 +3603,29 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2605,24 +3635,23 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2841,6 +3870,42 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightSource == nil {
+				m.WeightSource = &WeightSource{}
+			}
+			if err := m.WeightSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3637,6 +4702,74 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockEnd == nil {
+				m.TimelockEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimelockEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4021,6 +5154,139 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoterWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/group"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestThresholdDecisionPolicyValidate(t *testing.T) {
@@ -386,3 +388,171 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestQuorumDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.QuorumDecisionPolicy
+		expErr bool
+	}{
+		{
+			"valid policy",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "0.5", Windows: &group.DecisionPolicyWindows{VotingPeriod: time.Second}},
+			false,
+		},
+		{
+			"zero quorum",
+			group.QuorumDecisionPolicy{Quorum: "0", Threshold: "0.5", Windows: &group.DecisionPolicyWindows{VotingPeriod: time.Second}},
+			true,
+		},
+		{
+			"threshold > 1",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "1.5", Windows: &group.DecisionPolicyWindows{VotingPeriod: time.Second}},
+			true,
+		},
+		{
+			"zero voting period",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "0.5", Windows: &group.DecisionPolicyWindows{}},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTimelockValidateBasic(t *testing.T) {
+	guardian := sdk.AccAddress("guardian").String()
+	testCases := []struct {
+		name     string
+		timelock *group.Timelock
+		expErr   string
+	}{
+		{
+			"no timelock",
+			nil,
+			"",
+		},
+		{
+			"valid timelock",
+			&group.Timelock{Delay: time.Hour, Guardian: guardian},
+			"",
+		},
+		{
+			"valid timelock without guardian",
+			&group.Timelock{Delay: time.Hour},
+			"",
+		},
+		{
+			"zero delay",
+			&group.Timelock{Guardian: guardian},
+			"timelock delay must be positive",
+		},
+		{
+			"invalid guardian",
+			&group.Timelock{Delay: time.Hour, Guardian: "invalid"},
+			"timelock guardian",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := group.QuorumDecisionPolicy{
+				Quorum:    "0.4",
+				Threshold: "0.5",
+				Windows:   &group.DecisionPolicyWindows{VotingPeriod: time.Second, Timelock: tc.timelock},
+			}
+			err := policy.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQuorumDecisionPolicyAllow(t *testing.T) {
+	policy := &group.QuorumDecisionPolicy{
+		Quorum:    "0.4",
+		Threshold: "0.5",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: time.Second * 100,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		tally      group.TallyResult
+		totalPower string
+		result     group.DecisionPolicyResult
+	}{
+		{
+			"no votes",
+			group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"100",
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"quorum not reached yet, yes majority",
+			group.TallyResult{YesCount: "30", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"100",
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"quorum reached, yes majority not final",
+			group.TallyResult{YesCount: "30", NoCount: "20", AbstainCount: "0", NoWithVetoCount: "0"},
+			"100",
+			group.DecisionPolicyResult{Allow: true, Final: false},
+		},
+		{
+			"quorum reached, no majority not final",
+			group.TallyResult{YesCount: "20", NoCount: "30", AbstainCount: "0", NoWithVetoCount: "0"},
+			"100",
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"yes cannot be outvoted",
+			group.TallyResult{YesCount: "50", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"100",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"abstain counts towards quorum only",
+			group.TallyResult{YesCount: "30", NoCount: "0", AbstainCount: "40", NoWithVetoCount: "0"},
+			"100",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"no and veto cannot be outvoted",
+			group.TallyResult{YesCount: "0", NoCount: "30", AbstainCount: "0", NoWithVetoCount: "21"},
+			"100",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"everyone abstains",
+			group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "100", NoWithVetoCount: "0"},
+			"100",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"zero total power",
+			group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"0",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.Allow(tc.tally, tc.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, policyResult)
+		})
+	}
+}
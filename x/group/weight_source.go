@@ -0,0 +1,113 @@
+package group
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/math"
+	"cosmossdk.io/x/group/internal/orm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// WeightSourceStaking is the name of the weight provider using the
+	// member's total bonded tokens as voting weight.
+	WeightSourceStaking = "staking"
+	// WeightSourceBank is the name of the weight provider using the member's
+	// balance of a given denom as voting weight.
+	WeightSourceBank = "bank"
+)
+
+// WeightProvider computes the voting weight of the members of token-weighted
+// groups. Providers are registered by name in the keeper config and are
+// referenced by the `type` of a group's WeightSource.
+type WeightProvider interface {
+	// ValidateSource checks that the weight source is usable by the provider.
+	ValidateSource(source WeightSource) error
+	// MemberWeight returns the voting weight of the given account.
+	MemberWeight(ctx context.Context, source WeightSource, addr sdk.AccAddress) (sdkmath.Int, error)
+}
+
+// NewStakingWeightProvider returns a WeightProvider using the total amount of
+// tokens bonded by the member as voting weight.
+func NewStakingWeightProvider(sk StakingKeeper) WeightProvider {
+	return stakingWeightProvider{sk: sk}
+}
+
+type stakingWeightProvider struct {
+	sk StakingKeeper
+}
+
+func (p stakingWeightProvider) ValidateSource(source WeightSource) error {
+	if source.Denom != "" {
+		return errorsmod.Wrap(errors.ErrInvalid, "staking weight source does not accept a denom")
+	}
+	return nil
+}
+
+func (p stakingWeightProvider) MemberWeight(ctx context.Context, _ WeightSource, addr sdk.AccAddress) (sdkmath.Int, error) {
+	return p.sk.GetDelegatorBonded(ctx, addr)
+}
+
+// NewBankWeightProvider returns a WeightProvider using the member's balance of
+// the weight source denom as voting weight.
+func NewBankWeightProvider(bk BankKeeper) WeightProvider {
+	return bankWeightProvider{bk: bk}
+}
+
+type bankWeightProvider struct {
+	bk BankKeeper
+}
+
+func (p bankWeightProvider) ValidateSource(source WeightSource) error {
+	if err := sdk.ValidateDenom(source.Denom); err != nil {
+		return errorsmod.Wrap(errors.ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func (p bankWeightProvider) MemberWeight(ctx context.Context, source WeightSource, addr sdk.AccAddress) (sdkmath.Int, error) {
+	return p.bk.GetBalance(ctx, addr, source.Denom).Amount, nil
+}
+
+// ValidateBasic does basic validation on a weight source.
+func (s WeightSource) ValidateBasic() error {
+	if s.Type == "" {
+		return errorsmod.Wrap(errors.ErrEmpty, "weight source type")
+	}
+	if s.Denom != "" {
+		if err := sdk.ValidateDenom(s.Denom); err != nil {
+			return errorsmod.Wrap(errors.ErrInvalid, err.Error())
+		}
+	}
+	return nil
+}
+
+func (v VoterWeight) PrimaryKeyFields(addressCodec address.Codec) ([]interface{}, error) {
+	addr, err := addressCodec.StringToBytes(v.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{v.ProposalId, addr}, nil
+}
+
+var _ orm.Validateable = VoterWeight{}
+
+// ValidateBasic does basic validation on a voter weight.
+func (v VoterWeight) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return errorsmod.Wrap(err, "voter")
+	}
+	if v.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "voter weight ProposalId")
+	}
+	if _, err := math.NewNonNegativeDecFromString(v.Weight); err != nil {
+		return errorsmod.Wrap(err, "voter weight")
+	}
+	return nil
+}
@@ -29,6 +29,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod: "ScheduleProjection",
+					Use:       "schedule-projection <from-epoch>",
+					Short:     "Query the projected supply over the next epochs of the mint schedule",
+					Example:   fmt.Sprintf("%s query mint schedule-projection 12 --epochs 5", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "from_epoch"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
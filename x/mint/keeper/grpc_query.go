@@ -3,9 +3,21 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/x/mint/types"
 )
 
+const (
+	// defaultProjectionEpochs is the number of epochs projected by
+	// ScheduleProjection when the request does not set it.
+	defaultProjectionEpochs = 10
+	// maxProjectionEpochs bounds the number of epochs projected by
+	// ScheduleProjection.
+	maxProjectionEpochs = 1000
+)
+
 var _ types.QueryServer = queryServer{}
 
 func NewQueryServerImpl(k *Keeper) types.QueryServer {
@@ -45,3 +57,39 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// ScheduleProjection projects the supply of the mint denom over the next epochs
+// of the mint schedule.
+func (q queryServer) ScheduleProjection(ctx context.Context, req *types.QueryScheduleProjectionRequest) (*types.QueryScheduleProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = defaultProjectionEpochs
+	}
+	if epochs > maxProjectionEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot project more than %d epochs", maxProjectionEpochs)
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := q.k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	projections := make([]types.EpochSupplyProjection, 0, epochs)
+	for i := uint64(0); i < epochs; i++ {
+		epochNumber := req.FromEpoch + int64(i)
+		provision := scheduleProvision(params, epochNumber, supply)
+		supply = supply.Add(provision)
+		projections = append(projections, types.EpochSupplyProjection{
+			EpochNumber: epochNumber,
+			Provision:   provision,
+			Supply:      supply,
+		})
+	}
+
+	return &types.QueryScheduleProjectionResponse{Projections: projections}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/mint"
 	"cosmossdk.io/x/mint/keeper"
@@ -29,6 +30,7 @@ type MintTestSuite struct {
 	ctx         sdk.Context
 	queryClient types.QueryClient
 	mintKeeper  *keeper.Keeper
+	bankKeeper  *minttestutil.MockBankKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -53,6 +55,7 @@ func (suite *MintTestSuite) SetupTest() {
 		authtypes.FeeCollectorName,
 		govModuleNameStr,
 	)
+	suite.bankKeeper = bankKeeper
 
 	err := suite.mintKeeper.Params.Set(suite.ctx, types.DefaultParams())
 	suite.Require().NoError(err)
@@ -81,6 +84,35 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, minter.AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCScheduleProjection() {
+	params := types.DefaultParams()
+	params.MaxSupply = math.NewInt(2100)
+	params.Schedule = types.NewHalvingMintSchedule("week", 2, math.NewInt(400), 2)
+	suite.Require().NoError(suite.mintKeeper.Params.Set(suite.ctx, params))
+	suite.bankKeeper.EXPECT().GetSupply(gomock.Any(), params.MintDenom).Return(sdk.NewInt64Coin(params.MintDenom, 1000)).AnyTimes()
+
+	_, err := suite.queryClient.ScheduleProjection(gocontext.Background(), &types.QueryScheduleProjectionRequest{Epochs: 1001})
+	suite.Require().ErrorContains(err, "cannot project more than 1000 epochs")
+
+	res, err := suite.queryClient.ScheduleProjection(gocontext.Background(), &types.QueryScheduleProjectionRequest{FromEpoch: 1, Epochs: 5})
+	suite.Require().NoError(err)
+
+	// nothing before the start epoch, 400 for two epochs, then halved and
+	// capped at the max supply
+	expected := []types.EpochSupplyProjection{
+		{EpochNumber: 1, Provision: math.NewInt(0), Supply: math.NewInt(1000)},
+		{EpochNumber: 2, Provision: math.NewInt(400), Supply: math.NewInt(1400)},
+		{EpochNumber: 3, Provision: math.NewInt(400), Supply: math.NewInt(1800)},
+		{EpochNumber: 4, Provision: math.NewInt(200), Supply: math.NewInt(2000)},
+		{EpochNumber: 5, Provision: math.NewInt(100), Supply: math.NewInt(2100)},
+	}
+	suite.Require().Equal(expected, res.Projections)
+
+	res, err = suite.queryClient.ScheduleProjection(gocontext.Background(), &types.QueryScheduleProjectionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Projections, 10)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
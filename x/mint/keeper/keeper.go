@@ -185,3 +185,63 @@ func DefaultMintFn(ic types.InflationCalculationFn, staking types.StakingKeeper,
 		)
 	}
 }
+
+// ScheduleMintFn returns a mint function minting the amounts of the mint schedule
+// stored in params at the start of its epochs, bounded by the max supply. It does
+// not depend on the Staking module and leaves the minter untouched.
+func ScheduleMintFn(k *Keeper) types.MintFn {
+	return func(ctx context.Context, env appmodule.Environment, minter *types.Minter, epochId string, epochNumber int64) error {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !params.Schedule.Enabled() || epochId != params.Schedule.EpochIdentifier {
+			return nil
+		}
+
+		supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+		provision := scheduleProvision(params, epochNumber, supply)
+		if provision.IsZero() {
+			return nil
+		}
+
+		mintedCoin := sdk.NewCoin(params.MintDenom, provision)
+		mintedCoins := sdk.NewCoins(mintedCoin)
+		if err := k.MintCoins(ctx, mintedCoins); err != nil {
+			return err
+		}
+
+		// send the minted coins to the fee collector account
+		if err := k.AddCollectedFees(ctx, mintedCoins); err != nil {
+			return err
+		}
+
+		if mintedCoin.Amount.IsInt64() {
+			defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+		}
+
+		return env.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeMint,
+			event.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			event.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		)
+	}
+}
+
+// scheduleProvision returns the amount minted by the mint schedule at the start
+// of the given epoch, given the current supply of the mint denom.
+func scheduleProvision(params types.Params, epochNumber int64, supply math.Int) math.Int {
+	provision := params.Schedule.EpochProvision(epochNumber)
+
+	// if maxSupply is not infinite, do not mint above it
+	if !params.MaxSupply.IsZero() {
+		remaining := params.MaxSupply.Sub(supply)
+		if !remaining.IsPositive() {
+			return math.ZeroInt()
+		}
+		provision = math.MinInt(provision, remaining)
+	}
+
+	return provision
+}
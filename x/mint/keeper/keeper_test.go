@@ -116,6 +116,50 @@ func (s *KeeperTestSuite) TestDefaultMintFn() {
 	s.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestScheduleMintFn() {
+	s.NoError(s.mintKeeper.SetMintFn(keeper.ScheduleMintFn(s.mintKeeper)))
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+
+	// the schedule is disabled by default
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "block", -1))
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "day", 1))
+
+	params, err := s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+	params.MaxSupply = math.NewInt(1250)
+	params.Schedule = types.NewTableMintSchedule("day", 1, []types.EpochProvision{
+		{Epochs: 1, Amount: math.NewInt(100)},
+		{Epochs: 2, Amount: math.NewInt(200)},
+	})
+	s.NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	// other epochs do not mint
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "block", -1))
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "week", 2))
+
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, "stake").Return(sdk.NewInt64Coin("stake", 1000))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))).Return(nil)
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "day", 2))
+
+	// the provision is capped at the max supply
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, "stake").Return(sdk.NewInt64Coin("stake", 1200))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))).Return(nil)
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "day", 3))
+
+	// nothing is minted after the table
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, "stake").Return(sdk.NewInt64Coin("stake", 1000))
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "day", 4))
+
+	// the minter is left untouched
+	unchangedMinter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+	s.Equal(minter, unchangedMinter)
+}
+
 func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
 	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
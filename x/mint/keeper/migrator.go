@@ -46,3 +46,17 @@ func (m Migrator) Migrate2to3(ctx context.Context) error {
 
 	return nil
 }
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it initializes the new Schedule parameter.
+func (m Migrator) Migrate3to4(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Initialize the new Schedule parameter with the default value
+	params.Schedule = types.DefaultMintSchedule()
+
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -31,3 +31,19 @@ func (s *KeeperTestSuite) TestMigrator_Migrate2to3() {
 	s.NotEqual(params, migratedParams)
 	s.Equal(migratedParams, types.DefaultParams())
 }
+
+func (s *KeeperTestSuite) TestMigrator_Migrate3to4() {
+	migrator := keeper.NewMigrator(s.mintKeeper)
+
+	// params stored before the schedule was introduced have a nil provision
+	params := types.DefaultParams()
+	params.Schedule = types.MintSchedule{}
+	s.NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	s.NoError(migrator.Migrate3to4(s.ctx))
+
+	migratedParams, err := s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+	s.NoError(migratedParams.Validate())
+	s.Equal(types.DefaultParams(), migratedParams)
+}
@@ -7,4 +7,5 @@ const (
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyEpochNumber      = "epoch_number"
 )
@@ -94,6 +94,9 @@ type Params struct {
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// maximum supply for the token
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// schedule of the schedule-based mint function. It is ignored by other mint
+	// functions.
+	Schedule MintSchedule `protobuf:"bytes,8,opt,name=schedule,proto3" json:"schedule"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -143,46 +146,196 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetSchedule() MintSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return MintSchedule{}
+}
+
+// MintSchedule defines a per-epoch minting schedule. Starting at start_epoch,
+// it mints either the amounts of the epoch_provisions table or, when the table
+// is empty, initial_epoch_provision halved every halving_period_epochs epochs.
+type MintSchedule struct {
+	// identifier of the x/epochs epoch at the start of which coins are minted.
+	// An empty identifier disables the schedule.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// first epoch number at which coins are minted.
+	StartEpoch int64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// amount minted per epoch before the first halving.
+	InitialEpochProvision cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=initial_epoch_provision,json=initialEpochProvision,proto3,customtype=cosmossdk.io/math.Int" json:"initial_epoch_provision"`
+	// number of epochs after which the epoch provision is halved. Zero disables
+	// halving.
+	HalvingPeriodEpochs uint64 `protobuf:"varint,4,opt,name=halving_period_epochs,json=halvingPeriodEpochs,proto3" json:"halving_period_epochs,omitempty"`
+	// table of consecutive per-epoch amounts, starting at start_epoch. Nothing is
+	// minted after the last entry.
+	EpochProvisions []EpochProvision `protobuf:"bytes,5,rep,name=epoch_provisions,json=epochProvisions,proto3" json:"epoch_provisions"`
+}
+
+func (m *MintSchedule) Reset()         { *m = MintSchedule{} }
+func (m *MintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MintSchedule) ProtoMessage()    {}
+func (*MintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *MintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintSchedule.Merge(m, src)
+}
+func (m *MintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintSchedule proto.InternalMessageInfo
+
+func (m *MintSchedule) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetHalvingPeriodEpochs() uint64 {
+	if m != nil {
+		return m.HalvingPeriodEpochs
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetEpochProvisions() []EpochProvision {
+	if m != nil {
+		return m.EpochProvisions
+	}
+	return nil
+}
+
+// EpochProvision defines the amount minted in each of a number of consecutive
+// epochs.
+type EpochProvision struct {
+	// number of epochs minting the amount.
+	Epochs uint64 `protobuf:"varint,1,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// amount minted per epoch.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *EpochProvision) Reset()         { *m = EpochProvision{} }
+func (m *EpochProvision) String() string { return proto.CompactTextString(m) }
+func (*EpochProvision) ProtoMessage()    {}
+func (*EpochProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *EpochProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProvision.Merge(m, src)
+}
+func (m *EpochProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProvision proto.InternalMessageInfo
+
+func (m *EpochProvision) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*MintSchedule)(nil), "cosmos.mint.v1beta1.MintSchedule")
+	proto.RegisterType((*EpochProvision)(nil), "cosmos.mint.v1beta1.EpochProvision")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x10, 0x82, 0x72, 0xb4, 0x82, 0x5e, 0xa9, 0xe4, 0x16, 0xd5, 0x8d, 0x3a, 0xa0,
-	0xa8, 0xa8, 0xb1, 0xa2, 0x4a, 0x0c, 0x8c, 0x21, 0x4b, 0x11, 0x15, 0x91, 0x19, 0x10, 0x20, 0x61,
-	0xbd, 0xb6, 0xaf, 0xee, 0x11, 0xdf, 0x9d, 0xe5, 0xbb, 0x54, 0xf6, 0x57, 0x60, 0xe2, 0x63, 0x30,
-	0x76, 0x60, 0xe1, 0x1b, 0x74, 0x41, 0xaa, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0x5f, 0x03, 0xf9,
-	0xce, 0xa4, 0xfc, 0x99, 0x08, 0x5d, 0xac, 0xbb, 0xf7, 0x79, 0xdf, 0xdf, 0xf3, 0xd8, 0xf2, 0x8b,
-	0xdd, 0x48, 0x2a, 0x2e, 0x95, 0xc7, 0x99, 0xd0, 0xde, 0x71, 0x3f, 0xa4, 0x1a, 0xfa, 0xe6, 0xd2,
-	0xcb, 0x72, 0xa9, 0x25, 0x59, 0xb5, 0x7a, 0xcf, 0x94, 0x6a, 0x7d, 0xe3, 0x6e, 0x22, 0x13, 0x69,
-	0x74, 0xaf, 0x3a, 0xd9, 0xd6, 0x8d, 0x75, 0xdb, 0x1a, 0x58, 0xa1, 0x9e, 0xb3, 0xd2, 0x0a, 0x70,
-	0x26, 0xa4, 0x67, 0x9e, 0x3f, 0xbb, 0x13, 0x29, 0x93, 0x94, 0x7a, 0xe6, 0x16, 0x4e, 0x0e, 0x3d,
-	0x10, 0xa5, 0x95, 0xb6, 0x3f, 0x23, 0xdc, 0x3a, 0x60, 0x42, 0xd3, 0x9c, 0x3c, 0xc3, 0x6d, 0x26,
-	0x0e, 0x53, 0xd0, 0x4c, 0x0a, 0x07, 0x75, 0x50, 0xb7, 0x3d, 0xe8, 0x9f, 0x9e, 0x6f, 0x35, 0xbe,
-	0x9d, 0x6f, 0xdd, 0xb3, 0x0e, 0x2a, 0x1e, 0xf7, 0x98, 0xf4, 0x38, 0xe8, 0xa3, 0xde, 0x53, 0x9a,
-	0x40, 0x54, 0x0e, 0x69, 0xf4, 0xe5, 0xe3, 0x2e, 0xae, 0x03, 0x0c, 0x69, 0xe4, 0x5f, 0x32, 0xc8,
-	0x1b, 0xbc, 0x02, 0x42, 0x4c, 0x20, 0xad, 0x62, 0x1e, 0x33, 0xc5, 0xa4, 0x50, 0xce, 0xb5, 0x45,
-	0xc1, 0x77, 0x2c, 0x6b, 0x34, 0x47, 0x11, 0x82, 0x9b, 0x31, 0x68, 0x70, 0xae, 0x77, 0x50, 0x77,
-	0xc9, 0x37, 0xe7, 0xed, 0x4f, 0x4d, 0xdc, 0x1a, 0x41, 0x0e, 0x5c, 0x91, 0x4d, 0x8c, 0xab, 0x2f,
-	0x19, 0xc4, 0x54, 0x48, 0x6e, 0x5f, 0xc8, 0x6f, 0x57, 0x95, 0x61, 0x55, 0x20, 0x6f, 0xf1, 0xda,
-	0x3c, 0x6a, 0x90, 0x83, 0xa6, 0x41, 0x74, 0x04, 0x22, 0xa1, 0x75, 0xc2, 0x87, 0xff, 0x9c, 0xf0,
-	0xc3, 0xc5, 0xc9, 0x0e, 0xf2, 0x57, 0xe7, 0x50, 0x1f, 0x34, 0x7d, 0x6c, 0x90, 0xe4, 0x35, 0x5e,
-	0xbe, 0xf4, 0xe2, 0x50, 0x98, 0xc8, 0x8b, 0x7b, 0x2c, 0xcd, 0x61, 0x07, 0x50, 0xfc, 0x01, 0x67,
-	0xc2, 0x69, 0x5e, 0x15, 0x9c, 0x09, 0xf2, 0x02, 0xdf, 0x4a, 0x24, 0xa4, 0x41, 0x28, 0x45, 0x4c,
-	0x63, 0xe7, 0xc6, 0x7f, 0xa1, 0x71, 0x85, 0x1a, 0x18, 0x12, 0xb9, 0x8f, 0x6f, 0x87, 0xa9, 0x8c,
-	0xc6, 0x2a, 0xc8, 0x68, 0x1e, 0x94, 0x14, 0x72, 0xa7, 0xd5, 0x41, 0xdd, 0xa6, 0xbf, 0x6c, 0xcb,
-	0x23, 0x9a, 0xbf, 0xa4, 0x90, 0x93, 0x27, 0x18, 0x73, 0x28, 0x02, 0x35, 0xc9, 0xb2, 0xb4, 0x74,
-	0x6e, 0x1a, 0xff, 0x07, 0xb5, 0xff, 0xda, 0xdf, 0xfe, 0xfb, 0x42, 0xff, 0xe2, 0xbc, 0x2f, 0xb4,
-	0xdf, 0xe6, 0x50, 0x3c, 0x37, 0xd3, 0x8f, 0x36, 0xdf, 0x5d, 0x9c, 0xec, 0x38, 0x56, 0xdb, 0x55,
-	0xf1, 0xd8, 0x2b, 0xec, 0x2e, 0xda, 0x1f, 0x66, 0xb0, 0x77, 0x3a, 0x75, 0xd1, 0xd9, 0xd4, 0x45,
-	0xdf, 0xa7, 0x2e, 0x7a, 0x3f, 0x73, 0x1b, 0x67, 0x33, 0xb7, 0xf1, 0x75, 0xe6, 0x36, 0x5e, 0xad,
-	0xff, 0x66, 0x54, 0x4f, 0xe9, 0x32, 0xa3, 0x2a, 0x6c, 0x99, 0x3d, 0xda, 0xfb, 0x11, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x44, 0x4d, 0x1a, 0xdd, 0x03, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf5, 0x62, 0xe3, 0x87, 0xaf, 0xe1, 0x01, 0xc3, 0xf3, 0x7b, 0x0b, 0x4f, 0x18, 0xc7, 0x91,
+	0x22, 0x87, 0x08, 0x6f, 0x00, 0x29, 0x45, 0x4a, 0x87, 0x48, 0x10, 0x05, 0xc5, 0x5a, 0x8a, 0x88,
+	0x44, 0xca, 0x6a, 0xbc, 0x3b, 0xac, 0x27, 0xec, 0xce, 0x58, 0x3b, 0x63, 0x64, 0xd7, 0xe9, 0x52,
+	0xe5, 0x67, 0xa4, 0xa4, 0xc8, 0x8f, 0xa0, 0x89, 0x84, 0x92, 0x26, 0x4a, 0x81, 0x22, 0x28, 0xf8,
+	0x1b, 0xd1, 0xce, 0x4c, 0x96, 0x8f, 0xd0, 0x00, 0x69, 0xac, 0x9d, 0x7b, 0xee, 0x3d, 0xe7, 0xcc,
+	0x9d, 0x7b, 0x0d, 0x55, 0x9f, 0x8b, 0x98, 0x0b, 0x27, 0xa6, 0x4c, 0x3a, 0x7b, 0xcb, 0x1d, 0x22,
+	0xf1, 0xb2, 0x3a, 0x34, 0x7b, 0x09, 0x97, 0x1c, 0xcd, 0x68, 0xbc, 0xa9, 0x42, 0x06, 0x9f, 0xfb,
+	0x27, 0xe4, 0x21, 0x57, 0xb8, 0x93, 0x7e, 0xe9, 0xd4, 0xb9, 0x59, 0x9d, 0xea, 0x69, 0xc0, 0xd4,
+	0x69, 0x68, 0x1a, 0xc7, 0x94, 0x71, 0x47, 0xfd, 0xfe, 0xca, 0x0e, 0x39, 0x0f, 0x23, 0xe2, 0xa8,
+	0x53, 0xa7, 0xbf, 0xe3, 0x60, 0x36, 0xd4, 0x50, 0xfd, 0xb3, 0x05, 0xc5, 0x4d, 0xca, 0x24, 0x49,
+	0xd0, 0x0b, 0x28, 0x51, 0xb6, 0x13, 0x61, 0x49, 0x39, 0xb3, 0xad, 0x9a, 0xd5, 0x28, 0xb5, 0x96,
+	0x0f, 0x8e, 0x16, 0x72, 0xdf, 0x8f, 0x16, 0xfe, 0xd7, 0x0a, 0x22, 0xd8, 0x6d, 0x52, 0xee, 0xc4,
+	0x58, 0x76, 0x9b, 0xcf, 0x49, 0x88, 0xfd, 0xe1, 0x1a, 0xf1, 0xbf, 0x7c, 0x5a, 0x02, 0x63, 0x60,
+	0x8d, 0xf8, 0xee, 0x19, 0x07, 0x7a, 0x03, 0xd3, 0x98, 0xb1, 0x3e, 0x8e, 0x52, 0x9b, 0x7b, 0x54,
+	0x50, 0xce, 0x84, 0x3d, 0x72, 0x53, 0xe2, 0x29, 0xcd, 0xd5, 0xce, 0xa8, 0x10, 0x82, 0x42, 0x80,
+	0x25, 0xb6, 0xf3, 0x35, 0xab, 0x31, 0xee, 0xaa, 0xef, 0xfa, 0xbb, 0x51, 0x28, 0xb6, 0x71, 0x82,
+	0x63, 0x81, 0xe6, 0x01, 0xd2, 0x4e, 0x7a, 0x01, 0x61, 0x3c, 0xd6, 0x17, 0x72, 0x4b, 0x69, 0x64,
+	0x2d, 0x0d, 0xa0, 0xb7, 0x50, 0xc9, 0xac, 0x7a, 0x09, 0x96, 0xc4, 0xf3, 0xbb, 0x98, 0x85, 0xc4,
+	0x38, 0x7c, 0x74, 0x6d, 0x87, 0x1f, 0x4f, 0xf7, 0x17, 0x2d, 0x77, 0x26, 0x23, 0x75, 0xb1, 0x24,
+	0x4f, 0x14, 0x25, 0x7a, 0x0d, 0x13, 0x67, 0x5a, 0x31, 0x1e, 0xd8, 0xf9, 0x5b, 0x69, 0x8c, 0x67,
+	0x64, 0x9b, 0x78, 0x70, 0x89, 0x9c, 0x32, 0xbb, 0xf0, 0xa7, 0xc8, 0x29, 0x43, 0x2f, 0xa1, 0x1c,
+	0x72, 0x1c, 0x79, 0x1d, 0xce, 0x02, 0x12, 0xd8, 0xa3, 0xb7, 0xa2, 0x86, 0x94, 0xaa, 0xa5, 0x98,
+	0xd0, 0x3d, 0x98, 0xec, 0x44, 0xdc, 0xdf, 0x15, 0x5e, 0x8f, 0x24, 0xde, 0x90, 0xe0, 0xc4, 0x2e,
+	0xd6, 0xac, 0x46, 0xc1, 0x9d, 0xd0, 0xe1, 0x36, 0x49, 0xb6, 0x09, 0x4e, 0xd0, 0x33, 0x80, 0x18,
+	0x0f, 0x3c, 0xd1, 0xef, 0xf5, 0xa2, 0xa1, 0xfd, 0x97, 0xd2, 0x7f, 0x60, 0xf4, 0x2b, 0xbf, 0xeb,
+	0x6f, 0x30, 0x79, 0x4e, 0x79, 0x83, 0x49, 0xb7, 0x14, 0xe3, 0xc1, 0x96, 0xaa, 0x46, 0xeb, 0x30,
+	0x26, 0xfc, 0x2e, 0x09, 0xfa, 0x11, 0xb1, 0xc7, 0x6a, 0x56, 0xa3, 0xbc, 0x72, 0xa7, 0x79, 0xc5,
+	0xce, 0x35, 0xd3, 0x85, 0xd8, 0x32, 0x89, 0xad, 0x52, 0x2a, 0xa6, 0xfd, 0x67, 0xd5, 0x8f, 0xe7,
+	0xdf, 0x9f, 0xee, 0x2f, 0xda, 0xba, 0x76, 0x49, 0x04, 0xbb, 0xce, 0x40, 0x6f, 0xb5, 0x1e, 0xbd,
+	0xfa, 0xd7, 0x11, 0x18, 0x3f, 0x4f, 0x82, 0xee, 0xc3, 0x14, 0xe9, 0x71, 0xbf, 0xeb, 0xd1, 0x80,
+	0x30, 0x49, 0x77, 0x28, 0x49, 0xcc, 0x44, 0x4e, 0xaa, 0xf8, 0x46, 0x16, 0x46, 0x0b, 0x50, 0x16,
+	0x12, 0x27, 0xd2, 0x53, 0x80, 0x9a, 0xc6, 0xbc, 0x0b, 0x2a, 0xf4, 0x34, 0x8d, 0xa0, 0x2e, 0xfc,
+	0x47, 0x19, 0x95, 0x14, 0x47, 0x3a, 0xe5, 0x6c, 0xbb, 0xcc, 0x58, 0x3d, 0xbc, 0x46, 0x7b, 0xf4,
+	0xc5, 0x2a, 0x86, 0x50, 0x09, 0x64, 0x1b, 0x86, 0x56, 0xa0, 0xd2, 0xc5, 0xd1, 0x1e, 0x65, 0x61,
+	0xfa, 0x48, 0x94, 0x07, 0x5a, 0x50, 0xa8, 0x09, 0x2b, 0xb8, 0x33, 0x06, 0x6c, 0x2b, 0x4c, 0xd5,
+	0x0a, 0xb4, 0x0d, 0x53, 0x97, 0x5c, 0x09, 0x7b, 0xb4, 0x96, 0x6f, 0x94, 0x57, 0xee, 0x5e, 0xd9,
+	0xeb, 0x8b, 0x92, 0xe7, 0xbb, 0x3d, 0x49, 0x2e, 0x40, 0xa2, 0x9e, 0xc0, 0xdf, 0x97, 0x0c, 0xfe,
+	0x0b, 0x45, 0xe3, 0xc8, 0x52, 0x8e, 0xcc, 0x09, 0xad, 0x43, 0x11, 0xc7, 0xbc, 0xcf, 0xa4, 0x3d,
+	0x72, 0xc3, 0x8e, 0x98, 0xfa, 0xd6, 0xea, 0xc1, 0x71, 0xd5, 0x3a, 0x3c, 0xae, 0x5a, 0x3f, 0x8e,
+	0xab, 0xd6, 0x87, 0x93, 0x6a, 0xee, 0xf0, 0xa4, 0x9a, 0xfb, 0x76, 0x52, 0xcd, 0xbd, 0x9a, 0xbd,
+	0xc0, 0x65, 0xde, 0x5f, 0x0e, 0x7b, 0x44, 0x74, 0x8a, 0xea, 0xbf, 0x75, 0xf5, 0xe7, 0x00, 0x7e,
+	0x59, 0xf2, 0x54, 0xf1, 0x05, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -255,6 +408,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -320,6 +483,108 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochProvisions) > 0 {
+		for iNdEx := len(m.EpochProvisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochProvisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HalvingPeriodEpochs != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingPeriodEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.InitialEpochProvision.Size()
+		i -= size
+		if _, err := m.InitialEpochProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartEpoch != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epochs != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -371,6 +636,49 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovMint(uint64(m.StartEpoch))
+	}
+	l = m.InitialEpochProvision.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingPeriodEpochs != 0 {
+		n += 1 + sovMint(uint64(m.HalvingPeriodEpochs))
+	}
+	if len(m.EpochProvisions) > 0 {
+		for _, e := range m.EpochProvisions {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		n += 1 + sovMint(uint64(m.Epochs))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -782,6 +1090,330 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialEpochProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialEpochProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingPeriodEpochs", wireType)
+			}
+			m.HalvingPeriodEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingPeriodEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochProvisions = append(m.EpochProvisions, EpochProvision{})
+			if err := m.EpochProvisions[len(m.EpochProvisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
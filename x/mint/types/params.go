@@ -20,6 +20,7 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
 		MaxSupply:           maxSupply,
+		Schedule:            DefaultMintSchedule(),
 	}
 }
 
@@ -33,6 +34,7 @@ func DefaultParams() Params {
 		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5-second block times
 		MaxSupply:           math.ZeroInt(),             // assuming zero is infinite
+		Schedule:            DefaultMintSchedule(),
 	}
 }
 
@@ -59,6 +61,9 @@ func (p Params) Validate() error {
 	if err := validateMaxSupply(p.MaxSupply); err != nil {
 		return err
 	}
+	if err := p.Schedule.Validate(); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -270,6 +270,158 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryScheduleProjectionRequest is the request type for the
+// Query/ScheduleProjection RPC method.
+type QueryScheduleProjectionRequest struct {
+	// from_epoch is the first epoch number of the projection.
+	FromEpoch int64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// epochs is the number of projected epochs, it defaults to 10 and cannot
+	// exceed 1000.
+	Epochs uint64 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QueryScheduleProjectionRequest) Reset()         { *m = QueryScheduleProjectionRequest{} }
+func (m *QueryScheduleProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleProjectionRequest) ProtoMessage()    {}
+func (*QueryScheduleProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryScheduleProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleProjectionRequest.Merge(m, src)
+}
+func (m *QueryScheduleProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleProjectionRequest) GetFromEpoch() int64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryScheduleProjectionRequest) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// QueryScheduleProjectionResponse is the response type for the
+// Query/ScheduleProjection RPC method.
+type QueryScheduleProjectionResponse struct {
+	// projections are the projected provision and supply of each epoch.
+	Projections []EpochSupplyProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryScheduleProjectionResponse) Reset()         { *m = QueryScheduleProjectionResponse{} }
+func (m *QueryScheduleProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleProjectionResponse) ProtoMessage()    {}
+func (*QueryScheduleProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryScheduleProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleProjectionResponse.Merge(m, src)
+}
+func (m *QueryScheduleProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleProjectionResponse) GetProjections() []EpochSupplyProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// EpochSupplyProjection is the projected provision of an epoch of the mint
+// schedule and the supply of the mint denom after it.
+type EpochSupplyProjection struct {
+	EpochNumber int64                 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Provision   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=provision,proto3,customtype=cosmossdk.io/math.Int" json:"provision"`
+	Supply      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+}
+
+func (m *EpochSupplyProjection) Reset()         { *m = EpochSupplyProjection{} }
+func (m *EpochSupplyProjection) String() string { return proto.CompactTextString(m) }
+func (*EpochSupplyProjection) ProtoMessage()    {}
+func (*EpochSupplyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *EpochSupplyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSupplyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSupplyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSupplyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSupplyProjection.Merge(m, src)
+}
+func (m *EpochSupplyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSupplyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSupplyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSupplyProjection proto.InternalMessageInfo
+
+func (m *EpochSupplyProjection) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,42 +429,57 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryScheduleProjectionRequest)(nil), "cosmos.mint.v1beta1.QueryScheduleProjectionRequest")
+	proto.RegisterType((*QueryScheduleProjectionResponse)(nil), "cosmos.mint.v1beta1.QueryScheduleProjectionResponse")
+	proto.RegisterType((*EpochSupplyProjection)(nil), "cosmos.mint.v1beta1.EpochSupplyProjection")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x00, 0xbf, 0x26, 0x7d, 0xcb, 0x01, 0x86, 0x3f, 0x3f, 0x28, 0xb0, 0xc5, 0x35, 0xc1,
+	0xa6, 0xc6, 0x5d, 0x28, 0xc6, 0xa3, 0x89, 0x0d, 0x26, 0x92, 0x18, 0x82, 0x45, 0x43, 0xe2, 0xa5,
+	0x59, 0x96, 0xa1, 0xac, 0x76, 0x67, 0x96, 0x9d, 0x2d, 0xb1, 0x9e, 0x8c, 0xf1, 0xe8, 0xc1, 0xc4,
+	0x2f, 0xa1, 0x37, 0x4d, 0xfc, 0x10, 0x1c, 0x89, 0x7a, 0x30, 0x1e, 0x88, 0x01, 0x13, 0x3f, 0x83,
+	0x37, 0xb3, 0xef, 0x0c, 0xa5, 0xb4, 0xbb, 0x51, 0xf4, 0xd2, 0x74, 0xde, 0x3f, 0xcf, 0xf3, 0xbc,
+	0xef, 0xcc, 0xd3, 0x42, 0xd1, 0x15, 0xd2, 0x17, 0xd2, 0xf6, 0x3d, 0x1e, 0xd9, 0xfb, 0x4b, 0x5b,
+	0x2c, 0x72, 0x96, 0xec, 0xbd, 0x16, 0x0b, 0xdb, 0x56, 0x10, 0x8a, 0x48, 0xd0, 0x31, 0x55, 0x60,
+	0xc5, 0x05, 0x96, 0x2e, 0x28, 0x8c, 0x37, 0x44, 0x43, 0x60, 0xde, 0x8e, 0xbf, 0xa9, 0xd2, 0xc2,
+	0x6c, 0x43, 0x88, 0x46, 0x93, 0xd9, 0x4e, 0xe0, 0xd9, 0x0e, 0xe7, 0x22, 0x72, 0x22, 0x4f, 0x70,
+	0xa9, 0xb3, 0x46, 0x12, 0x13, 0xa2, 0xaa, 0xfc, 0xa8, 0xe3, 0x7b, 0x5c, 0xd8, 0xf8, 0xa9, 0x43,
+	0xd3, 0xaa, 0xa5, 0xae, 0x98, 0xb4, 0x10, 0x3c, 0x98, 0xe3, 0x40, 0xef, 0xc5, 0x2a, 0xd7, 0x9d,
+	0xd0, 0xf1, 0x65, 0x8d, 0xed, 0xb5, 0x98, 0x8c, 0xcc, 0x07, 0x30, 0x76, 0x2e, 0x2a, 0x03, 0xc1,
+	0x25, 0xa3, 0x37, 0x21, 0x1b, 0x60, 0x64, 0x8a, 0xcc, 0x93, 0x52, 0xbe, 0x32, 0x63, 0x25, 0x0c,
+	0x65, 0xa9, 0xa6, 0x6a, 0xee, 0xe0, 0xa8, 0x98, 0x79, 0xf3, 0xe3, 0x5d, 0x99, 0xd4, 0x74, 0x97,
+	0xf9, 0x3f, 0x4c, 0x20, 0xec, 0x2a, 0xdf, 0x69, 0xe2, 0x4c, 0xa7, 0x7c, 0x1c, 0x26, 0x7b, 0x13,
+	0x9a, 0xf2, 0x3e, 0xe4, 0xbc, 0xd3, 0x20, 0xb2, 0x0e, 0x57, 0x6f, 0xc4, 0xc0, 0x5f, 0x8f, 0x8a,
+	0x33, 0x8a, 0x5c, 0x6e, 0x3f, 0xb6, 0x3c, 0x61, 0xfb, 0x4e, 0xb4, 0x6b, 0xdd, 0x65, 0x0d, 0xc7,
+	0x6d, 0xaf, 0x30, 0xf7, 0xe3, 0x87, 0x6b, 0xa0, 0xb5, 0xad, 0x30, 0x57, 0xa9, 0x38, 0x03, 0x32,
+	0x0d, 0x98, 0x45, 0xbe, 0x5b, 0x9c, 0xb7, 0x9c, 0xe6, 0x7a, 0x28, 0xf6, 0x3d, 0x19, 0xaf, 0xf8,
+	0x54, 0xcf, 0x0b, 0x02, 0x73, 0x29, 0x05, 0x5a, 0x97, 0x0b, 0xa3, 0x0e, 0xe6, 0xea, 0x41, 0x27,
+	0xf9, 0x8f, 0xfa, 0x46, 0x9c, 0x1e, 0x32, 0x73, 0x13, 0x0c, 0x54, 0xb1, 0xe1, 0xee, 0xb2, 0xed,
+	0x56, 0x93, 0xad, 0x87, 0xe2, 0x11, 0x73, 0xbb, 0x16, 0x47, 0xe7, 0x00, 0x76, 0x42, 0xe1, 0xd7,
+	0x59, 0x20, 0xdc, 0x5d, 0xe4, 0x1f, 0xac, 0xe5, 0xe2, 0xc8, 0xed, 0x38, 0x40, 0x27, 0x21, 0x8b,
+	0x19, 0x39, 0x35, 0x30, 0x4f, 0x4a, 0x43, 0x35, 0x7d, 0x32, 0x9f, 0x42, 0x31, 0x15, 0x58, 0x0f,
+	0xb8, 0x09, 0xf9, 0xa0, 0x13, 0x8d, 0x47, 0x1b, 0x2c, 0xe5, 0x2b, 0xe5, 0xc4, 0x0b, 0x47, 0xae,
+	0x8d, 0x56, 0x10, 0x34, 0xdb, 0x67, 0x40, 0xdd, 0xf7, 0xdf, 0x8d, 0x64, 0x7e, 0x26, 0x30, 0x91,
+	0xd8, 0x41, 0x2f, 0xc1, 0x30, 0xea, 0xab, 0xf3, 0x96, 0xbf, 0xc5, 0x42, 0x3d, 0x4e, 0x1e, 0x63,
+	0x6b, 0x18, 0xa2, 0x6b, 0x90, 0xeb, 0xec, 0x1b, 0x67, 0xca, 0x55, 0x17, 0xf5, 0xba, 0x27, 0xfa,
+	0xd7, 0xbd, 0xca, 0xa3, 0xae, 0x45, 0xaf, 0xf2, 0x48, 0x3f, 0x84, 0x0e, 0x04, 0xbd, 0x03, 0x59,
+	0x89, 0x32, 0xa6, 0x06, 0xff, 0x12, 0x4c, 0xf7, 0x57, 0x7e, 0x0e, 0xc1, 0x7f, 0xb8, 0x53, 0xfa,
+	0x8c, 0x40, 0x56, 0x79, 0x80, 0x5e, 0x49, 0xdc, 0x57, 0xbf, 0xe1, 0x0a, 0xa5, 0xdf, 0x17, 0xaa,
+	0x7b, 0x31, 0x2f, 0x3f, 0xff, 0xf4, 0xfd, 0xf5, 0xc0, 0x1c, 0x9d, 0xb1, 0x93, 0x7e, 0x07, 0x94,
+	0xd1, 0xe8, 0x4b, 0x02, 0xb9, 0x8e, 0x97, 0x68, 0x39, 0x1d, 0xbc, 0xd7, 0x89, 0x85, 0xab, 0x7f,
+	0x54, 0xab, 0xb5, 0x2c, 0xa0, 0x96, 0x79, 0x6a, 0x24, 0x6a, 0xe9, 0xd8, 0x8d, 0xbe, 0x25, 0x30,
+	0xd2, 0xeb, 0x24, 0xba, 0x94, 0xce, 0x94, 0x62, 0xcb, 0x42, 0xe5, 0x22, 0x2d, 0x5a, 0xa3, 0x85,
+	0x1a, 0x4b, 0x74, 0x21, 0x51, 0x63, 0x9f, 0x87, 0xe9, 0x7b, 0x02, 0xb4, 0xdf, 0x16, 0x74, 0x39,
+	0x9d, 0x3a, 0xd5, 0x9d, 0x85, 0xeb, 0x17, 0x6b, 0xd2, 0x8a, 0x17, 0x51, 0x71, 0x99, 0x96, 0x12,
+	0x15, 0x4b, 0xdd, 0x58, 0x3f, 0xf3, 0x54, 0x75, 0xf9, 0xe0, 0xd8, 0x20, 0x87, 0xc7, 0x06, 0xf9,
+	0x76, 0x6c, 0x90, 0x57, 0x27, 0x46, 0xe6, 0xf0, 0xc4, 0xc8, 0x7c, 0x39, 0x31, 0x32, 0x0f, 0xa7,
+	0xcf, 0xbd, 0xe3, 0x27, 0x0a, 0x2a, 0x6a, 0x07, 0x4c, 0x6e, 0x65, 0xf1, 0x0f, 0x60, 0xf9, 0xd7,
+	0x00, 0xce, 0xc0, 0xad, 0xe7, 0xba, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +500,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ScheduleProjection projects the supply of the mint denom over the next
+	// epochs of the mint schedule.
+	ScheduleProjection(ctx context.Context, in *QueryScheduleProjectionRequest, opts ...grpc.CallOption) (*QueryScheduleProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -370,6 +540,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ScheduleProjection(ctx context.Context, in *QueryScheduleProjectionRequest, opts ...grpc.CallOption) (*QueryScheduleProjectionResponse, error) {
+	out := new(QueryScheduleProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ScheduleProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -378,6 +557,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ScheduleProjection projects the supply of the mint denom over the next
+	// epochs of the mint schedule.
+	ScheduleProjection(context.Context, *QueryScheduleProjectionRequest) (*QueryScheduleProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -393,6 +575,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ScheduleProjection(ctx context.Context, req *QueryScheduleProjectionRequest) (*QueryScheduleProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -452,6 +637,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduleProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduleProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ScheduleProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduleProjection(ctx, req.(*QueryScheduleProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
@@ -469,6 +672,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ScheduleProjection",
+			Handler:    _Query_ScheduleProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -642,6 +849,124 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryScheduleProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSupplyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSupplyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Provision.Size()
+		i -= size
+		if _, err := m.Provision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +1038,52 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduleProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QueryScheduleProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochSupplyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = m.Provision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1118,6 +1489,315 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScheduleProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, EpochSupplyProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSupplyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSupplyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+// maxHalvings bounds the number of halvings applied to the initial epoch
+// provision, any provision is zero after that many halvings.
+const maxHalvings = 256
+
+// DefaultMintSchedule returns the default mint schedule, which is disabled.
+func DefaultMintSchedule() MintSchedule {
+	return MintSchedule{
+		InitialEpochProvision: math.ZeroInt(),
+	}
+}
+
+// NewHalvingMintSchedule returns a mint schedule minting initialEpochProvision
+// per epoch from startEpoch on, halved every halvingPeriodEpochs epochs.
+func NewHalvingMintSchedule(epochIdentifier string, startEpoch int64, initialEpochProvision math.Int, halvingPeriodEpochs uint64) MintSchedule {
+	return MintSchedule{
+		EpochIdentifier:       epochIdentifier,
+		StartEpoch:            startEpoch,
+		InitialEpochProvision: initialEpochProvision,
+		HalvingPeriodEpochs:   halvingPeriodEpochs,
+	}
+}
+
+// NewTableMintSchedule returns a mint schedule minting the amounts of the
+// provisions table from startEpoch on.
+func NewTableMintSchedule(epochIdentifier string, startEpoch int64, provisions []EpochProvision) MintSchedule {
+	return MintSchedule{
+		EpochIdentifier:       epochIdentifier,
+		StartEpoch:            startEpoch,
+		InitialEpochProvision: math.ZeroInt(),
+		EpochProvisions:       provisions,
+	}
+}
+
+// Enabled returns true if the schedule mints at the start of an epoch.
+func (s MintSchedule) Enabled() bool {
+	return s.EpochIdentifier != ""
+}
+
+// EpochProvision returns the amount minted by the schedule at the start of
+// the given epoch.
+func (s MintSchedule) EpochProvision(epochNumber int64) math.Int {
+	if epochNumber < s.StartEpoch {
+		return math.ZeroInt()
+	}
+	elapsed := uint64(epochNumber - s.StartEpoch)
+
+	if len(s.EpochProvisions) > 0 {
+		for _, provision := range s.EpochProvisions {
+			if elapsed < provision.Epochs {
+				return provision.Amount
+			}
+			elapsed -= provision.Epochs
+		}
+		return math.ZeroInt()
+	}
+
+	if s.InitialEpochProvision.IsNil() {
+		return math.ZeroInt()
+	}
+	if s.HalvingPeriodEpochs == 0 {
+		return s.InitialEpochProvision
+	}
+	halvings := elapsed / s.HalvingPeriodEpochs
+	if halvings >= maxHalvings {
+		return math.ZeroInt()
+	}
+	return math.NewIntFromBigInt(new(big.Int).Rsh(s.InitialEpochProvision.BigInt(), uint(halvings)))
+}
+
+// Validate does the sanity check on the schedule. An unset initial epoch
+// provision is considered zero.
+func (s MintSchedule) Validate() error {
+	if s.StartEpoch < 0 {
+		return fmt.Errorf("mint schedule start epoch cannot be negative: %d", s.StartEpoch)
+	}
+	initialEpochProvision := s.InitialEpochProvision
+	if initialEpochProvision.IsNil() {
+		initialEpochProvision = math.ZeroInt()
+	}
+	if initialEpochProvision.IsNegative() {
+		return fmt.Errorf("mint schedule initial epoch provision cannot be negative: %s", initialEpochProvision)
+	}
+
+	if len(s.EpochProvisions) > 0 && (initialEpochProvision.IsPositive() || s.HalvingPeriodEpochs > 0) {
+		return errors.New("mint schedule cannot have both an epoch provisions table and a halving schedule")
+	}
+	for i, provision := range s.EpochProvisions {
+		if provision.Epochs == 0 {
+			return fmt.Errorf("mint schedule epoch provision %d must span at least one epoch", i)
+		}
+		if provision.Amount.IsNil() {
+			return fmt.Errorf("mint schedule epoch provision %d amount cannot be nil", i)
+		}
+		if provision.Amount.IsNegative() {
+			return fmt.Errorf("mint schedule epoch provision %d amount cannot be negative: %s", i, provision.Amount)
+		}
+	}
+
+	return nil
+}
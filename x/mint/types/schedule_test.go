@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestMintScheduleEpochProvision(t *testing.T) {
+	halving := NewHalvingMintSchedule("week", 10, math.NewInt(1000), 3)
+	require.Equal(t, math.ZeroInt(), halving.EpochProvision(9))
+	require.Equal(t, math.NewInt(1000), halving.EpochProvision(10))
+	require.Equal(t, math.NewInt(1000), halving.EpochProvision(12))
+	require.Equal(t, math.NewInt(500), halving.EpochProvision(13))
+	require.Equal(t, math.NewInt(250), halving.EpochProvision(16))
+	require.Equal(t, math.ZeroInt(), halving.EpochProvision(10+3*maxHalvings))
+
+	constant := NewHalvingMintSchedule("week", 0, math.NewInt(1000), 0)
+	require.Equal(t, math.NewInt(1000), constant.EpochProvision(1_000_000))
+
+	table := NewTableMintSchedule("day", 1, []EpochProvision{
+		{Epochs: 2, Amount: math.NewInt(300)},
+		{Epochs: 1, Amount: math.NewInt(100)},
+	})
+	require.Equal(t, math.ZeroInt(), table.EpochProvision(0))
+	require.Equal(t, math.NewInt(300), table.EpochProvision(1))
+	require.Equal(t, math.NewInt(300), table.EpochProvision(2))
+	require.Equal(t, math.NewInt(100), table.EpochProvision(3))
+	require.Equal(t, math.ZeroInt(), table.EpochProvision(4))
+}
+
+func TestMintScheduleValidate(t *testing.T) {
+	require.NoError(t, DefaultMintSchedule().Validate())
+	require.NoError(t, NewHalvingMintSchedule("week", 1, math.NewInt(1000), 52).Validate())
+	require.NoError(t, NewTableMintSchedule("day", 1, []EpochProvision{{Epochs: 1, Amount: math.NewInt(1)}}).Validate())
+
+	schedule := NewHalvingMintSchedule("week", -1, math.NewInt(1000), 52)
+	require.ErrorContains(t, schedule.Validate(), "start epoch cannot be negative")
+
+	// an unset initial epoch provision mints nothing
+	schedule = NewHalvingMintSchedule("week", 1, math.Int{}, 52)
+	require.NoError(t, schedule.Validate())
+	require.Equal(t, math.ZeroInt(), schedule.EpochProvision(1))
+
+	schedule = NewHalvingMintSchedule("week", 1, math.NewInt(-1), 52)
+	require.ErrorContains(t, schedule.Validate(), "initial epoch provision cannot be negative")
+
+	schedule = NewHalvingMintSchedule("week", 1, math.NewInt(1000), 52)
+	schedule.EpochProvisions = []EpochProvision{{Epochs: 1, Amount: math.NewInt(1)}}
+	require.ErrorContains(t, schedule.Validate(), "cannot have both an epoch provisions table and a halving schedule")
+
+	schedule = NewTableMintSchedule("day", 1, []EpochProvision{{Epochs: 0, Amount: math.NewInt(1)}})
+	require.ErrorContains(t, schedule.Validate(), "must span at least one epoch")
+
+	schedule = NewTableMintSchedule("day", 1, []EpochProvision{{Epochs: 1, Amount: math.NewInt(-1)}})
+	require.ErrorContains(t, schedule.Validate(), "amount cannot be negative")
+
+	params := DefaultParams()
+	params.Schedule.InitialEpochProvision = math.NewInt(-1)
+	require.Error(t, params.Validate())
+}
@@ -28,6 +28,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "Grant",
+					Use:            "grant [grant-id]",
+					Short:          "Query a milestone-based grant and the status of its milestones",
+					Example:        fmt.Sprintf(`$ %s query protocolpool grant 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "grant_id"}},
+				},
+				{
+					RpcMethod: "Grants",
+					Use:       "grants",
+					Short:     "Query the milestone-based grants, optionally filtered by recipient",
+					Example:   fmt.Sprintf(`$ %s query protocolpool grants --recipient cosmos1...`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +102,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CreateGrant",
+					Use:       "create-grant <recipient> <reviewers> <milestone> [milestone...]",
+					Short:     "Create a milestone-based grant, escrowing its funds from the community pool",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool create-grant cosmos1... cosmos1...,cosmos1... '{"description":"audit","amount":[{"denom":"uatom","amount":"1000"}]}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+						{ProtoField: "reviewers"},
+						{ProtoField: "milestones", Varargs: true},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "ApproveMilestone",
+					Use:       "approve-milestone <reviewer> <grant-id> <milestone>",
+					Short:     "Approve a grant milestone, paying its amount to the recipient",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool approve-milestone cosmos1... 1 0 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "reviewer"},
+						{ProtoField: "grant_id"},
+						{ProtoField: "milestone"},
+					},
+				},
+				{
+					RpcMethod: "CancelGrant",
+					Use:       "cancel-grant <grant-id>",
+					Short:     "Cancel a grant, returning the funds of its pending milestones to the community pool",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool cancel-grant 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "grant_id"},
+					},
+					GovProposal: true,
+				},
 			},
 		},
 	}
@@ -69,6 +69,20 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		return errors.New("total to be distributed is greater than the last balance")
 	}
 
+	nextGrantID := uint64(0)
+	for _, grant := range data.Grants {
+		if err := k.Grants.Set(ctx, grant.Id, grant); err != nil {
+			return fmt.Errorf("failed to set grant %d: %w", grant.Id, err)
+		}
+		if grant.Id >= nextGrantID {
+			nextGrantID = grant.Id + 1
+		}
+	}
+
+	if err := k.GrantSequence.Set(ctx, nextGrantID); err != nil {
+		return fmt.Errorf("failed to set grant sequence: %w", err)
+	}
+
 	return nil
 }
 
@@ -129,5 +143,13 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.Grants.Walk(ctx, nil, func(_ uint64, grant types.Grant) (stop bool, err error) {
+		genState.Grants = append(genState.Grants, grant)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return genState, nil
 }
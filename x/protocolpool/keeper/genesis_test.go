@@ -50,4 +50,28 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(gs.ContinuousFund, exportedGenState.ContinuousFund)
 	suite.Require().Equal(gs.Budget, exportedGenState.Budget)
 	suite.Require().Equal(math.OneInt(), exportedGenState.LastBalance)
+
+	// Grants
+	gs.Grants = []types.Grant{
+		{
+			Id:        3,
+			Recipient: "cosmos1qy3529yj3v4xw2z3vz3vz3vz3vz3vz3v3k0vyf",
+			Reviewers: []string{"cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4"},
+			Milestones: []types.Milestone{
+				{Description: "audit", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Status: types.MilestoneStatus_MILESTONE_STATUS_PENDING},
+			},
+			Status: types.GrantStatus_GRANT_STATUS_ACTIVE,
+		},
+	}
+	suite.Require().NoError(types.ValidateGenesis(gs))
+	suite.Require().NoError(suite.poolKeeper.InitGenesis(suite.ctx, gs))
+
+	exportedGenState, err = suite.poolKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gs.Grants, exportedGenState.Grants)
+
+	// the next grant id follows the imported grants
+	nextGrantID, err := suite.poolKeeper.GrantSequence.Peek(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), nextGrantID)
 }
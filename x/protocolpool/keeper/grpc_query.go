@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -73,3 +74,51 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// Grant queries a milestone-based grant by its id
+func (k Querier) Grant(ctx context.Context, req *types.QueryGrantRequest) (*types.QueryGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	grant, err := k.Keeper.Grants.Get(ctx, req.GrantId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no grant found with id: %d", req.GrantId)
+		}
+		return nil, err
+	}
+
+	return &types.QueryGrantResponse{
+		Grant:          grant,
+		PaidAmount:     grant.AmountWithStatus(types.MilestoneStatus_MILESTONE_STATUS_APPROVED),
+		EscrowedAmount: grant.AmountWithStatus(types.MilestoneStatus_MILESTONE_STATUS_PENDING),
+	}, nil
+}
+
+// Grants queries the milestone-based grants, optionally filtered by recipient
+func (k Querier) Grants(ctx context.Context, req *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Recipient != "" {
+		if _, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Recipient); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err.Error())
+		}
+	}
+
+	grants, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.Grants, req.Pagination,
+		func(_ uint64, grant types.Grant) (bool, error) {
+			return req.Recipient == "" || grant.Recipient == req.Recipient, nil
+		},
+		func(_ uint64, grant types.Grant) (types.Grant, error) {
+			return grant, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
 
@@ -123,3 +125,38 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGrants() {
+	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	reviewer, err := addrCodec.BytesToString(sdk.AccAddress("reviewer____________"))
+	suite.Require().NoError(err)
+	recipientStrAddr, err := addrCodec.BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	grantID := suite.createGrant([]string{reviewer}, sdk.NewCoins(fooCoin), sdk.NewCoins(fooCoin2))
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.GrantAccount, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil).Times(1)
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 0})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.Grant(suite.ctx, &types.QueryGrantRequest{GrantId: grantID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(grantID, res.Grant.Id)
+	suite.Require().Equal(sdk.NewCoins(fooCoin), res.PaidAmount)
+	suite.Require().Equal(sdk.NewCoins(fooCoin2), res.EscrowedAmount)
+
+	_, err = suite.queryServer.Grant(suite.ctx, &types.QueryGrantRequest{GrantId: 5})
+	suite.Require().ErrorContains(err, "no grant found with id: 5")
+
+	grants, err := suite.queryServer.Grants(suite.ctx, &types.QueryGrantsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(grants.Grants, 1)
+
+	grants, err = suite.queryServer.Grants(suite.ctx, &types.QueryGrantsRequest{Recipient: recipientStrAddr})
+	suite.Require().NoError(err)
+	suite.Require().Len(grants.Grants, 1)
+
+	grants, err = suite.queryServer.Grants(suite.ctx, &types.QueryGrantsRequest{Recipient: reviewer})
+	suite.Require().NoError(err)
+	suite.Require().Empty(grants.Grants)
+}
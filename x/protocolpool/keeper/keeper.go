@@ -38,6 +38,9 @@ type Keeper struct {
 	RecipientFundDistribution collections.Map[sdk.AccAddress, math.Int]
 	Distributions             collections.Map[time.Time, math.Int] // key: time.Time | value: amount
 	LastBalance               collections.Item[math.Int]
+	// Grants key: GrantID | value: milestone-based grant
+	Grants        collections.Map[uint64, types.Grant]
+	GrantSequence collections.Sequence
 }
 
 const (
@@ -58,6 +61,10 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 	if addr := ak.GetModuleAddress(types.ProtocolPoolDistrAccount); addr == nil {
 		panic(fmt.Sprintf(errModuleAccountNotSet, types.ProtocolPoolDistrAccount))
 	}
+	// ensure grant escrow account is set
+	if addr := ak.GetModuleAddress(types.GrantAccount); addr == nil {
+		panic(fmt.Sprintf(errModuleAccountNotSet, types.GrantAccount))
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
@@ -73,6 +80,8 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		RecipientFundDistribution: collections.NewMap(sb, types.RecipientFundDistributionKey, "recipient_fund_distribution", sdk.AccAddressKey, sdk.IntValue),
 		Distributions:             collections.NewMap(sb, types.DistributionsKey, "distributions", sdk.TimeKey, sdk.IntValue),
 		LastBalance:               collections.NewItem(sb, types.LastBalanceKey, "last_balance", sdk.IntValue),
+		Grants:                    collections.NewMap(sb, types.GrantsKey, "grants", collections.Uint64Key, codec.CollValue[types.Grant](cdc)),
+		GrantSequence:             collections.NewSequence(sb, types.GrantSequenceKey, "grant_sequence"),
 	}
 
 	schema, err := sb.Build()
@@ -507,6 +516,23 @@ func (k Keeper) validateContinuousFund(ctx context.Context, msg types.MsgCreateC
 	return nil
 }
 
+// getActiveGrant returns the grant with the given id, failing if it is completed or canceled.
+func (k Keeper) getActiveGrant(ctx context.Context, grantID uint64) (types.Grant, error) {
+	grant, err := k.Grants.Get(ctx, grantID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Grant{}, errorsmod.Wrapf(types.ErrGrantNotFound, "grant %d", grantID)
+		}
+		return types.Grant{}, err
+	}
+
+	if grant.Status != types.GrantStatus_GRANT_STATUS_ACTIVE {
+		return types.Grant{}, errorsmod.Wrapf(types.ErrGrantNotActive, "grant %d is %s", grantID, grant.Status)
+	}
+
+	return grant, nil
+}
+
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyBeginBlocker)
@@ -4,6 +4,7 @@ import (
 	"context"
 	errorspkg "errors"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -225,6 +226,146 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) CreateGrant(ctx context.Context, msg *types.MsgCreateGrant) (*types.MsgCreateGrantResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateGrantParams(msg.Recipient, msg.Reviewers, msg.Milestones); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	for _, reviewer := range msg.Reviewers {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(reviewer); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid reviewer address: %s", err)
+		}
+	}
+
+	milestones := make([]types.Milestone, len(msg.Milestones))
+	total := sdk.NewCoins()
+	for i, m := range msg.Milestones {
+		if m.Status != types.MilestoneStatus_MILESTONE_STATUS_UNSPECIFIED || m.ApprovedBy != "" || m.ApprovedAt != nil {
+			return nil, errors.Wrapf(types.ErrInvalidMilestone, "milestone %d: only description and amount can be set", i)
+		}
+
+		milestones[i] = types.Milestone{
+			Description: m.Description,
+			Amount:      m.Amount,
+			Status:      types.MilestoneStatus_MILESTONE_STATUS_PENDING,
+		}
+		total = total.Add(m.Amount...)
+	}
+
+	// escrow the funds of all the milestones from the community pool
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.GrantAccount, total); err != nil {
+		return nil, fmt.Errorf("error while escrowing grant funds from the community pool: %w", err)
+	}
+
+	grantID, err := k.GrantSequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grant := types.Grant{
+		Id:         grantID,
+		Recipient:  msg.Recipient,
+		Reviewers:  msg.Reviewers,
+		Milestones: milestones,
+		Status:     types.GrantStatus_GRANT_STATUS_ACTIVE,
+	}
+	if err := k.Grants.Set(ctx, grantID, grant); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGrantResponse{GrantId: grantID}, nil
+}
+
+func (k MsgServer) ApproveMilestone(ctx context.Context, msg *types.MsgApproveMilestone) (*types.MsgApproveMilestoneResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Reviewer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid reviewer address: %s", err)
+	}
+
+	grant, err := k.getActiveGrant(ctx, msg.GrantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(grant.Reviewers, msg.Reviewer) {
+		return nil, errors.Wrapf(types.ErrInvalidReviewer, "%s cannot approve milestones of grant %d", msg.Reviewer, msg.GrantId)
+	}
+
+	if int(msg.Milestone) >= len(grant.Milestones) {
+		return nil, errors.Wrapf(types.ErrInvalidMilestone, "grant %d has no milestone %d", msg.GrantId, msg.Milestone)
+	}
+
+	milestone := &grant.Milestones[msg.Milestone]
+	if milestone.Status != types.MilestoneStatus_MILESTONE_STATUS_PENDING {
+		return nil, errors.Wrapf(types.ErrInvalidMilestone, "milestone %d of grant %d is %s", msg.Milestone, msg.GrantId, milestone.Status)
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(grant.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	// pay the milestone to the recipient from the escrow
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.GrantAccount, recipient, milestone.Amount); err != nil {
+		return nil, fmt.Errorf("error while paying milestone %d of grant %d: %w", msg.Milestone, msg.GrantId, err)
+	}
+
+	approvedAt := k.HeaderService.HeaderInfo(ctx).Time
+	milestone.Status = types.MilestoneStatus_MILESTONE_STATUS_APPROVED
+	milestone.ApprovedBy = msg.Reviewer
+	milestone.ApprovedAt = &approvedAt
+	amount := milestone.Amount
+
+	if grant.AmountWithStatus(types.MilestoneStatus_MILESTONE_STATUS_PENDING).IsZero() {
+		grant.Status = types.GrantStatus_GRANT_STATUS_COMPLETED
+	}
+
+	if err := k.Grants.Set(ctx, grant.Id, grant); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveMilestoneResponse{Amount: amount}, nil
+}
+
+func (k MsgServer) CancelGrant(ctx context.Context, msg *types.MsgCancelGrant) (*types.MsgCancelGrantResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	grant, err := k.getActiveGrant(ctx, msg.GrantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// claw back the funds of the milestones not approved yet
+	clawedBack := grant.AmountWithStatus(types.MilestoneStatus_MILESTONE_STATUS_PENDING)
+	if !clawedBack.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.GrantAccount, types.ModuleName, clawedBack); err != nil {
+			return nil, fmt.Errorf("error while returning grant funds to the community pool: %w", err)
+		}
+	}
+
+	for i := range grant.Milestones {
+		if grant.Milestones[i].Status == types.MilestoneStatus_MILESTONE_STATUS_PENDING {
+			grant.Milestones[i].Status = types.MilestoneStatus_MILESTONE_STATUS_CLAWED_BACK
+		}
+	}
+	grant.Status = types.GrantStatus_GRANT_STATUS_CANCELED
+
+	if err := k.Grants.Set(ctx, grant.Id, grant); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelGrantResponse{ClawedBackAmount: clawedBack}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
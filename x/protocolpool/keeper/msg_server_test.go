@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -1039,3 +1040,187 @@ func (suite *KeeperTestSuite) TestCommunityPoolSpend() {
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) createGrant(reviewers []string, milestones ...sdk.Coins) uint64 {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	msg := &types.MsgCreateGrant{
+		Authority: suite.poolKeeper.GetAuthority(),
+		Recipient: recipientStrAddr,
+		Reviewers: reviewers,
+	}
+	total := sdk.NewCoins()
+	for i, amount := range milestones {
+		msg.Milestones = append(msg.Milestones, types.Milestone{Description: fmt.Sprintf("milestone %d", i), Amount: amount})
+		total = total.Add(amount...)
+	}
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, types.GrantAccount, total).Return(nil).Times(1)
+	res, err := suite.msgServer.CreateGrant(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	return res.GrantId
+}
+
+func (suite *KeeperTestSuite) TestCreateGrant() {
+	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	recipientStrAddr, err := addrCodec.BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+	reviewer, err := addrCodec.BytesToString(sdk.AccAddress("reviewer____________"))
+	suite.Require().NoError(err)
+
+	milestone := types.Milestone{Description: "audit", Amount: sdk.NewCoins(fooCoin)}
+	testCases := map[string]struct {
+		malleate  func(msg *types.MsgCreateGrant)
+		expErr    error
+		expErrMsg string
+	}{
+		"invalid authority": {
+			malleate:  func(msg *types.MsgCreateGrant) { msg.Authority = reviewer },
+			expErr:    types.ErrInvalidSigner,
+			expErrMsg: "invalid authority",
+		},
+		"no reviewer": {
+			malleate:  func(msg *types.MsgCreateGrant) { msg.Reviewers = nil },
+			expErr:    types.ErrInvalidGrant,
+			expErrMsg: "at least one reviewer is required",
+		},
+		"recipient as reviewer": {
+			malleate:  func(msg *types.MsgCreateGrant) { msg.Reviewers = []string{recipientStrAddr} },
+			expErr:    types.ErrInvalidGrant,
+			expErrMsg: "recipient cannot review its own grant",
+		},
+		"duplicate reviewer": {
+			malleate:  func(msg *types.MsgCreateGrant) { msg.Reviewers = []string{reviewer, reviewer} },
+			expErr:    types.ErrInvalidGrant,
+			expErrMsg: "duplicate reviewer",
+		},
+		"no milestone": {
+			malleate:  func(msg *types.MsgCreateGrant) { msg.Milestones = nil },
+			expErr:    types.ErrInvalidGrant,
+			expErrMsg: "at least one milestone is required",
+		},
+		"zero milestone amount": {
+			malleate:  func(msg *types.MsgCreateGrant) { msg.Milestones[0].Amount = nil },
+			expErr:    types.ErrInvalidMilestone,
+			expErrMsg: "amount cannot be zero",
+		},
+		"milestone with status": {
+			malleate: func(msg *types.MsgCreateGrant) {
+				msg.Milestones[0].Status = types.MilestoneStatus_MILESTONE_STATUS_APPROVED
+			},
+			expErr:    types.ErrInvalidMilestone,
+			expErrMsg: "only description and amount can be set",
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			msg := &types.MsgCreateGrant{
+				Authority:  suite.poolKeeper.GetAuthority(),
+				Recipient:  recipientStrAddr,
+				Reviewers:  []string{reviewer},
+				Milestones: []types.Milestone{milestone},
+			}
+			tc.malleate(msg)
+
+			_, err := suite.msgServer.CreateGrant(suite.ctx, msg)
+			suite.Require().ErrorIs(err, tc.expErr)
+			suite.Require().ErrorContains(err, tc.expErrMsg)
+		})
+	}
+
+	suite.SetupTest()
+	grantID := suite.createGrant([]string{reviewer}, sdk.NewCoins(fooCoin), sdk.NewCoins(fooCoin2))
+	suite.Require().Equal(uint64(0), grantID)
+	suite.Require().Equal(uint64(1), suite.createGrant([]string{reviewer}, sdk.NewCoins(fooCoin)))
+
+	grant, err := suite.poolKeeper.Grants.Get(suite.ctx, grantID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GrantStatus_GRANT_STATUS_ACTIVE, grant.Status)
+	suite.Require().Len(grant.Milestones, 2)
+	for _, m := range grant.Milestones {
+		suite.Require().Equal(types.MilestoneStatus_MILESTONE_STATUS_PENDING, m.Status)
+	}
+}
+
+func (suite *KeeperTestSuite) TestApproveMilestone() {
+	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	reviewer, err := addrCodec.BytesToString(sdk.AccAddress("reviewer____________"))
+	suite.Require().NoError(err)
+	other, err := addrCodec.BytesToString(sdk.AccAddress("other_______________"))
+	suite.Require().NoError(err)
+
+	grantID := suite.createGrant([]string{reviewer}, sdk.NewCoins(fooCoin), sdk.NewCoins(fooCoin2))
+
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: 5})
+	suite.Require().ErrorIs(err, types.ErrGrantNotFound)
+
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: other, GrantId: grantID})
+	suite.Require().ErrorIs(err, types.ErrInvalidReviewer)
+
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 2})
+	suite.Require().ErrorIs(err, types.ErrInvalidMilestone)
+
+	// milestones can be approved in any order
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.GrantAccount, recipientAddr, sdk.NewCoins(fooCoin2)).Return(nil).Times(1)
+	res, err := suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(fooCoin2), res.Amount)
+
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 1})
+	suite.Require().ErrorIs(err, types.ErrInvalidMilestone)
+
+	grant, err := suite.poolKeeper.Grants.Get(suite.ctx, grantID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GrantStatus_GRANT_STATUS_ACTIVE, grant.Status)
+	suite.Require().Equal(reviewer, grant.Milestones[1].ApprovedBy)
+	suite.Require().Equal(suite.ctx.HeaderInfo().Time, *grant.Milestones[1].ApprovedAt)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.GrantAccount, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil).Times(1)
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 0})
+	suite.Require().NoError(err)
+
+	grant, err = suite.poolKeeper.Grants.Get(suite.ctx, grantID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GrantStatus_GRANT_STATUS_COMPLETED, grant.Status)
+
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 0})
+	suite.Require().ErrorIs(err, types.ErrGrantNotActive)
+}
+
+func (suite *KeeperTestSuite) TestCancelGrant() {
+	reviewer, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(sdk.AccAddress("reviewer____________"))
+	suite.Require().NoError(err)
+
+	barCoin := sdk.NewInt64Coin("bar", 10)
+	grantID := suite.createGrant([]string{reviewer}, sdk.NewCoins(fooCoin), sdk.NewCoins(fooCoin2), sdk.NewCoins(barCoin))
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.GrantAccount, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil).Times(1)
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 0})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.CancelGrant(suite.ctx, &types.MsgCancelGrant{Authority: reviewer, GrantId: grantID})
+	suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	clawedBack := sdk.NewCoins(fooCoin2, barCoin)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.GrantAccount, types.ModuleName, clawedBack).Return(nil).Times(1)
+	res, err := suite.msgServer.CancelGrant(suite.ctx, &types.MsgCancelGrant{Authority: suite.poolKeeper.GetAuthority(), GrantId: grantID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(clawedBack, res.ClawedBackAmount)
+
+	grant, err := suite.poolKeeper.Grants.Get(suite.ctx, grantID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GrantStatus_GRANT_STATUS_CANCELED, grant.Status)
+	suite.Require().Equal(types.MilestoneStatus_MILESTONE_STATUS_APPROVED, grant.Milestones[0].Status)
+	suite.Require().Equal(types.MilestoneStatus_MILESTONE_STATUS_CLAWED_BACK, grant.Milestones[1].Status)
+	suite.Require().Equal(types.MilestoneStatus_MILESTONE_STATUS_CLAWED_BACK, grant.Milestones[2].Status)
+
+	_, err = suite.msgServer.CancelGrant(suite.ctx, &types.MsgCancelGrant{Authority: suite.poolKeeper.GetAuthority(), GrantId: grantID})
+	suite.Require().ErrorIs(err, types.ErrGrantNotActive)
+
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Reviewer: reviewer, GrantId: grantID, Milestone: 1})
+	suite.Require().ErrorIs(err, types.ErrGrantNotActive)
+}
@@ -5,4 +5,9 @@ import "cosmossdk.io/errors"
 var (
 	ErrInvalidSigner    = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
 	ErrNoRecipientFound = errors.Register(ModuleName, 3, "no recipient found")
+	ErrInvalidGrant     = errors.Register(ModuleName, 4, "invalid grant")
+	ErrGrantNotFound    = errors.Register(ModuleName, 5, "grant not found")
+	ErrGrantNotActive   = errors.Register(ModuleName, 6, "grant is not active")
+	ErrInvalidReviewer  = errors.Register(ModuleName, 7, "address is not a reviewer of the grant")
+	ErrInvalidMilestone = errors.Register(ModuleName, 8, "invalid milestone")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -37,6 +38,18 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+
+	grantIDs := make(map[uint64]struct{}, len(gs.Grants))
+	for _, g := range gs.Grants {
+		if _, ok := grantIDs[g.Id]; ok {
+			return fmt.Errorf("duplicate grant id %d", g.Id)
+		}
+		grantIDs[g.Id] = struct{}{}
+
+		if err := validateGrant(g); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
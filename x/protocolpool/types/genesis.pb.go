@@ -42,6 +42,8 @@ type GenesisState struct {
 	// funds and budgets. It contains time in order to distribute to non-expired
 	// funds only.
 	Distributions []*Distribution `protobuf:"bytes,4,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	// grants defines the milestone-based grants at genesis.
+	Grants []Grant `protobuf:"bytes,5,rep,name=grants,proto3" json:"grants"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +100,13 @@ func (m *GenesisState) GetDistributions() []*Distribution {
 	return nil
 }
 
+func (m *GenesisState) GetGrants() []Grant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 type Distribution struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	Time   *time.Time            `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time,omitempty"`
@@ -153,32 +162,34 @@ func init() {
 }
 
 var fileDescriptor_72560a99455b4146 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xca, 0xd3, 0x40,
-	0x14, 0xc5, 0x33, 0x5f, 0x4b, 0xc0, 0x69, 0x55, 0x08, 0x2a, 0x31, 0x8b, 0xa4, 0x96, 0x22, 0x05,
-	0x71, 0x42, 0xab, 0xb8, 0x71, 0x97, 0x8a, 0x52, 0x17, 0x0a, 0xd1, 0x95, 0x9b, 0x92, 0x3f, 0xd3,
-	0x18, 0x4c, 0xe6, 0x86, 0xce, 0x4c, 0xd1, 0x47, 0x70, 0xd7, 0x77, 0xd1, 0x87, 0xe8, 0xb2, 0xb8,
-	0x12, 0x17, 0x55, 0xda, 0x17, 0x91, 0xce, 0xa4, 0x92, 0x80, 0xdd, 0x7c, 0xbb, 0xc9, 0xbd, 0xbf,
-	0x73, 0x72, 0xe0, 0x5c, 0x3c, 0x4a, 0x80, 0x97, 0xc0, 0xfd, 0x6a, 0x05, 0x02, 0x12, 0x28, 0x2a,
-	0x80, 0xc2, 0x5f, 0x4f, 0xfc, 0x8c, 0x32, 0xca, 0x73, 0x4e, 0xd4, 0xdc, 0xba, 0xa7, 0x29, 0xd2,
-	0xa4, 0xc8, 0x7a, 0xe2, 0x0c, 0x2f, 0xa8, 0xc5, 0x97, 0x8a, 0xd6, 0xb4, 0x73, 0x27, 0x83, 0x0c,
-	0xd4, 0xd3, 0x3f, 0xbd, 0xea, 0xe9, 0x7d, 0xad, 0x5c, 0xe8, 0x45, 0xd3, 0xde, 0xf1, 0x32, 0x80,
-	0xac, 0xa0, 0xda, 0x34, 0x96, 0x4b, 0x5f, 0xe4, 0x25, 0xe5, 0x22, 0x2a, 0x2b, 0x0d, 0x0c, 0xbf,
-	0x5d, 0xe1, 0xfe, 0x2b, 0x9d, 0xef, 0x9d, 0x88, 0x04, 0xb5, 0xde, 0xe2, 0xdb, 0x09, 0x30, 0x91,
-	0x33, 0x09, 0x92, 0x2f, 0x96, 0x92, 0xa5, 0x36, 0x1a, 0x74, 0xc6, 0xbd, 0xe9, 0x43, 0xf2, 0xff,
-	0xe0, 0x64, 0xf6, 0x0f, 0x7f, 0x29, 0x59, 0x1a, 0xde, 0x4a, 0x5a, 0xdf, 0xd6, 0x33, 0x6c, 0xc6,
-	0x32, 0xcd, 0xa8, 0xb0, 0xaf, 0x94, 0x8f, 0x7b, 0xc9, 0x27, 0x50, 0x54, 0x58, 0xd3, 0xd6, 0x1b,
-	0xdc, 0x2f, 0x22, 0x2e, 0x16, 0x71, 0x54, 0x44, 0x2c, 0xa1, 0x76, 0x67, 0x80, 0xc6, 0x37, 0x82,
-	0x47, 0xdb, 0xbd, 0x67, 0xfc, 0xda, 0x7b, 0x77, 0xb5, 0x09, 0x4f, 0x3f, 0x91, 0x1c, 0xfc, 0x32,
-	0x12, 0x1f, 0xc9, 0x9c, 0x89, 0x1f, 0xdf, 0x1f, 0xe3, 0xda, 0x7d, 0xce, 0x44, 0xd8, 0x3b, 0x19,
-	0x04, 0x5a, 0x6f, 0xbd, 0xc6, 0x37, 0xd3, 0x9c, 0x8b, 0x55, 0x1e, 0x4b, 0x91, 0x03, 0xe3, 0x76,
-	0x57, 0xc5, 0x19, 0x5d, 0x8a, 0xf3, 0xa2, 0x01, 0x87, 0x6d, 0xe9, 0xf0, 0x2b, 0xc2, 0xfd, 0xe6,
-	0xde, 0x9a, 0x61, 0x33, 0x2a, 0x41, 0x32, 0x71, 0x9d, 0x98, 0xb5, 0xd4, 0x7a, 0x8a, 0xbb, 0xa7,
-	0x7a, 0x6c, 0x73, 0x80, 0xc6, 0xbd, 0xa9, 0x43, 0x74, 0x77, 0xe4, 0xdc, 0x1d, 0x79, 0x7f, 0xee,
-	0x2e, 0xe8, 0x6e, 0x7e, 0x7b, 0x28, 0x54, 0x74, 0xf0, 0x7c, 0x7b, 0x70, 0xd1, 0xee, 0xe0, 0xa2,
-	0x3f, 0x07, 0x17, 0x6d, 0x8e, 0xae, 0xb1, 0x3b, 0xba, 0xc6, 0xcf, 0xa3, 0x6b, 0x7c, 0x78, 0xd0,
-	0xfa, 0xf9, 0xe7, 0xf6, 0x65, 0xa9, 0xb3, 0x8a, 0x4d, 0x35, 0x7b, 0xf2, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x0c, 0x77, 0xdd, 0xf7, 0xbb, 0x02, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x77, 0xda, 0x35, 0xe0, 0xec, 0xaa, 0x30, 0xa8, 0xc4, 0x05, 0xb3, 0xeb, 0x52, 0x64,
+	0x41, 0x9c, 0xd0, 0x2a, 0x5e, 0x7a, 0x4b, 0xc5, 0x52, 0x0f, 0x0a, 0xd1, 0x93, 0x97, 0x65, 0x92,
+	0x4c, 0x63, 0x30, 0x99, 0x6f, 0xd9, 0xf9, 0xa6, 0xe8, 0x23, 0x78, 0xeb, 0xc3, 0xf8, 0x10, 0x3d,
+	0x16, 0x4f, 0xe2, 0xa1, 0xca, 0xee, 0x0b, 0xf8, 0x08, 0x92, 0x99, 0xac, 0x6c, 0xc0, 0x5c, 0x7a,
+	0x9b, 0x7c, 0xf9, 0xfd, 0x7f, 0xf9, 0x87, 0x6f, 0xe8, 0x5e, 0x0a, 0xba, 0x02, 0x1d, 0x2e, 0x96,
+	0x80, 0x90, 0x42, 0xb9, 0x00, 0x28, 0xc3, 0xb3, 0xfd, 0x30, 0x97, 0x4a, 0xea, 0x42, 0x73, 0x3b,
+	0x67, 0xf7, 0x1d, 0xc5, 0xb7, 0x29, 0x7e, 0xb6, 0x3f, 0x9a, 0x76, 0xa4, 0xf1, 0xcb, 0x42, 0x36,
+	0xf4, 0xe8, 0x6e, 0x0e, 0x39, 0xd8, 0x63, 0x58, 0x9f, 0x9a, 0xe9, 0x03, 0x97, 0x9c, 0xbb, 0x17,
+	0xdb, 0xfa, 0xd1, 0x38, 0x07, 0xc8, 0x4b, 0xe9, 0xa4, 0x89, 0x39, 0x0d, 0xb1, 0xa8, 0xa4, 0x46,
+	0x51, 0x2d, 0x1c, 0x30, 0xfd, 0xb3, 0x43, 0x87, 0xc7, 0xae, 0xdf, 0x3b, 0x14, 0x28, 0xd9, 0x5b,
+	0x7a, 0x27, 0x05, 0x85, 0x85, 0x32, 0x60, 0xf4, 0xfc, 0xd4, 0xa8, 0xcc, 0x27, 0x93, 0xdd, 0xd9,
+	0xe0, 0xe0, 0x31, 0xff, 0x7f, 0x71, 0x7e, 0xf4, 0x0f, 0x7f, 0x65, 0x54, 0x16, 0xdf, 0x4e, 0x5b,
+	0xcf, 0xec, 0x05, 0xf5, 0x12, 0x93, 0xe5, 0x12, 0xfd, 0x1d, 0xeb, 0x09, 0xba, 0x3c, 0x91, 0xa5,
+	0xe2, 0x86, 0x66, 0x6f, 0xe8, 0xb0, 0x14, 0x1a, 0xe7, 0x89, 0x28, 0x85, 0x4a, 0xa5, 0xbf, 0x3b,
+	0x21, 0xb3, 0x9b, 0xd1, 0x93, 0x8b, 0xab, 0x71, 0xef, 0xe7, 0xd5, 0xf8, 0x9e, 0x93, 0xe8, 0xec,
+	0x13, 0x2f, 0x20, 0xac, 0x04, 0x7e, 0xe4, 0x27, 0x0a, 0xbf, 0x7f, 0x7b, 0x4a, 0x1b, 0xfb, 0x89,
+	0xc2, 0x78, 0x50, 0x0b, 0x22, 0x97, 0x67, 0xaf, 0xe9, 0xad, 0xac, 0xd0, 0xb8, 0x2c, 0x12, 0x83,
+	0x05, 0x28, 0xed, 0xf7, 0x6d, 0x9d, 0xbd, 0xae, 0x3a, 0x2f, 0xb7, 0xe0, 0xb8, 0x1d, 0x65, 0x87,
+	0xd4, 0xcb, 0x97, 0x42, 0xa1, 0xf6, 0x6f, 0x58, 0xc9, 0xc3, 0x2e, 0xc9, 0x71, 0x4d, 0x45, 0xfd,
+	0xba, 0x74, 0xdc, 0x44, 0xa6, 0x5f, 0x09, 0x1d, 0x6e, 0xcb, 0xd9, 0x11, 0xf5, 0x44, 0x05, 0x46,
+	0xe1, 0x75, 0xfe, 0xb1, 0x89, 0xb2, 0xe7, 0xb4, 0x5f, 0xef, 0xd6, 0xf7, 0x26, 0x64, 0x36, 0x38,
+	0x18, 0x71, 0xb7, 0x78, 0xbe, 0x59, 0x3c, 0x7f, 0xbf, 0x59, 0x7c, 0xd4, 0x3f, 0xff, 0x35, 0x26,
+	0xb1, 0xa5, 0xa3, 0xc3, 0x8b, 0x55, 0x40, 0x2e, 0x57, 0x01, 0xf9, 0xbd, 0x0a, 0xc8, 0xf9, 0x3a,
+	0xe8, 0x5d, 0xae, 0x83, 0xde, 0x8f, 0x75, 0xd0, 0xfb, 0xf0, 0xa8, 0xf5, 0xf1, 0xcf, 0xed, 0x6b,
+	0x69, 0xef, 0x64, 0xe2, 0xd9, 0xd9, 0xb3, 0xbf, 0x03, 0x00, 0x1a, 0xd7, 0x08, 0xf0, 0xf8, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -201,6 +212,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Distributions) > 0 {
 		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -336,6 +361,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -525,6 +556,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
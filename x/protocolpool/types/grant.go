@@ -0,0 +1,79 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateGrantParams validates the recipient, reviewers and milestones of a new grant.
+func ValidateGrantParams(recipient string, reviewers []string, milestones []Milestone) error {
+	if recipient == "" {
+		return errorsmod.Wrap(ErrInvalidGrant, "recipient cannot be empty")
+	}
+
+	if len(reviewers) == 0 {
+		return errorsmod.Wrap(ErrInvalidGrant, "at least one reviewer is required")
+	}
+
+	seen := make(map[string]struct{}, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer == "" {
+			return errorsmod.Wrap(ErrInvalidGrant, "reviewer cannot be empty")
+		}
+		if reviewer == recipient {
+			return errorsmod.Wrap(ErrInvalidGrant, "recipient cannot review its own grant")
+		}
+		if _, ok := seen[reviewer]; ok {
+			return errorsmod.Wrapf(ErrInvalidGrant, "duplicate reviewer %s", reviewer)
+		}
+		seen[reviewer] = struct{}{}
+	}
+
+	if len(milestones) == 0 {
+		return errorsmod.Wrap(ErrInvalidGrant, "at least one milestone is required")
+	}
+
+	for i, m := range milestones {
+		if m.Description == "" {
+			return errorsmod.Wrapf(ErrInvalidMilestone, "milestone %d: description cannot be empty", i)
+		}
+		if m.Amount.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidMilestone, "milestone %d: amount cannot be zero", i)
+		}
+		if err := m.Amount.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMilestone, "milestone %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// AmountWithStatus returns the total amount of the milestones of the grant with the given status.
+func (g Grant) AmountWithStatus(status MilestoneStatus) sdk.Coins {
+	amount := sdk.NewCoins()
+	for _, m := range g.Milestones {
+		if m.Status == status {
+			amount = amount.Add(m.Amount...)
+		}
+	}
+	return amount
+}
+
+func validateGrant(g Grant) error {
+	if err := ValidateGrantParams(g.Recipient, g.Reviewers, g.Milestones); err != nil {
+		return err
+	}
+
+	if _, ok := GrantStatus_name[int32(g.Status)]; !ok || g.Status == GrantStatus_GRANT_STATUS_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidGrant, "grant %d: invalid status %s", g.Id, g.Status)
+	}
+
+	for i, m := range g.Milestones {
+		if _, ok := MilestoneStatus_name[int32(m.Status)]; !ok || m.Status == MilestoneStatus_MILESTONE_STATUS_UNSPECIFIED {
+			return errorsmod.Wrapf(ErrInvalidMilestone, "grant %d: milestone %d: invalid status %s", g.Id, i, m.Status)
+		}
+	}
+
+	return nil
+}
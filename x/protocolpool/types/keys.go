@@ -12,6 +12,9 @@ const (
 	// ProtocolPoolDistrAccount is an intermediary account that holds the funds to be distributed to the protocolpool accounts.
 	ProtocolPoolDistrAccount = "protocolpool_distr"
 
+	// GrantAccount is the name constant used for the account escrowing the funds of milestone-based grants.
+	GrantAccount = "protocolpool_grants"
+
 	// StoreKey is the store key string for protocolpool
 	StoreKey = ModuleName
 
@@ -31,4 +34,6 @@ var (
 	RecipientFundDistributionKey = collections.NewPrefix(5)
 	DistributionsKey             = collections.NewPrefix(6)
 	LastBalanceKey               = collections.NewPrefix(7)
+	GrantsKey                    = collections.NewPrefix(8)
+	GrantSequenceKey             = collections.NewPrefix(9)
 )
@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +32,79 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GrantStatus defines the status of a milestone-based grant.
+type GrantStatus int32
+
+const (
+	// GRANT_STATUS_UNSPECIFIED defines the default grant status.
+	GrantStatus_GRANT_STATUS_UNSPECIFIED GrantStatus = 0
+	// GRANT_STATUS_ACTIVE defines a grant with milestones pending approval.
+	GrantStatus_GRANT_STATUS_ACTIVE GrantStatus = 1
+	// GRANT_STATUS_COMPLETED defines a grant whose milestones have all been approved.
+	GrantStatus_GRANT_STATUS_COMPLETED GrantStatus = 2
+	// GRANT_STATUS_CANCELED defines a grant canceled by the authority.
+	GrantStatus_GRANT_STATUS_CANCELED GrantStatus = 3
+)
+
+var GrantStatus_name = map[int32]string{
+	0: "GRANT_STATUS_UNSPECIFIED",
+	1: "GRANT_STATUS_ACTIVE",
+	2: "GRANT_STATUS_COMPLETED",
+	3: "GRANT_STATUS_CANCELED",
+}
+
+var GrantStatus_value = map[string]int32{
+	"GRANT_STATUS_UNSPECIFIED": 0,
+	"GRANT_STATUS_ACTIVE":      1,
+	"GRANT_STATUS_COMPLETED":   2,
+	"GRANT_STATUS_CANCELED":    3,
+}
+
+func (x GrantStatus) String() string {
+	return proto.EnumName(GrantStatus_name, int32(x))
+}
+
+func (GrantStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{0}
+}
+
+// MilestoneStatus defines the status of a grant milestone.
+type MilestoneStatus int32
+
+const (
+	// MILESTONE_STATUS_UNSPECIFIED defines the default milestone status.
+	MilestoneStatus_MILESTONE_STATUS_UNSPECIFIED MilestoneStatus = 0
+	// MILESTONE_STATUS_PENDING defines a milestone waiting for the approval of a reviewer.
+	MilestoneStatus_MILESTONE_STATUS_PENDING MilestoneStatus = 1
+	// MILESTONE_STATUS_APPROVED defines a milestone approved by a reviewer and paid to the recipient.
+	MilestoneStatus_MILESTONE_STATUS_APPROVED MilestoneStatus = 2
+	// MILESTONE_STATUS_CLAWED_BACK defines a milestone whose funds returned to the community pool
+	// when the grant was canceled.
+	MilestoneStatus_MILESTONE_STATUS_CLAWED_BACK MilestoneStatus = 3
+)
+
+var MilestoneStatus_name = map[int32]string{
+	0: "MILESTONE_STATUS_UNSPECIFIED",
+	1: "MILESTONE_STATUS_PENDING",
+	2: "MILESTONE_STATUS_APPROVED",
+	3: "MILESTONE_STATUS_CLAWED_BACK",
+}
+
+var MilestoneStatus_value = map[string]int32{
+	"MILESTONE_STATUS_UNSPECIFIED": 0,
+	"MILESTONE_STATUS_PENDING":     1,
+	"MILESTONE_STATUS_APPROVED":    2,
+	"MILESTONE_STATUS_CLAWED_BACK": 3,
+}
+
+func (x MilestoneStatus) String() string {
+	return proto.EnumName(MilestoneStatus_name, int32(x))
+}
+
+func (MilestoneStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+}
+
 // Budget defines the fields of a budget proposal.
 type Budget struct {
 	// recipient_address is the address of the recipient who can claim the budget.
@@ -182,9 +256,179 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
+// Milestone defines a deliverable of a grant and the amount paid once it is approved.
+type Milestone struct {
+	// description describes the deliverable of the milestone.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// amount is the amount paid to the recipient once the milestone is approved.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// status is the status of the milestone.
+	Status MilestoneStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.protocolpool.v1.MilestoneStatus" json:"status,omitempty"`
+	// approved_by is the address of the reviewer who approved the milestone.
+	ApprovedBy string `protobuf:"bytes,4,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	// approved_at is the time when the milestone was approved.
+	ApprovedAt *time.Time `protobuf:"bytes,5,opt,name=approved_at,json=approvedAt,proto3,stdtime" json:"approved_at,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Milestone) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Milestone) GetStatus() MilestoneStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MilestoneStatus_MILESTONE_STATUS_UNSPECIFIED
+}
+
+func (m *Milestone) GetApprovedBy() string {
+	if m != nil {
+		return m.ApprovedBy
+	}
+	return ""
+}
+
+func (m *Milestone) GetApprovedAt() *time.Time {
+	if m != nil {
+		return m.ApprovedAt
+	}
+	return nil
+}
+
+// Grant defines a grant paid out milestone by milestone, upon the approval of a reviewer.
+// The funds of all the milestones are escrowed from the community pool when the grant is created.
+type Grant struct {
+	// id is the unique identifier of the grant.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address of the account receiving the grant.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// reviewers are the addresses allowed to approve the milestones of the grant.
+	// A group policy address can be used to require the approval of several members.
+	Reviewers []string `protobuf:"bytes,3,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	// milestones are the milestones of the grant.
+	Milestones []Milestone `protobuf:"bytes,4,rep,name=milestones,proto3" json:"milestones"`
+	// status is the status of the grant.
+	Status GrantStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.protocolpool.v1.GrantStatus" json:"status,omitempty"`
+}
+
+func (m *Grant) Reset()         { *m = Grant{} }
+func (m *Grant) String() string { return proto.CompactTextString(m) }
+func (*Grant) ProtoMessage()    {}
+func (*Grant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{3}
+}
+func (m *Grant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Grant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Grant.Merge(m, src)
+}
+func (m *Grant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Grant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Grant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Grant proto.InternalMessageInfo
+
+func (m *Grant) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Grant) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Grant) GetReviewers() []string {
+	if m != nil {
+		return m.Reviewers
+	}
+	return nil
+}
+
+func (m *Grant) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Grant) GetStatus() GrantStatus {
+	if m != nil {
+		return m.Status
+	}
+	return GrantStatus_GRANT_STATUS_UNSPECIFIED
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.protocolpool.v1.GrantStatus", GrantStatus_name, GrantStatus_value)
+	proto.RegisterEnum("cosmos.protocolpool.v1.MilestoneStatus", MilestoneStatus_name, MilestoneStatus_value)
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*Milestone)(nil), "cosmos.protocolpool.v1.Milestone")
+	proto.RegisterType((*Grant)(nil), "cosmos.protocolpool.v1.Grant")
 }
 
 func init() {
@@ -192,39 +436,60 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x69, 0x88, 0x94, 0x83, 0x94, 0x72, 0xaa, 0x90, 0x1b, 0x24, 0x27, 0x84, 0x25, 0x4b,
-	0xcf, 0x0a, 0x48, 0x80, 0xc4, 0x42, 0x9d, 0xf0, 0x31, 0x74, 0x80, 0xd0, 0x89, 0xc5, 0x3a, 0xdb,
-	0x6f, 0xdc, 0x13, 0xf6, 0x9d, 0x75, 0x77, 0x8e, 0x9a, 0x95, 0x5f, 0xd0, 0x91, 0x1f, 0xd2, 0x1f,
-	0xd1, 0xb1, 0xaa, 0x84, 0x84, 0x18, 0x0a, 0x4a, 0xfe, 0x08, 0x8a, 0xef, 0x12, 0xd2, 0x2e, 0x65,
-	0xb3, 0xde, 0xe7, 0xc3, 0xcf, 0xf3, 0x9e, 0x5e, 0xd4, 0x8b, 0x85, 0xca, 0x85, 0xf2, 0x0b, 0x29,
-	0xb4, 0x88, 0x45, 0x56, 0x08, 0x91, 0xf9, 0xd3, 0x81, 0xaf, 0x67, 0x05, 0x28, 0x52, 0x4d, 0xf1,
-	0x23, 0xc3, 0x21, 0x9b, 0x1c, 0x32, 0x1d, 0xb4, 0x77, 0x53, 0x91, 0x8a, 0x6a, 0xe8, 0x2f, 0xbf,
-	0x0c, 0xde, 0xde, 0x33, 0xec, 0xd0, 0x00, 0x9b, 0xd2, 0xb6, 0x67, 0x7f, 0x16, 0x51, 0x05, 0xfe,
-	0x74, 0x10, 0x81, 0xa6, 0x03, 0x3f, 0x16, 0x8c, 0x5b, 0xbc, 0x93, 0x0a, 0x91, 0x66, 0x60, 0xc2,
-	0x44, 0xe5, 0xc4, 0xd7, 0x2c, 0x07, 0xa5, 0x69, 0x5e, 0xac, 0x0c, 0x6e, 0x12, 0x92, 0x52, 0x52,
-	0xcd, 0x84, 0x35, 0xe8, 0x7d, 0xdb, 0x42, 0x8d, 0xa0, 0x4c, 0x52, 0xd0, 0xf8, 0x2d, 0x7a, 0x28,
-	0x21, 0x66, 0x05, 0x03, 0xae, 0x43, 0x9a, 0x24, 0x12, 0x94, 0x72, 0x9d, 0xae, 0xd3, 0x6f, 0x06,
-	0xee, 0xe5, 0xd9, 0xfe, 0xae, 0x0d, 0x76, 0x60, 0x90, 0xcf, 0x5a, 0x32, 0x9e, 0x8e, 0x77, 0xd6,
-	0x12, 0x3b, 0xc7, 0x6f, 0xd0, 0x76, 0x9c, 0x51, 0x96, 0x43, 0x12, 0xd2, 0x5c, 0x94, 0x5c, 0xbb,
-	0x77, 0xba, 0x4e, 0xff, 0xde, 0xb3, 0x3d, 0x62, 0x0d, 0x96, 0x5d, 0x88, 0xed, 0x42, 0x86, 0x82,
-	0xf1, 0x71, 0xcb, 0x0a, 0x0e, 0x2a, 0x3e, 0xfe, 0x80, 0x1e, 0x64, 0x54, 0xe9, 0x70, 0x6d, 0xa3,
-	0xdd, 0xad, 0xca, 0xa2, 0x4d, 0x4c, 0x1b, 0xb2, 0x6a, 0x43, 0x8e, 0x56, 0x75, 0x83, 0xfa, 0xe9,
-	0xef, 0x8e, 0x33, 0x6e, 0x2d, 0x85, 0x43, 0xeb, 0xa6, 0xf1, 0x53, 0xd4, 0xd2, 0x92, 0xf2, 0xf8,
-	0x18, 0x54, 0x98, 0xc1, 0x44, 0xbb, 0xf5, 0xae, 0xd3, 0xaf, 0x8f, 0xef, 0xaf, 0x86, 0x87, 0x30,
-	0xd1, 0xf8, 0x3d, 0xc2, 0x51, 0xb5, 0x81, 0xb0, 0x00, 0x19, 0x5a, 0xc8, 0xbd, 0x7b, 0x5b, 0xe8,
-	0x1d, 0x23, 0xfa, 0x08, 0xf2, 0xc8, 0x48, 0xf0, 0x4b, 0xd4, 0x28, 0x40, 0x32, 0x91, 0xb8, 0x0d,
-	0x2b, 0xbe, 0x19, 0x77, 0x64, 0x97, 0x1f, 0xd4, 0xbf, 0x2f, 0xd3, 0x5a, 0x7a, 0xef, 0x87, 0x83,
-	0xb6, 0x87, 0x82, 0x6b, 0xc6, 0x4b, 0x51, 0xaa, 0x77, 0x25, 0x4f, 0xf0, 0x0b, 0xd4, 0x5c, 0x6f,
-	0xf6, 0xd6, 0x47, 0xf8, 0x47, 0xc5, 0x9f, 0x10, 0x2a, 0x40, 0xc6, 0xc0, 0x35, 0x4d, 0xa1, 0xda,
-	0x7c, 0x33, 0x18, 0x9c, 0x5f, 0x75, 0x6a, 0xbf, 0xae, 0x3a, 0x8f, 0x8d, 0x58, 0x25, 0x5f, 0x09,
-	0x13, 0x7e, 0x4e, 0xf5, 0x31, 0x39, 0x84, 0x94, 0xc6, 0xb3, 0x11, 0xc4, 0x97, 0x67, 0xfb, 0xc8,
-	0x7a, 0x8f, 0x20, 0x1e, 0x6f, 0x98, 0xe0, 0x57, 0xa8, 0x01, 0x27, 0x05, 0x93, 0xb3, 0xff, 0x7e,
-	0x05, 0xcb, 0x0f, 0x5e, 0x9f, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0x7f, 0xe6, 0x9e, 0x73, 0xba,
-	0xf0, 0x6a, 0x17, 0x0b, 0xaf, 0xf6, 0x73, 0xe1, 0xd5, 0xbe, 0x3c, 0xb9, 0x16, 0xe5, 0xe4, 0xfa,
-	0x31, 0x55, 0x97, 0x14, 0x35, 0xaa, 0xd9, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x82,
-	0x01, 0x87, 0x70, 0x03, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x93, 0x6c, 0xa4, 0x7d, 0xcb, 0x6e, 0xc3, 0x50, 0x8a, 0x37, 0x2c, 0x49, 0x9a, 0x1e,
+	0x58, 0x55, 0x5a, 0x87, 0x14, 0x89, 0x1f, 0xea, 0x01, 0x6c, 0xc7, 0x0d, 0x11, 0xd9, 0x6c, 0x70,
+	0xd2, 0x22, 0x71, 0xb1, 0x1c, 0x7b, 0xd6, 0x3b, 0x6a, 0xe2, 0xb1, 0x3c, 0xe3, 0xd0, 0x88, 0x1b,
+	0x77, 0xa4, 0x8a, 0x13, 0x7f, 0x03, 0xe7, 0xfe, 0x11, 0x3d, 0x96, 0x4a, 0x48, 0x88, 0x43, 0x8b,
+	0x76, 0xff, 0x11, 0xe4, 0xf1, 0x24, 0x24, 0xdb, 0xa5, 0xd9, 0x53, 0xa2, 0xf7, 0xbe, 0xef, 0xf3,
+	0xfb, 0xbe, 0x37, 0x63, 0x43, 0xc3, 0xa3, 0x6c, 0x4a, 0x59, 0x33, 0x8a, 0x29, 0xa7, 0x1e, 0x9d,
+	0x44, 0x94, 0x4e, 0x9a, 0xb3, 0x56, 0x93, 0xcf, 0x23, 0xcc, 0x34, 0x51, 0x45, 0xb7, 0x32, 0x8c,
+	0xb6, 0x8a, 0xd1, 0x66, 0xad, 0xca, 0xcd, 0x80, 0x06, 0x54, 0x14, 0x9b, 0xe9, 0xbf, 0xac, 0x5f,
+	0xd9, 0xcf, 0xd0, 0x4e, 0xd6, 0x58, 0xa5, 0x56, 0xaa, 0xf2, 0x61, 0x63, 0x97, 0xe1, 0xe6, 0xac,
+	0x35, 0xc6, 0xdc, 0x6d, 0x35, 0x3d, 0x4a, 0x42, 0xd9, 0xaf, 0x05, 0x94, 0x06, 0x13, 0x9c, 0x0d,
+	0x33, 0x4e, 0x4e, 0x9b, 0x9c, 0x4c, 0x31, 0xe3, 0xee, 0x34, 0x5a, 0x08, 0x5c, 0x06, 0xf8, 0x49,
+	0xec, 0x72, 0x42, 0xa5, 0x40, 0xe3, 0xe7, 0x02, 0x94, 0x8c, 0xc4, 0x0f, 0x30, 0x47, 0x16, 0xbc,
+	0x1b, 0x63, 0x8f, 0x44, 0x04, 0x87, 0xdc, 0x71, 0x7d, 0x3f, 0xc6, 0x8c, 0xa9, 0x4a, 0x5d, 0x39,
+	0xdc, 0x36, 0xd4, 0x97, 0xcf, 0x8e, 0x6e, 0xca, 0xc1, 0xf4, 0xac, 0x33, 0xe4, 0x31, 0x09, 0x03,
+	0xbb, 0xbc, 0xa4, 0xc8, 0x3a, 0xfa, 0x1a, 0xf6, 0xbc, 0x89, 0x4b, 0xa6, 0xd8, 0x77, 0xdc, 0x29,
+	0x4d, 0x42, 0xae, 0xe6, 0xeb, 0xca, 0xe1, 0xce, 0xbd, 0x7d, 0x4d, 0x0a, 0xa4, 0x5e, 0x34, 0xe9,
+	0x45, 0x33, 0x29, 0x09, 0xed, 0x5d, 0x49, 0xd0, 0x05, 0x1e, 0x7d, 0x03, 0x37, 0x26, 0x2e, 0xe3,
+	0xce, 0x52, 0x86, 0xab, 0x05, 0x21, 0x51, 0xd1, 0x32, 0x37, 0xda, 0xc2, 0x8d, 0x36, 0x5a, 0xd8,
+	0x35, 0x8a, 0x4f, 0x5f, 0xd7, 0x14, 0x7b, 0x37, 0x25, 0x9a, 0x52, 0x8d, 0xa3, 0x3b, 0xb0, 0xcb,
+	0x63, 0x37, 0xf4, 0xce, 0x30, 0x73, 0x26, 0xf8, 0x94, 0xab, 0xc5, 0xba, 0x72, 0x58, 0xb4, 0xdf,
+	0x59, 0x14, 0x7b, 0xf8, 0x94, 0xa3, 0x0e, 0xa0, 0xb1, 0x48, 0xc0, 0x89, 0x70, 0xec, 0xc8, 0x96,
+	0xba, 0xb5, 0x69, 0xe8, 0x72, 0x46, 0x1a, 0xe0, 0x78, 0x94, 0x51, 0xd0, 0xe7, 0x50, 0x8a, 0x70,
+	0x4c, 0xa8, 0xaf, 0x96, 0x24, 0xf9, 0xf2, 0xb8, 0x6d, 0x19, 0xbe, 0x51, 0xfc, 0x2d, 0x9d, 0x56,
+	0xc2, 0x1b, 0x7f, 0x2a, 0xb0, 0x67, 0xd2, 0x90, 0x93, 0x30, 0xa1, 0x09, 0x7b, 0x90, 0x84, 0x3e,
+	0xfa, 0x0c, 0xb6, 0x97, 0xc9, 0x6e, 0x5c, 0xc2, 0x7f, 0x50, 0xf4, 0x1d, 0x40, 0x84, 0x63, 0x0f,
+	0x87, 0xdc, 0x0d, 0xb0, 0x48, 0x7e, 0xdb, 0x68, 0x3d, 0x7f, 0x55, 0xcb, 0xfd, 0xfd, 0xaa, 0xf6,
+	0x61, 0x46, 0x66, 0xfe, 0x63, 0x8d, 0xd0, 0xe6, 0xd4, 0xe5, 0x67, 0x5a, 0x0f, 0x07, 0xae, 0x37,
+	0x6f, 0x63, 0xef, 0xe5, 0xb3, 0x23, 0x90, 0xda, 0x6d, 0xec, 0xd9, 0x2b, 0x22, 0xe8, 0x0b, 0x28,
+	0xe1, 0x27, 0x11, 0x89, 0xe7, 0xd7, 0xde, 0x82, 0xc4, 0x37, 0xfe, 0xc8, 0xc3, 0xf6, 0x31, 0x99,
+	0x60, 0xc6, 0x69, 0x88, 0x51, 0x1d, 0x76, 0x7c, 0xcc, 0xbc, 0x98, 0x44, 0x69, 0x04, 0x99, 0x29,
+	0x7b, 0xb5, 0x84, 0x3c, 0x28, 0x2d, 0x8f, 0x4c, 0xe1, 0xad, 0xe9, 0x1b, 0x9f, 0xa4, 0x9e, 0x7e,
+	0x7f, 0x5d, 0x3b, 0x0c, 0x08, 0x3f, 0x4b, 0xc6, 0x9a, 0x47, 0xa7, 0xf2, 0xe6, 0xc8, 0x9f, 0x23,
+	0xe6, 0x3f, 0x96, 0x77, 0x32, 0x25, 0x30, 0x5b, 0x4a, 0xa3, 0xaf, 0xa0, 0xc4, 0xb8, 0xcb, 0x13,
+	0x26, 0xec, 0xec, 0xdd, 0xfb, 0x58, 0xbb, 0xfa, 0xb2, 0x6a, 0xcb, 0xc9, 0x87, 0x02, 0x6e, 0x4b,
+	0x1a, 0xfa, 0x12, 0x76, 0xdc, 0x28, 0x8a, 0xe9, 0x0c, 0xfb, 0xce, 0x78, 0xae, 0x16, 0x37, 0x2c,
+	0x07, 0x16, 0x60, 0x63, 0x8e, 0xf4, 0x15, 0xaa, 0xcb, 0xd5, 0xad, 0x6b, 0xe6, 0xb9, 0x94, 0xd0,
+	0x79, 0xe3, 0x97, 0x3c, 0x6c, 0x75, 0x62, 0x37, 0xe4, 0x68, 0x0f, 0xf2, 0xc4, 0x17, 0x31, 0x16,
+	0xed, 0x3c, 0xb9, 0x74, 0x64, 0xf2, 0xd7, 0x3f, 0x32, 0x82, 0x37, 0x23, 0xf8, 0x47, 0x1c, 0xa7,
+	0x99, 0x14, 0x36, 0xf1, 0x24, 0x14, 0x75, 0x00, 0xa6, 0x8b, 0x88, 0x98, 0x5a, 0x14, 0x1b, 0xbb,
+	0xbd, 0x31, 0x4c, 0xa3, 0x98, 0x6e, 0xce, 0x5e, 0xa1, 0xa2, 0xfb, 0xcb, 0x8d, 0x6c, 0x89, 0x8d,
+	0xdc, 0xf9, 0x3f, 0x11, 0xe1, 0x7b, 0x7d, 0x1b, 0x77, 0x7f, 0x82, 0x9d, 0x95, 0x32, 0x3a, 0x00,
+	0xb5, 0x63, 0xeb, 0xfd, 0x91, 0x33, 0x1c, 0xe9, 0xa3, 0x87, 0x43, 0xe7, 0x61, 0x7f, 0x38, 0xb0,
+	0xcc, 0xee, 0x83, 0xae, 0xd5, 0x2e, 0xe7, 0xd0, 0x07, 0xf0, 0xde, 0x5a, 0x57, 0x37, 0x47, 0xdd,
+	0x47, 0x56, 0x59, 0x41, 0x15, 0xb8, 0xb5, 0xd6, 0x30, 0x4f, 0x8e, 0x07, 0x3d, 0x6b, 0x64, 0xb5,
+	0xcb, 0x79, 0xb4, 0x0f, 0xef, 0xaf, 0xf7, 0xf4, 0xbe, 0x69, 0xf5, 0xac, 0x76, 0xb9, 0x70, 0xf7,
+	0x57, 0x05, 0x6e, 0x5c, 0x3a, 0x26, 0xa8, 0x0e, 0x07, 0xc7, 0xdd, 0x9e, 0x35, 0x1c, 0x9d, 0xf4,
+	0xad, 0xab, 0xa7, 0x38, 0x00, 0xf5, 0x0d, 0xc4, 0xc0, 0xea, 0xb7, 0xbb, 0xfd, 0x4e, 0x59, 0x41,
+	0x1f, 0xc1, 0xfe, 0x1b, 0x5d, 0x7d, 0x30, 0xb0, 0x4f, 0x1e, 0x89, 0x69, 0xae, 0x92, 0x37, 0x7b,
+	0xfa, 0xf7, 0x56, 0xdb, 0x31, 0x74, 0xf3, 0xdb, 0x72, 0xc1, 0xb8, 0xff, 0xfc, 0xbc, 0xaa, 0xbc,
+	0x38, 0xaf, 0x2a, 0xff, 0x9c, 0x57, 0x95, 0xa7, 0x17, 0xd5, 0xdc, 0x8b, 0x8b, 0x6a, 0xee, 0xaf,
+	0x8b, 0x6a, 0xee, 0x87, 0xdb, 0x6b, 0x2f, 0x80, 0x27, 0xeb, 0x9f, 0x30, 0x71, 0x57, 0xc6, 0x25,
+	0x51, 0xfb, 0xf4, 0xdf, 0x01, 0x00, 0xf0, 0x6f, 0x63, 0x2d, 0xe6, 0x06, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -356,6 +621,135 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ApprovedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ApprovedAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApprovedBy) > 0 {
+		i -= len(m.ApprovedBy)
+		copy(dAtA[i:], m.ApprovedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ApprovedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reviewers) > 0 {
+		for iNdEx := len(m.Reviewers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reviewers[iNdEx])
+			copy(dAtA[i:], m.Reviewers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Reviewers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -418,6 +812,67 @@ func (m *ContinuousFund) Size() (n int) {
 	return n
 }
 
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.ApprovedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ApprovedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ApprovedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Reviewers) > 0 {
+		for _, s := range m.Reviewers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -821,6 +1276,395 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MilestoneStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ApprovedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewers = append(m.Reviewers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= GrantStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
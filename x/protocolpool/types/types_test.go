@@ -24,6 +24,9 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgWithdrawContinuousFund{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateGrant{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgApproveMilestone{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelGrant{}))
 }
 
 func TestNewMsgFundCommunityPool(t *testing.T) {
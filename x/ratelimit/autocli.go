@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	ratelimitv1 "cosmossdk.io/api/cosmos/ratelimit/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: ratelimitv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Quotas",
+					Use:       "quotas",
+					Short:     "Query all the outflow quotas and their usage",
+				},
+				{
+					RpcMethod:      "QuotaUsage",
+					Use:            "quota-usage <address> <denom>",
+					Short:          "Query the usage of the outflow quota of an account and denom",
+					Example:        fmt.Sprintf(`%s query ratelimit quota-usage [address] stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: ratelimitv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetQuota",
+					Use:            "set-quota-proposal <quota_json>",
+					Short:          "Submit a proposal to set an outflow quota",
+					Example:        fmt.Sprintf(`%s tx ratelimit set-quota-proposal '{"target":{"channel_id":"channel-0"},"denom":"stake","max_net_outflow":"1000000","window":"86400s"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "quota"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveQuota",
+					Use:            "remove-quota-proposal <target_json> <denom>",
+					Short:          "Submit a proposal to remove an outflow quota",
+					Example:        fmt.Sprintf(`%s tx ratelimit remove-quota-proposal '{"module_name":"distribution"}' stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "target"}, {ProtoField: "denom"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/ratelimit/module/v1"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/ratelimit/keeper"
+	"cosmossdk.io/x/ratelimit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(
+		&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config      *modulev1.Module
+	Cdc         codec.Codec
+	Environment appmodule.Environment
+
+	AddressCodec address.Codec
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	RateLimitKeeper keeper.Keeper
+	Module          appmodule.AppModule
+	SendRestriction banktypes.SendRestrictionFn
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	authorityAddr, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, authorityAddr, in.AddressCodec)
+	m := NewAppModule(in.Cdc, k, in.AddressCodec)
+
+	return ModuleOutputs{RateLimitKeeper: k, Module: m, SendRestriction: k.SendRestriction}
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/ratelimit/types"
+)
+
+// InitGenesis initializes the ratelimit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) error {
+	for _, quota := range genState.Quotas {
+		if err := k.SetQuota(ctx, quota); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the ratelimit module's exported genesis. The tracked
+// flows are not exported, the windows start afresh on import.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var quotas []types.Quota
+	err := k.Quotas.Walk(ctx, nil, func(_ collections.Pair[[]byte, string], quota types.Quota) (bool, error) {
+		quotas = append(quotas, quota)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(quotas), nil
+}
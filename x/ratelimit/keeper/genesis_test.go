@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/ratelimit/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	channelQuota := f.vaultQuota(t, 500)
+	channelQuota.Target = types.Target{ChannelId: "channel-0"}
+	genesis := types.NewGenesisState([]types.Quota{f.vaultQuota(t, 100), channelQuota})
+	require.NoError(t, genesis.Validate(f.ac))
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesis))
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, genesis.Quotas, exported.Quotas)
+}
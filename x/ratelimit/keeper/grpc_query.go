@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/ratelimit/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+type QueryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the ratelimit QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper Keeper) types.QueryServer {
+	return &QueryServer{keeper: keeper}
+}
+
+// Quotas returns all the outflow quotas along with their usage.
+func (qs QueryServer) Quotas(ctx context.Context, req *types.QueryQuotasRequest) (*types.QueryQuotasResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Quotas,
+		req.Pagination,
+		func(key collections.Pair[[]byte, string], quota types.Quota) (types.QuotaUsage, error) {
+			return qs.quotaUsage(ctx, key.K1(), quota)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQuotasResponse{Quotas: results, Pagination: pageRes}, nil
+}
+
+// QuotaUsage returns the usage of the outflow quota of an account and denom.
+func (qs QueryServer) QuotaUsage(ctx context.Context, req *types.QueryQuotaUsageRequest) (*types.QueryQuotaUsageResponse, error) {
+	addr, err := qs.keeper.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, found, err := qs.keeper.getQuota(ctx, addr, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, types.ErrQuotaNotFound.Wrapf("%s for %s", req.Denom, req.Address)
+	}
+
+	usage, err := qs.quotaUsage(ctx, addr, quota)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQuotaUsageResponse{Usage: usage}, nil
+}
+
+func (qs QueryServer) quotaUsage(ctx context.Context, addr []byte, quota types.Quota) (types.QuotaUsage, error) {
+	addrStr, err := qs.keeper.addressCodec.BytesToString(addr)
+	if err != nil {
+		return types.QuotaUsage{}, err
+	}
+
+	netOutflow, err := qs.keeper.NetOutflow(ctx, addr, quota)
+	if err != nil {
+		return types.QuotaUsage{}, err
+	}
+
+	return types.QuotaUsage{
+		Quota:      quota,
+		Address:    addrStr,
+		NetOutflow: netOutflow,
+		Remaining:  math.MaxInt(quota.MaxNetOutflow.Sub(netOutflow), math.ZeroInt()),
+	}, nil
+}
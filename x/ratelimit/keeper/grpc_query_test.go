@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/ratelimit/keeper"
+	"cosmossdk.io/x/ratelimit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQueryQuotas(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(f.keeper)
+
+	quota := f.vaultQuota(t, 100)
+	require.NoError(t, f.keeper.SetQuota(f.ctx, quota))
+	_, err := f.keeper.SendRestriction(f.ctx, vault, user, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))
+	require.NoError(t, err)
+
+	expected := types.QuotaUsage{
+		Quota:      quota,
+		Address:    quota.Target.Address,
+		NetOutflow: math.NewInt(30),
+		Remaining:  math.NewInt(70),
+	}
+
+	res, err := qs.Quotas(f.ctx, &types.QueryQuotasRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.QuotaUsage{expected}, res.Quotas)
+
+	usage, err := qs.QuotaUsage(f.ctx, &types.QueryQuotaUsageRequest{Address: quota.Target.Address, Denom: "stake"})
+	require.NoError(t, err)
+	require.Equal(t, expected, usage.Usage)
+
+	_, err = qs.QuotaUsage(f.ctx, &types.QueryQuotaUsageRequest{Address: quota.Target.Address, Denom: "other"})
+	require.ErrorIs(t, err, types.ErrQuotaNotFound)
+}
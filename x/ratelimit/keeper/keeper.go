@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/ratelimit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// flowKey is the key of a tracked flow: target address, denom and bucket start time.
+type flowKey = collections.Triple[[]byte, string, time.Time]
+
+// Keeper defines the ratelimit module's keeper.
+type Keeper struct {
+	appmodule.Environment
+
+	cdc          codec.BinaryCodec
+	addressCodec address.Codec
+	authority    string
+
+	Schema collections.Schema
+	// Quotas contains the outflow quotas, keyed by target address and denom
+	Quotas collections.Map[collections.Pair[[]byte, string], types.Quota]
+	// Flows contains the flows of the quotas, keyed by target address, denom and bucket start time
+	Flows collections.Map[flowKey, types.Flow]
+}
+
+// NewKeeper constructs a new ratelimit Keeper instance
+func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority string, addressCodec address.Codec) Keeper {
+	if _, err := addressCodec.StringToBytes(authority); err != nil {
+		panic(err)
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+
+	k := Keeper{
+		Environment:  env,
+		cdc:          cdc,
+		authority:    authority,
+		addressCodec: addressCodec,
+		Quotas: collections.NewMap(
+			sb,
+			types.QuotasPrefix,
+			"quotas",
+			collections.PairKeyCodec(collections.BytesKey, collections.StringKey),
+			codec.CollValue[types.Quota](cdc),
+		),
+		Flows: collections.NewMap(
+			sb,
+			types.FlowsPrefix,
+			"flows",
+			collections.TripleKeyCodec(collections.BytesKey, collections.StringKey, sdk.TimeKey),
+			codec.CollValue[types.Flow](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/ratelimit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetQuota sets the quota of its target and denom, resetting the tracked flows.
+func (k Keeper) SetQuota(ctx context.Context, quota types.Quota) error {
+	addr, err := quota.Target.AccAddress(k.addressCodec)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clearFlows(ctx, addr, quota.Denom); err != nil {
+		return err
+	}
+
+	return k.Quotas.Set(ctx, collections.Join(addr, quota.Denom), quota)
+}
+
+// RemoveQuota removes the quota of an account and denom along with its flows.
+func (k Keeper) RemoveQuota(ctx context.Context, addr []byte, denom string) error {
+	key := collections.Join(addr, denom)
+	has, err := k.Quotas.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return types.ErrQuotaNotFound
+	}
+
+	if err := k.clearFlows(ctx, addr, denom); err != nil {
+		return err
+	}
+
+	return k.Quotas.Remove(ctx, key)
+}
+
+// NetOutflow returns the net outflow of an account and denom over the current
+// window of the quota.
+func (k Keeper) NetOutflow(ctx context.Context, addr []byte, quota types.Quota) (math.Int, error) {
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	start := windowStart(quota, now)
+
+	netOutflow := math.ZeroInt()
+	rng := new(collections.Range[flowKey]).
+		StartInclusive(collections.Join3(addr, quota.Denom, start)).
+		EndInclusive(collections.Join3(addr, quota.Denom, now.Truncate(quota.BucketDuration())))
+	err := k.Flows.Walk(ctx, rng, func(_ flowKey, flow types.Flow) (bool, error) {
+		netOutflow = netOutflow.Add(flow.NetOutflow())
+		return false, nil
+	})
+
+	return netOutflow, err
+}
+
+// trackFlow records a flow of an account for the denom of the quota in the
+// current bucket, and prunes the buckets that are out of the window.
+func (k Keeper) trackFlow(ctx context.Context, addr []byte, quota types.Quota, flow types.Flow) error {
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	bucket := now.Truncate(quota.BucketDuration())
+
+	rng := new(collections.Range[flowKey]).
+		StartInclusive(collections.Join3(addr, quota.Denom, time.Time{})).
+		EndExclusive(collections.Join3(addr, quota.Denom, windowStart(quota, now)))
+	if err := k.Flows.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	key := collections.Join3(addr, quota.Denom, bucket)
+	current, err := k.Flows.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		current = types.Flow{Outflow: math.ZeroInt(), Inflow: math.ZeroInt()}
+	}
+
+	current.Outflow = current.Outflow.Add(flow.Outflow)
+	current.Inflow = current.Inflow.Add(flow.Inflow)
+	return k.Flows.Set(ctx, key, current)
+}
+
+// clearFlows removes all the tracked flows of an account and denom.
+func (k Keeper) clearFlows(ctx context.Context, addr []byte, denom string) error {
+	return k.Flows.Clear(ctx, collections.NewSuperPrefixedTripleRange[[]byte, string, time.Time](addr, denom))
+}
+
+// windowStart returns the start time of the first bucket of the rolling window
+// ending at now.
+func windowStart(quota types.Quota, now time.Time) time.Time {
+	bucketDuration := quota.BucketDuration()
+	return now.Truncate(bucketDuration).Add(-bucketDuration * (types.BucketsPerWindow - 1))
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/ratelimit"
+	"cosmossdk.io/x/ratelimit/keeper"
+	"cosmossdk.io/x/ratelimit/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	vault = sdk.AccAddress("vault_______________")
+	user  = sdk.AccAddress("user________________")
+	now   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type fixture struct {
+	ctx       context.Context
+	keeper    keeper.Keeper
+	authority string
+	ac        address.Codec
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, ratelimit.AppModule{})
+	ac := addresscodec.NewBech32Codec("cosmos")
+	mockStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(mockStoreKey), coretesting.NewNopLogger())
+	authority, err := ac.BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
+	require.NoError(t, err)
+
+	ctx := testutil.DefaultContextWithDB(t, mockStoreKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	return &fixture{
+		ctx:       ctx.WithHeaderInfo(header.Info{Height: 1, Time: now}),
+		keeper:    keeper.NewKeeper(env, encCfg.Codec, authority, ac),
+		authority: authority,
+		ac:        ac,
+	}
+}
+
+func (f *fixture) at(t time.Time) context.Context {
+	return sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Time: t})
+}
+
+func (f *fixture) vaultQuota(t *testing.T, maxNetOutflow int64) types.Quota {
+	t.Helper()
+	addr, err := f.ac.BytesToString(vault)
+	require.NoError(t, err)
+
+	return types.Quota{
+		Target:        types.Target{Address: addr},
+		Denom:         "stake",
+		MaxNetOutflow: math.NewInt(maxNetOutflow),
+		Window:        10 * time.Hour,
+	}
+}
+
+func TestSendRestriction(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.SetQuota(f.ctx, f.vaultQuota(t, 100)))
+
+	send := func(ctx context.Context, from, to sdk.AccAddress, amt int64) error {
+		newTo, err := f.keeper.SendRestriction(ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", amt)))
+		if err == nil {
+			require.Equal(t, to, newTo)
+		}
+		return err
+	}
+
+	// other denoms and accounts are not limited
+	_, err := f.keeper.SendRestriction(f.ctx, vault, user, sdk.NewCoins(sdk.NewInt64Coin("other", 1000)))
+	require.NoError(t, err)
+	require.NoError(t, send(f.ctx, user, sdk.AccAddress("other_______________"), 1000))
+
+	require.NoError(t, send(f.ctx, vault, user, 60))
+	require.ErrorIs(t, send(f.ctx, vault, user, 50), types.ErrQuotaExceeded)
+
+	// inflows replenish the quota
+	require.NoError(t, send(f.ctx, user, vault, 20))
+	require.NoError(t, send(f.ctx, vault, user, 50))
+	require.ErrorIs(t, send(f.ctx, vault, user, 20), types.ErrQuotaExceeded)
+
+	// the window rolls bucket by bucket: the flows of the first bucket still
+	// count 9 hours later and are dropped once they leave the window
+	require.ErrorIs(t, send(f.at(now.Add(9*time.Hour)), vault, user, 20), types.ErrQuotaExceeded)
+	require.NoError(t, send(f.at(now.Add(10*time.Hour)), vault, user, 100))
+
+	netOutflow, err := f.keeper.NetOutflow(f.at(now.Add(10*time.Hour)), vault, f.vaultQuota(t, 100))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), netOutflow)
+}
+
+func TestSetAndRemoveQuota(t *testing.T) {
+	f := initFixture(t)
+	quota := f.vaultQuota(t, 100)
+	require.NoError(t, f.keeper.SetQuota(f.ctx, quota))
+
+	_, err := f.keeper.SendRestriction(f.ctx, vault, user, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, err)
+
+	// setting the quota again resets its flows
+	require.NoError(t, f.keeper.SetQuota(f.ctx, quota))
+	netOutflow, err := f.keeper.NetOutflow(f.ctx, vault, quota)
+	require.NoError(t, err)
+	require.True(t, netOutflow.IsZero())
+
+	require.NoError(t, f.keeper.RemoveQuota(f.ctx, vault, "stake"))
+	require.ErrorIs(t, f.keeper.RemoveQuota(f.ctx, vault, "stake"), types.ErrQuotaNotFound)
+
+	_, err = f.keeper.SendRestriction(f.ctx, vault, user, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	require.NoError(t, err)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/ratelimit/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the ratelimit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SetQuota sets an outflow quota, overwriting any existing quota for the same target and denom.
+func (srv msgServer) SetQuota(ctx context.Context, msg *types.MsgSetQuota) (*types.MsgSetQuotaResponse, error) {
+	if err := srv.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Quota.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := srv.Keeper.SetQuota(ctx, msg.Quota); err != nil {
+		return nil, err
+	}
+
+	addr, err := srv.quotaAddress(msg.Quota.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetQuota,
+		event.NewAttribute(types.AttributeKeyAddress, addr),
+		event.NewAttribute(types.AttributeKeyDenom, msg.Quota.Denom),
+		event.NewAttribute(types.AttributeKeyMaxNetOutflow, msg.Quota.MaxNetOutflow.String()),
+		event.NewAttribute(types.AttributeKeyWindow, msg.Quota.Window.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetQuotaResponse{}, nil
+}
+
+// RemoveQuota removes an outflow quota.
+func (srv msgServer) RemoveQuota(ctx context.Context, msg *types.MsgRemoveQuota) (*types.MsgRemoveQuotaResponse, error) {
+	if err := srv.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Target.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := msg.Target.AccAddress(srv.addressCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.Keeper.RemoveQuota(ctx, addr, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	addrStr, err := srv.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRemoveQuota,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveQuotaResponse{}, nil
+}
+
+// quotaAddress returns the address of the account targeted by a quota.
+func (srv msgServer) quotaAddress(target types.Target) (string, error) {
+	addr, err := target.AccAddress(srv.addressCodec)
+	if err != nil {
+		return "", err
+	}
+
+	return srv.addressCodec.BytesToString(addr)
+}
+
+func (srv msgServer) validateAuthority(authority string) error {
+	if _, err := srv.addressCodec.StringToBytes(authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if srv.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", srv.authority, authority)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/ratelimit/keeper"
+	"cosmossdk.io/x/ratelimit/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgSetQuota(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	quota := f.vaultQuota(t, 100)
+	userAddr, err := f.ac.BytesToString(user)
+	require.NoError(t, err)
+
+	_, err = srv.SetQuota(f.ctx, &types.MsgSetQuota{Authority: userAddr, Quota: quota})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	invalid := quota
+	invalid.Target.ModuleName = "distribution"
+	_, err = srv.SetQuota(f.ctx, &types.MsgSetQuota{Authority: f.authority, Quota: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidQuota)
+
+	_, err = srv.SetQuota(f.ctx, &types.MsgSetQuota{Authority: f.authority, Quota: quota})
+	require.NoError(t, err)
+
+	stored, err := f.keeper.Quotas.Get(f.ctx, collections.Join([]byte(vault), "stake"))
+	require.NoError(t, err)
+	require.Equal(t, quota, stored)
+
+	// module accounts are targeted by name
+	moduleQuota := quota
+	moduleQuota.Target = types.Target{ModuleName: "distribution"}
+	_, err = srv.SetQuota(f.ctx, &types.MsgSetQuota{Authority: f.authority, Quota: moduleQuota})
+	require.NoError(t, err)
+
+	has, err := f.keeper.Quotas.Has(f.ctx, collections.Join([]byte(authtypes.NewModuleAddress("distribution")), "stake"))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestMsgRemoveQuota(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	channelQuota := f.vaultQuota(t, 100)
+	channelQuota.Target = types.Target{ChannelId: "channel-0"}
+	require.NoError(t, f.keeper.SetQuota(f.ctx, channelQuota))
+
+	_, err := srv.RemoveQuota(f.ctx, &types.MsgRemoveQuota{Authority: f.authority, Target: types.Target{ChannelId: "channel-1"}, Denom: "stake"})
+	require.ErrorIs(t, err, types.ErrQuotaNotFound)
+
+	// the port defaults to transfer
+	_, err = srv.RemoveQuota(f.ctx, &types.MsgRemoveQuota{Authority: f.authority, Target: types.Target{PortId: types.DefaultPortID, ChannelId: "channel-0"}, Denom: "stake"})
+	require.NoError(t, err)
+
+	has, err := f.keeper.Quotas.Has(f.ctx, collections.Join(types.EscrowAddress(types.DefaultPortID, "channel-0"), "stake"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
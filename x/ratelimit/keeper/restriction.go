@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/ratelimit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestriction is an x/bank send restriction enforcing the outflow quotas.
+// Coins sent from an account with a quota count as outflow and coins sent to it
+// count as inflow. The send is rejected if it brings the net outflow of the
+// current window above the quota.
+func (k Keeper) SendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if bytes.Equal(fromAddr, toAddr) {
+		return toAddr, nil
+	}
+
+	for _, coin := range amt {
+		if err := k.trackOutflow(ctx, fromAddr, coin); err != nil {
+			return nil, err
+		}
+
+		if err := k.trackInflow(ctx, toAddr, coin); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// trackOutflow records coin as sent from addr and checks the quota of addr.
+func (k Keeper) trackOutflow(ctx context.Context, addr []byte, coin sdk.Coin) error {
+	quota, found, err := k.getQuota(ctx, addr, coin.Denom)
+	if err != nil || !found {
+		return err
+	}
+
+	netOutflow, err := k.NetOutflow(ctx, addr, quota)
+	if err != nil {
+		return err
+	}
+
+	netOutflow = netOutflow.Add(coin.Amount)
+	if netOutflow.GT(quota.MaxNetOutflow) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return err
+		}
+
+		return errorsmod.Wrapf(types.ErrQuotaExceeded, "sending %s from %s would bring its net outflow to %s, above the quota of %s every %s",
+			coin, addrStr, netOutflow, quota.MaxNetOutflow, quota.Window)
+	}
+
+	if err := k.trackFlow(ctx, addr, quota, types.Flow{Outflow: coin.Amount, Inflow: math.ZeroInt()}); err != nil {
+		return err
+	}
+
+	return k.emitOutflowEvent(ctx, addr, coin, netOutflow, quota)
+}
+
+// trackInflow records coin as sent to addr.
+func (k Keeper) trackInflow(ctx context.Context, addr []byte, coin sdk.Coin) error {
+	quota, found, err := k.getQuota(ctx, addr, coin.Denom)
+	if err != nil || !found {
+		return err
+	}
+
+	return k.trackFlow(ctx, addr, quota, types.Flow{Outflow: math.ZeroInt(), Inflow: coin.Amount})
+}
+
+// getQuota returns the quota of an account and denom, if any.
+func (k Keeper) getQuota(ctx context.Context, addr []byte, denom string) (types.Quota, bool, error) {
+	quota, err := k.Quotas.Get(ctx, collections.Join(addr, denom))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Quota{}, false, nil
+		}
+		return types.Quota{}, false, err
+	}
+
+	return quota, true, nil
+}
+
+func (k Keeper) emitOutflowEvent(ctx context.Context, addr []byte, coin sdk.Coin, netOutflow math.Int, quota types.Quota) error {
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeOutflow,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyAmount, coin.String()),
+		event.NewAttribute(types.AttributeKeyNetOutflow, netOutflow.String()),
+		event.NewAttribute(types.AttributeKeyMaxNetOutflow, quota.MaxNetOutflow.String()),
+	)
+}
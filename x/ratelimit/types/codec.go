@@ -0,0 +1,25 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+	coretransaction "cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/ratelimit interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc registry.AminoRegistrar) {
+	legacy.RegisterAminoMsg(cdc, &MsgSetQuota{}, "cosmos-sdk/x/ratelimit/MsgSetQuota")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveQuota{}, "cosmos-sdk/x/ratelimit/MsgRemoveQuota")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
+		&MsgSetQuota{},
+		&MsgRemoveQuota{},
+	)
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
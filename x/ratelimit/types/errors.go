@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/ratelimit module sentinel errors
+var (
+	ErrInvalidQuota  = errors.Register(ModuleName, 2, "invalid quota")
+	ErrQuotaNotFound = errors.Register(ModuleName, 3, "quota not found")
+	ErrQuotaExceeded = errors.Register(ModuleName, 4, "outflow quota exceeded")
+	ErrInvalidSigner = errors.Register(ModuleName, 5, "expected authority account as only signer for proposal message")
+)
@@ -0,0 +1,15 @@
+package types
+
+// ratelimit module event types
+const (
+	EventTypeSetQuota    = "set_quota"
+	EventTypeRemoveQuota = "remove_quota"
+	EventTypeOutflow     = "outflow"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyNetOutflow    = "net_outflow"
+	AttributeKeyMaxNetOutflow = "max_net_outflow"
+	AttributeKeyWindow        = "window"
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// NewGenesisState creates a new genesis state for the ratelimit module.
+func NewGenesisState(quotas []Quota) *GenesisState {
+	return &GenesisState{Quotas: quotas}
+}
+
+// DefaultGenesisState returns a default ratelimit module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	seen := make(map[string]bool, len(gs.Quotas))
+	for _, quota := range gs.Quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+
+		addr, err := quota.Target.AccAddress(ac)
+		if err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%X/%s", addr, quota.Denom)
+		if seen[key] {
+			return fmt.Errorf("duplicate quota for %s", quota.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
+
+	// DefaultPortID is the IBC port used by a channel target that does not set one.
+	DefaultPortID = "transfer"
+
+	// BucketsPerWindow is the number of buckets a quota window is divided into
+	// to track flows. The window rolls forward one bucket at a time.
+	BucketsPerWindow = 10
+)
+
+// KVStore keys
+var (
+	QuotasPrefix = collections.NewPrefix(1)
+	FlowsPrefix  = collections.NewPrefix(2)
+)
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ics20Version is the version of the IBC transfer application, used to derive
+// the escrow address of a channel.
+const ics20Version = "ics20-1"
+
+// Validate checks that exactly one account is targeted.
+func (t Target) Validate() error {
+	set := 0
+	for _, field := range []string{t.Address, t.ModuleName, t.ChannelId} {
+		if field != "" {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return errors.New("exactly one of address, module_name or channel_id must be set")
+	}
+
+	if t.PortId != "" && t.ChannelId == "" {
+		return errors.New("port_id can only be set with channel_id")
+	}
+
+	return nil
+}
+
+// AccAddress resolves the address of the targeted account.
+func (t Target) AccAddress(ac address.Codec) ([]byte, error) {
+	switch {
+	case t.Address != "":
+		return ac.StringToBytes(t.Address)
+	case t.ModuleName != "":
+		return authtypes.NewModuleAddress(t.ModuleName), nil
+	case t.ChannelId != "":
+		portID := t.PortId
+		if portID == "" {
+			portID = DefaultPortID
+		}
+		return EscrowAddress(portID, t.ChannelId), nil
+	default:
+		return nil, errors.New("empty target")
+	}
+}
+
+// EscrowAddress returns the address of the account escrowing the tokens sent
+// through an IBC transfer channel, derived as ibc-go's transfer module does.
+func EscrowAddress(portID, channelID string) []byte {
+	preImage := []byte(ics20Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, fmt.Sprintf("%s/%s", portID, channelID)...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// Validate performs a stateless validation of the quota.
+func (q Quota) Validate() error {
+	if err := q.Target.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidQuota, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(q.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidQuota, err.Error())
+	}
+
+	if q.MaxNetOutflow.IsNil() || q.MaxNetOutflow.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidQuota, "max net outflow must be non-negative")
+	}
+
+	if q.Window < time.Second {
+		return errorsmod.Wrap(ErrInvalidQuota, "window must be at least one second")
+	}
+
+	return nil
+}
+
+// BucketDuration returns the length of the buckets flows are tracked in.
+func (q Quota) BucketDuration() time.Duration {
+	return q.Window / BucketsPerWindow
+}
+
+// NetOutflow returns the outflow minus the inflow.
+func (f Flow) NetOutflow() math.Int {
+	return f.Outflow.Sub(f.Inflow)
+}
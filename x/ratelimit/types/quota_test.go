@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/ratelimit/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	valid := types.Quota{
+		Target:        types.Target{ChannelId: "channel-0"},
+		Denom:         "stake",
+		MaxNetOutflow: math.NewInt(100),
+		Window:        time.Hour,
+	}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(q *types.Quota)
+	}{
+		{"no target", func(q *types.Quota) { q.Target = types.Target{} }},
+		{"several targets", func(q *types.Quota) { q.Target.ModuleName = "distribution" }},
+		{"port without channel", func(q *types.Quota) { q.Target = types.Target{ModuleName: "distribution", PortId: "transfer"} }},
+		{"invalid denom", func(q *types.Quota) { q.Denom = "1" }},
+		{"nil max net outflow", func(q *types.Quota) { q.MaxNetOutflow = math.Int{} }},
+		{"negative max net outflow", func(q *types.Quota) { q.MaxNetOutflow = math.NewInt(-1) }},
+		{"short window", func(q *types.Quota) { q.Window = time.Millisecond }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			quota := valid
+			tc.malleate(&quota)
+			require.ErrorIs(t, quota.Validate(), types.ErrInvalidQuota)
+		})
+	}
+}
+
+func TestTargetAccAddress(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	defaultPort, err := types.Target{ChannelId: "channel-0"}.AccAddress(ac)
+	require.NoError(t, err)
+	require.Len(t, defaultPort, 20)
+
+	explicitPort, err := types.Target{PortId: "transfer", ChannelId: "channel-0"}.AccAddress(ac)
+	require.NoError(t, err)
+	require.Equal(t, defaultPort, explicitPort)
+
+	otherChannel, err := types.Target{ChannelId: "channel-1"}.AccAddress(ac)
+	require.NoError(t, err)
+	require.NotEqual(t, defaultPort, otherChannel)
+
+	_, err = types.Target{Address: "invalid"}.AccAddress(ac)
+	require.Error(t, err)
+}
@@ -0,0 +1,1039 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/ratelimit/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetQuota is the Msg/SetQuota request type.
+type MsgSetQuota struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// quota is the outflow quota to set.
+	Quota Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *MsgSetQuota) Reset()         { *m = MsgSetQuota{} }
+func (m *MsgSetQuota) String() string { return proto.CompactTextString(m) }
+func (*MsgSetQuota) ProtoMessage()    {}
+func (*MsgSetQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99d8b3b5deac5a02, []int{0}
+}
+func (m *MsgSetQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetQuota.Merge(m, src)
+}
+func (m *MsgSetQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetQuota proto.InternalMessageInfo
+
+func (m *MsgSetQuota) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetQuota) GetQuota() Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return Quota{}
+}
+
+// MsgSetQuotaResponse defines the response structure for executing a
+// MsgSetQuota message.
+type MsgSetQuotaResponse struct {
+}
+
+func (m *MsgSetQuotaResponse) Reset()         { *m = MsgSetQuotaResponse{} }
+func (m *MsgSetQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetQuotaResponse) ProtoMessage()    {}
+func (*MsgSetQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99d8b3b5deac5a02, []int{1}
+}
+func (m *MsgSetQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetQuotaResponse.Merge(m, src)
+}
+func (m *MsgSetQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetQuotaResponse proto.InternalMessageInfo
+
+// MsgRemoveQuota is the Msg/RemoveQuota request type.
+type MsgRemoveQuota struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// target is the account of the quota to remove.
+	Target Target `protobuf:"bytes,2,opt,name=target,proto3" json:"target"`
+	// denom is the denom of the quota to remove.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveQuota) Reset()         { *m = MsgRemoveQuota{} }
+func (m *MsgRemoveQuota) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveQuota) ProtoMessage()    {}
+func (*MsgRemoveQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99d8b3b5deac5a02, []int{2}
+}
+func (m *MsgRemoveQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveQuota.Merge(m, src)
+}
+func (m *MsgRemoveQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveQuota proto.InternalMessageInfo
+
+func (m *MsgRemoveQuota) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveQuota) GetTarget() Target {
+	if m != nil {
+		return m.Target
+	}
+	return Target{}
+}
+
+func (m *MsgRemoveQuota) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveQuotaResponse defines the response structure for executing a
+// MsgRemoveQuota message.
+type MsgRemoveQuotaResponse struct {
+}
+
+func (m *MsgRemoveQuotaResponse) Reset()         { *m = MsgRemoveQuotaResponse{} }
+func (m *MsgRemoveQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveQuotaResponse) ProtoMessage()    {}
+func (*MsgRemoveQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99d8b3b5deac5a02, []int{3}
+}
+func (m *MsgRemoveQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveQuotaResponse.Merge(m, src)
+}
+func (m *MsgRemoveQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveQuotaResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetQuota)(nil), "cosmos.ratelimit.v1.MsgSetQuota")
+	proto.RegisterType((*MsgSetQuotaResponse)(nil), "cosmos.ratelimit.v1.MsgSetQuotaResponse")
+	proto.RegisterType((*MsgRemoveQuota)(nil), "cosmos.ratelimit.v1.MsgRemoveQuota")
+	proto.RegisterType((*MsgRemoveQuotaResponse)(nil), "cosmos.ratelimit.v1.MsgRemoveQuotaResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/ratelimit/v1/tx.proto", fileDescriptor_99d8b3b5deac5a02) }
+
+var fileDescriptor_99d8b3b5deac5a02 = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x8b, 0xda, 0x40,
+	0x14, 0xc7, 0x33, 0x15, 0xa5, 0x8e, 0x50, 0x68, 0xb4, 0x6d, 0x9a, 0x96, 0x28, 0x29, 0x05, 0xb1,
+	0x98, 0xa0, 0xa5, 0x45, 0x2c, 0x14, 0xea, 0xdd, 0x43, 0x63, 0xe9, 0xa1, 0x17, 0x49, 0x37, 0xc3,
+	0x6c, 0x70, 0x93, 0x71, 0x33, 0xa3, 0xe8, 0x6d, 0xd9, 0xe3, 0x9e, 0xf6, 0x63, 0xec, 0xd1, 0xc3,
+	0x5e, 0xf6, 0x03, 0x2c, 0x78, 0x94, 0x3d, 0x79, 0x5a, 0x16, 0x3d, 0xf8, 0x35, 0x96, 0x64, 0xa2,
+	0x8e, 0x6c, 0x64, 0x17, 0xf6, 0x12, 0xf2, 0xde, 0xfb, 0xbf, 0xff, 0x9b, 0xdf, 0x4b, 0x06, 0x7e,
+	0x3c, 0x20, 0xd4, 0x23, 0xd4, 0x0c, 0x6c, 0x86, 0x8e, 0x5c, 0xcf, 0x65, 0xe6, 0xb0, 0x66, 0xb2,
+	0x91, 0xd1, 0x0f, 0x08, 0x23, 0x72, 0x9e, 0x57, 0x8d, 0x4d, 0xd5, 0x18, 0xd6, 0xd4, 0xd7, 0xb6,
+	0xe7, 0xfa, 0xc4, 0x8c, 0x9e, 0x5c, 0xa7, 0xbe, 0x8b, 0x5d, 0x3c, 0x8a, 0xc3, 0x7e, 0x8f, 0xe2,
+	0xb8, 0x50, 0x4c, 0xb4, 0x1f, 0xf7, 0x11, 0x8d, 0x05, 0xef, 0xb9, 0xa0, 0x1b, 0x45, 0x66, 0x3c,
+	0x8e, 0x97, 0x0a, 0x98, 0x60, 0xc2, 0xf3, 0xe1, 0x1b, 0xcf, 0xea, 0x57, 0x00, 0xe6, 0xda, 0x14,
+	0x77, 0x10, 0xfb, 0x3d, 0x20, 0xcc, 0x96, 0xbf, 0xc3, 0xac, 0x3d, 0x60, 0x87, 0x24, 0x70, 0xd9,
+	0x58, 0x01, 0x25, 0x50, 0xce, 0xb6, 0x94, 0x9b, 0xcb, 0x6a, 0x21, 0xb6, 0xfa, 0xe5, 0x38, 0x01,
+	0xa2, 0xb4, 0xc3, 0x02, 0xd7, 0xc7, 0xd6, 0x56, 0x2a, 0xff, 0x80, 0xe9, 0xe3, 0xd0, 0x40, 0x79,
+	0x51, 0x02, 0xe5, 0x5c, 0x5d, 0x35, 0x12, 0x50, 0x8d, 0x68, 0x44, 0x2b, 0x3b, 0xbd, 0x2d, 0x4a,
+	0x17, 0xab, 0x49, 0x05, 0x58, 0xbc, 0xa7, 0xf9, 0xed, 0x74, 0x35, 0xa9, 0x6c, 0xcd, 0xce, 0x56,
+	0x93, 0x8a, 0xce, 0xfb, 0xab, 0xd4, 0xe9, 0x99, 0x23, 0x81, 0x57, 0x38, 0xab, 0xfe, 0x06, 0xe6,
+	0x85, 0xd0, 0x42, 0xb4, 0x4f, 0x7c, 0x8a, 0xf4, 0x39, 0x80, 0xaf, 0xda, 0x14, 0x5b, 0xc8, 0x23,
+	0x43, 0xf4, 0x3c, 0xaa, 0x9f, 0x30, 0xc3, 0xec, 0x00, 0x23, 0x16, 0x63, 0x7d, 0x48, 0xc4, 0xfa,
+	0x13, 0x49, 0x44, 0xae, 0xb8, 0x4b, 0x2e, 0xc0, 0xb4, 0x83, 0x7c, 0xe2, 0x29, 0xa9, 0x70, 0xa6,
+	0xc5, 0x83, 0x66, 0xe3, 0x21, 0xee, 0xe7, 0xfd, 0xb8, 0x02, 0x87, 0xae, 0xc0, 0xb7, 0xbb, 0x99,
+	0x35, 0x74, 0xfd, 0x1a, 0xc0, 0x54, 0x9b, 0x62, 0xf9, 0x2f, 0x7c, 0xb9, 0xf9, 0x96, 0xa5, 0xc4,
+	0xd3, 0x0a, 0x2b, 0x53, 0xcb, 0x8f, 0x29, 0xd6, 0xfe, 0x72, 0x17, 0xe6, 0xc4, 0x85, 0x7e, 0xda,
+	0xd7, 0x28, 0x88, 0xd4, 0x2f, 0x4f, 0x10, 0xad, 0x07, 0xa8, 0xe9, 0x93, 0x70, 0x73, 0xad, 0xc6,
+	0x74, 0xa1, 0x81, 0xd9, 0x42, 0x03, 0x77, 0x0b, 0x0d, 0x9c, 0x2f, 0x35, 0x69, 0xb6, 0xd4, 0xa4,
+	0xf9, 0x52, 0x93, 0xfe, 0x69, 0xdc, 0x8c, 0x3a, 0x3d, 0xc3, 0x25, 0x3b, 0x4b, 0x8a, 0x2e, 0xc0,
+	0xff, 0x4c, 0xf4, 0x43, 0x7f, 0xbd, 0x1f, 0x00, 0x04, 0x33, 0xe5, 0xe8, 0x83, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetQuota defines a governance operation for setting an outflow quota,
+	// overwriting any existing quota for the same target and denom.
+	SetQuota(ctx context.Context, in *MsgSetQuota, opts ...grpc.CallOption) (*MsgSetQuotaResponse, error)
+	// RemoveQuota defines a governance operation for removing an outflow quota.
+	RemoveQuota(ctx context.Context, in *MsgRemoveQuota, opts ...grpc.CallOption) (*MsgRemoveQuotaResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetQuota(ctx context.Context, in *MsgSetQuota, opts ...grpc.CallOption) (*MsgSetQuotaResponse, error) {
+	out := new(MsgSetQuotaResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ratelimit.v1.Msg/SetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveQuota(ctx context.Context, in *MsgRemoveQuota, opts ...grpc.CallOption) (*MsgRemoveQuotaResponse, error) {
+	out := new(MsgRemoveQuotaResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ratelimit.v1.Msg/RemoveQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetQuota defines a governance operation for setting an outflow quota,
+	// overwriting any existing quota for the same target and denom.
+	SetQuota(context.Context, *MsgSetQuota) (*MsgSetQuotaResponse, error)
+	// RemoveQuota defines a governance operation for removing an outflow quota.
+	RemoveQuota(context.Context, *MsgRemoveQuota) (*MsgRemoveQuotaResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetQuota(ctx context.Context, req *MsgSetQuota) (*MsgSetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (*UnimplementedMsgServer) RemoveQuota(ctx context.Context, req *MsgRemoveQuota) (*MsgRemoveQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveQuota not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetQuota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ratelimit.v1.Msg/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetQuota(ctx, req.(*MsgSetQuota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveQuota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ratelimit.v1.Msg/RemoveQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveQuota(ctx, req.(*MsgRemoveQuota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.ratelimit.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetQuota",
+			Handler:    _Msg_SetQuota_Handler,
+		},
+		{
+			MethodName: "RemoveQuota",
+			Handler:    _Msg_RemoveQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/ratelimit/v1/tx.proto",
+}
+
+func (m *MsgSetQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Target.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
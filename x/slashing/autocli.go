@@ -34,6 +34,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "DowntimeInfractions",
+					Use:       "downtime-infractions <validator-conspub/address>",
+					Short:     "Query a validator's recent downtime infractions and the penalty of its next one",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -80,3 +80,36 @@ func (k Querier) SigningInfos(ctx context.Context, req *types.QuerySigningInfosR
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// DowntimeInfractions returns the downtime infractions of a specific validator
+// within the decay window and the penalty of its next downtime infraction.
+func (k Querier) DowntimeInfractions(ctx context.Context, req *types.QueryDowntimeInfractionsRequest) (*types.QueryDowntimeInfractionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infractions := signingInfo.RecentDowntimeInfractions(k.HeaderService.HeaderInfo(ctx).Time, params.DowntimeOffenseDecayWindow)
+	return &types.QueryDowntimeInfractionsResponse{
+		Infractions: infractions,
+		NextPenalty: params.DowntimePenalty(len(infractions)),
+	}, nil
+}
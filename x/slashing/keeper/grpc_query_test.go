@@ -92,3 +92,39 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCDowntimeInfractions() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, err := queryClient.DowntimeInfractions(gocontext.Background(), &slashingtypes.QueryDowntimeInfractionsRequest{ConsAddress: ""})
+	require.ErrorContains(err, "invalid request")
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	_, err = queryClient.DowntimeInfractions(gocontext.Background(), &slashingtypes.QueryDowntimeInfractionsRequest{ConsAddress: consStr})
+	require.ErrorContains(err, "SigningInfo not found")
+
+	params := testutil.TestEscalationParams()
+	require.NoError(keeper.Params.Set(ctx, params))
+	now := ctx.HeaderInfo().Time
+	recent := slashingtypes.DowntimeInfraction{
+		Height:        10,
+		Time:          now.Add(-time.Hour),
+		SlashFraction: params.SlashFractionDowntime,
+		JailDuration:  params.DowntimeJailDuration,
+	}
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(2, 0), false, 0)
+	signingInfo.DowntimeInfractions = []slashingtypes.DowntimeInfraction{
+		{Height: 1, Time: now.Add(-params.DowntimeOffenseDecayWindow - time.Hour)},
+		recent,
+	}
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+
+	// expired infractions are not returned and do not escalate the next penalty
+	res, err := queryClient.DowntimeInfractions(gocontext.Background(), &slashingtypes.QueryDowntimeInfractionsRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal([]slashingtypes.DowntimeInfraction{recent}, res.Infractions)
+	require.Equal(params.DowntimeEscalationSchedule[0], res.NextPenalty)
+}
@@ -150,10 +150,12 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			// This is acceptable since it's only used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
-			if err != nil {
-				return err
-			}
+			// the penalty escalates with the downtime infractions committed within
+			// the decay window, older ones are forgotten
+			now := k.HeaderService.HeaderInfo(ctx).Time
+			recentInfractions := signInfo.RecentDowntimeInfractions(now, params.DowntimeOffenseDecayWindow)
+			penalty := params.DowntimePenalty(len(recentInfractions))
+			slashFractionDowntime := penalty.SlashFraction
 
 			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
 			if err != nil {
@@ -167,6 +169,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				event.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 				event.NewAttribute(types.AttributeKeyJailed, consStr),
 				event.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+				event.NewAttribute(types.AttributeKeyDowntimeInfractions, fmt.Sprintf("%d", len(recentInfractions)+1)),
 			); err != nil {
 				return err
 			}
@@ -175,11 +178,13 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
-			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-			if err != nil {
-				return err
-			}
-			signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(downtimeJailDur)
+			signInfo.JailedUntil = now.Add(penalty.JailDuration)
+			signInfo.DowntimeInfractions = append(recentInfractions, types.DowntimeInfraction{
+				Height:        height,
+				Time:          now,
+				SlashFraction: penalty.SlashFraction,
+				JailDuration:  penalty.JailDuration,
+			})
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
@@ -199,6 +204,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				"threshold", minSignedPerWindow,
 				"slashed", slashFractionDowntime.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"downtime_infractions", len(signInfo.DowntimeInfractions),
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
@@ -8,6 +8,7 @@ import (
 	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/testutil"
 	"cosmossdk.io/x/slashing/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
@@ -125,3 +126,70 @@ func (s *KeeperTestSuite) TestKeeper_HandleValidatorSignature() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestKeeper_HandleValidatorSignatureDowntimeEscalation() {
+	_, edPubKey, valAddr := testdata.KeyTestPubAddrED25519()
+	consAddr := sdk.ConsAddress(edPubKey.Address())
+	valStrAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	s.Require().NoError(err)
+	consStrAddr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	s.Require().NoError(err)
+	vpk, err := gogoany.NewAnyWithCacheWithValue(edPubKey)
+	s.Require().NoError(err)
+
+	validator := stakingtypes.Validator{
+		OperatorAddress: valStrAddr,
+		ConsensusPubkey: vpk,
+		Status:          stakingtypes.Bonded,
+		Tokens:          math.NewInt(100),
+		DelegatorShares: math.LegacyNewDec(100),
+	}
+
+	params := testutil.TestEscalationParams()
+	s.Require().NoError(s.slashingKeeper.Params.Set(s.ctx, params))
+	headerInfo := s.ctx.HeaderInfo()
+	headerInfo.Height = 2000
+	s.ctx = s.ctx.WithHeaderInfo(headerInfo)
+	now := headerInfo.Time
+
+	// the infraction older than the decay window is forgotten, the recent one
+	// escalates the penalty to the first step of the schedule
+	expired := types.DowntimeInfraction{
+		Height:        10,
+		Time:          now.Add(-params.DowntimeOffenseDecayWindow),
+		SlashFraction: params.SlashFractionDowntime,
+		JailDuration:  params.DowntimeJailDuration,
+	}
+	recent := types.DowntimeInfraction{
+		Height:        1000,
+		Time:          now.Add(-time.Hour),
+		SlashFraction: params.SlashFractionDowntime,
+		JailDuration:  params.DowntimeJailDuration,
+	}
+	signInfo := types.NewValidatorSigningInfo(consStrAddr, 0, time.Unix(0, 0), false, 501)
+	signInfo.DowntimeInfractions = []types.DowntimeInfraction{expired, recent}
+	s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr, signInfo))
+
+	penalty := params.DowntimeEscalationSchedule[0]
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(s.ctx, consAddr).Return(consAddr, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx, consAddr, int64(1998), int64(0), penalty.SlashFraction, stakingv1beta1.Infraction_INFRACTION_DOWNTIME).Return(math.NewInt(2), nil)
+	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return(nil)
+
+	s.Require().NoError(s.slashingKeeper.HandleValidatorSignatureWithParams(s.ctx, params, edPubKey.Address(), 0, comet.BlockIDFlagAbsent))
+
+	signInfo, err = s.slashingKeeper.ValidatorSigningInfo.Get(s.ctx, consAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(now.Add(penalty.JailDuration), signInfo.JailedUntil)
+	s.Require().Equal(int64(0), signInfo.MissedBlocksCounter)
+	s.Require().Equal([]types.DowntimeInfraction{recent, {
+		Height:        2000,
+		Time:          now,
+		SlashFraction: penalty.SlashFraction,
+		JailDuration:  penalty.JailDuration,
+	}}, signInfo.DowntimeInfractions)
+
+	// infractions beyond the schedule get its last step
+	s.Require().Equal(params.DowntimeEscalationSchedule[1], params.DowntimePenalty(len(signInfo.DowntimeInfractions)))
+	s.Require().Equal(params.DowntimeEscalationSchedule[1], params.DowntimePenalty(10))
+}
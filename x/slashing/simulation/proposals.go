@@ -49,6 +49,10 @@ func SimulateMsgUpdateParams(_ context.Context, r *rand.Rand, _ []simtypes.Accou
 	params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDoubleSign = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDowntime = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
+	params.DowntimeEscalationSchedule = []types.DowntimePenalty{{
+		SlashFraction: sdkmath.LegacyMinDec(params.SlashFractionDowntime.MulInt64(2), sdkmath.LegacyOneDec()),
+		JailDuration:  params.DowntimeJailDuration,
+	}}
 
 	return &types.MsgUpdateParams{
 		Authority: authorityAddr,
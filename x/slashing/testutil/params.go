@@ -1,6 +1,9 @@
 package testutil
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 )
 
@@ -14,3 +17,18 @@ func TestParams() types.Params {
 
 	return params
 }
+
+// TestEscalationParams construct TestParams opting in to downtime penalty
+// escalation: the second downtime infraction within 30 days is penalized with
+// a 2% slash and 1 hour of jail, and any further one with a 5% slash and 1 day
+// of jail.
+func TestEscalationParams() types.Params {
+	params := TestParams()
+	params.DowntimeEscalationSchedule = []types.DowntimePenalty{
+		{SlashFraction: math.LegacyNewDecWithPrec(2, 2), JailDuration: time.Hour},
+		{SlashFraction: math.LegacyNewDecWithPrec(5, 2), JailDuration: 24 * time.Hour},
+	}
+	params.DowntimeOffenseDecayWindow = 30 * 24 * time.Hour
+
+	return params
+}
@@ -33,7 +33,9 @@ func NewParams(
 	}
 }
 
-// DefaultParams defines the parameters for this module
+// DefaultParams defines the parameters for this module. Downtime penalty
+// escalation is opt-in: the default escalation schedule and offense decay
+// window are empty, so every downtime infraction gets the base penalty.
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow,
@@ -61,6 +63,9 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeEscalation(p); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +152,47 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateDowntimeEscalation(p Params) error {
+	if p.DowntimeOffenseDecayWindow < 0 {
+		return fmt.Errorf("downtime offense decay window cannot be negative: %s", p.DowntimeOffenseDecayWindow)
+	}
+
+	// each step of the schedule must be at least as severe as the previous one
+	prev := DowntimePenalty{SlashFraction: p.SlashFractionDowntime, JailDuration: p.DowntimeJailDuration}
+	for i, step := range p.DowntimeEscalationSchedule {
+		if err := validateSlashFractionDowntime(step.SlashFraction); err != nil {
+			return fmt.Errorf("downtime escalation step %d: %w", i, err)
+		}
+		if err := validateDowntimeJailDuration(step.JailDuration); err != nil {
+			return fmt.Errorf("downtime escalation step %d: %w", i, err)
+		}
+		if step.SlashFraction.LT(prev.SlashFraction) {
+			return fmt.Errorf("downtime escalation step %d: slash fraction %s is lower than the previous one %s", i, step.SlashFraction, prev.SlashFraction)
+		}
+		if step.JailDuration < prev.JailDuration {
+			return fmt.Errorf("downtime escalation step %d: jail duration %s is shorter than the previous one %s", i, step.JailDuration, prev.JailDuration)
+		}
+		prev = step
+	}
+
+	return nil
+}
+
+// DowntimePenalty returns the penalty of a downtime infraction given the
+// number of downtime infractions the validator committed within the decay
+// window before it.
+func (p Params) DowntimePenalty(previousInfractions int) DowntimePenalty {
+	if previousInfractions <= 0 || len(p.DowntimeEscalationSchedule) == 0 {
+		return DowntimePenalty{SlashFraction: p.SlashFractionDowntime, JailDuration: p.DowntimeJailDuration}
+	}
+
+	step := previousInfractions - 1
+	if step >= len(p.DowntimeEscalationSchedule) {
+		step = len(p.DowntimeEscalationSchedule) - 1
+	}
+	return p.DowntimeEscalationSchedule[step]
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
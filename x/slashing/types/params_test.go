@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/testutil"
+	"cosmossdk.io/x/slashing/types"
+)
+
+func TestParamsValidateDowntimeEscalation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params)
+		expErr   string
+	}{
+		{
+			name: "default params",
+			malleate: func(p *types.Params) {
+				*p = types.DefaultParams()
+			},
+		},
+		{
+			name:     "escalation enabled",
+			malleate: func(*types.Params) {},
+		},
+		{
+			name: "escalation disabled",
+			malleate: func(p *types.Params) {
+				p.DowntimeEscalationSchedule = nil
+				p.DowntimeOffenseDecayWindow = 0
+			},
+		},
+		{
+			name: "negative decay window",
+			malleate: func(p *types.Params) {
+				p.DowntimeOffenseDecayWindow = -time.Second
+			},
+			expErr: "downtime offense decay window cannot be negative",
+		},
+		{
+			name: "slash fraction too large",
+			malleate: func(p *types.Params) {
+				p.DowntimeEscalationSchedule[1].SlashFraction = math.LegacyNewDec(2)
+			},
+			expErr: "downtime escalation step 1: downtime slash fraction too large",
+		},
+		{
+			name: "non positive jail duration",
+			malleate: func(p *types.Params) {
+				p.DowntimeEscalationSchedule[0].JailDuration = 0
+			},
+			expErr: "downtime escalation step 0: downtime jail duration must be positive",
+		},
+		{
+			name: "slash fraction lower than the base one",
+			malleate: func(p *types.Params) {
+				p.DowntimeEscalationSchedule[0].SlashFraction = math.LegacyNewDecWithPrec(1, 3)
+			},
+			expErr: "downtime escalation step 0: slash fraction 0.001000000000000000 is lower than the previous one",
+		},
+		{
+			name: "jail duration shorter than the previous step",
+			malleate: func(p *types.Params) {
+				p.DowntimeEscalationSchedule[1].JailDuration = time.Minute
+			},
+			expErr: "downtime escalation step 1: jail duration 1m0s is shorter than the previous one 1h0m0s",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := testutil.TestEscalationParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsDowntimePenalty(t *testing.T) {
+	params := testutil.TestEscalationParams()
+	base := types.DowntimePenalty{SlashFraction: params.SlashFractionDowntime, JailDuration: params.DowntimeJailDuration}
+
+	require.Equal(t, base, params.DowntimePenalty(0))
+	require.Equal(t, params.DowntimeEscalationSchedule[0], params.DowntimePenalty(1))
+	require.Equal(t, params.DowntimeEscalationSchedule[1], params.DowntimePenalty(2))
+	require.Equal(t, params.DowntimeEscalationSchedule[1], params.DowntimePenalty(5))
+
+	params.DowntimeEscalationSchedule = nil
+	require.Equal(t, base, params.DowntimePenalty(5))
+
+	// escalation is opt-in
+	defaultParams := types.DefaultParams()
+	require.Equal(t, types.DowntimePenalty{SlashFraction: defaultParams.SlashFractionDowntime, JailDuration: defaultParams.DowntimeJailDuration}, defaultParams.DowntimePenalty(5))
+}
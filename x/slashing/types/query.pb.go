@@ -309,6 +309,109 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDowntimeInfractionsRequest is the request type for the
+// Query/DowntimeInfractions RPC method
+type QueryDowntimeInfractionsRequest struct {
+	// cons_address is the address to query the downtime infractions of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryDowntimeInfractionsRequest) Reset()         { *m = QueryDowntimeInfractionsRequest{} }
+func (m *QueryDowntimeInfractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeInfractionsRequest) ProtoMessage()    {}
+func (*QueryDowntimeInfractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeInfractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeInfractionsRequest.Merge(m, src)
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeInfractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeInfractionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeInfractionsRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeInfractionsRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryDowntimeInfractionsResponse is the response type for the
+// Query/DowntimeInfractions RPC method
+type QueryDowntimeInfractionsResponse struct {
+	// infractions are the downtime infractions within the decay window, oldest first
+	Infractions []DowntimeInfraction `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+	// next_penalty is the penalty of the next downtime infraction
+	NextPenalty DowntimePenalty `protobuf:"bytes,2,opt,name=next_penalty,json=nextPenalty,proto3" json:"next_penalty"`
+}
+
+func (m *QueryDowntimeInfractionsResponse) Reset()         { *m = QueryDowntimeInfractionsResponse{} }
+func (m *QueryDowntimeInfractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeInfractionsResponse) ProtoMessage()    {}
+func (*QueryDowntimeInfractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeInfractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeInfractionsResponse.Merge(m, src)
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeInfractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeInfractionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeInfractionsResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeInfractionsResponse) GetInfractions() []DowntimeInfraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *QueryDowntimeInfractionsResponse) GetNextPenalty() DowntimePenalty {
+	if m != nil {
+		return m.NextPenalty
+	}
+	return DowntimePenalty{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +419,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryDowntimeInfractionsRequest)(nil), "cosmos.slashing.v1beta1.QueryDowntimeInfractionsRequest")
+	proto.RegisterType((*QueryDowntimeInfractionsResponse)(nil), "cosmos.slashing.v1beta1.QueryDowntimeInfractionsResponse")
 }
 
 func init() {
@@ -323,43 +428,49 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xa0, 0x90, 0x30, 0x25, 0x46, 0x07, 0x12, 0xa0, 0xd1, 0x2d, 0xac, 0x09, 0x10, 0x94,
+	0x5d, 0xc1, 0x18, 0xf4, 0xe0, 0xc1, 0x4a, 0x34, 0x18, 0x0e, 0x58, 0x12, 0xa2, 0x5e, 0x36, 0x53,
+	0x3a, 0xac, 0x13, 0xb7, 0x33, 0xcb, 0xce, 0x82, 0x10, 0xa3, 0x07, 0xcf, 0x1e, 0x4c, 0xfc, 0x0c,
+	0x26, 0x1e, 0xd5, 0xf8, 0x21, 0x38, 0x12, 0xf1, 0xe0, 0x89, 0x18, 0x6a, 0xe2, 0xd7, 0x30, 0x3b,
+	0xf3, 0xda, 0x6e, 0x53, 0x16, 0x0a, 0x7a, 0x21, 0x9b, 0x37, 0xef, 0xf7, 0xe7, 0xfd, 0xf2, 0x1e,
+	0xc5, 0x57, 0xd7, 0xa4, 0xaa, 0x49, 0xe5, 0xaa, 0x80, 0xaa, 0xe7, 0x5c, 0xf8, 0xee, 0xd6, 0x6c,
+	0x85, 0xc5, 0x74, 0xd6, 0xdd, 0xd8, 0x64, 0xd1, 0x8e, 0x13, 0x46, 0x32, 0x96, 0x64, 0xd8, 0x34,
+	0x39, 0x8d, 0x26, 0x07, 0x9a, 0x0a, 0xd3, 0x80, 0xae, 0x50, 0xc5, 0x0c, 0xa2, 0x89, 0x0f, 0xa9,
+	0xcf, 0x05, 0x8d, 0xb9, 0x14, 0x86, 0xa4, 0x30, 0xe4, 0x4b, 0x5f, 0xea, 0x4f, 0x37, 0xf9, 0x82,
+	0xea, 0x65, 0x5f, 0x4a, 0x3f, 0x60, 0x2e, 0x0d, 0xb9, 0x4b, 0x85, 0x90, 0xb1, 0x86, 0x28, 0x78,
+	0x9d, 0xc8, 0x72, 0xd7, 0x74, 0x62, 0xfa, 0x46, 0x4d, 0x9f, 0x67, 0xe8, 0xc1, 0xad, 0x79, 0xba,
+	0x44, 0x6b, 0x5c, 0x48, 0x57, 0xff, 0x35, 0x25, 0x7b, 0x08, 0x93, 0xc7, 0x89, 0xd7, 0x65, 0x1a,
+	0xd1, 0x9a, 0x2a, 0xb3, 0x8d, 0x4d, 0xa6, 0x62, 0xfb, 0x29, 0x1e, 0x6c, 0xab, 0xaa, 0x50, 0x0a,
+	0xc5, 0x48, 0x09, 0xf7, 0x85, 0xba, 0x32, 0x82, 0xc6, 0xd0, 0x54, 0x7e, 0xae, 0xe8, 0x64, 0x84,
+	0xe1, 0x18, 0x60, 0xa9, 0x7f, 0xf7, 0xa0, 0x98, 0xfb, 0xf4, 0xe7, 0xf3, 0x34, 0x2a, 0x03, 0xd2,
+	0xf6, 0xf0, 0xb0, 0xa6, 0x5e, 0xe1, 0xbe, 0xe0, 0xc2, 0x5f, 0x14, 0xeb, 0x12, 0x54, 0xc9, 0x02,
+	0x1e, 0x58, 0x93, 0x42, 0x79, 0xb4, 0x5a, 0x8d, 0x98, 0x32, 0x22, 0xfd, 0xa5, 0xf1, 0xef, 0xdf,
+	0x66, 0xae, 0x80, 0xce, 0xfd, 0xc4, 0x86, 0x50, 0x9b, 0xea, 0x9e, 0x69, 0x59, 0x89, 0x23, 0x2e,
+	0xfc, 0x72, 0x3e, 0x81, 0x41, 0xc9, 0x7e, 0x83, 0x47, 0x3a, 0x05, 0x60, 0x80, 0x0a, 0xbe, 0xb8,
+	0x45, 0x03, 0x4f, 0x99, 0x27, 0x8f, 0x8b, 0x75, 0x09, 0xa3, 0xcc, 0x64, 0x8e, 0xb2, 0x4a, 0x03,
+	0x5e, 0xa5, 0xb1, 0x8c, 0x52, 0x84, 0xe9, 0xc1, 0x2e, 0x6c, 0xd1, 0x20, 0xf5, 0x64, 0x57, 0x3a,
+	0xf5, 0x1b, 0xb9, 0x92, 0x07, 0x18, 0xb7, 0x76, 0x01, 0x94, 0x27, 0x1a, 0xca, 0xc9, 0xe2, 0x38,
+	0x66, 0xd5, 0x5a, 0x31, 0xfa, 0x0c, 0xb0, 0xe5, 0x14, 0xd2, 0xfe, 0x8a, 0xf0, 0xe8, 0x11, 0x22,
+	0x30, 0xe5, 0x12, 0x3e, 0x0f, 0x93, 0x9d, 0xfb, 0xa7, 0xc9, 0x34, 0x0b, 0x79, 0xd8, 0xe6, 0xb9,
+	0x47, 0x7b, 0x9e, 0x3c, 0xd1, 0xb3, 0xb1, 0xd2, 0x66, 0xda, 0xc7, 0x45, 0xed, 0x79, 0x41, 0xbe,
+	0x14, 0x31, 0xaf, 0xb1, 0x45, 0xb1, 0x1e, 0xd1, 0xb5, 0xe4, 0x49, 0xfd, 0xdf, 0x0d, 0xf8, 0x81,
+	0xf0, 0x58, 0xb6, 0x12, 0x84, 0xf4, 0x04, 0xe7, 0x79, 0xab, 0x0c, 0x59, 0x5d, 0xcb, 0xcc, 0xaa,
+	0x93, 0x2a, 0x9d, 0x54, 0x9a, 0x8a, 0xac, 0xe2, 0x01, 0xc1, 0xb6, 0x63, 0x2f, 0x64, 0x82, 0x06,
+	0xf1, 0x0e, 0x44, 0x36, 0x75, 0x22, 0xf5, 0xb2, 0xe9, 0x6f, 0xe3, 0x4d, 0x88, 0xa0, 0x3e, 0xb7,
+	0xdf, 0x8b, 0x7b, 0xf5, 0x58, 0xe4, 0x1d, 0xc2, 0x7d, 0xe6, 0xc2, 0x48, 0xb6, 0xe3, 0xce, 0xb3,
+	0x2e, 0x5c, 0xef, 0xae, 0xd9, 0x24, 0x64, 0x4f, 0xbe, 0xdd, 0xff, 0xfd, 0xa1, 0x67, 0x9c, 0x14,
+	0xdd, 0xac, 0xff, 0x3c, 0xe6, 0xa4, 0xc9, 0x17, 0x84, 0xf3, 0xa9, 0x15, 0x22, 0x37, 0x8e, 0x97,
+	0xe9, 0xbc, 0xfc, 0xc2, 0xec, 0x29, 0x10, 0xe0, 0xee, 0xae, 0x76, 0x37, 0x4f, 0x6e, 0x65, 0xba,
+	0x4b, 0x5f, 0xb9, 0x72, 0x5f, 0xa5, 0x17, 0xeb, 0x35, 0xf9, 0x88, 0xf0, 0x40, 0x8a, 0x56, 0x91,
+	0xee, 0x2d, 0x34, 0xe3, 0x9c, 0x3b, 0x0d, 0x04, 0x6c, 0x3b, 0xda, 0xf6, 0x14, 0x99, 0xe8, 0xce,
+	0x36, 0x39, 0x40, 0x78, 0xf0, 0x88, 0x35, 0x26, 0xb7, 0x8f, 0xd7, 0xce, 0xbe, 0xb1, 0xc2, 0x9d,
+	0x33, 0x20, 0xc1, 0x7c, 0x59, 0x9b, 0x5f, 0x22, 0x8f, 0xce, 0x94, 0xb9, 0x5b, 0x05, 0x6a, 0x2f,
+	0x75, 0x2d, 0xa5, 0xf9, 0xdd, 0x43, 0x0b, 0xed, 0x1d, 0x5a, 0xe8, 0xd7, 0xa1, 0x85, 0xde, 0xd7,
+	0xad, 0xdc, 0x5e, 0xdd, 0xca, 0xfd, 0xac, 0x5b, 0xb9, 0x67, 0x70, 0xf2, 0xaa, 0xfa, 0xc2, 0xe1,
+	0xd2, 0xdd, 0x6e, 0x89, 0xc5, 0x3b, 0x21, 0x53, 0x95, 0x3e, 0xfd, 0x03, 0x76, 0xf3, 0xef, 0x00,
+	0x0a, 0xc7, 0xb3, 0x44, 0xb6, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +491,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeInfractions queries the downtime infraction history of given cons
+	// address and the penalty of its next downtime infraction.
+	DowntimeInfractions(ctx context.Context, in *QueryDowntimeInfractionsRequest, opts ...grpc.CallOption) (*QueryDowntimeInfractionsResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +531,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeInfractions(ctx context.Context, in *QueryDowntimeInfractionsRequest, opts ...grpc.CallOption) (*QueryDowntimeInfractionsResponse, error) {
+	out := new(QueryDowntimeInfractionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimeInfractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +548,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeInfractions queries the downtime infraction history of given cons
+	// address and the penalty of its next downtime infraction.
+	DowntimeInfractions(context.Context, *QueryDowntimeInfractionsRequest) (*QueryDowntimeInfractionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +566,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) DowntimeInfractions(ctx context.Context, req *QueryDowntimeInfractionsRequest) (*QueryDowntimeInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeInfractions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +628,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeInfractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeInfractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeInfractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimeInfractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeInfractions(ctx, req.(*QueryDowntimeInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
@@ -516,6 +663,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeInfractions",
+			Handler:    _Query_DowntimeInfractions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -724,6 +875,83 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDowntimeInfractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeInfractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeInfractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeInfractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeInfractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeInfractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NextPenalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -811,6 +1039,36 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDowntimeInfractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeInfractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.NextPenalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1321,6 +1579,205 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimeInfractionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeInfractionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeInfractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeInfractionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeInfractionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeInfractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, DowntimeInfraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPenalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,3 +17,15 @@ func NewValidatorSigningInfo(
 		MissedBlocksCounter: missedBlocksCounter,
 	}
 }
+
+// RecentDowntimeInfractions returns the downtime infractions of the validator
+// that happened within the decay window before the given time.
+func (i ValidatorSigningInfo) RecentDowntimeInfractions(now time.Time, decayWindow time.Duration) []DowntimeInfraction {
+	recent := make([]DowntimeInfraction, 0, len(i.DowntimeInfractions))
+	for _, infraction := range i.DowntimeInfractions {
+		if now.Sub(infraction.Time) < decayWindow {
+			recent = append(recent, infraction)
+		}
+	}
+	return recent
+}
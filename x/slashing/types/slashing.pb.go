@@ -50,6 +50,10 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Downtime infractions committed by the validator within the downtime
+	// offense decay window, oldest first. They determine the escalated penalty
+	// of the next downtime infraction.
+	DowntimeInfractions []DowntimeInfraction `protobuf:"bytes,7,rep,name=downtime_infractions,json=downtimeInfractions,proto3" json:"downtime_infractions"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +132,127 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeInfractions() []DowntimeInfraction {
+	if m != nil {
+		return m.DowntimeInfractions
+	}
+	return nil
+}
+
+// DowntimeInfraction records a downtime infraction of a validator and the
+// penalty that was applied for it.
+type DowntimeInfraction struct {
+	// Height at which the validator was slashed and jailed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Block time at which the validator was slashed and jailed.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// Fraction of the stake that was slashed.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// Duration for which the validator was jailed.
+	JailDuration time.Duration `protobuf:"bytes,4,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *DowntimeInfraction) Reset()         { *m = DowntimeInfraction{} }
+func (m *DowntimeInfraction) String() string { return proto.CompactTextString(m) }
+func (*DowntimeInfraction) ProtoMessage()    {}
+func (*DowntimeInfraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *DowntimeInfraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeInfraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeInfraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeInfraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeInfraction.Merge(m, src)
+}
+func (m *DowntimeInfraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeInfraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeInfraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeInfraction proto.InternalMessageInfo
+
+func (m *DowntimeInfraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeInfraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeInfraction) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// DowntimePenalty defines the slash fraction and jail duration applied for a
+// downtime infraction.
+type DowntimePenalty struct {
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	JailDuration  time.Duration               `protobuf:"bytes,2,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *DowntimePenalty) Reset()         { *m = DowntimePenalty{} }
+func (m *DowntimePenalty) String() string { return proto.CompactTextString(m) }
+func (*DowntimePenalty) ProtoMessage()    {}
+func (*DowntimePenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *DowntimePenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimePenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimePenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimePenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimePenalty.Merge(m, src)
+}
+func (m *DowntimePenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimePenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimePenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimePenalty proto.InternalMessageInfo
+
+func (m *DowntimePenalty) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,13 +260,22 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// downtime_escalation_schedule defines the penalties of repeated downtime
+	// infractions within the downtime_offense_decay_window. The first infraction
+	// is penalized with slash_fraction_downtime and downtime_jail_duration, the
+	// n-th with the (n-1)-th entry of the schedule, and infractions beyond the
+	// schedule with its last entry. An empty schedule disables escalation.
+	DowntimeEscalationSchedule []DowntimePenalty `protobuf:"bytes,6,rep,name=downtime_escalation_schedule,json=downtimeEscalationSchedule,proto3" json:"downtime_escalation_schedule"`
+	// downtime_offense_decay_window defines for how long a downtime infraction
+	// counts towards the escalation of the next ones.
+	DowntimeOffenseDecayWindow time.Duration `protobuf:"bytes,7,opt,name=downtime_offense_decay_window,json=downtimeOffenseDecayWindow,proto3,stdduration" json:"downtime_offense_decay_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,8 +318,24 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeEscalationSchedule() []DowntimePenalty {
+	if m != nil {
+		return m.DowntimeEscalationSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetDowntimeOffenseDecayWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeOffenseDecayWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*DowntimeInfraction)(nil), "cosmos.slashing.v1beta1.DowntimeInfraction")
+	proto.RegisterType((*DowntimePenalty)(nil), "cosmos.slashing.v1beta1.DowntimePenalty")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -194,47 +344,58 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd8, 0x89, 0x43, 0xc7, 0x09, 0x88, 0x69, 0xda, 0x6c, 0x4d, 0xb3, 0x76, 0x22, 0x81,
+	0xac, 0xa2, 0xec, 0xd2, 0x20, 0x71, 0x68, 0xc5, 0x01, 0xd7, 0x20, 0x8a, 0x8a, 0x1a, 0xd9, 0xfc,
+	0x90, 0x90, 0x60, 0x35, 0xde, 0x19, 0xaf, 0x07, 0xef, 0xce, 0x58, 0x3b, 0xb3, 0xa4, 0xfe, 0x17,
+	0x38, 0xf5, 0x08, 0x37, 0x8e, 0x3d, 0xe6, 0xd0, 0x3b, 0xd7, 0x1e, 0xab, 0x9e, 0x10, 0x87, 0x82,
+	0x9c, 0x43, 0xb9, 0xf2, 0x1f, 0xa0, 0x9d, 0x99, 0xdd, 0xda, 0x31, 0x08, 0x45, 0x56, 0x2f, 0x96,
+	0xf7, 0xfd, 0xf8, 0xde, 0xfb, 0xde, 0xf7, 0x79, 0x0d, 0xdf, 0x09, 0x85, 0x4c, 0x84, 0xf4, 0x65,
+	0x8c, 0xe5, 0x88, 0xf1, 0xc8, 0xff, 0xe1, 0xe6, 0x80, 0x2a, 0x7c, 0xb3, 0x0c, 0x78, 0x93, 0x54,
+	0x28, 0x81, 0x76, 0x4d, 0x9d, 0x57, 0x86, 0x6d, 0x5d, 0x63, 0x27, 0x12, 0x91, 0xd0, 0x35, 0x7e,
+	0xfe, 0xcd, 0x94, 0x37, 0xdc, 0x48, 0x88, 0x28, 0xa6, 0xbe, 0x7e, 0x1a, 0x64, 0x43, 0x9f, 0x64,
+	0x29, 0x56, 0x4c, 0x70, 0x9b, 0x6f, 0x9e, 0xcf, 0x2b, 0x96, 0x50, 0xa9, 0x70, 0x32, 0xb1, 0x05,
+	0xd7, 0xcc, 0xbc, 0xc0, 0x20, 0xdb, 0xe1, 0x26, 0xf5, 0x26, 0x4e, 0x18, 0x17, 0xbe, 0xfe, 0x34,
+	0xa1, 0x83, 0xd3, 0x2a, 0xdc, 0xf9, 0x0a, 0xc7, 0x8c, 0x60, 0x25, 0xd2, 0x3e, 0x8b, 0x38, 0xe3,
+	0xd1, 0x5d, 0x3e, 0x14, 0xe8, 0x36, 0xdc, 0xc4, 0x84, 0xa4, 0x54, 0x4a, 0x07, 0xb4, 0x40, 0xfb,
+	0x52, 0x67, 0xff, 0xd9, 0xe3, 0xc3, 0x3d, 0x0b, 0x77, 0x47, 0x70, 0x49, 0xb9, 0xcc, 0xe4, 0x47,
+	0xa6, 0xa4, 0xaf, 0x52, 0xc6, 0xa3, 0x5e, 0xd1, 0x81, 0xf6, 0xe1, 0x96, 0x54, 0x38, 0x55, 0xc1,
+	0x88, 0xb2, 0x68, 0xa4, 0x9c, 0x4a, 0x0b, 0xb4, 0xab, 0xbd, 0xba, 0x8e, 0x7d, 0xaa, 0x43, 0xe8,
+	0x6d, 0xb8, 0xc5, 0x38, 0xa1, 0x0f, 0x02, 0x31, 0x1c, 0x4a, 0xaa, 0x9c, 0x6a, 0x5e, 0xd2, 0xa9,
+	0x38, 0xa0, 0x57, 0xd7, 0xf1, 0xfb, 0x3a, 0x8c, 0xee, 0xc1, 0xad, 0xef, 0x31, 0x8b, 0x29, 0x09,
+	0x32, 0xae, 0x58, 0xec, 0xac, 0xb7, 0x40, 0xbb, 0x7e, 0xd4, 0xf0, 0xcc, 0x15, 0xbc, 0xe2, 0x0a,
+	0xde, 0x17, 0xc5, 0x15, 0x3a, 0xdb, 0x4f, 0x9e, 0x37, 0xd7, 0x1e, 0xfe, 0xd1, 0x04, 0x8f, 0x5e,
+	0x9c, 0xde, 0x00, 0xbd, 0xba, 0x69, 0xff, 0x32, 0xef, 0x46, 0x2e, 0x84, 0x4a, 0x24, 0x03, 0xa9,
+	0x04, 0xa7, 0xc4, 0xd9, 0x68, 0x81, 0xf6, 0x6b, 0xbd, 0xb9, 0x08, 0x3a, 0x82, 0x57, 0x12, 0x26,
+	0x25, 0x25, 0xc1, 0x20, 0x16, 0xe1, 0x58, 0x06, 0xa1, 0xc8, 0xb8, 0xa2, 0xa9, 0x53, 0xd3, 0x04,
+	0x2e, 0x9b, 0x64, 0x47, 0xe7, 0xee, 0x98, 0x14, 0x62, 0x70, 0x87, 0x88, 0x13, 0x9e, 0xcb, 0x10,
+	0x30, 0x3e, 0x4c, 0x71, 0x98, 0xab, 0x25, 0x9d, 0xcd, 0x56, 0xb5, 0x5d, 0x3f, 0x7a, 0xd7, 0xfb,
+	0x0f, 0xf9, 0xbd, 0xae, 0x6d, 0xba, 0x5b, 0xf6, 0x74, 0x2e, 0xe5, 0xab, 0x9b, 0xb5, 0x2f, 0x93,
+	0xa5, 0xb4, 0xbc, 0xb5, 0xfe, 0xd7, 0x2f, 0x4d, 0x70, 0xf0, 0x73, 0x05, 0xa2, 0xe5, 0x66, 0x74,
+	0x15, 0xd6, 0xec, 0xb5, 0x81, 0x5e, 0xd6, 0x3e, 0xa1, 0x0f, 0xe1, 0x7a, 0x5e, 0xe9, 0x54, 0x2e,
+	0x7a, 0x39, 0xdd, 0x86, 0xbe, 0x85, 0xaf, 0xeb, 0xd5, 0x83, 0x62, 0x90, 0x56, 0x6a, 0xab, 0xf3,
+	0x41, 0x5e, 0xfc, 0xfb, 0xf3, 0xe6, 0x5b, 0x86, 0x9f, 0x24, 0x63, 0x8f, 0x09, 0x3f, 0xc1, 0x6a,
+	0xe4, 0xdd, 0xa3, 0x11, 0x0e, 0xa7, 0x5d, 0x1a, 0x3e, 0x7b, 0x7c, 0x08, 0x2d, 0xfd, 0x2e, 0x0d,
+	0x0d, 0xea, 0xb6, 0x46, 0xfb, 0xa4, 0xd8, 0xfa, 0x73, 0xb8, 0x9d, 0x0b, 0x14, 0x14, 0x2e, 0xb7,
+	0x02, 0x5f, 0x5b, 0x5a, 0xb3, 0x6b, 0x0b, 0xcc, 0x96, 0x3f, 0x95, 0x5b, 0x6a, 0x7b, 0x14, 0xc9,
+	0x83, 0x5f, 0x01, 0x7c, 0xa3, 0xb8, 0xcd, 0x31, 0xe5, 0x38, 0x56, 0xd3, 0x7f, 0x61, 0x00, 0x5e,
+	0x29, 0x83, 0xca, 0x4a, 0x0c, 0xfe, 0xde, 0x80, 0xb5, 0x63, 0x9c, 0xe2, 0x44, 0xa2, 0xf7, 0xe0,
+	0x8e, 0x64, 0x11, 0x7f, 0xe9, 0xc6, 0x13, 0xc6, 0x89, 0x38, 0xb1, 0xfa, 0x22, 0x93, 0x33, 0x66,
+	0xfc, 0x5a, 0x67, 0x10, 0xcb, 0xfd, 0xcb, 0x03, 0xdb, 0x35, 0xa1, 0x69, 0xd1, 0x52, 0x59, 0x89,
+	0x31, 0x4a, 0x18, 0xef, 0x6b, 0xcc, 0x63, 0x9a, 0xda, 0x51, 0xdf, 0xc1, 0xab, 0xa5, 0xed, 0x17,
+	0xf9, 0x57, 0x2f, 0xc8, 0xbf, 0xfc, 0xf9, 0x7c, 0x36, 0x77, 0x07, 0x24, 0x61, 0x63, 0x51, 0xb5,
+	0x80, 0x88, 0x6c, 0x10, 0x53, 0x4d, 0xce, 0x59, 0x5f, 0x89, 0xcf, 0xee, 0x82, 0x82, 0x5d, 0x8d,
+	0x9b, 0xf3, 0x43, 0x1c, 0xee, 0x2e, 0x0d, 0x35, 0xbb, 0x39, 0x1b, 0x2b, 0x4d, 0xbc, 0x72, 0x6e,
+	0xa2, 0x01, 0x45, 0x19, 0xbc, 0x5e, 0x1e, 0x91, 0xca, 0x10, 0xc7, 0x9a, 0x7b, 0x20, 0xc3, 0x11,
+	0x25, 0x59, 0x4c, 0x9d, 0x9a, 0x7e, 0x87, 0xb4, 0xff, 0xf7, 0x1d, 0x62, 0xad, 0x3e, 0xff, 0x02,
+	0x69, 0x14, 0xc0, 0x1f, 0x97, 0xb8, 0x7d, 0x0b, 0x8b, 0xc6, 0x70, 0xaf, 0x1c, 0x2b, 0x86, 0x43,
+	0xca, 0x25, 0x0d, 0x08, 0x0d, 0xf1, 0xb4, 0xb0, 0xcb, 0xe6, 0x05, 0x25, 0x2c, 0x87, 0xdd, 0x37,
+	0x68, 0xdd, 0x1c, 0xcc, 0x18, 0xe5, 0xd6, 0xfe, 0x8f, 0x2f, 0x4e, 0x6f, 0x5c, 0x37, 0x0c, 0x0e,
+	0x25, 0x19, 0xfb, 0x0f, 0x5e, 0xfe, 0x65, 0x1a, 0xa3, 0x77, 0x6e, 0x3f, 0x9a, 0xb9, 0xe0, 0xc9,
+	0xcc, 0x05, 0x4f, 0x67, 0x2e, 0xf8, 0x73, 0xe6, 0x82, 0x87, 0x67, 0xee, 0xda, 0xd3, 0x33, 0x77,
+	0xed, 0xb7, 0x33, 0x77, 0xed, 0x9b, 0xbd, 0x85, 0x5b, 0xcf, 0x75, 0xab, 0xe9, 0x84, 0xca, 0x41,
+	0x4d, 0x6f, 0xf7, 0xfe, 0x3f, 0x03, 0x00, 0x6f, 0x13, 0x2f, 0xc3, 0x90, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +435,74 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if len(this.DowntimeInfractions) != len(that1.DowntimeInfractions) {
+		return false
+	}
+	for i := range this.DowntimeInfractions {
+		if !this.DowntimeInfractions[i].Equal(&that1.DowntimeInfractions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DowntimeInfraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeInfraction)
+	if !ok {
+		that2, ok := that.(DowntimeInfraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	return true
+}
+func (this *DowntimePenalty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimePenalty)
+	if !ok {
+		that2, ok := that.(DowntimePenalty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +539,17 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.DowntimeEscalationSchedule) != len(that1.DowntimeEscalationSchedule) {
+		return false
+	}
+	for i := range this.DowntimeEscalationSchedule {
+		if !this.DowntimeEscalationSchedule[i].Equal(&that1.DowntimeEscalationSchedule[i]) {
+			return false
+		}
+	}
+	if this.DowntimeOffenseDecayWindow != that1.DowntimeOffenseDecayWindow {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,6 +572,20 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeInfractions) > 0 {
+		for iNdEx := len(m.DowntimeInfractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeInfractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -375,6 +629,101 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeInfraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeInfraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeInfraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DowntimePenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimePenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimePenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -395,6 +744,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeOffenseDecayWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseDecayWindow):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DowntimeEscalationSchedule) > 0 {
+		for iNdEx := len(m.DowntimeEscalationSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeEscalationSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +786,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -476,6 +847,43 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if len(m.DowntimeInfractions) > 0 {
+		for _, e := range m.DowntimeInfractions {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DowntimeInfraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DowntimePenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -496,6 +904,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.DowntimeEscalationSchedule) > 0 {
+		for _, e := range m.DowntimeEscalationSchedule {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseDecayWindow)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -676,11 +1092,329 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeInfractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeInfractions = append(m.DowntimeInfractions, DowntimeInfraction{})
+			if err := m.DowntimeInfractions[len(m.DowntimeInfractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeInfraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeInfraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeInfraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimePenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimePenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimePenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSlashing
@@ -877,6 +1611,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeEscalationSchedule = append(m.DowntimeEscalationSchedule, DowntimePenalty{})
+			if err := m.DowntimeEscalationSchedule[len(m.DowntimeEscalationSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseDecayWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeOffenseDecayWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
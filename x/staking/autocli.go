@@ -132,6 +132,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod:      "TokenizeShareRecordById",
+					Use:            "tokenize-share-record <id>",
+					Short:          "Query a tokenize share record by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordByDenom",
+					Use:            "tokenize-share-record-by-denom <denom>",
+					Short:          "Query a tokenize share record by the denom of its share tokens",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordsOwned",
+					Use:            "tokenize-share-records-owned <owner>",
+					Short:          "Query the tokenize share records owned by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the amount of tokens delegated through tokenize share records",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -175,6 +198,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share <validator-addr> <amount> <owner> --from <delegator_address>",
+					Short:          "Tokenize shares of a delegation into share tokens",
+					Long:           "Tokenize an amount of delegated tokens into share tokens sent to the owner, who also receives the rewards of the tokenized delegation.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens <amount> --from <delegator_address>",
+					Short:          "Redeem share tokens for shares of the tokenized delegation",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferTokenizeShareRecord",
+					Use:            "transfer-tokenize-share-record <record-id> <new-owner> --from <owner>",
+					Short:          "Transfer the ownership of a tokenize share record and of the rewards of its delegation",
+					Example:        fmt.Sprintf(`%s tx staking transfer-tokenize-share-record 1 cosmos... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenize_share_record_id"}, {ProtoField: "new_owner"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
@@ -61,6 +61,10 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -101,3 +105,28 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if record.Id == 0 || record.Id > lastID {
+			return fmt.Errorf("invalid tokenize share record id %d, last id is %d", record.Id, lastID)
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.Owner == "" || record.Validator == "" {
+			return fmt.Errorf("tokenize share record %d must have an owner and a validator", record.Id)
+		}
+
+		if expected := types.NewTokenizeShareRecord(record.Id, record.Owner, record.Validator); record.ModuleAccount != expected.ModuleAccount {
+			return fmt.Errorf("invalid module account of tokenize share record %d: got %s, expected %s", record.Id, record.ModuleAccount, expected.ModuleAccount)
+		}
+	}
+
+	return nil
+}
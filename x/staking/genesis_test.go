@@ -41,6 +41,26 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(1, "owner", "validator")}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"tokenize share record id above last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(2, "owner", "validator")}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, "owner", "validator")
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record with another module account", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, "owner", "validator")
+			record.ModuleAccount = "bonded_tokens_pool"
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
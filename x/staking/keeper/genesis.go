@@ -195,6 +195,31 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+			return nil, err
+		}
+
+		// the liquid shares of the validators are the shares delegated by the records
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return nil, fmt.Errorf("delegation of tokenize share record %d not found: %w", record.Id, err)
+		}
+
+		if err := k.addValidatorLiquidShares(ctx, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.LastTokenizeShareRecordID.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		return nil, err
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -331,17 +356,33 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var tokenizeShareRecords []types.TokenizeShareRecord
+	err = k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastTokenizeShareRecordID, err := k.LastTokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
-		RotationIndexRecords: rotationIndex,
-		RotationHistory:      conspubKeyRotationHistory,
-		RotationQueue:        rotationQueue,
+		Params:                    params,
+		LastTotalPower:            totalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                allValidators,
+		Delegations:               allDelegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		RotationIndexRecords:      rotationIndex,
+		RotationHistory:           conspubKeyRotationHistory,
+		RotationQueue:             rotationQueue,
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
 	}, nil
 }
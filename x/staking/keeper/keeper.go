@@ -50,6 +50,43 @@ func NewRotationHistoryIndexes(sb *collections.SchemaBuilder) rotationHistoryInd
 	}
 }
 
+type tokenizeShareRecordIndexes struct {
+	Owner *indexes.Multi[sdk.AccAddress, uint64, types.TokenizeShareRecord]
+	Denom *indexes.Unique[string, uint64, types.TokenizeShareRecord]
+}
+
+func (a tokenizeShareRecordIndexes) IndexesList() []collections.Index[uint64, types.TokenizeShareRecord] {
+	return []collections.Index[uint64, types.TokenizeShareRecord]{
+		a.Owner,
+		a.Denom,
+	}
+}
+
+func NewTokenizeShareRecordIndexes(sb *collections.SchemaBuilder, addressCodec addresscodec.Codec) tokenizeShareRecordIndexes {
+	return tokenizeShareRecordIndexes{
+		Owner: indexes.NewMulti(
+			sb,
+			types.TokenizeShareRecordsByOwnerKey,
+			"tokenize_share_records_by_owner",
+			sdk.AccAddressKey,
+			collections.Uint64Key,
+			func(_ uint64, v types.TokenizeShareRecord) (sdk.AccAddress, error) {
+				return addressCodec.StringToBytes(v.Owner)
+			},
+		),
+		Denom: indexes.NewUnique(
+			sb,
+			types.TokenizeShareRecordsByDenomKey,
+			"tokenize_share_records_by_denom",
+			collections.StringKey,
+			collections.Uint64Key,
+			func(_ uint64, v types.TokenizeShareRecord) (string, error) {
+				return v.GetShareTokenDenom(), nil
+			},
+		),
+	}
+}
+
 // Keeper of the x/staking store
 type Keeper struct {
 	appmodule.Environment
@@ -112,6 +149,13 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	// Indexes are being added with keys `TokenizeShareRecordsByOwner` and `TokenizeShareRecordsByDenom`
+	TokenizeShareRecords *collections.IndexedMap[uint64, types.TokenizeShareRecord, tokenizeShareRecordIndexes]
+	// LastTokenizeShareRecordID value: id of the last tokenize share record created
+	LastTokenizeShareRecordID collections.Sequence
+	// ValidatorLiquidShares key: valAddr | value: delegator shares of the validator held by tokenize share records
+	ValidatorLiquidShares collections.Map[sdk.ValAddress, math.LegacyDec]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -289,6 +333,28 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 121 | recordID
+		// indexes are: 122 | owner | recordID and 123 | denom
+		TokenizeShareRecords: collections.NewIndexedMap(
+			sb,
+			types.TokenizeShareRecordsKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+			NewTokenizeShareRecordIndexes(sb, ak.AddressCodec()),
+		),
+
+		// key is: 124
+		LastTokenizeShareRecordID: collections.NewSequence(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id"),
+
+		// key format is: 125 | valAddr
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
 	}
 
 	schema, err := sb.Build()
@@ -284,7 +284,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"245edb7b2c06f4963b7320a70b1185d9a0e02f90f3bc74b3bd2558fc9d97700d",
 	)
 	s.Require().NoError(err)
 
@@ -299,7 +299,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"245edb7b2c06f4963b7320a70b1185d9a0e02f90f3bc74b3bd2558fc9d97700d",
 	)
 	s.Require().NoError(err)
 }
@@ -317,7 +317,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"e1e18ec9a93f4f728d76009314346d77b1bd1c50ebb72b92602abe7707603342",
 	)
 	s.Require().NoError(err)
 
@@ -330,7 +330,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"e1e18ec9a93f4f728d76009314346d77b1bd1c50ebb72b92602abe7707603342",
 	)
 
 	s.Require().NoError(err)
@@ -349,7 +349,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1", // this hash obtained when ran this test in main branch
+		"6cd6e623b423d79f3d8853b2825096febdfec382363dc00af9f991db2e4df8b9", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -362,7 +362,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1",
+		"6cd6e623b423d79f3d8853b2825096febdfec382363dc00af9f991db2e4df8b9",
 	)
 
 	s.Require().NoError(err)
@@ -393,7 +393,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"a3c8e539dc9ebeeb667d271e4a48739a795ca3e94cdff2c2609c2295ff1874f5",
 	)
 	s.Require().NoError(err)
 
@@ -417,7 +417,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"a3c8e539dc9ebeeb667d271e4a48739a795ca3e94cdff2c2609c2295ff1874f5",
 	)
 	s.Require().NoError(err)
 }
@@ -434,7 +434,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"c6080c0d4b7a0734484be3531bf4b48925823af87a93280b048d0e14a7d4f40d",
 	)
 	s.Require().NoError(err)
 
@@ -447,7 +447,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"c6080c0d4b7a0734484be3531bf4b48925823af87a93280b048d0e14a7d4f40d",
 	)
 	s.Require().NoError(err)
 }
@@ -481,7 +481,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"c808f42fe4c12cbe333b21961b7e8471d196e20bdea7bb535706884576dc6c86",
 	)
 	s.Require().NoError(err)
 
@@ -507,7 +507,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"c808f42fe4c12cbe333b21961b7e8471d196e20bdea7bb535706884576dc6c86",
 	)
 	s.Require().NoError(err)
 }
@@ -530,7 +530,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"acda8d0f348c138506f5ced8d0ceba418d07afb88e50d51bf13ef6ad70333ecd",
 	)
 	s.Require().NoError(err)
 
@@ -545,7 +545,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"acda8d0f348c138506f5ced8d0ceba418d07afb88e50d51bf13ef6ad70333ecd",
 	)
 	s.Require().NoError(err)
 }
@@ -573,7 +573,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"d1bf4b44ca4e713dfe3de9e77ee17ba84bb3dc3acce44f26608fc94fe90fabd4",
 	)
 	s.Require().NoError(err)
 
@@ -595,7 +595,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"d1bf4b44ca4e713dfe3de9e77ee17ba84bb3dc3acce44f26608fc94fe90fabd4",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,387 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return record, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns a tokenize share record by the denom of its share tokens.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	id, err := k.TokenizeShareRecords.Indexes.Denom.MatchExact(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "denom %s", denom)
+	} else if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	return k.GetTokenizeShareRecord(ctx, id)
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by an address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	iter, err := k.TokenizeShareRecords.Indexes.Owner.MatchExact(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]types.TokenizeShareRecord, 0, len(ids))
+	for _, id := range ids {
+		record, err := k.GetTokenizeShareRecord(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated through tokenize share records.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.LegacyZeroDec()
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr sdk.ValAddress, shares math.LegacyDec) (bool, error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(validator.TokensFromShares(shares))
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return total.TruncateInt(), nil
+}
+
+// TokenizeShares moves shares of a delegation to a new tokenize share record and mints the
+// corresponding share tokens to the owner of the record.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// shares received through a redelegation could escape a slash of the source validator once tokenized
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if hasRedelegation {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// unbonding all the shares of an unbonded validator removes it, so that there would be
+	// no validator left to delegate the tokens of the record to
+	if validator.IsUnbonded() && shares.Equal(validator.DelegatorShares) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot tokenize all the shares of an unbonded validator")
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	lastID, err := k.LastTokenizeShareRecordID.Next(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(lastID+1, ownerStr, validator.GetOperator())
+	recordAddr := record.GetModuleAddress()
+	if !k.authKeeper.HasAccount(ctx, recordAddr) {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, recordAddr))
+	}
+
+	// the tokens go through the delegator account, so that the delegation of vesting
+	// accounts is tracked and locked tokens cannot be tokenized
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if !returnAmount.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is too small to tokenize")
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// the validator must be fetched again, as unbonding updated its tokens and shares
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareTokens, nil
+}
+
+// RedeemTokensForShares burns share tokens and moves the corresponding shares of the delegation
+// of their tokenize share record to the delegator. The record is removed once all its shares are
+// redeemed. It returns the amount of tokens delegated to the validator.
+func (k Keeper) RedeemTokensForShares(
+	ctx context.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareTokens.Denom)
+	if balance.IsLT(shareTokens) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, shareTokens)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoDelegation
+	} else if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// redeeming the whole supply of share tokens redeems all the shares of the record,
+	// including those truncated when the share tokens were minted
+	shares := math.LegacyNewDecFromInt(shareTokens.Amount)
+	supply := k.bankKeeper.GetSupply(ctx, shareTokens.Denom)
+	switch {
+	case shareTokens.Amount.Equal(supply.Amount):
+		shares = delegation.Shares
+	case shares.GT(delegation.Shares):
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, shares.Neg()); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if shares.Equal(delegation.Shares) {
+		// pays the rewards accrued by the record to its owner before removing it
+		if err := k.Hooks().BeforeTokenizeShareRecordModified(ctx, record.Id); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+
+		if err := k.TokenizeShareRecords.Remove(ctx, record.Id); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	returnCoin := sdk.NewCoin(bondDenom, returnAmount)
+	if !returnAmount.IsPositive() {
+		return record, returnCoin, nil
+	}
+
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// the validator must be fetched again, as unbonding updated its tokens and shares
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, returnCoin, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share record, and thus of
+// the rewards of its delegation, from its owner to a new owner.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(owner, sender) {
+		return errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "record %d is owned by %s", id, record.Owner)
+	}
+
+	newOwnerStr, err := k.authKeeper.AddressCodec().BytesToString(newOwner)
+	if err != nil {
+		return err
+	}
+
+	// the rewards accrued so far are paid to the previous owner
+	if err := k.Hooks().BeforeTokenizeShareRecordModified(ctx, id); err != nil {
+		return err
+	}
+
+	record.Owner = newOwnerStr
+	return k.TokenizeShareRecords.Set(ctx, id, record)
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing shares of a validator would exceed the
+// global or the validator liquid staking cap. A cap of 100% is disabled.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		liquidShares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			liquidShares = math.LegacyZeroDec()
+		} else if err != nil {
+			return err
+		}
+
+		if liquidShares.Add(shares).Quo(validator.DelegatorShares).GT(params.ValidatorLiquidStakingCap) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !totalBonded.IsPositive() {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+
+		liquid := math.LegacyNewDecFromInt(totalLiquid).Add(validator.TokensFromShares(shares))
+		if liquid.QuoInt(totalBonded).GT(params.GlobalLiquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// addValidatorLiquidShares adds shares to the liquid shares of a validator, removing the
+// entry once they reach zero. Negative shares remove them.
+func (k Keeper) addValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		liquidShares = math.LegacyZeroDec()
+	} else if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Add(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *KeeperTestSuite) TestTokenizeShareRecordQueries() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	owner1 := s.addressToString(sdk.AccAddress(PKs[0].Address()))
+	owner2 := s.addressToString(sdk.AccAddress(PKs[1].Address()))
+	valAddr := s.valAddressToString(sdk.ValAddress(PKs[2].Address()))
+
+	records := []stakingtypes.TokenizeShareRecord{
+		stakingtypes.NewTokenizeShareRecord(1, owner1, valAddr),
+		stakingtypes.NewTokenizeShareRecord(2, owner2, valAddr),
+		stakingtypes.NewTokenizeShareRecord(3, owner1, valAddr),
+	}
+	for _, record := range records {
+		require.NoError(keeper.TokenizeShareRecords.Set(ctx, record.Id, record))
+	}
+
+	byID, err := queryClient.TokenizeShareRecordById(ctx, &stakingtypes.QueryTokenizeShareRecordByIdRequest{Id: 2})
+	require.NoError(err)
+	require.Equal(records[1], byID.Record)
+
+	_, err = queryClient.TokenizeShareRecordById(ctx, &stakingtypes.QueryTokenizeShareRecordByIdRequest{Id: 4})
+	require.ErrorContains(err, stakingtypes.ErrTokenizeShareRecordNotFound.Error())
+
+	byDenom, err := queryClient.TokenizeShareRecordByDenom(ctx, &stakingtypes.QueryTokenizeShareRecordByDenomRequest{Denom: records[2].GetShareTokenDenom()})
+	require.NoError(err)
+	require.Equal(records[2], byDenom.Record)
+
+	owned, err := queryClient.TokenizeShareRecordsOwned(ctx, &stakingtypes.QueryTokenizeShareRecordsOwnedRequest{Owner: owner1})
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{records[0], records[2]}, owned.Records)
+
+	owned, err = queryClient.TokenizeShareRecordsOwned(ctx, &stakingtypes.QueryTokenizeShareRecordsOwnedRequest{Owner: owner1, Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{records[0]}, owned.Records)
+
+	_, err = queryClient.TokenizeShareRecordsOwned(ctx, &stakingtypes.QueryTokenizeShareRecordsOwnedRequest{Owner: "invalid"})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestTransferTokenizeShareRecord() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	owner, newOwner := sdk.AccAddress(PKs[0].Address()), sdk.AccAddress(PKs[1].Address())
+	record := stakingtypes.NewTokenizeShareRecord(1, s.addressToString(owner), s.valAddressToString(sdk.ValAddress(PKs[2].Address())))
+	require.NoError(keeper.TokenizeShareRecords.Set(ctx, record.Id, record))
+
+	err := keeper.TransferTokenizeShareRecord(ctx, record.Id, newOwner, newOwner)
+	require.ErrorIs(err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	err = keeper.TransferTokenizeShareRecord(ctx, 2, owner, newOwner)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotFound)
+
+	require.NoError(keeper.TransferTokenizeShareRecord(ctx, record.Id, owner, newOwner))
+
+	records, err := keeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	require.NoError(err)
+	require.Empty(records)
+	records, err = keeper.GetTokenizeShareRecordsByOwner(ctx, newOwner)
+	require.NoError(err)
+	require.Len(records, 1)
+	require.Equal(s.addressToString(newOwner), records[0].Owner)
+}
+
+func (s *KeeperTestSuite) TestMigrate6to7() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.GlobalLiquidStakingCap = math.LegacyDec{}
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	require.NoError(stakingkeeper.NewMigrator(keeper).Migrate6to7(ctx))
+
+	params, err = keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(stakingtypes.DefaultValidatorLiquidStakingCap, params.ValidatorLiquidStakingCap)
+}
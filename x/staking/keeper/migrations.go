@@ -5,6 +5,7 @@ import (
 
 	v5 "cosmossdk.io/x/staking/migrations/v5"
 	v6 "cosmossdk.io/x/staking/migrations/v6"
+	"cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -47,3 +48,17 @@ func (m Migrator) Migrate5to6(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
 	return v6.MigrateStore(ctx, store, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+// It sets the liquid staking caps introduced with the tokenize share records to their defaults.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -50,4 +50,11 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotFound       = errors.Register(ModuleName, 48, "tokenize share record not found")
+	ErrNotTokenizeShareRecordOwner       = errors.Register(ModuleName, 49, "not the owner of the tokenize share record")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 50, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 51, "tokenization exceeds the validator liquid staking cap")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 52, "cannot tokenize shares of a validator with a redelegation in progress")
 )
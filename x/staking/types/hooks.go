@@ -126,3 +126,12 @@ func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPu
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) BeforeTokenizeShareRecordModified(ctx context.Context, recordID uint64) error {
+	for i := range h {
+		if err := h[i].BeforeTokenizeShareRecordModified(ctx, recordID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
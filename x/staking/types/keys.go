@@ -67,6 +67,12 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordsKey        = collections.NewPrefix(121) // prefix for the tokenize share records
+	TokenizeShareRecordsByOwnerKey = collections.NewPrefix(122) // prefix for the tokenize share records index, by owner
+	TokenizeShareRecordsByDenomKey = collections.NewPrefix(123) // prefix for the tokenize share records index, by share token denom
+	LastTokenizeShareRecordIDKey   = collections.NewPrefix(124) // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey       = collections.NewPrefix(125) // prefix for the delegator shares of a validator held by tokenize share records
 )
 
 // Reserved kvstore keys
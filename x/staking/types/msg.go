@@ -23,6 +23,9 @@ var (
 	_ coretransaction.Msg                  = &MsgBeginRedelegate{}
 	_ coretransaction.Msg                  = &MsgCancelUnbondingDelegation{}
 	_ coretransaction.Msg                  = &MsgUpdateParams{}
+	_ coretransaction.Msg                  = &MsgTokenizeShares{}
+	_ coretransaction.Msg                  = &MsgRedeemTokensForShares{}
+	_ coretransaction.Msg                  = &MsgTransferTokenizeShareRecord{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -141,6 +144,33 @@ func NewMsgUndelegate(delAddr, valAddr string, amount sdk.Coin) *MsgUndelegate {
 	}
 }
 
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner string) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordID,
+		Sender:                sender,
+		NewOwner:              newOwner,
+	}
+}
+
 // NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
 func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int64, amount sdk.Coin) *MsgCancelUnbondingDelegation {
 	return &MsgCancelUnbondingDelegation{
@@ -46,4 +46,17 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// validate liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
 }
@@ -0,0 +1,214 @@
+package eip712
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// SignMode is SIGN_MODE_EIP_712 of the cosmos.tx.signing.v1beta1.SignMode enum.
+// It is declared here as x/tx depends on a release of cosmossdk.io/api which predates it.
+const SignMode = signingv1beta1.SignMode(712)
+
+const signModeName = "SIGN_MODE_EIP_712"
+
+const (
+	// DefaultDomainName is the default name of the EIP-712 domain.
+	DefaultDomainName = "Cosmos SDK"
+	// DomainVersion is the version of the EIP-712 domain, to be bumped when the typed data derived from
+	// transactions changes.
+	DomainVersion = "1"
+
+	// TxTypeName is the name of the primary type of the typed data of a transaction.
+	TxTypeName = "Tx"
+)
+
+// SignModeHandler implements the SIGN_MODE_EIP_712 signing mode.
+//
+// The transaction is signed as EIP-712 typed data, whose message holds the fields of the Amino JSON sign doc:
+// the account number, the chain ID, the fee, the memo, the sequence, the timeout height and one member per
+// transaction message, named msg0, msg1, ... It also holds the timeout timestamp and the unordered flag of
+// the transaction, which bound the replay of unordered transactions. The struct types of the messages are derived from their
+// protobuf descriptors. The sign bytes are the EIP-712 encoding of the typed data, whose keccak256 hash is
+// signed by Ethereum wallets with eth_signTypedData_v4, and are verified with VerifySignature.
+type SignModeHandler struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	domainName   string
+	ethChainID   uint64
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	FileResolver signing.ProtoFileResolver
+	TypeResolver signing.TypeResolver
+
+	// DomainName is the name of the EIP-712 domain, DefaultDomainName if empty.
+	DomainName string
+	// EthChainID is the chain ID of the EIP-712 domain. Wallets require it to match the chain they are
+	// connected to. It is omitted from the domain if zero, the Cosmos chain ID being part of the message.
+	EthChainID uint64
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	h := &SignModeHandler{
+		domainName: options.DomainName,
+		ethChainID: options.EthChainID,
+	}
+	if options.FileResolver == nil {
+		h.fileResolver = gogoproto.HybridResolver
+	} else {
+		h.fileResolver = options.FileResolver
+	}
+	if options.TypeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	} else {
+		h.typeResolver = options.TypeResolver
+	}
+	if h.domainName == "" {
+		h.domainName = DefaultDomainName
+	}
+	return h
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return SignMode
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedData.SignBytes()
+}
+
+// GetTypedData returns the EIP-712 typed data of the transaction, to be signed by Ethereum wallets.
+func (h SignModeHandler) GetTypedData(_ context.Context, signerData signing.SignerData, txData signing.TxData) (*TypedData, error) {
+	body := txData.Body
+	_, err := decode.RejectUnknownFields(
+		txData.BodyBytes, body.ProtoReflect().Descriptor(), false, h.fileResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	if (len(body.ExtensionOptions) > 0) || (len(body.NonCriticalExtensionOptions) > 0) {
+		return nil, fmt.Errorf("%s does not support protobuf extension options: invalid request", signModeName)
+	}
+
+	if signerData.Address == "" {
+		return nil, fmt.Errorf("got empty address in %s handler: invalid request", signModeName)
+	}
+
+	if txData.AuthInfo.Fee == nil {
+		return nil, errors.New("fee cannot be nil")
+	}
+
+	enc := &encoder{
+		fileResolver: h.fileResolver,
+		typeResolver: h.typeResolver,
+		types:        map[string][]Type{},
+	}
+
+	domainTypes := []Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+	}
+	domain := map[string]any{
+		"name":    h.domainName,
+		"version": DomainVersion,
+	}
+	if h.ethChainID != 0 {
+		domainTypes = append(domainTypes, Type{Name: "chainId", Type: "uint256"})
+		domain["chainId"] = strconv.FormatUint(h.ethChainID, 10)
+	}
+	enc.types[domainTypeName] = domainTypes
+
+	feeType, fee, err := enc.message(txData.AuthInfo.Fee.ProtoReflect(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: feeType},
+		{Name: "memo", Type: "string"},
+	}
+	message := map[string]any{
+		"account_number": strconv.FormatUint(signerData.AccountNumber, 10),
+		"chain_id":       signerData.ChainID,
+		"fee":            fee,
+		"memo":           body.Memo,
+	}
+
+	for i, msg := range body.Messages {
+		msgType, value, err := enc.message(msg.ProtoReflect(), 0)
+		if err != nil {
+			return nil, err
+		}
+
+		name := "msg" + strconv.Itoa(i)
+		members = append(members, Type{Name: name, Type: msgType})
+		message[name] = value
+	}
+
+	timeoutTimestamp, unordered := unorderedFields(body.ProtoReflect())
+	timeoutTimestampType, timeoutTimestampValue, err := enc.message(timeoutTimestamp, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	members = append(members,
+		Type{Name: "sequence", Type: "uint64"},
+		Type{Name: "timeout_height", Type: "uint64"},
+		Type{Name: "timeout_timestamp", Type: timeoutTimestampType},
+		Type{Name: "unordered", Type: "bool"},
+	)
+	message["sequence"] = strconv.FormatUint(signerData.Sequence, 10)
+	message["timeout_height"] = strconv.FormatUint(body.TimeoutHeight, 10)
+	message["timeout_timestamp"] = timeoutTimestampValue
+	message["unordered"] = unordered
+
+	return &TypedData{
+		Types:       enc.types,
+		PrimaryType: enc.define(TxTypeName, members),
+		Domain:      domain,
+		Message:     message,
+	}, nil
+}
+
+// unorderedFields returns the timeout timestamp and the unordered flag of a transaction body. They are read
+// through reflection as the release of cosmossdk.io/api x/tx depends on predates them, an unset timestamp
+// being returned with its default value like the unset fields of messages.
+func unorderedFields(body protoreflect.Message) (protoreflect.Message, bool) {
+	fields := body.Descriptor().Fields()
+
+	timeoutTimestamp := (&timestamppb.Timestamp{}).ProtoReflect()
+	if fd := fields.ByName("timeout_timestamp"); fd != nil && body.Has(fd) {
+		timeoutTimestamp = body.Get(fd).Message()
+	}
+
+	unordered := false
+	if fd := fields.ByName("unordered"); fd != nil {
+		unordered = body.Get(fd).Bool()
+	}
+
+	return timeoutTimestamp, unordered
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
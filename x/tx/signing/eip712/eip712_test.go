@@ -0,0 +1,231 @@
+package eip712_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip712"
+	"cosmossdk.io/x/tx/signing/testutil"
+)
+
+var (
+	fee = &txv1beta1.Fee{
+		Amount:   []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
+		GasLimit: 200000,
+	}
+	msgSend = &bankv1beta1.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      []*basev1beta1.Coin{{Denom: "uatom", Amount: "100"}},
+	}
+)
+
+func handlerArguments(t *testing.T, msgs ...proto.Message) (signing.SignerData, signing.TxData) {
+	t.Helper()
+
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID:       "test-chain",
+		Memo:          "memo",
+		Msg:           msgs[0],
+		AccNum:        1,
+		AccSeq:        2,
+		SignerAddress: "cosmos1from",
+		Fee:           fee,
+	})
+	require.NoError(t, err)
+
+	for _, msg := range msgs[1:] {
+		anyMsg, err := anyutil.New(msg)
+		require.NoError(t, err)
+		txData.Body.Messages = append(txData.Body.Messages, anyMsg)
+	}
+	txData.BodyBytes, err = proto.MarshalOptions{Deterministic: true}.Marshal(txData.Body)
+	require.NoError(t, err)
+
+	return signerData, txData
+}
+
+func TestSignModeHandler(t *testing.T) {
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+	require.Equal(t, eip712.SignMode, handler.Mode())
+	require.Equal(t, "712", handler.Mode().String())
+
+	signerData, txData := handlerArguments(t, msgSend)
+	td, err := handler.GetTypedData(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	require.Equal(t, eip712.TxTypeName, td.PrimaryType)
+	encoded, err := td.EncodeType(td.PrimaryType)
+	require.NoError(t, err)
+	require.Equal(t, "Tx(uint64 account_number,string chain_id,CosmosTxV1Beta1Fee fee,string memo,"+
+		"AnyCosmosBankV1Beta1MsgSend msg0,uint64 sequence,uint64 timeout_height,"+
+		"GoogleProtobufTimestamp timeout_timestamp,bool unordered)"+
+		"AnyCosmosBankV1Beta1MsgSend(string type_url,CosmosBankV1Beta1MsgSend value)"+
+		"CosmosBankV1Beta1MsgSend(string from_address,string to_address,CosmosBaseV1Beta1Coin[] amount)"+
+		"CosmosBaseV1Beta1Coin(string denom,string amount)"+
+		"CosmosTxV1Beta1Fee(CosmosBaseV1Beta1Coin[] amount,uint64 gas_limit,string payer,string granter)"+
+		"GoogleProtobufTimestamp(int64 seconds,int32 nanos)", encoded)
+
+	require.Equal(t, map[string]any{"name": eip712.DefaultDomainName, "version": eip712.DomainVersion}, td.Domain)
+	require.Equal(t, map[string]any{
+		"account_number": "1",
+		"chain_id":       "test-chain",
+		"fee": map[string]any{
+			"amount":    []any{map[string]any{"denom": "uatom", "amount": "1000"}},
+			"gas_limit": "200000",
+			"payer":     "",
+			"granter":   "",
+		},
+		"memo": "memo",
+		"msg0": map[string]any{
+			"type_url": "/cosmos.bank.v1beta1.MsgSend",
+			"value": map[string]any{
+				"from_address": "cosmos1from",
+				"to_address":   "cosmos1to",
+				"amount":       []any{map[string]any{"denom": "uatom", "amount": "100"}},
+			},
+		},
+		"sequence":          "2",
+		"timeout_height":    "0",
+		"timeout_timestamp": map[string]any{"seconds": "0", "nanos": "0"},
+		"unordered":         false,
+	}, td.Message)
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	expected, err := td.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, expected, signBytes)
+
+	// the signature of an Ethereum wallet verifies
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	compact := ecdsa.SignCompact(privKey, keccak256(signBytes), false)
+	sig := append(compact[1:], compact[0]-27)
+	require.True(t, eip712.VerifySignature(privKey.PubKey().SerializeCompressed(), signBytes, sig))
+
+	// but not for another transaction
+	signerData.Sequence++
+	otherSignBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.False(t, eip712.VerifySignature(privKey.PubKey().SerializeCompressed(), otherSignBytes, sig))
+}
+
+func TestSignModeHandlerDomain(t *testing.T) {
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{DomainName: "Test Chain", EthChainID: 9001})
+
+	signerData, txData := handlerArguments(t, msgSend)
+	td, err := handler.GetTypedData(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "Test Chain", "version": eip712.DomainVersion, "chainId": "9001"}, td.Domain)
+
+	encoded, err := td.EncodeType("EIP712Domain")
+	require.NoError(t, err)
+	require.Equal(t, "EIP712Domain(string name,string version,uint256 chainId)", encoded)
+}
+
+func TestSignModeHandlerMessages(t *testing.T) {
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+
+	send, err := anyutil.New(msgSend)
+	require.NoError(t, err)
+	delegate := &stakingv1beta1.MsgDelegate{
+		DelegatorAddress: "cosmos1from",
+		ValidatorAddress: "cosmosvaloper1val",
+		Amount:           &basev1beta1.Coin{Denom: "uatom", Amount: "10"},
+	}
+
+	// messages of different types, one of them nesting a packed message
+	signerData, txData := handlerArguments(t, delegate, &authzv1beta1.MsgExec{
+		Grantee: "cosmos1grantee",
+		Msgs:    []*anypb.Any{send},
+	})
+	td, err := handler.GetTypedData(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	require.Equal(t, []eip712.Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: "CosmosTxV1Beta1Fee"},
+		{Name: "memo", Type: "string"},
+		{Name: "msg0", Type: "AnyCosmosStakingV1Beta1MsgDelegate"},
+		{Name: "msg1", Type: "AnyCosmosAuthzV1Beta1MsgExec"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "timeout_height", Type: "uint64"},
+		{Name: "timeout_timestamp", Type: "GoogleProtobufTimestamp"},
+		{Name: "unordered", Type: "bool"},
+	}, td.Types[eip712.TxTypeName])
+	require.Equal(t, []eip712.Type{
+		{Name: "grantee", Type: "string"},
+		{Name: "msgs", Type: "AnyCosmosBankV1Beta1MsgSend[]"},
+	}, td.Types["CosmosAuthzV1Beta1MsgExec"])
+
+	_, err = td.SignBytes()
+	require.NoError(t, err)
+
+	// a repeated Any must hold messages of the same type
+	delegateAny, err := anyutil.New(delegate)
+	require.NoError(t, err)
+	signerData, txData = handlerArguments(t, &authzv1beta1.MsgExec{
+		Grantee: "cosmos1grantee",
+		Msgs:    []*anypb.Any{send, delegateAny},
+	})
+	_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+	require.ErrorContains(t, err, "elements of cosmos.authz.v1beta1.MsgExec.msgs have different types")
+}
+
+func TestSignModeHandlerErrors(t *testing.T) {
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+
+	signerData, txData := handlerArguments(t, msgSend)
+	signerData.Address = ""
+	_, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.ErrorContains(t, err, "got empty address in SIGN_MODE_EIP_712 handler")
+
+	signerData, txData = handlerArguments(t, msgSend)
+	txData.AuthInfo.Fee = nil
+	_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+	require.ErrorContains(t, err, "fee cannot be nil")
+
+	signerData, txData = handlerArguments(t, msgSend)
+	txData.Body.ExtensionOptions = []*anypb.Any{{TypeUrl: "/foo"}}
+	_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+	require.ErrorContains(t, err, "does not support protobuf extension options")
+}
+
+func TestVerifySignature(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	msg := []byte("sign bytes")
+	compact := ecdsa.SignCompact(privKey, keccak256(msg), true)
+	sig := compact[1:]
+	require.True(t, eip712.VerifySignature(pubKey, msg, sig))
+
+	// the message is hashed with keccak256
+	require.False(t, eip712.VerifySignature(pubKey, keccak256(msg), sig))
+
+	// signatures with a high S are rejected
+	var s secp256k1.ModNScalar
+	s.SetByteSlice(sig[32:])
+	s.Negate()
+	highS := s.Bytes()
+	require.False(t, eip712.VerifySignature(pubKey, msg, append(append([]byte{}, sig[:32]...), highS[:]...)))
+
+	require.False(t, eip712.VerifySignature(pubKey, msg, sig[:63]))
+	require.False(t, eip712.VerifySignature(pubKey[1:], msg, sig))
+}
@@ -0,0 +1,219 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/x/tx/signing"
+)
+
+const (
+	anyFullName         = "google.protobuf.Any"
+	anyTypeURLFieldName = "type_url"
+	anyValueFieldName   = "value"
+)
+
+// encoder derives the EIP-712 struct types of protobuf messages from their descriptors, and encodes their values.
+//
+// Every field of a message is a member of its struct type, unset fields being encoded with their default
+// value, so that the type of a message only depends on its descriptor. The exception are google.protobuf.Any
+// fields, which are encoded as a struct of their type URL and their unpacked value: the struct types
+// containing them depend on the packed values, and are suffixed with a number when they differ from an
+// already defined type of the same message.
+type encoder struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	types        map[string][]Type
+}
+
+// message returns the struct type name and the value of a message.
+func (e *encoder) message(msg protoreflect.Message, depth int) (string, map[string]any, error) {
+	if depth > maxDepth {
+		return "", nil, fmt.Errorf("message is nested more than %d times", maxDepth)
+	}
+
+	desc := msg.Descriptor()
+	if desc.FullName() == anyFullName {
+		return e.any(msg, depth)
+	}
+
+	fields := desc.Fields()
+	members := make([]Type, 0, fields.Len())
+	value := make(map[string]any, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+
+		var (
+			typ string
+			v   any
+			err error
+		)
+		switch {
+		case fd.IsMap():
+			return "", nil, fmt.Errorf("map field %s is not supported", fd.FullName())
+		case fd.IsList():
+			typ, v, err = e.list(fd, msg.Get(fd).List(), depth)
+		default:
+			typ, v, err = e.value(fd, msg.Get(fd), depth)
+		}
+		if err != nil {
+			return "", nil, err
+		}
+
+		members = append(members, Type{Name: name, Type: typ})
+		value[name] = v
+	}
+
+	return e.define(strcase.ToCamel(string(desc.FullName())), members), value, nil
+}
+
+// any returns the struct type name and the value of a google.protobuf.Any message, unpacking its value.
+func (e *encoder) any(msg protoreflect.Message, depth int) (string, map[string]any, error) {
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName(anyTypeURLFieldName)).String()
+	bz := msg.Get(fields.ByName(anyValueFieldName)).Bytes()
+
+	if typeURL == "" {
+		// an unset Any is encoded with an empty value
+		name := e.define(strcase.ToCamel(anyFullName), []Type{
+			{Name: anyTypeURLFieldName, Type: "string"},
+			{Name: anyValueFieldName, Type: "bytes"},
+		})
+		return name, map[string]any{anyTypeURLFieldName: "", anyValueFieldName: "0x"}, nil
+	}
+
+	unpacked, err := e.unpack(typeURL, bz)
+	if err != nil {
+		return "", nil, err
+	}
+
+	valueType, value, err := e.message(unpacked, depth+1)
+	if err != nil {
+		return "", nil, err
+	}
+
+	name := e.define("Any"+valueType, []Type{
+		{Name: anyTypeURLFieldName, Type: "string"},
+		{Name: anyValueFieldName, Type: valueType},
+	})
+	return name, map[string]any{anyTypeURLFieldName: typeURL, anyValueFieldName: value}, nil
+}
+
+func (e *encoder) unpack(typeURL string, bz []byte) (protoreflect.Message, error) {
+	var msg protoreflect.Message
+	if typ, err := e.typeResolver.FindMessageByURL(typeURL); err == nil {
+		msg = typ.New()
+	} else {
+		name := typeURL[strings.LastIndexByte(typeURL, '/')+1:]
+		desc, err := e.fileResolver.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("can't resolve type URL %s: %w", typeURL, err)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("type URL %s does not refer to a message", typeURL)
+		}
+		msg = dynamicpb.NewMessage(msgDesc)
+	}
+
+	if err := proto.Unmarshal(bz, msg.Interface()); err != nil {
+		return nil, fmt.Errorf("can't unpack %s: %w", typeURL, err)
+	}
+
+	return msg, nil
+}
+
+// list returns the type and the value of a repeated field.
+func (e *encoder) list(fd protoreflect.FieldDescriptor, list protoreflect.List, depth int) (string, any, error) {
+	values := make([]any, list.Len())
+	elemType := ""
+	for i := 0; i < list.Len(); i++ {
+		typ, v, err := e.value(fd, list.Get(i), depth)
+		if err != nil {
+			return "", nil, err
+		}
+		if elemType != "" && typ != elemType {
+			return "", nil, fmt.Errorf("elements of %s have different types %s and %s", fd.FullName(), elemType, typ)
+		}
+		elemType = typ
+		values[i] = v
+	}
+
+	if elemType == "" {
+		// the element type of an empty list is the type of a default element
+		var err error
+		elemType, _, err = e.value(fd, e.defaultValue(fd), depth)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return elemType + "[]", values, nil
+}
+
+func (e *encoder) defaultValue(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return protoreflect.ValueOfMessage(dynamicpb.NewMessage(fd.Message()))
+	}
+	return fd.Default()
+}
+
+// value returns the type and the value of a singular field, or of an element of a repeated field.
+func (e *encoder) value(fd protoreflect.FieldDescriptor, v protoreflect.Value, depth int) (string, any, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "bool", v.Bool(), nil
+	case protoreflect.StringKind:
+		return "string", v.String(), nil
+	case protoreflect.BytesKind:
+		return "bytes", "0x" + hex.EncodeToString(v.Bytes()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32", strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64", strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.FloatKind:
+		return "string", strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+	case protoreflect.DoubleKind:
+		return "string", strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return "string", string(ev.Name()), nil
+		}
+		return "string", strconv.FormatInt(int64(v.Enum()), 10), nil
+	case protoreflect.MessageKind:
+		return e.message(v.Message(), depth+1)
+	default:
+		return "", nil, fmt.Errorf("field %s of kind %s is not supported", fd.FullName(), fd.Kind())
+	}
+}
+
+// define registers a struct type and returns its name, which is suffixed with a number if a different
+// type of the same name is already defined.
+func (e *encoder) define(name string, members []Type) string {
+	candidate := name
+	for i := 2; ; i++ {
+		defined, ok := e.types[candidate]
+		if !ok {
+			e.types[candidate] = members
+			return candidate
+		}
+		if reflect.DeepEqual(defined, members) {
+			return candidate
+		}
+		candidate = name + strconv.Itoa(i)
+	}
+}
@@ -0,0 +1,364 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// domainTypeName is the name of the EIP-712 domain type.
+const domainTypeName = "EIP712Domain"
+
+// Type is a member of an EIP-712 struct type.
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is an EIP-712 typed structured data document, in the format expected by eth_signTypedData_v4.
+//
+// Integer values are represented as decimal strings and bytes values as 0x-prefixed hex strings,
+// so that the document can be passed as JSON to a wallet without losing precision.
+type TypedData struct {
+	Types       map[string][]Type `json:"types"`
+	PrimaryType string            `json:"primaryType"`
+	Domain      map[string]any    `json:"domain"`
+	Message     map[string]any    `json:"message"`
+}
+
+// SignBytes returns the EIP-712 encoding of the typed data, "\x19\x01" ‖ domainSeparator ‖ hashStruct(message).
+// Its keccak256 hash is the digest signed by Ethereum wallets.
+func (td *TypedData) SignBytes() ([]byte, error) {
+	domainSeparator, err := td.HashStruct(domainTypeName, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("domain: %w", err)
+	}
+
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("message: %w", err)
+	}
+
+	return append(append([]byte{0x19, 0x01}, domainSeparator...), messageHash...), nil
+}
+
+// Hash returns the keccak256 hash of the EIP-712 encoding of the typed data.
+func (td *TypedData) Hash() ([]byte, error) {
+	bz, err := td.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak256(bz), nil
+}
+
+// HashStruct returns the hashStruct of a value of the given struct type.
+func (td *TypedData) HashStruct(typeName string, value map[string]any) ([]byte, error) {
+	typeHash, err := td.TypeHash(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := td.encodeData(typeName, value, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak256(typeHash, encoded), nil
+}
+
+// TypeHash returns the keccak256 hash of the encoding of the given struct type.
+func (td *TypedData) TypeHash(typeName string) ([]byte, error) {
+	encoded, err := td.EncodeType(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak256([]byte(encoded)), nil
+}
+
+// EncodeType returns the encoding of the given struct type, followed by the encodings of the struct types it
+// references, sorted by name.
+func (td *TypedData) EncodeType(typeName string) (string, error) {
+	deps := map[string]bool{}
+	if err := td.dependencies(typeName, deps); err != nil {
+		return "", err
+	}
+	delete(deps, typeName)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range append([]string{typeName}, names...) {
+		b.WriteString(name)
+		b.WriteByte('(')
+		for i, member := range td.Types[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(member.Type)
+			b.WriteByte(' ')
+			b.WriteString(member.Name)
+		}
+		b.WriteByte(')')
+	}
+
+	return b.String(), nil
+}
+
+// dependencies collects the struct types referenced by the given type, itself included.
+func (td *TypedData) dependencies(typeName string, deps map[string]bool) error {
+	if deps[typeName] {
+		return nil
+	}
+
+	members, ok := td.Types[typeName]
+	if !ok {
+		return fmt.Errorf("undefined type %s", typeName)
+	}
+	deps[typeName] = true
+
+	for _, member := range members {
+		elem := elementType(member.Type)
+		if _, ok := td.Types[elem]; ok {
+			if err := td.dependencies(elem, deps); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// maxDepth is the maximum nesting of values in typed data.
+const maxDepth = 64
+
+func (td *TypedData) encodeData(typeName string, value map[string]any, depth int) ([]byte, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("typed data is nested more than %d times", maxDepth)
+	}
+
+	members := td.Types[typeName]
+	if len(value) != len(members) {
+		return nil, fmt.Errorf("%s has %d members, got %d values", typeName, len(members), len(value))
+	}
+
+	var buf bytes.Buffer
+	for _, member := range members {
+		v, ok := value[member.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value for %s.%s", typeName, member.Name)
+		}
+
+		encoded, err := td.encodeValue(member.Type, v, depth)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typeName, member.Name, err)
+		}
+		buf.Write(encoded)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeValue returns the 32 bytes encoding of a value of the given type.
+func (td *TypedData) encodeValue(typ string, value any, depth int) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		i := strings.LastIndexByte(typ, '[')
+		elem := typ[:i]
+		values, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected an array, got %T", value)
+		}
+		if size := typ[i+1 : len(typ)-1]; size != "" && size != strconv.Itoa(len(values)) {
+			return nil, fmt.Errorf("expected %s values, got %d", size, len(values))
+		}
+
+		var buf bytes.Buffer
+		for _, v := range values {
+			encoded, err := td.encodeValue(elem, v, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		return keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a struct, got %T", value)
+		}
+
+		encoded, err := td.encodeData(typ, fields, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		typeHash, err := td.TypeHash(typ)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(typeHash, encoded), nil
+	}
+
+	switch {
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case typ == "bytes":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(bz), nil
+
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %T", value)
+		}
+		if b {
+			return leftPad([]byte{1}), nil
+		}
+		return leftPad(nil), nil
+
+	case typ == "address":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != 20 {
+			return nil, fmt.Errorf("expected a 20 bytes address, got %d bytes", len(bz))
+		}
+		return leftPad(bz), nil
+
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		return encodeInteger(typ, value)
+
+	case strings.HasPrefix(typ, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(typ, "bytes"))
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("unknown type %s", typ)
+		}
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", size, len(bz))
+		}
+		res := make([]byte, 32)
+		copy(res, bz)
+		return res, nil
+
+	default:
+		return nil, fmt.Errorf("unknown type %s", typ)
+	}
+}
+
+// encodeInteger encodes an integer of type uintN or intN as a 256 bits two's complement big endian number.
+func encodeInteger(typ string, value any) ([]byte, error) {
+	signed := strings.HasPrefix(typ, "int")
+	bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(typ, "u"), "int"))
+	if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+		return nil, fmt.Errorf("unknown type %s", typ)
+	}
+
+	n, err := parseInteger(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var lowerBound, upperBound *big.Int
+	if signed {
+		upperBound = new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+		lowerBound = new(big.Int).Neg(upperBound)
+	} else {
+		upperBound = new(big.Int).Lsh(big.NewInt(1), uint(bits))
+		lowerBound = new(big.Int)
+	}
+	if n.Cmp(lowerBound) < 0 || n.Cmp(upperBound) >= 0 {
+		return nil, fmt.Errorf("%s overflows %s", n, typ)
+	}
+
+	if n.Sign() < 0 {
+		// two's complement on 256 bits
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+
+	return leftPad(n.Bytes()), nil
+}
+
+func parseInteger(value any) (*big.Int, error) {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case *big.Int:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("expected an integer, got %T", value)
+	}
+
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	return n, nil
+}
+
+func parseBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		if !strings.HasPrefix(v, "0x") && !strings.HasPrefix(v, "0X") {
+			return nil, fmt.Errorf("expected 0x-prefixed hex bytes, got %q", v)
+		}
+		return hex.DecodeString(v[2:])
+	default:
+		return nil, fmt.Errorf("expected bytes, got %T", value)
+	}
+}
+
+// elementType strips the array suffixes of a type.
+func elementType(typ string) string {
+	if i := strings.IndexByte(typ, '['); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
+
+func leftPad(bz []byte) []byte {
+	res := make([]byte, 32)
+	copy(res[32-len(bz):], bz)
+	return res
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,124 @@
+package eip712_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"cosmossdk.io/x/tx/signing/eip712"
+)
+
+// mailTypedData is the example of the EIP-712 specification.
+const mailTypedData = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallet", "type": "address"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person"},
+      {"name": "contents", "type": "string"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": 1,
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+    "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+    "contents": "Hello, Bob!"
+  }
+}`
+
+func keccak256(bz []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(bz)
+	return h.Sum(nil)
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestTypedDataSpecExample(t *testing.T) {
+	var td eip712.TypedData
+	require.NoError(t, json.Unmarshal([]byte(mailTypedData), &td))
+
+	encoded, err := td.EncodeType("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encoded)
+
+	typeHash, err := td.TypeHash("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", hex.EncodeToString(typeHash))
+
+	messageHash, err := td.HashStruct("Mail", td.Message)
+	require.NoError(t, err)
+	require.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(messageHash))
+
+	domainSeparator, err := td.HashStruct("EIP712Domain", td.Domain)
+	require.NoError(t, err)
+	require.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	hash, err := td.Hash()
+	require.NoError(t, err)
+	require.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hash))
+
+	// the signature of the specification, by the private key keccak256("cow")
+	signBytes, err := td.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, hash, keccak256(signBytes))
+
+	privKey := secp256k1.PrivKeyFromBytes(keccak256([]byte("cow")))
+	sig := append(mustHex(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562"), 28)
+	require.True(t, eip712.VerifySignature(privKey.PubKey().SerializeCompressed(), signBytes, sig))
+	require.True(t, eip712.VerifySignature(privKey.PubKey().SerializeUncompressed(), signBytes, sig[:64]))
+
+	// the public key is the one of the "from" wallet
+	require.Equal(t, "cd2a3d9f938e13cd947ec05abc7fe734df8dd826", hex.EncodeToString(keccak256(privKey.PubKey().SerializeUncompressed()[1:])[12:]))
+}
+
+func TestTypedDataErrors(t *testing.T) {
+	var td eip712.TypedData
+	require.NoError(t, json.Unmarshal([]byte(mailTypedData), &td))
+
+	_, err := td.EncodeType("Letter")
+	require.ErrorContains(t, err, "undefined type Letter")
+
+	td.Message["contents"] = 42.0
+	_, err = td.SignBytes()
+	require.ErrorContains(t, err, "Mail.contents: expected a string, got float64")
+
+	td.Message["contents"] = "Hello, Bob!"
+	delete(td.Message, "to")
+	_, err = td.SignBytes()
+	require.ErrorContains(t, err, "Mail has 3 members, got 2 values")
+
+	td.Message["to"] = map[string]any{"name": "Bob", "wallet": "0xbBbB"}
+	_, err = td.SignBytes()
+	require.ErrorContains(t, err, "Person.wallet: expected a 20 bytes address, got 2 bytes")
+
+	td.Types["Mail"] = append(td.Types["Mail"], eip712.Type{Name: "count", Type: "uint8"})
+	td.Message["to"] = map[string]any{"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"}
+	td.Message["count"] = "256"
+	_, err = td.SignBytes()
+	require.ErrorContains(t, err, "256 overflows uint8")
+}
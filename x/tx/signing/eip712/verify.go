@@ -0,0 +1,36 @@
+package eip712
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// VerifySignature verifies an Ethereum style secp256k1 signature of the keccak256 hash of the sign bytes,
+// as produced by eth_signTypedData_v4 or by an eth_secp256k1 key.
+//
+// The public key is a compressed or uncompressed secp256k1 public key. The signature is the 64 bytes
+// R ‖ S, optionally followed by the recovery ID V, which is ignored. Like secp256k1 signatures of the SDK,
+// signatures with a high S are rejected as malleable.
+func VerifySignature(pubKey, signBytes, sig []byte) bool {
+	if len(sig) == 65 {
+		sig = sig[:64]
+	}
+	if len(sig) != 64 {
+		return false
+	}
+
+	pk, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sig[:32]); overflow || r.IsZero() {
+		return false
+	}
+	if overflow := s.SetByteSlice(sig[32:]); overflow || s.IsZero() || s.IsOverHalfOrder() {
+		return false
+	}
+
+	return ecdsa.NewSignature(&r, &s).Verify(keccak256(signBytes), pk)
+}
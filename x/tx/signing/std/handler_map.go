@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip712"
 	"cosmossdk.io/x/tx/signing/textual"
 )
 
@@ -16,6 +17,8 @@ type SignModeOptions struct {
 	DirectAux directaux.SignModeHandlerOptions
 	// AminoJSON are options for SIGN_MODE_LEGACY_AMINO_JSON
 	AminoJSON aminojson.SignModeHandlerOptions
+	// EIP712 are options for SIGN_MODE_EIP_712
+	EIP712 eip712.SignModeHandlerOptions
 }
 
 // HandlerMap returns a sign mode handler map that Cosmos SDK apps can use out
@@ -32,11 +35,13 @@ func (s SignModeOptions) HandlerMap() (*signing.HandlerMap, error) {
 	}
 
 	aminoJSON := aminojson.NewSignModeHandler(s.AminoJSON)
+	eip712Handler := eip712.NewSignModeHandler(s.EIP712)
 
 	return signing.NewHandlerMap(
 		direct.SignModeHandler{},
 		txt,
 		directAux,
 		aminoJSON,
+		eip712Handler,
 	), nil
 }
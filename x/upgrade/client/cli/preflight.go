@@ -0,0 +1,446 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	FlagForkDir  = "fork-dir"
+	FlagKeepFork = "keep-fork"
+
+	applicationDBDir = "application.db"
+)
+
+// PreflightApplication defines the application methods required to rehearse
+// an upgrade with the preflight command.
+type PreflightApplication interface {
+	servertypes.Application
+
+	// NewUncachedContext returns a context writing directly to the application's
+	// commit multi store.
+	NewUncachedContext(isCheckTx bool, header cmtproto.Header) sdk.Context
+	// GetUpgradeKeeper returns the application's x/upgrade keeper.
+	GetUpgradeKeeper() *keeper.Keeper
+}
+
+// ModuleVersionChange is the consensus version change of a module during an upgrade.
+// A zero version means the module did not exist before, respectively after, the upgrade.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// PreflightReport is the outcome of an upgrade preflight.
+type PreflightReport struct {
+	Plan           string                `json:"plan"`
+	Height         int64                 `json:"height"`
+	VersionChanges []ModuleVersionChange `json:"version_changes"`
+	Duration       time.Duration         `json:"duration"`
+	StoreSizeDelta int64                 `json:"store_size_delta"`
+	Panic          string                `json:"panic,omitempty"`
+	Error          string                `json:"error,omitempty"`
+}
+
+// Cmd returns the upgrade group command, gathering the upgrade commands run
+// against the node's local data.
+func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Rehearse software upgrades against local state",
+	}
+	cmd.AddCommand(
+		PreflightCmd(appCreator),
+	)
+	return cmd
+}
+
+// PreflightCmd returns a command running the upgrade handler of a plan and the
+// module migrations it triggers against a copy of the latest committed state.
+func PreflightCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preflight <plan-name>",
+		Short: "Rehearse an upgrade against a copy of the latest committed state",
+		Long: `Rehearse an upgrade against a copy of the latest committed state.
+The application database is copied into a temporary directory, the upgrade handler registered
+by this binary for the given plan is run on top of it, as it would be at the upgrade height,
+and the result is committed to the copy. The production data is left untouched.
+
+The command reports the consensus version changes of every module, the time spent running
+the upgrade handler, the size delta of the application database and any panic raised.
+Store upgrades set up by the application from the upgrade info file are applied as well.
+
+The node should be stopped, so that the copied database is consistent.`,
+		Example: fmt.Sprintf("%s upgrade preflight v2 --output json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// failures are reported with the preflight results
+			cmd.SilenceUsage = true
+
+			cfg := client.GetConfigFromCmd(cmd)
+			viper := client.GetViperFromCmd(cmd)
+
+			forkParent, err := cmd.Flags().GetString(FlagForkDir)
+			if err != nil {
+				return err
+			}
+			keepFork, err := cmd.Flags().GetBool(FlagKeepFork)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			forkHome, err := os.MkdirTemp(forkParent, "preflight-")
+			if err != nil {
+				return err
+			}
+			if keepFork {
+				defer cmd.Printf("forked state kept in %s\n", forkHome)
+			} else {
+				defer os.RemoveAll(forkHome)
+			}
+
+			src := filepath.Join(cfg.RootDir, "data", applicationDBDir)
+			dst := filepath.Join(forkHome, "data", applicationDBDir)
+			if err := copyDir(src, dst); err != nil {
+				return fmt.Errorf("failed to fork application state: %w", err)
+			}
+			// the application may read the genesis from its home when being created
+			forkGenesis := filepath.Join(forkHome, "config", filepath.Base(cfg.GenesisFile()))
+			if err := os.MkdirAll(filepath.Dir(forkGenesis), 0o700); err != nil {
+				return err
+			}
+			if err := copyFile(cfg.GenesisFile(), forkGenesis); err != nil {
+				return fmt.Errorf("failed to fork genesis: %w", err)
+			}
+
+			db, err := openDB(forkHome, server.GetAppDBBackend(viper))
+			if err != nil {
+				return err
+			}
+			height := rootmulti.GetLatestVersion(db) + 1
+
+			// the upgrade info file lets the application set up its store loader
+			// with the store upgrades of the plan, as it would at the upgrade height
+			if err := writeUpgradeInfo(forkHome, types.Plan{Name: args[0], Height: height}); err != nil {
+				return err
+			}
+
+			sizeBefore, err := dirSize(dst)
+			if err != nil {
+				return err
+			}
+
+			// the application reads its home, including the upgrade info file, from the fork
+			viper.Set(flags.FlagHome, forkHome)
+			report := &PreflightReport{Plan: args[0], Height: height}
+			app, err := createApp(appCreator, log.NewLogger(cmd.ErrOrStderr()), db, viper, report)
+			if err != nil {
+				return err
+			}
+			if app != nil {
+				err = runPreflight(app, header.Info{
+					Height:  height,
+					Time:    time.Now().UTC(),
+					ChainID: appGenesis.ChainID,
+				}, report)
+				if closeErr := app.Close(); closeErr != nil && err == nil {
+					err = closeErr
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			sizeAfter, err := dirSize(dst)
+			if err != nil {
+				return err
+			}
+			report.StoreSizeDelta = sizeAfter - sizeBefore
+
+			if err := printReport(cmd.OutOrStdout(), output, report); err != nil {
+				return err
+			}
+			if report.Error != "" {
+				return fmt.Errorf("upgrade %s failed preflight: %s", report.Plan, report.Error)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagForkDir, "", "Directory in which the state is forked (defaults to the OS temporary directory)")
+	cmd.Flags().Bool(FlagKeepFork, false, "Keep the forked state after the preflight for inspection")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// createApp creates the application on top of the forked state. Loading the
+// store upgrades of the plan may panic, in which case the panic is recorded in
+// the report and no application is returned.
+func createApp[T servertypes.Application](
+	appCreator servertypes.AppCreator[T],
+	logger log.Logger,
+	db corestore.KVStoreWithBatch,
+	appOpts servertypes.AppOptions,
+	report *PreflightReport,
+) (app PreflightApplication, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			report.Panic = fmt.Sprintf("%v\n%s", r, debug.Stack())
+			report.Error = fmt.Sprintf("failed to load the application: %v", r)
+			app, err = nil, nil
+		}
+	}()
+
+	app, ok := any(appCreator(logger, db, nil, appOpts)).(PreflightApplication)
+	if !ok {
+		return nil, errors.New("the application does not support upgrade preflight")
+	}
+	return app, nil
+}
+
+// runPreflight applies the upgrade of the report on the application state and
+// commits it. Failures of the upgrade handler, including panics, are recorded
+// in the report.
+func runPreflight(app PreflightApplication, headerInfo header.Info, report *PreflightReport) error {
+	upgradeKeeper := app.GetUpgradeKeeper()
+	if !upgradeKeeper.HasHandler(report.Plan) {
+		return fmt.Errorf("no upgrade handler registered for plan %s in this binary", report.Plan)
+	}
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: headerInfo.ChainID,
+		Height:  headerInfo.Height,
+		Time:    headerInfo.Time,
+	}).WithHeaderInfo(headerInfo)
+
+	doneHeight, err := upgradeKeeper.GetDoneHeight(ctx, report.Plan)
+	if err != nil {
+		return err
+	}
+	if doneHeight != 0 {
+		return fmt.Errorf("upgrade %s was already applied at height %d", report.Plan, doneHeight)
+	}
+
+	// use the scheduled plan if it matches, so that the handler sees its info
+	plan, err := upgradeKeeper.GetUpgradePlan(ctx)
+	if err != nil && !errors.Is(err, types.ErrNoUpgradePlanFound) {
+		return err
+	}
+	if plan.Name != report.Plan {
+		plan = types.Plan{Name: report.Plan}
+	}
+	plan.Height = headerInfo.Height
+
+	fromVM, err := upgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				report.Panic = fmt.Sprintf("%v\n%s", r, debug.Stack())
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return upgradeKeeper.ApplyUpgrade(ctx, plan)
+	}()
+	report.Duration = time.Since(start)
+	if err != nil {
+		report.Error = err.Error()
+		return nil
+	}
+
+	toVM, err := upgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return err
+	}
+	report.VersionChanges = versionChanges(fromVM, toVM)
+
+	app.CommitMultiStore().Commit()
+	return nil
+}
+
+// versionChanges returns the modules whose consensus version differs between
+// the two version maps, sorted by module name.
+func versionChanges(from, to appmodule.VersionMap) []ModuleVersionChange {
+	changes := []ModuleVersionChange{}
+	for module, toVersion := range to {
+		if fromVersion := from[module]; fromVersion != toVersion {
+			changes = append(changes, ModuleVersionChange{Module: module, From: fromVersion, To: toVersion})
+		}
+	}
+	for module, fromVersion := range from {
+		if _, ok := to[module]; !ok {
+			changes = append(changes, ModuleVersionChange{Module: module, From: fromVersion})
+		}
+	}
+	slices.SortFunc(changes, func(a, b ModuleVersionChange) int {
+		switch {
+		case a.Module < b.Module:
+			return -1
+		case a.Module > b.Module:
+			return 1
+		}
+		return 0
+	})
+	return changes
+}
+
+func printReport(w io.Writer, output string, report *PreflightReport) error {
+	if output == flags.OutputFormatJSON {
+		bz, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bz))
+		return err
+	}
+
+	fmt.Fprintf(w, "upgrade %s at height %d\n", report.Plan, report.Height)
+	fmt.Fprintf(w, "upgrade handler duration: %s\n", report.Duration)
+	if report.Error != "" {
+		fmt.Fprintf(w, "error: %s\n", report.Error)
+		if report.Panic != "" {
+			fmt.Fprintf(w, "panic: %s\n", report.Panic)
+		}
+		return nil
+	}
+
+	fmt.Fprintf(w, "store size delta: %+d bytes\n", report.StoreSizeDelta)
+	if len(report.VersionChanges) == 0 {
+		fmt.Fprintln(w, "no module consensus version changes")
+		return nil
+	}
+	fmt.Fprintln(w, "module consensus version changes:")
+	for _, change := range report.VersionChanges {
+		switch {
+		case change.From == 0:
+			fmt.Fprintf(w, "  %s: added at %d\n", change.Module, change.To)
+		case change.To == 0:
+			fmt.Fprintf(w, "  %s: removed from %d\n", change.Module, change.From)
+		default:
+			fmt.Fprintf(w, "  %s: %d -> %d\n", change.Module, change.From, change.To)
+		}
+	}
+	return nil
+}
+
+func openDB(rootDir string, backendType dbm.BackendType) (corestore.KVStoreWithBatch, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("application", backendType, dataDir)
+}
+
+func writeUpgradeInfo(home string, plan types.Plan) error {
+	dataDir := filepath.Join(home, "data")
+	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dataDir, types.UpgradeInfoFilename), bz, 0o600)
+}
+
+// copyDir recursively copies the regular files of src into dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// dirSize returns the total size of the regular files in dir.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
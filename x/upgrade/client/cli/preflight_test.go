@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+)
+
+func TestVersionChanges(t *testing.T) {
+	from := appmodule.VersionMap{"auth": 5, "bank": 4, "crisis": 2, "mint": 3}
+	to := appmodule.VersionMap{"auth": 5, "bank": 5, "epochs": 1, "mint": 4}
+
+	require.Equal(t, []ModuleVersionChange{
+		{Module: "bank", From: 4, To: 5},
+		{Module: "crisis", From: 2},
+		{Module: "epochs", To: 1},
+		{Module: "mint", From: 3, To: 4},
+	}, versionChanges(from, to))
+	require.Empty(t, versionChanges(from, from))
+}
+
+func TestCopyDir(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a"), []byte("hello"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b"), []byte("world!"), 0o600))
+
+	dst := filepath.Join(t.TempDir(), "fork")
+	require.NoError(t, copyDir(src, dst))
+
+	bz, err := os.ReadFile(filepath.Join(dst, "sub", "b"))
+	require.NoError(t, err)
+	require.Equal(t, "world!", string(bz))
+
+	size, err := dirSize(dst)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), size)
+}
@@ -23,6 +23,7 @@ const (
 	FlagUpgradeInfo        = "upgrade-info"
 	FlagNoValidate         = "no-validate"
 	FlagNoChecksumRequired = "no-checksum-required"
+	FlagSignatureRequired  = "signature-required"
 	FlagDaemonName         = "daemon-name"
 	FlagAuthority          = "authority"
 )
@@ -84,8 +85,13 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 					return err
 				}
 
+				signatureRequired, err := cmd.Flags().GetBool(FlagSignatureRequired)
+				if err != nil {
+					return err
+				}
+
 				var planInfo *plan.Info
-				if planInfo, err = plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(!noChecksum)); err != nil {
+				if planInfo, err = plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(!noChecksum), plan.ParseOptionEnforceSignature(signatureRequired)); err != nil {
 					return err
 				}
 
@@ -122,6 +128,7 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().String(FlagUpgradeInfo, "", "Info for the upgrade plan such as new version download urls, etc.")
 	cmd.Flags().Bool(FlagNoValidate, false, "Skip validation of the upgrade info (dangerous!)")
 	cmd.Flags().Bool(FlagNoChecksumRequired, false, "Skip requirement of checksums for binaries in the upgrade info")
+	cmd.Flags().Bool(FlagSignatureRequired, false, "Require a publisher public key and signatures for binaries in the upgrade info")
 	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded (for upgrade-info validation). Default is the DAEMON_NAME env var if set, or else this executable")
 	cmd.Flags().String(FlagAuthority, "", "The address of the upgrade module authority (defaults to gov)")
 
@@ -23,8 +23,15 @@ import (
 //	If the archive does not contain either /bin/{daemonName} or /{daemonName}, an error is returned.
 //
 // If dstRoot already exists, some or all of its contents might be updated.
+// When a signature is to be verified (see DownloadOptionVerifySignature), it is checked against {dstRoot}/bin/{daemonName},
+// which is removed if the signature doesn't verify.
 // NOTE: This functions does not check the provided url for validity.
-func DownloadUpgrade(dstRoot, url, daemonName string) error {
+func DownloadUpgrade(dstRoot, url, daemonName string, opts ...DownloadOption) error {
+	downloadConfig := &DownloadConfig{}
+	for _, opt := range opts {
+		opt(downloadConfig)
+	}
+
 	target := filepath.Join(dstRoot, "bin", daemonName)
 
 	// First try to download it as a single file. If there's no error, it's okay and we're done.
@@ -40,7 +47,54 @@ func DownloadUpgrade(dstRoot, url, daemonName string) error {
 			return err
 		}
 	}
-	return EnsureBinary(target)
+	if err := EnsureBinary(target); err != nil {
+		return err
+	}
+	if downloadConfig.VerifySignature {
+		if err := verifyBinary(target, downloadConfig.PublicKey, downloadConfig.Signature); err != nil {
+			if rerr := os.Remove(target); rerr != nil {
+				return errors.Join(err, rerr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// DownloadConfig is used to configure the download of an upgrade binary.
+type DownloadConfig struct {
+	// VerifySignature, if true, will cause the downloaded binary to be checked against Signature with PublicKey.
+	VerifySignature bool
+	// PublicKey is the publisher public key, see ParsePublicKey.
+	PublicKey string
+	// Signature is the detached signature of the binary, see PublicKey.Verify.
+	Signature string
+}
+
+// DownloadOption is used to configure the download of an upgrade binary.
+type DownloadOption func(*DownloadConfig)
+
+// DownloadOptionVerifySignature returns a DownloadOption that verifies the downloaded binary against the given
+// detached signature with the given publisher public key.
+func DownloadOptionVerifySignature(publicKey, signature string) DownloadOption {
+	return func(c *DownloadConfig) {
+		c.VerifySignature = true
+		c.PublicKey = publicKey
+		c.Signature = signature
+	}
+}
+
+// verifyBinary checks the detached signature of the binary at the given path.
+func verifyBinary(path, publicKey, signature string) error {
+	pk, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return fmt.Errorf("invalid publisher public key: %w", err)
+	}
+	if err := pk.VerifyFile(path, signature); err != nil {
+		_, f := filepath.Split(path)
+		return fmt.Errorf("could not verify signature of %s: %w", f, err)
+	}
+	return nil
 }
 
 // downloadUpgradeAsArchive tries to download the given url as an archive.
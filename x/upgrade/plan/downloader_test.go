@@ -214,6 +214,43 @@ func (s *DownloaderTestSuite) TestDownloadUpgrade() {
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "result does not contain a bin/not-expected or not-expected file")
 	})
+
+	signer := newTestSigner(s.T(), 1)
+
+	s.T().Run("signed single file", func(t *testing.T) {
+		dstRoot := getDstDir(t.Name())
+		url := makeFileURL(t, justAFilePath)
+		sig := signer.minisignSignature(justAFile.Contents, minisignAlgEdPrehashed)
+		err := DownloadUpgrade(dstRoot, url, justAFile.Name, DownloadOptionVerifySignature(signer.minisignPublicKey(), sig))
+		require.NoError(t, err)
+		requireFileEquals(t, filepath.Join(dstRoot, "bin", justAFile.Name), justAFile)
+	})
+
+	s.T().Run("signed archive", func(t *testing.T) {
+		dstRoot := getDstDir(t.Name())
+		url := makeFileURL(t, someFileInBinZip)
+		sig := signer.rawSignature(someFileInBin.Contents)
+		err := DownloadUpgrade(dstRoot, url, someFileName, DownloadOptionVerifySignature(signer.rawPublicKey(), sig))
+		require.NoError(t, err)
+		requireFileEquals(t, filepath.Join(dstRoot, "bin", someFileName), someFileInBin)
+	})
+
+	s.T().Run("signature does not verify", func(t *testing.T) {
+		dstRoot := getDstDir(t.Name())
+		url := makeFileURL(t, justAFilePath)
+		sig := signer.minisignSignature(anotherFile.Contents, minisignAlgEdPrehashed)
+		err := DownloadUpgrade(dstRoot, url, justAFile.Name, DownloadOptionVerifySignature(signer.minisignPublicKey(), sig))
+		require.ErrorContains(t, err, "could not verify signature of just-a-file: invalid signature")
+		assert.NoFileExists(t, filepath.Join(dstRoot, "bin", justAFile.Name))
+	})
+
+	s.T().Run("missing signature", func(t *testing.T) {
+		dstRoot := getDstDir(t.Name())
+		url := makeFileURL(t, justAFilePath)
+		err := DownloadUpgrade(dstRoot, url, justAFile.Name, DownloadOptionVerifySignature(signer.minisignPublicKey(), ""))
+		require.ErrorContains(t, err, "neither an ed25519 nor a minisign signature")
+		assert.NoFileExists(t, filepath.Join(dstRoot, "bin", justAFile.Name))
+	})
 }
 
 func (s *DownloaderTestSuite) TestEnsureBinary() {
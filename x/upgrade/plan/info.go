@@ -18,6 +18,11 @@ type Info struct {
 	parseConfig ParseConfig
 
 	Binaries BinaryDownloadURLMap `json:"binaries"`
+	// PublicKey is the optional publisher public key, either a base64 encoded ed25519 public key or a minisign public key.
+	// When set, all binaries must have a detached signature made with the corresponding private key.
+	PublicKey string `json:"public_key,omitempty"`
+	// Signatures are the detached signatures of the binaries, by os/arch string.
+	Signatures BinarySignatureMap `json:"signatures,omitempty"`
 }
 
 // BinaryDownloadURLMap is a map of os/architecture strings to a URL where the binary can be downloaded.
@@ -28,6 +33,9 @@ type ParseConfig struct {
 	// EnforceChecksum, if true, will cause all downloaded files to be checked against their checksums.
 	// When false, checksums are not enforced to be present in the url.
 	EnforceChecksum bool
+	// EnforceSignature, if true, will cause all downloaded binaries to be checked against their signatures.
+	// When false, a publisher public key is not required to be present in the plan info.
+	EnforceSignature bool
 }
 
 // ParseOption is used to configure the parsing of a Plan.Info string.
@@ -40,6 +48,13 @@ func ParseOptionEnforceChecksum(enforce bool) ParseOption {
 	}
 }
 
+// ParseOptionEnforceSignature returns a ParseOption that sets the EnforceSignature field of the ParseConfig.
+func ParseOptionEnforceSignature(enforce bool) ParseOption {
+	return func(c *ParseConfig) {
+		c.EnforceSignature = enforce
+	}
+}
+
 // ParseInfo parses an info string into a map of os/arch strings to URL string.
 // If the infoStr is a url, an GET request will be made to it, and its response will be parsed instead.
 func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
@@ -80,19 +95,66 @@ func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 // The provided daemonName is the name of the executable file expected in all downloaded directories.
 // It checks that:
 //   - Binaries.ValidateBasic() doesn't return an error
-//   - Binaries.CheckURLs(daemonName) doesn't return an error.
+//   - ValidateSignatures() doesn't return an error
+//   - Binaries.CheckURLs(daemonName) doesn't return an error, all binaries being verified against their signatures.
 //
 // Warning: This is an expensive process. See BinaryDownloadURLMap.CheckURLs for more info.
 func (m Info) ValidateFull(daemonName string) error {
 	if err := m.Binaries.ValidateBasic(m.parseConfig.EnforceChecksum); err != nil {
 		return err
 	}
-	if err := m.Binaries.CheckURLs(daemonName, m.parseConfig.EnforceChecksum); err != nil {
+	if err := m.ValidateSignatures(); err != nil {
 		return err
 	}
+	if err := m.Binaries.checkURLs(daemonName, m.parseConfig.EnforceChecksum, m.DownloadOptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateSignatures does stateless validation of the publisher public key and binary signatures of this Info.
+// It validates that:
+//   - When the EnforceSignature parse option is set, a public key is present.
+//   - The public key, if any, is a valid ed25519 or minisign public key.
+//   - When there is a public key, all binaries have a signature, and no signature is present without a public key.
+//   - All signatures are for one of the binaries.
+func (m Info) ValidateSignatures() error {
+	if len(m.PublicKey) == 0 {
+		if m.parseConfig.EnforceSignature {
+			return errors.New("missing publisher public key")
+		}
+		if len(m.Signatures) != 0 {
+			return errors.New("binary signatures require a publisher public key")
+		}
+		return nil
+	}
+
+	if _, err := ParsePublicKey(m.PublicKey); err != nil {
+		return fmt.Errorf("invalid publisher public key: %w", err)
+	}
+	for key := range m.Binaries {
+		if len(strings.TrimSpace(m.Signatures[key])) == 0 {
+			return fmt.Errorf("missing signature for binaries[%s]", key)
+		}
+	}
+	for key := range m.Signatures {
+		if _, ok := m.Binaries[key]; !ok {
+			return fmt.Errorf("signature for unknown binaries[%s]", key)
+		}
+	}
+
 	return nil
 }
 
+// DownloadOptions returns the options to pass to DownloadUpgrade for the binary of the given os/arch string.
+// If this Info has a publisher public key, the downloaded binary is verified against its signature.
+func (m Info) DownloadOptions(osArch string) []DownloadOption {
+	if len(m.PublicKey) == 0 {
+		return nil
+	}
+	return []DownloadOption{DownloadOptionVerifySignature(m.PublicKey, m.Signatures[osArch])}
+}
+
 // ValidateBasic does stateless validation of this BinaryDownloadURLMap.
 // It validates that:
 //   - This has at least one entry.
@@ -124,6 +186,11 @@ func (m BinaryDownloadURLMap) ValidateBasic(enforceChecksum bool) error {
 // Warning: This is an expensive process.
 // It will make an HTTP GET request to each URL and download the response.
 func (m BinaryDownloadURLMap) CheckURLs(daemonName string, enforceChecksum bool) error {
+	return m.checkURLs(daemonName, enforceChecksum, nil)
+}
+
+// checkURLs is CheckURLs, downloading each binary with the options returned by downloadOpts, if any.
+func (m BinaryDownloadURLMap) checkURLs(daemonName string, enforceChecksum bool, downloadOpts func(osArch string) []DownloadOption) error {
 	tempDir, err := os.MkdirTemp("", "os-arch-downloads")
 	if err != nil {
 		return fmt.Errorf("could not create temp directory: %w", err)
@@ -135,7 +202,11 @@ func (m BinaryDownloadURLMap) CheckURLs(daemonName string, enforceChecksum bool)
 			return fmt.Errorf("error validating url for os/arch %s: %w", osArch, err)
 		}
 
-		if err = DownloadUpgrade(dstRoot, url, daemonName); err != nil {
+		var opts []DownloadOption
+		if downloadOpts != nil {
+			opts = downloadOpts(osArch)
+		}
+		if err = DownloadUpgrade(dstRoot, url, daemonName, opts...); err != nil {
 			return fmt.Errorf("error downloading binary for os/arch %s: %w", osArch, err)
 		}
 	}
@@ -144,6 +144,11 @@ func (s *InfoTestSuite) TestInfoValidateFull() {
 	linux386Path := s.saveTestFile(linux386File)
 	darwinAMD64URL := makeFileURL(s.T(), darwinAMD64Path)
 	linux386URL := makeFileURL(s.T(), linux386Path)
+	signer := newTestSigner(s.T(), 1)
+	goodSignatures := BinarySignatureMap{
+		"darwin/amd64": signer.minisignSignature(darwinAMD64File.Contents, minisignAlgEdPrehashed),
+		"linux/386":    signer.minisignSignature(linux386File.Contents, minisignAlgEdPrehashed),
+	}
 
 	tests := []struct {
 		name     string
@@ -167,7 +172,72 @@ func (s *InfoTestSuite) TestInfoValidateFull() {
 			planInfo: &Info{Binaries: BinaryDownloadURLMap{}},
 			errs:     []string{"no \"binaries\" entries found"},
 		},
+		{
+			name: "two good signed entries",
+			planInfo: &Info{
+				Binaries: BinaryDownloadURLMap{
+					"darwin/amd64": darwinAMD64URL,
+					"linux/386":    linux386URL,
+				},
+				PublicKey:  signer.minisignPublicKey(),
+				Signatures: goodSignatures,
+			},
+			errs: nil,
+		},
+		// failures from Info.ValidateSignatures
+		{
+			name: "signature enforced without public key",
+			planInfo: &Info{
+				parseConfig: ParseConfig{EnforceSignature: true},
+				Binaries:    BinaryDownloadURLMap{"darwin/amd64": darwinAMD64URL},
+			},
+			errs: []string{"missing publisher public key"},
+		},
+		{
+			name: "signatures without public key",
+			planInfo: &Info{
+				Binaries:   BinaryDownloadURLMap{"darwin/amd64": darwinAMD64URL},
+				Signatures: BinarySignatureMap{"darwin/amd64": goodSignatures["darwin/amd64"]},
+			},
+			errs: []string{"binary signatures require a publisher public key"},
+		},
+		{
+			name: "missing signature",
+			planInfo: &Info{
+				Binaries: BinaryDownloadURLMap{
+					"darwin/amd64": darwinAMD64URL,
+					"linux/386":    linux386URL,
+				},
+				PublicKey:  signer.minisignPublicKey(),
+				Signatures: BinarySignatureMap{"darwin/amd64": goodSignatures["darwin/amd64"]},
+			},
+			errs: []string{"missing signature for binaries[linux/386]"},
+		},
+		{
+			name: "signature for unknown binary",
+			planInfo: &Info{
+				Binaries:   BinaryDownloadURLMap{"darwin/amd64": darwinAMD64URL},
+				PublicKey:  signer.minisignPublicKey(),
+				Signatures: goodSignatures,
+			},
+			errs: []string{"signature for unknown binaries[linux/386]"},
+		},
 		// a failure from BinaryDownloadURLMap.CheckURLS
+		{
+			name: "signature does not verify",
+			planInfo: &Info{
+				Binaries: BinaryDownloadURLMap{
+					"darwin/amd64": darwinAMD64URL,
+					"linux/386":    linux386URL,
+				},
+				PublicKey: signer.minisignPublicKey(),
+				Signatures: BinarySignatureMap{
+					"darwin/amd64": signer.minisignSignature([]byte("malware"), minisignAlgEdPrehashed),
+					"linux/386":    goodSignatures["linux/386"],
+				},
+			},
+			errs: []string{"error downloading binary", "darwin/amd64", "invalid signature"},
+		},
 		{
 			name: "url does not exist",
 			planInfo: &Info{
@@ -0,0 +1,148 @@
+package plan
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// minisignAlgEd is the minisign signature algorithm signing the file itself.
+	minisignAlgEd = "Ed"
+	// minisignAlgEdPrehashed is the minisign signature algorithm signing the
+	// BLAKE2b-512 hash of the file. It is the default since minisign 0.10.
+	minisignAlgEdPrehashed = "ED"
+
+	minisignKeyIDLen      = 8
+	minisignPublicKeyLen  = 2 + minisignKeyIDLen + ed25519.PublicKeySize
+	minisignSignatureLen  = 2 + minisignKeyIDLen + ed25519.SignatureSize
+	minisignTrustedPrefix = "trusted comment: "
+)
+
+// BinarySignatureMap is a map of os/architecture strings to the detached signature of the binary downloaded for it.
+type BinarySignatureMap map[string]string
+
+// PublicKey is a publisher public key used to verify the detached signatures of upgrade binaries.
+type PublicKey struct {
+	// keyID is the minisign key id, it is empty for raw ed25519 public keys.
+	keyID []byte
+	key   ed25519.PublicKey
+}
+
+// ParsePublicKey parses a publisher public key.
+// It can be either a base64 encoded raw ed25519 public key, or a minisign public key,
+// with or without its untrusted comment line.
+func ParsePublicKey(s string) (*PublicKey, error) {
+	lines := nonEmptyLines(s)
+	if len(lines) == 0 {
+		return nil, errors.New("public key must not be blank")
+	}
+
+	// The key is on the last line, after the optional untrusted comment.
+	bz, err := base64.StdEncoding.DecodeString(lines[len(lines)-1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key encoding: %w", err)
+	}
+
+	switch {
+	case len(bz) == ed25519.PublicKeySize:
+		return &PublicKey{key: bz}, nil
+	case len(bz) == minisignPublicKeyLen && string(bz[:2]) == minisignAlgEd:
+		return &PublicKey{keyID: bz[2 : 2+minisignKeyIDLen], key: bz[2+minisignKeyIDLen:]}, nil
+	default:
+		return nil, errors.New("public key is neither an ed25519 nor a minisign public key")
+	}
+}
+
+// Verify checks the detached signature of the given data.
+// The signature can be either a base64 encoded raw ed25519 signature, or the content of a minisign signature file.
+func (pk PublicKey) Verify(data []byte, signature string) error {
+	lines := nonEmptyLines(signature)
+	switch len(lines) {
+	case 1:
+		sig, err := base64.StdEncoding.DecodeString(lines[0])
+		if err != nil {
+			return fmt.Errorf("invalid signature encoding: %w", err)
+		}
+		if len(sig) != ed25519.SignatureSize {
+			return errors.New("invalid ed25519 signature length")
+		}
+		if !ed25519.Verify(pk.key, data, sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case 4:
+		return pk.verifyMinisign(data, lines)
+	default:
+		return errors.New("signature is neither an ed25519 nor a minisign signature")
+	}
+}
+
+// VerifyFile checks the detached signature of the file at the given path.
+func (pk PublicKey) VerifyFile(path, signature string) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return pk.Verify(bz, signature)
+}
+
+// verifyMinisign checks a minisign signature, made of an untrusted comment, the signature,
+// a trusted comment and the global signature over the signature and the trusted comment.
+func (pk PublicKey) verifyMinisign(data []byte, lines []string) error {
+	sig, err := base64.StdEncoding.DecodeString(lines[1])
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if len(sig) != minisignSignatureLen {
+		return errors.New("invalid minisign signature length")
+	}
+
+	alg, keyID, sig := string(sig[:2]), sig[2:2+minisignKeyIDLen], sig[2+minisignKeyIDLen:]
+	if pk.keyID != nil && !bytes.Equal(keyID, pk.keyID) {
+		return fmt.Errorf("signature key id %X does not match public key id %X", keyID, pk.keyID)
+	}
+
+	switch alg {
+	case minisignAlgEd:
+	case minisignAlgEdPrehashed:
+		hash := blake2b.Sum512(data)
+		data = hash[:]
+	default:
+		return fmt.Errorf("unsupported minisign signature algorithm %q", alg)
+	}
+	if !ed25519.Verify(pk.key, data, sig) {
+		return errors.New("invalid signature")
+	}
+
+	trustedComment, ok := strings.CutPrefix(lines[2], minisignTrustedPrefix)
+	if !ok {
+		return errors.New("missing minisign trusted comment")
+	}
+	globalSig, err := base64.StdEncoding.DecodeString(lines[3])
+	if err != nil {
+		return fmt.Errorf("invalid global signature encoding: %w", err)
+	}
+	if !ed25519.Verify(pk.key, append(bytes.Clone(sig), trustedComment...), globalSig) {
+		return errors.New("invalid global signature")
+	}
+
+	return nil
+}
+
+// nonEmptyLines returns the trimmed non-empty lines of s.
+func nonEmptyLines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); len(line) != 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}